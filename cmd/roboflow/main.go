@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/tuanvumaihuynh/roboflow/cmd/roboflow_api/api"
+	"github.com/tuanvumaihuynh/roboflow/cmd/roboflow_api/worker"
 	"github.com/tuanvumaihuynh/roboflow/internal/application"
 	"github.com/tuanvumaihuynh/roboflow/pkg/cmdutils"
 )
@@ -40,5 +41,11 @@ func run() {
 		}
 	}()
 
+	go func() {
+		if err := worker.Start(app, interruptChan); err != nil {
+			log.Fatalf("error starting worker: %v", err)
+		}
+	}()
+
 	<-interruptChan
 }
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+)
+
+var (
+	workflowServerURL string
+	workflowOutput    string
+	workflowFormat    string
+)
+
+var workflowCmd = &cobra.Command{
+	Use:   "workflow",
+	Short: "Manage the workflows of a running Roboflow server.",
+}
+
+var workflowExportCmd = &cobra.Command{
+	Use:   "export <workflow-id>",
+	Short: "Export a workflow to a portable JSON or YAML document.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format := workflowFormat
+		if format == "" {
+			format = formatFromPath(workflowOutput)
+		}
+
+		endpoint := fmt.Sprintf("%s/api/v1/workflows/%s/export?format=%s",
+			strings.TrimSuffix(workflowServerURL, "/"), url.PathEscape(args[0]), url.QueryEscape(format))
+		body, err := doRequest(http.MethodGet, endpoint, "", nil)
+		if err != nil {
+			return fmt.Errorf("export workflow: %w", err)
+		}
+
+		if workflowOutput == "" {
+			_, err = cmd.OutOrStdout().Write(body)
+			return err
+		}
+
+		if err := os.WriteFile(workflowOutput, body, 0o644); err != nil {
+			return fmt.Errorf("write document: %w", err)
+		}
+
+		return nil
+	},
+}
+
+var workflowImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import a workflow from a document created by the export command.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		doc, err := os.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("read document: %w", err)
+		}
+
+		contentType := "application/json"
+		if formatFromPath(args[0]) == "yaml" {
+			contentType = "application/yaml"
+		}
+
+		endpoint := fmt.Sprintf("%s/api/v1/workflows/import", strings.TrimSuffix(workflowServerURL, "/"))
+		body, err := doRequest(http.MethodPost, endpoint, contentType, bytes.NewReader(doc))
+		if err != nil {
+			return fmt.Errorf("import workflow: %w", err)
+		}
+
+		var res gen.ImportWorkflowResponse
+		if err := json.Unmarshal(body, &res); err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Imported workflow %q with id %s\n", res.Workflow.Name, res.Workflow.Id)
+		if len(res.MissingQrLocations) > 0 {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Missing QR locations: %s\n", strings.Join(res.MissingQrLocations, ", "))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	workflowCmd.PersistentFlags().StringVar(&workflowServerURL, "server", "http://localhost:8080", "URL of the Roboflow server")
+	workflowExportCmd.Flags().StringVarP(&workflowOutput, "output", "o", "", "file to write the document to, defaults to stdout")
+	workflowExportCmd.Flags().StringVar(&workflowFormat, "format", "", "format of the document, json or yaml, defaults to the extension of the output file")
+
+	workflowCmd.AddCommand(workflowExportCmd, workflowImportCmd)
+	rootCmd.AddCommand(workflowCmd)
+}
+
+// formatFromPath returns yaml for .yaml and .yml files and json otherwise.
+func formatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return "yaml"
+	default:
+		return "json"
+	}
+}
+
+// doRequest sends a request to the API and returns the body of a successful
+// response. Error responses are returned as errors.
+func doRequest(method, endpoint, contentType string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("new request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var errRes gen.ErrorResponse
+		if err := json.Unmarshal(resBody, &errRes); err != nil || errRes.Message == "" {
+			return nil, fmt.Errorf("unexpected status %d: %s", res.StatusCode, resBody)
+		}
+		return nil, fmt.Errorf("%s (%s)", errRes.Message, errRes.Code)
+	}
+
+	return resBody, nil
+}
@@ -5,16 +5,23 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/application"
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/retention"
 )
 
 func Start(app *application.Application, interruptChan <-chan any) error {
 	httpSvc := http.NewHTTPService(app.Config.HTTPServer, app.Service, app.Log)
+	purgerSvc := retention.NewPurgerService(app.Config.Retention, app.Service, app.Log)
 
 	cleanup, err := httpSvc.Run()
 	if err != nil {
 		return fmt.Errorf("error running http server: %w", err)
 	}
 
+	purgerCleanup, err := purgerSvc.Run()
+	if err != nil {
+		return fmt.Errorf("error running retention purger: %w", err)
+	}
+
 	<-interruptChan
 
 	app.Log.Debug("http server shutting down")
@@ -25,5 +32,11 @@ func Start(app *application.Application, interruptChan <-chan any) error {
 
 	app.Log.Debug("http server shutdown complete")
 
+	if err := purgerCleanup(); err != nil {
+		return fmt.Errorf("error cleaning up retention purger: %w", err)
+	}
+
+	app.Log.Debug("retention purger shutdown complete")
+
 	return nil
 }
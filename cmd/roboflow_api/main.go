@@ -2,10 +2,13 @@ package main
 
 import (
 	"log"
+	"log/slog"
 
 	"github.com/spf13/cobra"
 
 	"github.com/tuanvumaihuynh/roboflow/cmd/roboflow_api/api"
+	"github.com/tuanvumaihuynh/roboflow/cmd/roboflow_api/relay"
+	"github.com/tuanvumaihuynh/roboflow/cmd/roboflow_api/worker"
 	"github.com/tuanvumaihuynh/roboflow/internal/application"
 	"github.com/tuanvumaihuynh/roboflow/pkg/cmdutils"
 )
@@ -18,6 +21,23 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+var workerCmd = &cobra.Command{
+	Use:   "worker",
+	Short: "Start the Roboflow worker, which runs the workflow executions, delivers the webhooks, stores the dead-lettered messages and relays its outbox messages.",
+	Run: func(_ *cobra.Command, _ []string) {
+		runWorker()
+	},
+}
+
+// withWorker also runs the worker in the API process, required by the
+// pubsub drivers connecting a single process.
+var withWorker bool
+
+func init() {
+	rootCmd.Flags().BoolVar(&withWorker, "with-worker", false, "also run the worker in the same process")
+	rootCmd.AddCommand(workerCmd)
+}
+
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatalf("error executing root command: %v", err)
@@ -32,6 +52,11 @@ func run() {
 		}
 	}()
 
+	if !withWorker && app.PubSubDriver.InProcess() {
+		app.Log.Warn("the pubsub driver only connects a single process, the messages of the api are not handled "+
+			"unless it runs with --with-worker", slog.String("driver", string(app.PubSubDriver)))
+	}
+
 	interruptChan := cmdutils.InterruptChan()
 
 	go func() {
@@ -40,5 +65,49 @@ func run() {
 		}
 	}()
 
+	go func() {
+		if err := relay.Start(app, interruptChan); err != nil {
+			log.Fatalf("error starting outbox relay: %v", err)
+		}
+	}()
+
+	if withWorker {
+		go func() {
+			if err := worker.Start(app, interruptChan); err != nil {
+				log.Fatalf("error starting worker: %v", err)
+			}
+		}()
+	}
+
+	<-interruptChan
+}
+
+func runWorker() {
+	app, cleanup := application.New()
+	defer func() {
+		if err := cleanup(); err != nil {
+			log.Fatalf("error cleaning up application: %v", err)
+		}
+	}()
+
+	if app.PubSubDriver.InProcess() {
+		app.Log.Warn("the pubsub driver only connects a single process, the worker does not receive the messages "+
+			"of the api, run the api with --with-worker instead", slog.String("driver", string(app.PubSubDriver)))
+	}
+
+	interruptChan := cmdutils.InterruptChan()
+
+	go func() {
+		if err := worker.Start(app, interruptChan); err != nil {
+			log.Fatalf("error starting worker: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := relay.Start(app, interruptChan); err != nil {
+			log.Fatalf("error starting outbox relay: %v", err)
+		}
+	}()
+
 	<-interruptChan
 }
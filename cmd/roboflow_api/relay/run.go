@@ -0,0 +1,30 @@
+package relay
+
+import (
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/application"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/outbox"
+)
+
+// Start runs the outbox relay. Both the API and the worker write to the
+// outbox, so every process runs a single relay, the metrics are served by
+// the HTTP server of the API process.
+func Start(app *application.Application, interruptChan <-chan any) error {
+	relaySvc := outbox.NewRelayService(app.Config.Outbox, app.Service, app.Log)
+
+	cleanup, err := relaySvc.Run()
+	if err != nil {
+		return fmt.Errorf("error running outbox relay: %w", err)
+	}
+
+	<-interruptChan
+
+	if err := cleanup(); err != nil {
+		return fmt.Errorf("error cleaning up outbox relay: %w", err)
+	}
+
+	app.Log.Debug("outbox relay shutdown complete")
+
+	return nil
+}
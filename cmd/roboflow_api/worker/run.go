@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/application"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/deadletter"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/webhook"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/worker"
+)
+
+func Start(app *application.Application, interruptChan <-chan any) error {
+	workerSvc := worker.NewWorkerService(app.Subscriber, app.Publisher, app.Service, app.Log)
+	webhookSvc := webhook.NewDeliveryService(app.Config.Webhook, app.WebhookSubscriber, app.Publisher, app.Service, app.Log)
+	deadLetterSvc := deadletter.NewCollectorService(app.Subscriber, app.Service, app.Log)
+
+	cleanup, err := workerSvc.Run()
+	if err != nil {
+		return fmt.Errorf("error running worker: %w", err)
+	}
+
+	webhookCleanup, err := webhookSvc.Run()
+	if err != nil {
+		return fmt.Errorf("error running webhook delivery: %w", err)
+	}
+
+	deadLetterCleanup, err := deadLetterSvc.Run()
+	if err != nil {
+		return fmt.Errorf("error running dead letter collector: %w", err)
+	}
+
+	<-interruptChan
+
+	app.Log.Debug("worker shutting down")
+
+	if err := cleanup(); err != nil {
+		return fmt.Errorf("error cleaning up worker: %w", err)
+	}
+
+	if err := webhookCleanup(); err != nil {
+		return fmt.Errorf("error cleaning up webhook delivery: %w", err)
+	}
+
+	if err := deadLetterCleanup(); err != nil {
+		return fmt.Errorf("error cleaning up dead letter collector: %w", err)
+	}
+
+	app.Log.Debug("worker shutdown complete")
+
+	return nil
+}
@@ -13,14 +13,18 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
 	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository/repoimpl"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
 	"github.com/tuanvumaihuynh/roboflow/internal/service/serviceimpl"
+	"github.com/tuanvumaihuynh/roboflow/internal/simulator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/archive"
 	"github.com/tuanvumaihuynh/roboflow/pkg/config"
 	mylog "github.com/tuanvumaihuynh/roboflow/pkg/log"
 	"github.com/tuanvumaihuynh/roboflow/pkg/pgxslog"
 	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/webhookhttp"
 )
 
 type Application struct {
@@ -28,8 +32,14 @@ type Application struct {
 
 	Service service.Service
 
-	Publisher  message.Publisher
-	Subscriber message.Subscriber
+	PubSubDriver pubsub.Driver
+	Publisher    message.Publisher
+	Subscriber   message.Subscriber
+
+	WebhookSubscriber message.Subscriber
+
+	BroadcastPublisher  message.Publisher
+	BroadcastSubscriber message.Subscriber
 
 	Log *slog.Logger
 
@@ -89,10 +99,10 @@ func New() (*Application, CleanupFunc) {
 		os.Exit(1)
 	}
 
-	// Setup Nats pubsub
-	publisher, subscriber, err := pubsub.NewNatsPubSub(conf.Nats, log)
+	// Setup pubsub
+	ps, err := pubsub.New(conf.PubSub, conf.Nats, pgPool, log)
 	if err != nil {
-		log.Error("error creating nats pubsub", slog.Any("error", err))
+		log.Error("error creating pubsub", slog.Any("error", err))
 		os.Exit(1)
 	}
 
@@ -103,25 +113,42 @@ func New() (*Application, CleanupFunc) {
 
 	// Setup service
 	validator := validator.NewValidator()
-	svc := serviceimpl.NewService(repo, sqlDBProvider, publisher, validator, log)
+	raybotSimulator, err := simulator.NewRaybot(conf.Simulator)
+	if err != nil {
+		log.Error("error creating raybot simulator", slog.Any("error", err))
+		os.Exit(1)
+	}
+	webhookSender := webhookhttp.NewClient(conf.Webhook.Timeout)
+	webhookRetryPolicy := webhook.RetryPolicy{
+		MaxAttempts:     conf.Webhook.MaxAttempts,
+		InitialInterval: conf.Webhook.InitialRetryInterval,
+		MaxInterval:     conf.Webhook.MaxRetryInterval,
+	}
+	var archiver archive.Archiver
+	if conf.Retention.ArchiveDir != "" {
+		archiver = archive.NewDir(conf.Retention.ArchiveDir)
+	}
+	svc := serviceimpl.NewService(repo, sqlDBProvider, ps.Publisher, ps.BroadcastPublisher,
+		ps.BroadcastSubscriber, raybotSimulator, webhookSender, webhookRetryPolicy, archiver, validator, log)
 
 	// Setup application
 	app := &Application{
-		Config:     conf,
-		Service:    svc,
-		Publisher:  publisher,
-		Subscriber: subscriber,
-		Log:        log,
-		context:    ctx,
+		Config:              conf,
+		Service:             svc,
+		PubSubDriver:        ps.Driver,
+		Publisher:           ps.Publisher,
+		Subscriber:          ps.Subscriber,
+		WebhookSubscriber:   ps.WebhookSubscriber,
+		BroadcastPublisher:  ps.BroadcastPublisher,
+		BroadcastSubscriber: ps.BroadcastSubscriber,
+		Log:                 log,
+		context:             ctx,
 	}
 
 	// Cleanup function
 	cleanup := func() error {
-		if err := publisher.Close(); err != nil {
-			return fmt.Errorf("error closing publisher: %w", err)
-		}
-		if err := subscriber.Close(); err != nil {
-			return fmt.Errorf("error closing subscriber: %w", err)
+		if err := ps.Close(); err != nil {
+			return fmt.Errorf("error closing pubsub: %w", err)
 		}
 		pgPool.Close()
 		return nil
@@ -0,0 +1,105 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+)
+
+// CollectorService stores the messages published to the dead-letter topic,
+// so they can be inspected, replayed and purged through the API.
+type CollectorService struct {
+	subscriber message.Subscriber
+	service    service.Service
+	log        *slog.Logger
+}
+
+func NewCollectorService(
+	subscriber message.Subscriber,
+	service service.Service,
+	log *slog.Logger,
+) *CollectorService {
+	return &CollectorService{
+		subscriber: subscriber,
+		service:    service,
+		log:        log.With(slog.String("service", "dead_letter_collector_service")),
+	}
+}
+
+type CleanupFunc func() error
+
+func (s CollectorService) Run() (CleanupFunc, error) {
+	// No poison queue, a message failing to be stored would be dead-lettered
+	// again. It is nacked and redelivered until it is stored.
+	router, err := pubsub.NewRouter(s.log)
+	if err != nil {
+		return nil, fmt.Errorf("error creating router: %w", err)
+	}
+
+	router.AddNoPublisherHandler(
+		"store_dead_letter_message",
+		pubsub.DeadLetterTopic,
+		s.subscriber,
+		s.handleDeadLetter,
+	)
+
+	go func() {
+		s.log.Info("starting dead letter collector")
+		if err := router.Run(context.Background()); err != nil {
+			s.log.Error("error running dead letter collector", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}()
+	<-router.Running()
+
+	cleanup := func() error {
+		if err := router.Close(); err != nil {
+			s.log.Error("error closing dead letter collector", slog.Any("error", err))
+			return err
+		}
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (s CollectorService) handleDeadLetter(msg *message.Message) error {
+	deadLetter, err := pubsub.UnmarshalDeadLetter(msg)
+	if err != nil {
+		// Nothing to replay it to, redelivering it would not help
+		s.log.Error("dropping invalid dead letter message",
+			slog.String("message_id", msg.UUID),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+
+	s.log.Warn("message dead-lettered",
+		slog.String("message_id", deadLetter.MessageID),
+		slog.String("topic", deadLetter.Topic),
+		slog.String("handler", deadLetter.Handler),
+		slog.String("reason", deadLetter.Reason),
+	)
+
+	if err := s.service.DeadLetter().CreateDeadLetterMessage(msg.Context(), service.CreateDeadLetterMessageParams{
+		ID:         msg.UUID,
+		MessageID:  deadLetter.MessageID,
+		Topic:      deadLetter.Topic,
+		Handler:    deadLetter.Handler,
+		Subscriber: deadLetter.Subscriber,
+		Reason:     deadLetter.Reason,
+		Payload:    msg.Payload,
+		Metadata:   deadLetter.Metadata,
+	}); err != nil {
+		return fmt.Errorf("create dead letter message: %w", err)
+	}
+
+	return nil
+}
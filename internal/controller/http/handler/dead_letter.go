@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type deadLetterHandler struct {
+	deadLetterSvc service.DeadLetterService
+}
+
+func newDeadLetterHandler(deadLetterSvc service.DeadLetterService) *deadLetterHandler {
+	return &deadLetterHandler{deadLetterSvc: deadLetterSvc}
+}
+
+func (h deadLetterHandler) DeadLetterMessageGet(
+	ctx context.Context,
+	request gen.DeadLetterMessageGetRequestObject,
+) (gen.DeadLetterMessageGetResponseObject, error) {
+	m, err := h.deadLetterSvc.GetDeadLetterMessage(ctx, service.GetDeadLetterMessageParams{
+		ID: request.DeadLetterMessageId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dead letter service get dead letter message: %w", err)
+	}
+
+	return gen.DeadLetterMessageGet200JSONResponse(converter.ToDeadLetterMessageResponse(m)), nil
+}
+
+func (h deadLetterHandler) DeadLetterMessageList(
+	ctx context.Context,
+	request gen.DeadLetterMessageListRequestObject,
+) (gen.DeadLetterMessageListResponseObject, error) {
+	pagingParams := paging.NewParams(
+		request.Params.PageSize,
+		request.Params.Page,
+		paging.WithMaxPageSize(1000),
+	)
+
+	var sorts []sort.Sort
+	var err error
+	if request.Params.Sort != nil {
+		sorts, err = sort.NewListFromString(*request.Params.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("sort new list from string: %w", err)
+		}
+	}
+
+	mp, err := h.deadLetterSvc.ListDeadLetterMessages(ctx, service.ListDeadLetterMessagesParams{
+		PagingParams: pagingParams,
+		Sorts:        sorts,
+		Topic:        request.Params.Topic,
+		Handler:      request.Params.Handler,
+		Replayed:     request.Params.Replayed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dead letter service list dead letter messages: %w", err)
+	}
+
+	items := make([]gen.DeadLetterMessageResponse, len(mp.Items))
+	for i, item := range mp.Items {
+		items[i] = converter.ToDeadLetterMessageResponse(item)
+	}
+
+	return gen.DeadLetterMessageList200JSONResponse{
+		Items:      items,
+		TotalItems: mp.TotalItems,
+	}, nil
+}
+
+func (h deadLetterHandler) DeadLetterMessageReplay(
+	ctx context.Context,
+	request gen.DeadLetterMessageReplayRequestObject,
+) (gen.DeadLetterMessageReplayResponseObject, error) {
+	m, err := h.deadLetterSvc.ReplayDeadLetterMessage(ctx, service.ReplayDeadLetterMessageParams{
+		ID: request.DeadLetterMessageId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dead letter service replay dead letter message: %w", err)
+	}
+
+	return gen.DeadLetterMessageReplay200JSONResponse(converter.ToDeadLetterMessageResponse(m)), nil
+}
+
+func (h deadLetterHandler) DeadLetterMessageDelete(
+	ctx context.Context,
+	request gen.DeadLetterMessageDeleteRequestObject,
+) (gen.DeadLetterMessageDeleteResponseObject, error) {
+	if err := h.deadLetterSvc.DeleteDeadLetterMessage(ctx, service.DeleteDeadLetterMessageParams{
+		ID: request.DeadLetterMessageId,
+	}); err != nil {
+		return nil, fmt.Errorf("dead letter service delete dead letter message: %w", err)
+	}
+
+	return gen.DeadLetterMessageDelete204Response{}, nil
+}
+
+func (h deadLetterHandler) DeadLetterMessagePurge(
+	ctx context.Context,
+	request gen.DeadLetterMessagePurgeRequestObject,
+) (gen.DeadLetterMessagePurgeResponseObject, error) {
+	count, err := h.deadLetterSvc.PurgeDeadLetterMessages(ctx, service.PurgeDeadLetterMessagesParams{
+		Topic:         request.Params.Topic,
+		Handler:       request.Params.Handler,
+		Replayed:      request.Params.Replayed,
+		CreatedBefore: request.Params.CreatedBefore,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dead letter service purge dead letter messages: %w", err)
+	}
+
+	return gen.DeadLetterMessagePurge200JSONResponse{
+		DeletedCount: count,
+	}, nil
+}
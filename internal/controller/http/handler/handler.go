@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
 )
@@ -9,20 +11,34 @@ var _ gen.StrictServerInterface = (*APIHandler)(nil)
 
 type APIHandler struct {
 	*qrLocationHandler
+	*trackMapHandler
+	*routeHandler
 	*raybotHandler
 	*raybotCommandHandler
+	*raybotPositionHandler
 	*workflowHandler
+	*workflowVersionHandler
 	*workflowExecutionHandler
 	*stepExecutionHandler
+	*webhookHandler
+	*deadLetterHandler
 }
 
-func NewAPIHandler(svc service.Service) *APIHandler {
+// NewAPIHandler creates the handler of the API. shutdownCtx is done when the
+// server shuts down, which ends the open event streams.
+func NewAPIHandler(shutdownCtx context.Context, svc service.Service) *APIHandler {
 	return &APIHandler{
 		qrLocationHandler:        newQRLocationHandler(svc.QRLocation()),
+		trackMapHandler:          newTrackMapHandler(svc.TrackMap()),
+		routeHandler:             newRouteHandler(svc.Route()),
 		raybotHandler:            newRaybotHandler(svc.Raybot()),
 		raybotCommandHandler:     newRaybotCommandHandler(svc.RaybotCommand()),
+		raybotPositionHandler:    newRaybotPositionHandler(svc.RaybotPosition()),
 		workflowHandler:          newWorkflowHandler(svc.Workflow()),
-		workflowExecutionHandler: newWorkflowExecutionHandler(svc.WorkflowExecution()),
+		workflowVersionHandler:   newWorkflowVersionHandler(svc.WorkflowVersion()),
+		workflowExecutionHandler: newWorkflowExecutionHandler(shutdownCtx, svc.WorkflowExecution()),
 		stepExecutionHandler:     newStepExecutionHandler(svc.StepExecution()),
+		webhookHandler:           newWebhookHandler(svc.Webhook()),
+		deadLetterHandler:        newDeadLetterHandler(svc.DeadLetter()),
 	}
 }
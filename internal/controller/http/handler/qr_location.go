@@ -1,14 +1,19 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/qrlabel"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
 )
 
 type qrLocationHandler struct {
@@ -47,9 +52,24 @@ func (h qrLocationHandler) QrLocationList(ctx context.Context, request gen.QrLoc
 		}
 	}
 
+	var filters []filter.Filter
+	if request.Params.Filter != nil {
+		filters, err = filter.NewListFromString(*request.Params.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("filter new list from string: %w", err)
+		}
+	}
+
+	var search string
+	if request.Params.Search != nil {
+		search = *request.Params.Search
+	}
+
 	mp, err := h.qrLocationSvc.ListQRLocations(ctx, service.ListQRLocationsParams{
 		PagingParams: pagingParams,
 		Sorts:        sorts,
+		Filters:      filters,
+		Search:       search,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("qr location service list qr locations: %w", err)
@@ -108,3 +128,138 @@ func (h qrLocationHandler) QrLocationDelete(ctx context.Context, request gen.QrL
 
 	return gen.QrLocationDelete204Response{}, nil
 }
+
+func (h qrLocationHandler) QrLocationImport(ctx context.Context, request gen.QrLocationImportRequestObject) (gen.QrLocationImportResponseObject, error) {
+	var rows []qrlocation.ImportRow
+	switch {
+	case request.JSONBody != nil:
+		rows = converter.FromImportQRLocationsRequest(*request.JSONBody)
+	case request.Body != nil:
+		var err error
+		rows, err = qrlocation.ReadCSV(request.Body)
+		if err != nil {
+			return nil, fmt.Errorf("read csv: %w", err)
+		}
+	default:
+		return nil, xerror.ValidationFailed(nil, "Request body must be application/json or text/csv")
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	plan, err := h.qrLocationSvc.ImportQRLocations(ctx, service.ImportQRLocationsParams{
+		Rows:   rows,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("qr location service import qr locations: %w", err)
+	}
+
+	return gen.QrLocationImport200JSONResponse(converter.ToImportQRLocationsResponse(plan, dryRun)), nil
+}
+
+func (h qrLocationHandler) QrLocationExport(ctx context.Context, request gen.QrLocationExportRequestObject) (gen.QrLocationExportResponseObject, error) {
+	format := "json"
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	if format != "json" && format != "csv" {
+		return nil, xerror.ValidationFailed(nil, "Format must be one of json, csv")
+	}
+
+	qrLocations, err := h.qrLocationSvc.ExportQRLocations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("qr location service export qr locations: %w", err)
+	}
+
+	if format == "csv" {
+		var buf bytes.Buffer
+		if err := qrlocation.WriteCSV(&buf, qrLocations); err != nil {
+			return nil, fmt.Errorf("write csv: %w", err)
+		}
+
+		return gen.QrLocationExport200TextcsvResponse{
+			Body:          &buf,
+			ContentLength: int64(buf.Len()),
+		}, nil
+	}
+
+	items := make([]gen.QRLocationImportItem, len(qrLocations))
+	for i, qrLocation := range qrLocations {
+		items[i] = converter.ToQRLocationImportItem(qrLocation)
+	}
+
+	return gen.QrLocationExport200JSONResponse(items), nil
+}
+
+func (h qrLocationHandler) QrLocationLabelPng(ctx context.Context, request gen.QrLocationLabelPngRequestObject) (gen.QrLocationLabelPngResponseObject, error) {
+	label, err := h.qrLocationSvc.RenderQRLocationLabel(ctx, labelParams(
+		request.QrLocationId,
+		qrlabel.FormatPNG,
+		request.Params.Size,
+		request.Params.ErrorCorrection,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("qr location service render qr location label: %w", err)
+	}
+
+	return gen.QrLocationLabelPng200ImagepngResponse{
+		Body:          bytes.NewReader(label),
+		ContentLength: int64(len(label)),
+	}, nil
+}
+
+func (h qrLocationHandler) QrLocationLabelSvg(ctx context.Context, request gen.QrLocationLabelSvgRequestObject) (gen.QrLocationLabelSvgResponseObject, error) {
+	label, err := h.qrLocationSvc.RenderQRLocationLabel(ctx, labelParams(
+		request.QrLocationId,
+		qrlabel.FormatSVG,
+		request.Params.Size,
+		request.Params.ErrorCorrection,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("qr location service render qr location label: %w", err)
+	}
+
+	return gen.QrLocationLabelSvg200ImagesvgXmlResponse{
+		Body:          bytes.NewReader(label),
+		ContentLength: int64(len(label)),
+	}, nil
+}
+
+func (h qrLocationHandler) QrLocationLabelsPdf(ctx context.Context, request gen.QrLocationLabelsPdfRequestObject) (gen.QrLocationLabelsPdfResponseObject, error) {
+	params := service.RenderQRLocationLabelsPDFParams{
+		IDs:   request.Body.QrLocationIds,
+		Size:  qrlabel.DefaultPDFSize,
+		Level: qrlabel.DefaultLevel,
+	}
+	if request.Body.Size != nil {
+		params.Size = *request.Body.Size
+	}
+	if request.Body.ErrorCorrection != nil {
+		params.Level = qrlabel.Level(*request.Body.ErrorCorrection)
+	}
+
+	pdf, err := h.qrLocationSvc.RenderQRLocationLabelsPDF(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("qr location service render qr location labels pdf: %w", err)
+	}
+
+	return gen.QrLocationLabelsPdf200ApplicationpdfResponse{
+		Body:          bytes.NewReader(pdf),
+		ContentLength: int64(len(pdf)),
+	}, nil
+}
+
+func labelParams(id string, format qrlabel.Format, size *int, errorCorrection *string) service.RenderQRLocationLabelParams {
+	params := service.RenderQRLocationLabelParams{
+		ID:     id,
+		Format: format,
+		Size:   qrlabel.DefaultImageSize,
+		Level:  qrlabel.DefaultLevel,
+	}
+	if size != nil {
+		params.Size = *size
+	}
+	if errorCorrection != nil {
+		params.Level = qrlabel.Level(*errorCorrection)
+	}
+	return params
+}
@@ -6,10 +6,12 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
-	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/ptr"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
 )
 
 type raybotCommandHandler struct {
@@ -36,6 +38,8 @@ func (h raybotCommandHandler) RaybotCommandList(ctx context.Context, request gen
 		request.Params.PageSize,
 		request.Params.Page,
 		paging.WithMaxPageSize(1000),
+		paging.WithCursor(request.Params.Cursor),
+		paging.WithSkipCount(request.Params.Count),
 	)
 
 	var sorts []sort.Sort
@@ -47,10 +51,19 @@ func (h raybotCommandHandler) RaybotCommandList(ctx context.Context, request gen
 		}
 	}
 
+	var filters []filter.Filter
+	if request.Params.Filter != nil {
+		filters, err = filter.NewListFromString(*request.Params.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("filter new list from string: %w", err)
+		}
+	}
+
 	mp, err := h.raybotCommandSvc.ListRaybotCommandsByRaybotID(ctx, service.ListRaybotCommandsByRaybotIDParams{
 		RaybotID:     request.RaybotId,
 		PagingParams: pagingParams,
 		Sorts:        sorts,
+		Filters:      filters,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("raybot command service list raybot commands by raybot id: %w", err)
@@ -61,17 +74,29 @@ func (h raybotCommandHandler) RaybotCommandList(ctx context.Context, request gen
 		items[i] = converter.ToRaybotCommandResponse(item)
 	}
 
-	return gen.RaybotCommandList200JSONResponse{
-		Items:      items,
-		TotalItems: mp.TotalItems,
-	}, nil
+	res := gen.RaybotCommandList200JSONResponse{
+		Items: items,
+	}
+	if mp.Counted {
+		res.TotalItems = ptr.New(mp.TotalItems)
+	}
+	if mp.NextCursor != "" {
+		res.NextCursor = ptr.New(mp.NextCursor)
+	}
+
+	return res, nil
 }
 
 func (h raybotCommandHandler) RaybotCommandCreate(ctx context.Context, request gen.RaybotCommandCreateRequestObject) (gen.RaybotCommandCreateResponseObject, error) {
+	commandType, inputs, err := converter.FromCreateRaybotCommandRequest(*request.Body)
+	if err != nil {
+		return nil, xerror.ValidationFailed(err, "Invalid raybot command")
+	}
+
 	m, err := h.raybotCommandSvc.CreateRaybotCommand(ctx, service.CreateRaybotCommandParams{
 		RaybotID: request.RaybotId,
-		Type:     raybotcommand.Type(request.Body.Type),
-		Inputs:   raybotcommand.NewInputs(request.Body.Inputs),
+		Type:     commandType,
+		Inputs:   inputs,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("raybot command service create raybot command: %w", err)
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type raybotPositionHandler struct {
+	raybotPositionSvc service.RaybotPositionService
+}
+
+func newRaybotPositionHandler(raybotPositionSvc service.RaybotPositionService) *raybotPositionHandler {
+	return &raybotPositionHandler{raybotPositionSvc: raybotPositionSvc}
+}
+
+func (h raybotPositionHandler) RaybotPositionGet(ctx context.Context, request gen.RaybotPositionGetRequestObject) (gen.RaybotPositionGetResponseObject, error) {
+	position, err := h.raybotPositionSvc.GetRaybotPosition(ctx, service.GetRaybotPositionParams{
+		RaybotID: request.RaybotId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("raybot position service get raybot position: %w", err)
+	}
+
+	return gen.RaybotPositionGet200JSONResponse(converter.ToRaybotPositionResponse(position)), nil
+}
+
+func (h raybotPositionHandler) RaybotPositionReport(ctx context.Context, request gen.RaybotPositionReportRequestObject) (gen.RaybotPositionReportResponseObject, error) {
+	position, err := h.raybotPositionSvc.ReportRaybotPosition(ctx, service.ReportRaybotPositionParams{
+		RaybotID: request.RaybotId,
+		QRCode:   request.Body.QrCode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("raybot position service report raybot position: %w", err)
+	}
+
+	return gen.RaybotPositionReport200JSONResponse(converter.ToRaybotPositionResponse(position)), nil
+}
+
+func (h raybotPositionHandler) RaybotPositionListHistory(ctx context.Context, request gen.RaybotPositionListHistoryRequestObject) (gen.RaybotPositionListHistoryResponseObject, error) {
+	pagingParams := paging.NewParams(
+		request.Params.PageSize,
+		request.Params.Page,
+		paging.WithMaxPageSize(1000),
+	)
+
+	var sorts []sort.Sort
+	var err error
+	if request.Params.Sort != nil {
+		sorts, err = sort.NewListFromString(*request.Params.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("sort new list from string: %w", err)
+		}
+	}
+
+	mp, err := h.raybotPositionSvc.ListRaybotPositionHistory(ctx, service.ListRaybotPositionHistoryParams{
+		RaybotID:     request.RaybotId,
+		PagingParams: pagingParams,
+		Sorts:        sorts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("raybot position service list raybot position history: %w", err)
+	}
+
+	items := make([]gen.RaybotPositionResponse, len(mp.Items))
+	for i, item := range mp.Items {
+		items[i] = converter.ToRaybotPositionResponse(item)
+	}
+
+	return gen.RaybotPositionListHistory200JSONResponse{
+		Items:      items,
+		TotalItems: mp.TotalItems,
+	}, nil
+}
+
+func (h raybotPositionHandler) RaybotPositionListOccupants(ctx context.Context, request gen.RaybotPositionListOccupantsRequestObject) (gen.RaybotPositionListOccupantsResponseObject, error) {
+	positions, err := h.raybotPositionSvc.ListQRLocationOccupants(ctx, service.ListQRLocationOccupantsParams{
+		QRLocationID: request.QrLocationId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("raybot position service list qr location occupants: %w", err)
+	}
+
+	items := make([]gen.RaybotPositionResponse, len(positions))
+	for i, item := range positions {
+		items[i] = converter.ToRaybotPositionResponse(item)
+	}
+
+	return gen.RaybotPositionListOccupants200JSONResponse{Items: items}, nil
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+)
+
+type routeHandler struct {
+	routeSvc service.RouteService
+}
+
+func newRouteHandler(routeSvc service.RouteService) *routeHandler {
+	return &routeHandler{routeSvc: routeSvc}
+}
+
+func (h routeHandler) RoutePlan(ctx context.Context, request gen.RoutePlanRequestObject) (gen.RoutePlanResponseObject, error) {
+	params := service.PlanRouteParams{ToLocationID: request.Params.To}
+	if request.Params.From != nil {
+		params.FromLocationID = *request.Params.From
+	}
+	if request.Params.RaybotId != nil {
+		params.RaybotID = *request.Params.RaybotId
+	}
+
+	route, err := h.routeSvc.PlanRoute(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("route service plan route: %w", err)
+	}
+
+	return gen.RoutePlan200JSONResponse(converter.ToRouteResponse(route)), nil
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/ptr"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
 
 type stepExecutionHandler struct {
@@ -35,15 +38,34 @@ func (h stepExecutionHandler) StepExecutionGet(ctx context.Context, request gen.
 
 //nolint:revive
 func (h stepExecutionHandler) StepExecutionListByWorkflowExecutionId(ctx context.Context, request gen.StepExecutionListByWorkflowExecutionIdRequestObject) (gen.StepExecutionListByWorkflowExecutionIdResponseObject, error) {
-	stepExecutions, err := h.stepExecutionSvc.ListStepsByWorkflowExecutionID(ctx, service.ListStepsByWorkflowExecutionIDParams{
+	pagingParams := paging.NewParams(
+		request.Params.PageSize,
+		request.Params.Page,
+		paging.WithMaxPageSize(1000),
+		paging.WithCursor(request.Params.Cursor),
+		paging.WithSkipCount(request.Params.Count),
+	)
+
+	var sorts []sort.Sort
+	var err error
+	if request.Params.Sort != nil {
+		sorts, err = sort.NewListFromString(*request.Params.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("sort new list from string: %w", err)
+		}
+	}
+
+	mp, err := h.stepExecutionSvc.ListStepsByWorkflowExecutionID(ctx, service.ListStepsByWorkflowExecutionIDParams{
 		WorkflowExecutionID: request.WorkflowExecutionId,
+		PagingParams:        pagingParams,
+		Sorts:               sorts,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list steps by workflow execution id: %w", err)
 	}
 
-	items := make([]gen.StepExecutionResponse, len(stepExecutions))
-	for i, stepExecution := range stepExecutions {
+	items := make([]gen.StepExecutionResponse, len(mp.Items))
+	for i, stepExecution := range mp.Items {
 		res, err := converter.ToStepExecutionResponse(stepExecution)
 		if err != nil {
 			return nil, fmt.Errorf("convert to step execution response: %w", err)
@@ -52,5 +74,15 @@ func (h stepExecutionHandler) StepExecutionListByWorkflowExecutionId(ctx context
 		items[i] = res
 	}
 
-	return gen.StepExecutionListByWorkflowExecutionId200JSONResponse(items), nil
+	res := gen.StepExecutionListByWorkflowExecutionId200JSONResponse{
+		Items: items,
+	}
+	if mp.Counted {
+		res.TotalItems = ptr.New(mp.TotalItems)
+	}
+	if mp.NextCursor != "" {
+		res.NextCursor = ptr.New(mp.NextCursor)
+	}
+
+	return res, nil
 }
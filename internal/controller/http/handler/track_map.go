@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type trackMapHandler struct {
+	trackMapSvc service.TrackMapService
+}
+
+func newTrackMapHandler(trackMapSvc service.TrackMapService) *trackMapHandler {
+	return &trackMapHandler{trackMapSvc: trackMapSvc}
+}
+
+func (h trackMapHandler) TrackMapGet(ctx context.Context, _ gen.TrackMapGetRequestObject) (gen.TrackMapGetResponseObject, error) {
+	m, err := h.trackMapSvc.GetTrackMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("track map service get track map: %w", err)
+	}
+
+	return gen.TrackMapGet200JSONResponse(converter.ToTrackMapResponse(m)), nil
+}
+
+func (h trackMapHandler) TrackSegmentGet(ctx context.Context, request gen.TrackSegmentGetRequestObject) (gen.TrackSegmentGetResponseObject, error) {
+	m, err := h.trackMapSvc.GetTrackSegment(ctx, service.GetTrackSegmentParams{
+		ID: request.TrackSegmentId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("track map service get track segment: %w", err)
+	}
+
+	return gen.TrackSegmentGet200JSONResponse(converter.ToTrackSegmentResponse(m)), nil
+}
+
+func (h trackMapHandler) TrackSegmentList(ctx context.Context, request gen.TrackSegmentListRequestObject) (gen.TrackSegmentListResponseObject, error) {
+	pagingParams := paging.NewParams(
+		request.Params.PageSize,
+		request.Params.Page,
+		paging.WithMaxPageSize(1000),
+	)
+
+	var sorts []sort.Sort
+	var err error
+	if request.Params.Sort != nil {
+		sorts, err = sort.NewListFromString(*request.Params.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("sort new list from string: %w", err)
+		}
+	}
+
+	mp, err := h.trackMapSvc.ListTrackSegments(ctx, service.ListTrackSegmentsParams{
+		PagingParams: pagingParams,
+		Sorts:        sorts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("track map service list track segments: %w", err)
+	}
+
+	items := make([]gen.TrackSegmentResponse, len(mp.Items))
+	for i, segment := range mp.Items {
+		items[i] = converter.ToTrackSegmentResponse(segment)
+	}
+
+	return gen.TrackSegmentList200JSONResponse(
+		gen.TrackSegmentsListResponse{
+			Items:      items,
+			TotalItems: mp.TotalItems,
+		},
+	), nil
+}
+
+func (h trackMapHandler) TrackSegmentCreate(ctx context.Context, request gen.TrackSegmentCreateRequestObject) (gen.TrackSegmentCreateResponseObject, error) {
+	m, err := h.trackMapSvc.CreateTrackSegment(ctx, service.CreateTrackSegmentParams{
+		FromLocationID: request.Body.FromLocationId,
+		ToLocationID:   request.Body.ToLocationId,
+		Distance:       request.Body.Distance,
+		Direction:      raybotcommand.MoveDirection(request.Body.Direction),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("track map service create track segment: %w", err)
+	}
+
+	return gen.TrackSegmentCreate201JSONResponse(converter.ToTrackSegmentResponse(m)), nil
+}
+
+func (h trackMapHandler) TrackSegmentUpdate(ctx context.Context, request gen.TrackSegmentUpdateRequestObject) (gen.TrackSegmentUpdateResponseObject, error) {
+	m, err := h.trackMapSvc.UpdateTrackSegment(ctx, service.UpdateTrackSegmentParams{
+		ID:             request.TrackSegmentId,
+		FromLocationID: request.Body.FromLocationId,
+		ToLocationID:   request.Body.ToLocationId,
+		Distance:       request.Body.Distance,
+		Direction:      raybotcommand.MoveDirection(request.Body.Direction),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("track map service update track segment: %w", err)
+	}
+
+	return gen.TrackSegmentUpdate200JSONResponse(converter.ToTrackSegmentResponse(m)), nil
+}
+
+func (h trackMapHandler) TrackSegmentDelete(ctx context.Context, request gen.TrackSegmentDeleteRequestObject) (gen.TrackSegmentDeleteResponseObject, error) {
+	err := h.trackMapSvc.DeleteTrackSegment(ctx, service.DeleteTrackSegmentParams{
+		ID: request.TrackSegmentId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("track map service delete track segment: %w", err)
+	}
+
+	return gen.TrackSegmentDelete204Response{}, nil
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/ptr"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type webhookHandler struct {
+	webhookSvc service.WebhookService
+}
+
+func newWebhookHandler(webhookSvc service.WebhookService) *webhookHandler {
+	return &webhookHandler{webhookSvc: webhookSvc}
+}
+
+func (h webhookHandler) WebhookSubscriptionGet(
+	ctx context.Context,
+	request gen.WebhookSubscriptionGetRequestObject,
+) (gen.WebhookSubscriptionGetResponseObject, error) {
+	m, err := h.webhookSvc.GetWebhookSubscription(ctx, service.GetWebhookSubscriptionParams{
+		ID: request.WebhookSubscriptionId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webhook service get webhook subscription: %w", err)
+	}
+
+	return gen.WebhookSubscriptionGet200JSONResponse(converter.ToWebhookSubscriptionResponse(m)), nil
+}
+
+func (h webhookHandler) WebhookSubscriptionList(
+	ctx context.Context,
+	request gen.WebhookSubscriptionListRequestObject,
+) (gen.WebhookSubscriptionListResponseObject, error) {
+	pagingParams := paging.NewParams(
+		request.Params.PageSize,
+		request.Params.Page,
+		paging.WithMaxPageSize(1000),
+	)
+
+	var sorts []sort.Sort
+	var err error
+	if request.Params.Sort != nil {
+		sorts, err = sort.NewListFromString(*request.Params.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("sort new list from string: %w", err)
+		}
+	}
+
+	mp, err := h.webhookSvc.ListWebhookSubscriptions(ctx, service.ListWebhookSubscriptionsParams{
+		PagingParams: pagingParams,
+		Sorts:        sorts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webhook service list webhook subscriptions: %w", err)
+	}
+
+	items := make([]gen.WebhookSubscriptionResponse, len(mp.Items))
+	for i, item := range mp.Items {
+		items[i] = converter.ToWebhookSubscriptionResponse(item)
+	}
+
+	return gen.WebhookSubscriptionList200JSONResponse{
+		Items:      items,
+		TotalItems: mp.TotalItems,
+	}, nil
+}
+
+func (h webhookHandler) WebhookSubscriptionCreate(
+	ctx context.Context,
+	request gen.WebhookSubscriptionCreateRequestObject,
+) (gen.WebhookSubscriptionCreateResponseObject, error) {
+	isActive := true
+	if request.Body.IsActive != nil {
+		isActive = *request.Body.IsActive
+	}
+
+	m, err := h.webhookSvc.CreateWebhookSubscription(ctx, service.CreateWebhookSubscriptionParams{
+		URL:        request.Body.Url,
+		Secret:     request.Body.Secret,
+		EventTypes: request.Body.EventTypes,
+		IsActive:   isActive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webhook service create webhook subscription: %w", err)
+	}
+
+	return gen.WebhookSubscriptionCreate201JSONResponse(converter.ToWebhookSubscriptionResponse(m)), nil
+}
+
+func (h webhookHandler) WebhookSubscriptionUpdate(
+	ctx context.Context,
+	request gen.WebhookSubscriptionUpdateRequestObject,
+) (gen.WebhookSubscriptionUpdateResponseObject, error) {
+	m, err := h.webhookSvc.UpdateWebhookSubscription(ctx, service.UpdateWebhookSubscriptionParams{
+		ID:         request.WebhookSubscriptionId,
+		URL:        request.Body.Url,
+		Secret:     request.Body.Secret,
+		EventTypes: request.Body.EventTypes,
+		IsActive:   request.Body.IsActive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webhook service update webhook subscription: %w", err)
+	}
+
+	return gen.WebhookSubscriptionUpdate200JSONResponse(converter.ToWebhookSubscriptionResponse(m)), nil
+}
+
+func (h webhookHandler) WebhookSubscriptionDelete(
+	ctx context.Context,
+	request gen.WebhookSubscriptionDeleteRequestObject,
+) (gen.WebhookSubscriptionDeleteResponseObject, error) {
+	if err := h.webhookSvc.DeleteWebhookSubscription(ctx, service.DeleteWebhookSubscriptionParams{
+		ID: request.WebhookSubscriptionId,
+	}); err != nil {
+		return nil, fmt.Errorf("webhook service delete webhook subscription: %w", err)
+	}
+
+	return gen.WebhookSubscriptionDelete204Response{}, nil
+}
+
+func (h webhookHandler) WebhookDeliveryList(
+	ctx context.Context,
+	request gen.WebhookDeliveryListRequestObject,
+) (gen.WebhookDeliveryListResponseObject, error) {
+	pagingParams := paging.NewParams(
+		request.Params.PageSize,
+		request.Params.Page,
+		paging.WithMaxPageSize(1000),
+	)
+
+	var sorts []sort.Sort
+	var err error
+	if request.Params.Sort != nil {
+		sorts, err = sort.NewListFromString(*request.Params.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("sort new list from string: %w", err)
+		}
+	}
+
+	var status *webhook.DeliveryStatus
+	if request.Params.Status != nil {
+		status = ptr.New(webhook.DeliveryStatus(*request.Params.Status))
+	}
+
+	mp, err := h.webhookSvc.ListWebhookDeliveries(ctx, service.ListWebhookDeliveriesParams{
+		SubscriptionID: request.WebhookSubscriptionId,
+		PagingParams:   pagingParams,
+		Sorts:          sorts,
+		Status:         status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webhook service list webhook deliveries: %w", err)
+	}
+
+	items := make([]gen.WebhookDeliveryResponse, len(mp.Items))
+	for i, item := range mp.Items {
+		items[i] = converter.ToWebhookDeliveryResponse(item)
+	}
+
+	return gen.WebhookDeliveryList200JSONResponse{
+		Items:      items,
+		TotalItems: mp.TotalItems,
+	}, nil
+}
+
+func (h webhookHandler) WebhookDeliveryRedeliver(
+	ctx context.Context,
+	request gen.WebhookDeliveryRedeliverRequestObject,
+) (gen.WebhookDeliveryRedeliverResponseObject, error) {
+	m, err := h.webhookSvc.RedeliverWebhookDelivery(ctx, service.RedeliverWebhookDeliveryParams{
+		SubscriptionID: request.WebhookSubscriptionId,
+		ID:             request.WebhookDeliveryId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webhook service redeliver webhook delivery: %w", err)
+	}
+
+	return gen.WebhookDeliveryRedeliver200JSONResponse(converter.ToWebhookDeliveryResponse(m)), nil
+}
@@ -1,16 +1,22 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
 )
 
 type workflowHandler struct {
@@ -53,9 +59,32 @@ func (h workflowHandler) WorkflowList(ctx context.Context, request gen.WorkflowL
 		}
 	}
 
+	var filters []filter.Filter
+	if request.Params.Filter != nil {
+		filters, err = filter.NewListFromString(*request.Params.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("filter new list from string: %w", err)
+		}
+	}
+	if request.Params.IsDraft != nil {
+		filters = append(filters, filter.Filter{
+			Col:      "is_draft",
+			Operator: filter.OperatorEq,
+			Values:   []string{strconv.FormatBool(*request.Params.IsDraft)},
+		})
+	}
+
+	var search string
+	if request.Params.Search != nil {
+		search = *request.Params.Search
+	}
+
 	mp, err := h.workflowSvc.ListWorkflows(ctx, service.ListWorkflowsParams{
 		PagingParams: pagingParams,
 		Sorts:        sorts,
+		Filters:      filters,
+		Search:       search,
+		IsTemplate:   request.Params.IsTemplate,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("workflow service list workflows: %w", err)
@@ -79,11 +108,19 @@ func (h workflowHandler) WorkflowCreate(ctx context.Context, request gen.Workflo
 		return nil, fmt.Errorf("unmarshal workflow data: %w", err)
 	}
 
-	m, err := h.workflowSvc.CreateWorkflow(ctx, service.CreateWorkflowParams{
+	params := service.CreateWorkflowParams{
 		Name:        request.Body.Name,
 		Description: request.Body.Description,
 		Data:        data,
-	})
+	}
+	if request.Body.IsTemplate != nil {
+		params.IsTemplate = *request.Body.IsTemplate
+	}
+	if request.Body.TemplateParameters != nil {
+		params.TemplateParameters = converter.FromWorkflowTemplateParameters(*request.Body.TemplateParameters)
+	}
+
+	m, err := h.workflowSvc.CreateWorkflow(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("workflow service create workflow: %w", err)
 	}
@@ -103,7 +140,7 @@ func (h workflowHandler) WorkflowUpdate(ctx context.Context, request gen.Workflo
 		return nil, fmt.Errorf("unmarshal workflow data: %w", err)
 	}
 
-	m, err := h.workflowSvc.UpdateWorkflow(ctx, service.UpdateWorkflowParams{
+	params := service.UpdateWorkflowParams{
 		ID:             request.WorkflowId,
 		Name:           request.Body.Name,
 		SetName:        true,
@@ -111,7 +148,17 @@ func (h workflowHandler) WorkflowUpdate(ctx context.Context, request gen.Workflo
 		SetDescription: true,
 		Data:           data,
 		SetData:        true,
-	})
+	}
+	if request.Body.IsTemplate != nil {
+		params.IsTemplate = *request.Body.IsTemplate
+		params.SetIsTemplate = true
+	}
+	if request.Body.TemplateParameters != nil {
+		params.TemplateParameters = converter.FromWorkflowTemplateParameters(*request.Body.TemplateParameters)
+		params.SetTemplateParameters = true
+	}
+
+	m, err := h.workflowSvc.UpdateWorkflow(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("workflow service update workflow: %w", err)
 	}
@@ -136,12 +183,126 @@ func (h workflowHandler) WorkflowDelete(ctx context.Context, request gen.Workflo
 }
 
 func (h workflowHandler) WorkflowRun(ctx context.Context, request gen.WorkflowRunRequestObject) (gen.WorkflowRunResponseObject, error) {
-	workflowExecutionID, err := h.workflowSvc.RunWorkflow(ctx, service.RunWorkflowParams{
-		ID: request.WorkflowId,
-	})
+	params := service.RunWorkflowParams{
+		ID:               request.WorkflowId,
+		RuntimeVariables: request.Body.RuntimeVariables,
+	}
+	if request.Body.DryRun != nil {
+		params.DryRun = *request.Body.DryRun
+	}
+
+	workflowExecutionID, err := h.workflowSvc.RunWorkflow(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("workflow service run workflow: %w", err)
 	}
 
 	return gen.WorkflowRun201JSONResponse(converter.ToWorkflowRunResponse(workflowExecutionID)), nil
 }
+
+func (h workflowHandler) WorkflowDuplicate(ctx context.Context, request gen.WorkflowDuplicateRequestObject) (gen.WorkflowDuplicateResponseObject, error) {
+	m, err := h.workflowSvc.DuplicateWorkflow(ctx, service.DuplicateWorkflowParams{
+		ID:          request.WorkflowId,
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workflow service duplicate workflow: %w", err)
+	}
+
+	res, err := converter.ToWorkflowResponse(m)
+	if err != nil {
+		return nil, fmt.Errorf("converter to workflow response: %w", err)
+	}
+
+	return gen.WorkflowDuplicate201JSONResponse(res), nil
+}
+
+func (h workflowHandler) WorkflowInstantiate(ctx context.Context, request gen.WorkflowInstantiateRequestObject) (gen.WorkflowInstantiateResponseObject, error) {
+	m, err := h.workflowSvc.InstantiateWorkflowTemplate(ctx, service.InstantiateWorkflowTemplateParams{
+		ID:          request.WorkflowId,
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		Parameters:  request.Body.Parameters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workflow service instantiate workflow template: %w", err)
+	}
+
+	res, err := converter.ToWorkflowResponse(m)
+	if err != nil {
+		return nil, fmt.Errorf("converter to workflow response: %w", err)
+	}
+
+	return gen.WorkflowInstantiate201JSONResponse(res), nil
+}
+
+func (h workflowHandler) WorkflowExport(ctx context.Context, request gen.WorkflowExportRequestObject) (gen.WorkflowExportResponseObject, error) {
+	format := "json"
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	if format != "json" && format != "yaml" {
+		return nil, xerror.ValidationFailed(nil, "Format must be one of json, yaml")
+	}
+
+	doc, err := h.workflowSvc.ExportWorkflow(ctx, service.ExportWorkflowParams{
+		ID: request.WorkflowId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workflow service export workflow: %w", err)
+	}
+
+	if format == "yaml" {
+		out, err := yaml.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("yaml marshal workflow document: %w", err)
+		}
+
+		return gen.WorkflowExport200ApplicationyamlResponse{
+			Body:          bytes.NewReader(out),
+			ContentLength: int64(len(out)),
+		}, nil
+	}
+
+	res, err := converter.ToWorkflowDocument(doc)
+	if err != nil {
+		return nil, fmt.Errorf("converter to workflow document: %w", err)
+	}
+
+	return gen.WorkflowExport200JSONResponse(res), nil
+}
+
+func (h workflowHandler) WorkflowImport(ctx context.Context, request gen.WorkflowImportRequestObject) (gen.WorkflowImportResponseObject, error) {
+	var doc workflow.Document
+	switch {
+	case request.JSONBody != nil:
+		var err error
+		doc, err = converter.FromWorkflowDocument(*request.JSONBody)
+		if err != nil {
+			return nil, xerror.ValidationFailed(err, fmt.Sprintf("Invalid JSON document: %s", err))
+		}
+	case request.Body != nil:
+		if err := yaml.NewDecoder(request.Body).Decode(&doc); err != nil {
+			return nil, xerror.ValidationFailed(err, fmt.Sprintf("Invalid YAML document: %s", err))
+		}
+	default:
+		return nil, xerror.ValidationFailed(nil, "Request body must be application/json or application/yaml")
+	}
+
+	result, err := h.workflowSvc.ImportWorkflow(ctx, service.ImportWorkflowParams{
+		Document: doc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workflow service import workflow: %w", err)
+	}
+
+	res, err := converter.ToWorkflowResponse(result.Workflow)
+	if err != nil {
+		return nil, fmt.Errorf("converter to workflow response: %w", err)
+	}
+
+	return gen.WorkflowImport201JSONResponse{
+		Workflow:           res,
+		MissingQrLocations: result.MissingQRLocations,
+	}, nil
+}
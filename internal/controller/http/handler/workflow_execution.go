@@ -2,19 +2,35 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/ptr"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
 
+// eventStreamKeepAliveInterval is the interval of the comments sent on an
+// idle event stream, so that proxies do not close it.
+const eventStreamKeepAliveInterval = 15 * time.Second
+
 type workflowExecutionHandler struct {
 	workflowExecutionSvc service.WorkflowExecutionService
+	shutdownCtx          context.Context
 }
 
-func newWorkflowExecutionHandler(workflowExecutionSvc service.WorkflowExecutionService) *workflowExecutionHandler {
-	return &workflowExecutionHandler{workflowExecutionSvc: workflowExecutionSvc}
+func newWorkflowExecutionHandler(shutdownCtx context.Context, workflowExecutionSvc service.WorkflowExecutionService) *workflowExecutionHandler {
+	return &workflowExecutionHandler{
+		workflowExecutionSvc: workflowExecutionSvc,
+		shutdownCtx:          shutdownCtx,
+	}
 }
 
 func (h workflowExecutionHandler) WorkflowExecutionGet(ctx context.Context, request gen.WorkflowExecutionGetRequestObject) (gen.WorkflowExecutionGetResponseObject, error) {
@@ -34,8 +50,28 @@ func (h workflowExecutionHandler) WorkflowExecutionGet(ctx context.Context, requ
 }
 
 func (h workflowExecutionHandler) WorkflowExecutionList(ctx context.Context, request gen.WorkflowExecutionListRequestObject) (gen.WorkflowExecutionListResponseObject, error) {
+	pagingParams := paging.NewParams(
+		request.Params.PageSize,
+		request.Params.Page,
+		paging.WithMaxPageSize(1000),
+		paging.WithCursor(request.Params.Cursor),
+		paging.WithSkipCount(request.Params.Count),
+	)
+
+	var sorts []sort.Sort
+	var err error
+	if request.Params.Sort != nil {
+		sorts, err = sort.NewListFromString(*request.Params.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("sort new list from string: %w", err)
+		}
+	}
+
 	workflowExecutions, err := h.workflowExecutionSvc.ListWorkflowExecutionsByWorkflowID(ctx, service.ListWorkflowExecutionsByWorkflowIDParams{
-		WorkflowID: request.WorkflowId,
+		WorkflowID:   request.WorkflowId,
+		PagingParams: pagingParams,
+		Sorts:        sorts,
+		IsSimulated:  request.Params.IsSimulated,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list workflow executions by workflow id: %w", err)
@@ -51,8 +87,174 @@ func (h workflowExecutionHandler) WorkflowExecutionList(ctx context.Context, req
 		items[i] = res
 	}
 
-	return gen.WorkflowExecutionList200JSONResponse{
-		Items:      items,
-		TotalItems: workflowExecutions.TotalItems,
+	res := gen.WorkflowExecutionList200JSONResponse{
+		Items: items,
+	}
+	if workflowExecutions.Counted {
+		res.TotalItems = ptr.New(workflowExecutions.TotalItems)
+	}
+	if workflowExecutions.NextCursor != "" {
+		res.NextCursor = ptr.New(workflowExecutions.NextCursor)
+	}
+
+	return res, nil
+}
+
+func (h workflowExecutionHandler) WorkflowExecutionListAll(ctx context.Context, request gen.WorkflowExecutionListAllRequestObject) (gen.WorkflowExecutionListAllResponseObject, error) {
+	pagingParams := paging.NewParams(
+		request.Params.PageSize,
+		request.Params.Page,
+		paging.WithMaxPageSize(1000),
+		paging.WithCursor(request.Params.Cursor),
+		paging.WithSkipCount(request.Params.Count),
+	)
+
+	var sorts []sort.Sort
+	var err error
+	if request.Params.Sort != nil {
+		sorts, err = sort.NewListFromString(*request.Params.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("sort new list from string: %w", err)
+		}
+	}
+
+	var filters []filter.Filter
+	if request.Params.Filter != nil {
+		filters, err = filter.NewListFromString(*request.Params.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("filter new list from string: %w", err)
+		}
+	}
+
+	workflowExecutions, err := h.workflowExecutionSvc.ListWorkflowExecutions(ctx, service.ListWorkflowExecutionsParams{
+		PagingParams: pagingParams,
+		Sorts:        sorts,
+		Filters:      filters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list workflow executions: %w", err)
+	}
+
+	items := make([]gen.WorkflowExecutionResponse, len(workflowExecutions.Items))
+	for i, item := range workflowExecutions.Items {
+		res, err := converter.ToWorkflowExecutionResponse(item)
+		if err != nil {
+			return nil, fmt.Errorf("convert to workflow execution response: %w", err)
+		}
+
+		items[i] = res
+	}
+
+	res := gen.WorkflowExecutionListAll200JSONResponse{
+		Items: items,
+	}
+	if workflowExecutions.Counted {
+		res.TotalItems = ptr.New(workflowExecutions.TotalItems)
+	}
+	if workflowExecutions.NextCursor != "" {
+		res.NextCursor = ptr.New(workflowExecutions.NextCursor)
+	}
+
+	return res, nil
+}
+
+func (h workflowExecutionHandler) WorkflowExecutionCountByStatus(ctx context.Context, request gen.WorkflowExecutionCountByStatusRequestObject) (gen.WorkflowExecutionCountByStatusResponseObject, error) {
+	var filters []filter.Filter
+	if request.Params.Filter != nil {
+		var err error
+		filters, err = filter.NewListFromString(*request.Params.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("filter new list from string: %w", err)
+		}
+	}
+
+	counts, err := h.workflowExecutionSvc.CountWorkflowExecutionsByStatus(ctx, service.CountWorkflowExecutionsByStatusParams{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("count workflow executions by status: %w", err)
+	}
+
+	return gen.WorkflowExecutionCountByStatus200JSONResponse(converter.ToWorkflowExecutionStatusCountsResponse(counts)), nil
+}
+
+func (h workflowExecutionHandler) WorkflowExecutionEvents(ctx context.Context, request gen.WorkflowExecutionEventsRequestObject) (gen.WorkflowExecutionEventsResponseObject, error) {
+	var lastEventID int64
+	if request.Params.LastEventID != nil {
+		lastEventID = *request.Params.LastEventID
+	}
+
+	// The stream outlives this function, it ends when the client goes away
+	// or the server shuts down.
+	ctx, cancel := context.WithCancel(ctx)
+	stopAfterShutdown := context.AfterFunc(h.shutdownCtx, cancel)
+
+	events, err := h.workflowExecutionSvc.StreamWorkflowExecutionEvents(ctx, service.StreamWorkflowExecutionEventsParams{
+		ID:          request.WorkflowExecutionId,
+		LastEventID: lastEventID,
+	})
+	if err != nil {
+		stopAfterShutdown()
+		cancel()
+		return nil, fmt.Errorf("stream workflow execution events: %w", err)
+	}
+
+	return workflowExecutionEventsResponse{
+		events: events,
+		stop: func() {
+			stopAfterShutdown()
+			cancel()
+		},
 	}, nil
 }
+
+// workflowExecutionEventsResponse writes the events as server-sent events,
+// flushing each one.
+type workflowExecutionEventsResponse struct {
+	events <-chan workflowexecution.Event
+	stop   func()
+}
+
+func (r workflowExecutionEventsResponse) VisitWorkflowExecutionEventsResponse(w http.ResponseWriter) error {
+	defer r.stop()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	// Errors are not returned once the stream has started, they only mean
+	// that the client went away.
+	if err := rc.Flush(); err != nil {
+		return nil
+	}
+
+	keepAlive := time.NewTicker(eventStreamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case ev, ok := <-r.events:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(converter.ToWorkflowExecutionEventResponse(ev))
+			if err != nil {
+				return fmt.Errorf("marshal workflow execution event: %w", err)
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.ID, ev.Type, data); err != nil {
+				return nil
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/converter"
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type workflowVersionHandler struct {
+	workflowVersionSvc service.WorkflowVersionService
+}
+
+func newWorkflowVersionHandler(workflowVersionSvc service.WorkflowVersionService) *workflowVersionHandler {
+	return &workflowVersionHandler{workflowVersionSvc: workflowVersionSvc}
+}
+
+func (h workflowVersionHandler) WorkflowPublish(ctx context.Context, request gen.WorkflowPublishRequestObject) (gen.WorkflowPublishResponseObject, error) {
+	m, err := h.workflowVersionSvc.PublishWorkflow(ctx, service.PublishWorkflowParams{
+		WorkflowID: request.WorkflowId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workflow version service publish workflow: %w", err)
+	}
+
+	res, err := converter.ToWorkflowVersionResponse(m)
+	if err != nil {
+		return nil, fmt.Errorf("converter to workflow version response: %w", err)
+	}
+
+	return gen.WorkflowPublish201JSONResponse(res), nil
+}
+
+func (h workflowVersionHandler) WorkflowVersionList(ctx context.Context, request gen.WorkflowVersionListRequestObject) (gen.WorkflowVersionListResponseObject, error) {
+	pagingParams := paging.NewParams(
+		request.Params.PageSize,
+		request.Params.Page,
+		paging.WithMaxPageSize(1000),
+	)
+
+	var sorts []sort.Sort
+	var err error
+	if request.Params.Sort != nil {
+		sorts, err = sort.NewListFromString(*request.Params.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("sort new list from string: %w", err)
+		}
+	}
+
+	mp, err := h.workflowVersionSvc.ListWorkflowVersions(ctx, service.ListWorkflowVersionsParams{
+		WorkflowID:   request.WorkflowId,
+		PagingParams: pagingParams,
+		Sorts:        sorts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workflow version service list workflow versions: %w", err)
+	}
+
+	items := make([]gen.WorkflowVersionResponse, len(mp.Items))
+	for i, item := range mp.Items {
+		res, err := converter.ToWorkflowVersionResponse(item)
+		if err != nil {
+			return nil, fmt.Errorf("converter to workflow version response: %w", err)
+		}
+
+		items[i] = res
+	}
+
+	return gen.WorkflowVersionList200JSONResponse{
+		Items:      items,
+		TotalItems: mp.TotalItems,
+	}, nil
+}
+
+func (h workflowVersionHandler) WorkflowVersionGet(ctx context.Context, request gen.WorkflowVersionGetRequestObject) (gen.WorkflowVersionGetResponseObject, error) {
+	m, err := h.workflowVersionSvc.GetWorkflowVersion(ctx, service.GetWorkflowVersionParams{
+		WorkflowID: request.WorkflowId,
+		Version:    request.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workflow version service get workflow version: %w", err)
+	}
+
+	res, err := converter.ToWorkflowVersionResponse(m)
+	if err != nil {
+		return nil, fmt.Errorf("converter to workflow version response: %w", err)
+	}
+
+	return gen.WorkflowVersionGet200JSONResponse(res), nil
+}
+
+func (h workflowVersionHandler) WorkflowVersionDiff(ctx context.Context, request gen.WorkflowVersionDiffRequestObject) (gen.WorkflowVersionDiffResponseObject, error) {
+	diff, err := h.workflowVersionSvc.DiffWorkflowVersions(ctx, service.DiffWorkflowVersionsParams{
+		WorkflowID:  request.WorkflowId,
+		Version:     request.Version,
+		BaseVersion: request.Params.Base,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workflow version service diff workflow versions: %w", err)
+	}
+
+	return gen.WorkflowVersionDiff200JSONResponse(converter.ToWorkflowVersionDiffResponse(diff)), nil
+}
+
+func (h workflowVersionHandler) WorkflowVersionRollback(ctx context.Context, request gen.WorkflowVersionRollbackRequestObject) (gen.WorkflowVersionRollbackResponseObject, error) {
+	m, err := h.workflowVersionSvc.RollbackWorkflow(ctx, service.RollbackWorkflowParams{
+		WorkflowID: request.WorkflowId,
+		Version:    request.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workflow version service rollback workflow: %w", err)
+	}
+
+	res, err := converter.ToWorkflowResponse(m)
+	if err != nil {
+		return nil, fmt.Errorf("converter to workflow response: %w", err)
+	}
+
+	return gen.WorkflowVersionRollback200JSONResponse(res), nil
+}
@@ -3,7 +3,10 @@ package apierr
 import (
 	"errors"
 	"net/http"
+	"strings"
 
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	govalidator "github.com/go-playground/validator/v10"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
@@ -33,6 +36,32 @@ func errorToErrorResponse(err error) ErrorResponse {
 		}
 	}
 
+	var requestErr *openapi3filter.RequestError
+	if errors.As(err, &requestErr) {
+		// The validation of a whole request reports an error per invalid
+		// parameter or body.
+		var details []gen.FieldError
+		if multiErr, ok := err.(openapi3.MultiError); ok {
+			for _, e := range multiErr {
+				var requestErr *openapi3filter.RequestError
+				if errors.As(e, &requestErr) {
+					details = append(details, requestErrorDetails(requestErr)...)
+				}
+			}
+		}
+		if len(details) == 0 {
+			details = requestErrorDetails(requestErr)
+		}
+		return ErrorResponse{
+			ErrorResponse: gen.ErrorResponse{
+				Code:    "validationError",
+				Message: "validation error",
+				Details: &details,
+			},
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+
 	var validationErrs govalidator.ValidationErrors
 	if errors.As(err, &validationErrs) {
 		details := make([]gen.FieldError, len(validationErrs))
@@ -61,3 +90,64 @@ func errorToErrorResponse(err error) ErrorResponse {
 		StatusCode: http.StatusInternalServerError,
 	}
 }
+
+// requestErrorDetails returns an error per invalid field of a request not
+// matching the OpenAPI spec. The field of a body is its JSON path, a oneOf
+// mismatch reports the errors of the schemas it was checked against.
+func requestErrorDetails(err *openapi3filter.RequestError) []gen.FieldError {
+	if err.Parameter != nil {
+		return []gen.FieldError{{Field: err.Parameter.Name, Message: parameterErrorMessage(err)}}
+	}
+
+	details := []gen.FieldError{}
+	var visit func(error)
+	visit = func(err error) {
+		var multiErr openapi3.MultiError
+		if errors.As(err, &multiErr) {
+			for _, e := range multiErr {
+				visit(e)
+			}
+			return
+		}
+
+		var schemaErr *openapi3.SchemaError
+		if !errors.As(err, &schemaErr) {
+			details = append(details, gen.FieldError{Field: "body", Message: err.Error()})
+			return
+		}
+		if schemaErr.Origin != nil && schemaErr.SchemaField == "oneOf" {
+			visit(schemaErr.Origin)
+			return
+		}
+
+		field := strings.Join(schemaErr.JSONPointer(), ".")
+		if schemaErr.SchemaField == "discriminator" {
+			field = schemaErr.Schema.Discriminator.PropertyName
+		}
+		if field == "" {
+			field = "body"
+		}
+		details = append(details, gen.FieldError{Field: field, Message: schemaErr.Reason})
+	}
+	visit(err.Err)
+
+	return details
+}
+
+// parameterErrorMessage returns why a parameter is invalid, the reason of a
+// parameter not matching its schema is held by the wrapped error.
+func parameterErrorMessage(err *openapi3filter.RequestError) string {
+	if err.Reason != "" {
+		return err.Reason
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	if err.Err != nil {
+		return err.Err.Error()
+	}
+
+	return "invalid value"
+}
@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	deadletter "github.com/tuanvumaihuynh/roboflow/internal/model/dead_letter"
+)
+
+func ToDeadLetterMessageResponse(m deadletter.Message) gen.DeadLetterMessageResponse {
+	metadata := m.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	return gen.DeadLetterMessageResponse{
+		Id:             m.ID,
+		MessageId:      m.MessageID,
+		Topic:          m.Topic,
+		Handler:        m.Handler,
+		Subscriber:     m.Subscriber,
+		Reason:         m.Reason,
+		Payload:        string(m.Payload),
+		Metadata:       metadata,
+		ReplayCount:    m.ReplayCount,
+		LastReplayedAt: m.LastReplayedAt,
+		CreatedAt:      m.CreatedAt,
+	}
+}
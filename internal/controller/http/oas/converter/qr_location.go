@@ -15,3 +15,70 @@ func ToQRLocationResponse(m qrlocation.QRLocation) gen.QRLocationResponse {
 		UpdatedAt: m.UpdatedAt,
 	}
 }
+
+func ToQRLocationImportItem(m qrlocation.QRLocation) gen.QRLocationImportItem {
+	return gen.QRLocationImportItem{
+		Name:     m.Name,
+		QrCode:   m.QRCode,
+		Metadata: &m.Metadata,
+	}
+}
+
+// FromImportQRLocationsRequest returns the rows of the request, numbered
+// from 1. A missing metadata is an empty object.
+func FromImportQRLocationsRequest(req gen.ImportQRLocationsRequest) []qrlocation.ImportRow {
+	rows := make([]qrlocation.ImportRow, len(req))
+	for i, item := range req {
+		metadata := map[string]any{}
+		if item.Metadata != nil {
+			metadata = *item.Metadata
+		}
+		rows[i] = qrlocation.ImportRow{
+			Row:      i + 1,
+			Name:     item.Name,
+			QRCode:   item.QrCode,
+			Metadata: metadata,
+		}
+	}
+	return rows
+}
+
+func ToImportQRLocationsResponse(plan qrlocation.ImportPlan, dryRun bool) gen.ImportQRLocationsResponse {
+	conflicts := make([]gen.QRLocationImportConflict, len(plan.Conflicts))
+	for i, c := range plan.Conflicts {
+		conflicts[i] = gen.QRLocationImportConflict{
+			Row:    c.Row,
+			QrCode: c.QRCode,
+			Reason: c.Reason,
+		}
+	}
+
+	created := toQRLocationImportReportItems(plan.Creates)
+	if dryRun {
+		// The ids of the locations a dry run would create are not kept.
+		for i := range created {
+			created[i].Id = nil
+		}
+	}
+
+	return gen.ImportQRLocationsResponse{
+		DryRun:    dryRun,
+		Created:   created,
+		Updated:   toQRLocationImportReportItems(plan.Updates),
+		Unchanged: toQRLocationImportReportItems(plan.Unchanged),
+		Conflicts: conflicts,
+	}
+}
+
+func toQRLocationImportReportItems(items []qrlocation.ImportItem) []gen.QRLocationImportReportItem {
+	res := make([]gen.QRLocationImportReportItem, len(items))
+	for i, item := range items {
+		res[i] = gen.QRLocationImportReportItem{
+			Row:    item.Row,
+			Id:     &item.Location.ID,
+			Name:   item.Location.Name,
+			QrCode: item.Location.QRCode,
+		}
+	}
+	return res
+}
@@ -1,21 +1,97 @@
 package converter
 
 import (
+	"fmt"
+
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
 	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
 )
 
 func ToRaybotCommandResponse(m raybotcommand.RaybotCommand) gen.RaybotCommandResponse {
+	// The unions only hold the raw JSON, setting them never fails.
+	var inputs gen.RaybotCommandInputs
+	_ = inputs.UnmarshalJSON(m.Inputs.Raw())
+	var outputs gen.RaybotCommandOutputs
+	_ = outputs.UnmarshalJSON(m.Outputs.Raw())
+
 	return gen.RaybotCommandResponse{
 		Id:          m.ID,
 		RaybotId:    m.RaybotID,
 		Type:        string(m.Type),
 		Status:      string(m.Status),
-		Inputs:      m.Inputs.Raw(),
-		Outputs:     m.Outputs.Raw(),
+		Inputs:      inputs,
+		Outputs:     outputs,
 		Error:       m.Error,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
 		CompletedAt: m.CompletedAt,
 	}
 }
+
+// FromCreateRaybotCommandRequest returns the type and the inputs of the
+// command to create. The commands without inputs get empty inputs.
+func FromCreateRaybotCommandRequest(req gen.CreateRaybotCommandRequest) (raybotcommand.Type, raybotcommand.Inputs, error) {
+	discriminator, err := req.Discriminator()
+	if err != nil {
+		return "", raybotcommand.Inputs{}, fmt.Errorf("discriminator: %w", err)
+	}
+
+	commandType := raybotcommand.Type(discriminator)
+	inputs := raybotcommand.NewInputs([]byte(`{}`))
+	switch commandType {
+	case raybotcommand.TypeMoveToLocation:
+		body, err := req.AsCreateMoveToLocationCommandRequest()
+		if err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("as create move to location command request: %w", err)
+		}
+		err = inputs.FromMoveToLocationInput(raybotcommand.MoveToLocationInput{
+			Location:  body.Inputs.Location,
+			Direction: raybotcommand.MoveDirection(body.Inputs.Direction),
+		})
+		if err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("from move to location input: %w", err)
+		}
+	case raybotcommand.TypeLiftBox:
+		body, err := req.AsCreateLiftBoxCommandRequest()
+		if err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("as create lift box command request: %w", err)
+		}
+		input := raybotcommand.LiftBoxInput{}
+		if body.Inputs != nil && body.Inputs.Distance != nil {
+			input.Distance = *body.Inputs.Distance
+		}
+		if err := inputs.FromLiftBoxInput(input); err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("from lift box input: %w", err)
+		}
+	case raybotcommand.TypeDropBox:
+		body, err := req.AsCreateDropBoxCommandRequest()
+		if err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("as create drop box command request: %w", err)
+		}
+		input := raybotcommand.DropBoxInput{}
+		if body.Inputs != nil && body.Inputs.Distance != nil {
+			input.Distance = *body.Inputs.Distance
+		}
+		if err := inputs.FromDropBoxInput(input); err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("from drop box input: %w", err)
+		}
+	case raybotcommand.TypeCheckQRCode:
+		body, err := req.AsCreateCheckQRCodeCommandRequest()
+		if err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("as create check qr code command request: %w", err)
+		}
+		if err := inputs.FromCheckQRCodeInput(raybotcommand.CheckQRCodeInput{QRCode: body.Inputs.QrCode}); err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("from check qr code input: %w", err)
+		}
+	case raybotcommand.TypeSpeak:
+		body, err := req.AsCreateSpeakCommandRequest()
+		if err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("as create speak command request: %w", err)
+		}
+		if err := inputs.FromSpeakInput(raybotcommand.SpeakInput{Text: body.Inputs.Text}); err != nil {
+			return "", raybotcommand.Inputs{}, fmt.Errorf("from speak input: %w", err)
+		}
+	}
+
+	return commandType, inputs, nil
+}
@@ -0,0 +1,17 @@
+package converter
+
+import (
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	raybotposition "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_position"
+)
+
+func ToRaybotPositionResponse(m raybotposition.Position) gen.RaybotPositionResponse {
+	return gen.RaybotPositionResponse{
+		Id:         m.ID,
+		RaybotId:   m.RaybotID,
+		LocationId: m.LocationID,
+		Source:     string(m.Source),
+		CommandId:  m.CommandID,
+		CreatedAt:  m.CreatedAt,
+	}
+}
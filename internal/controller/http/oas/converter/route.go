@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+	"github.com/tuanvumaihuynh/roboflow/pkg/ptr"
+)
+
+func ToRouteResponse(m trackmap.Route) gen.RouteResponse {
+	var direction *string
+	if d := m.Direction(); d != "" {
+		direction = ptr.New(string(d))
+	}
+
+	moves := m.Moves()
+	genMoves := make([]gen.RouteMove, len(moves))
+	for i, move := range moves {
+		genMoves[i] = gen.RouteMove{
+			Location:  move.ToLocationID,
+			Direction: string(move.Direction),
+			Distance:  move.Distance,
+		}
+	}
+
+	segments := make([]gen.TrackSegmentResponse, len(m.Segments))
+	for i, segment := range m.Segments {
+		segments[i] = ToTrackSegmentResponse(segment)
+	}
+
+	return gen.RouteResponse{
+		FromLocationId: m.FromLocationID,
+		ToLocationId:   m.ToLocationID,
+		Distance:       m.Distance,
+		Direction:      direction,
+		Moves:          genMoves,
+		Segments:       segments,
+	}
+}
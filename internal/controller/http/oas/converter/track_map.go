@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+)
+
+func ToTrackSegmentResponse(m trackmap.Segment) gen.TrackSegmentResponse {
+	return gen.TrackSegmentResponse{
+		Id:             m.ID,
+		FromLocationId: m.FromLocationID,
+		ToLocationId:   m.ToLocationID,
+		Distance:       m.Distance,
+		Direction:      string(m.Direction),
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func ToTrackMapResponse(m trackmap.Map) gen.TrackMapResponse {
+	locations := make([]gen.QRLocationResponse, len(m.Locations))
+	for i, location := range m.Locations {
+		locations[i] = ToQRLocationResponse(location)
+	}
+
+	segments := make([]gen.TrackSegmentResponse, len(m.Segments))
+	for i, segment := range m.Segments {
+		segments[i] = ToTrackSegmentResponse(segment)
+	}
+
+	components := m.Components()
+
+	return gen.TrackMapResponse{
+		Locations:  locations,
+		Segments:   segments,
+		Connected:  len(components) <= 1,
+		Components: components,
+	}
+}
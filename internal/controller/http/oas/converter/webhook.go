@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+)
+
+// ToWebhookSubscriptionResponse converts a Subscription, leaving out its secret.
+func ToWebhookSubscriptionResponse(m webhook.Subscription) gen.WebhookSubscriptionResponse {
+	eventTypes := m.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	return gen.WebhookSubscriptionResponse{
+		Id:         m.ID,
+		Url:        m.URL,
+		EventTypes: eventTypes,
+		IsActive:   m.IsActive,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+func ToWebhookDeliveryResponse(m webhook.Delivery) gen.WebhookDeliveryResponse {
+	return gen.WebhookDeliveryResponse{
+		Id:                 m.ID,
+		SubscriptionId:     m.SubscriptionID,
+		EventId:            m.EventID,
+		EventType:          m.EventType,
+		Status:             string(m.Status),
+		Attempts:           m.Attempts,
+		NextAttemptAt:      m.NextAttemptAt,
+		LastAttemptAt:      m.LastAttemptAt,
+		LastResponseStatus: m.LastResponseStatus,
+		LastError:          m.LastError,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
 )
 
 func ToWorkflowResponse(m workflow.Workflow) (gen.WorkflowResponse, error) {
@@ -15,29 +16,123 @@ func ToWorkflowResponse(m workflow.Workflow) (gen.WorkflowResponse, error) {
 	}
 
 	return gen.WorkflowResponse{
-		Id:          m.ID,
-		Name:        m.Name,
-		Description: m.Description,
-		IsDraft:     m.IsDraft,
-		Data:        data,
-		CreatedAt:   m.CreatedAt,
-		UpdatedAt:   m.UpdatedAt,
+		Id:                 m.ID,
+		Name:               m.Name,
+		Description:        m.Description,
+		IsDraft:            m.IsDraft,
+		Data:               data,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+		PublishedVersion:   m.PublishedVersion,
+		IsTemplate:         m.IsTemplate,
+		TemplateParameters: ToWorkflowTemplateParameters(m.TemplateParameters),
 	}, nil
 }
 
 func ToWorkflowItemListResponse(m workflow.Workflow) gen.WorkflowItemListResponse {
 	return gen.WorkflowItemListResponse{
-		Id:          m.ID,
-		Name:        m.Name,
-		Description: m.Description,
-		IsDraft:     m.IsDraft,
-		CreatedAt:   m.CreatedAt,
-		UpdatedAt:   m.UpdatedAt,
+		Id:               m.ID,
+		Name:             m.Name,
+		Description:      m.Description,
+		IsDraft:          m.IsDraft,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+		PublishedVersion: m.PublishedVersion,
+		IsTemplate:       m.IsTemplate,
 	}
 }
 
+func ToWorkflowTemplateParameters(params []workflow.TemplateParameter) []gen.WorkflowTemplateParameter {
+	res := make([]gen.WorkflowTemplateParameter, len(params))
+	for i, p := range params {
+		res[i] = gen.WorkflowTemplateParameter{
+			Key:         p.Key,
+			InputType:   string(p.InputType),
+			Description: p.Description,
+		}
+	}
+	return res
+}
+
+func FromWorkflowTemplateParameters(params []gen.WorkflowTemplateParameter) []workflow.TemplateParameter {
+	res := make([]workflow.TemplateParameter, len(params))
+	for i, p := range params {
+		res[i] = workflow.TemplateParameter{
+			Key:         p.Key,
+			InputType:   node.InputType(p.InputType),
+			Description: p.Description,
+		}
+	}
+	return res
+}
+
 func ToWorkflowRunResponse(workflowExecutionID string) gen.RunWorkflowResponse {
 	return gen.RunWorkflowResponse{
 		Id: workflowExecutionID,
 	}
 }
+
+func ToWorkflowDocument(m workflow.Document) (gen.WorkflowDocument, error) {
+	data, err := json.Marshal(m.Data)
+	if err != nil {
+		return gen.WorkflowDocument{}, fmt.Errorf("json marshal workflow data: %w", err)
+	}
+
+	qrLocations := make([]gen.WorkflowDocumentQRLocation, len(m.QRLocations))
+	for i, l := range m.QRLocations {
+		qrLocations[i] = gen.WorkflowDocumentQRLocation{
+			Id:     l.ID,
+			Name:   l.Name,
+			QrCode: l.QRCode,
+		}
+	}
+
+	res := gen.WorkflowDocument{
+		FormatVersion: m.FormatVersion,
+		Name:          m.Name,
+		Description:   m.Description,
+		ExportedAt:    m.ExportedAt,
+		Data:          data,
+		QrLocations:   qrLocations,
+	}
+	if m.IsTemplate {
+		templateParameters := ToWorkflowTemplateParameters(m.TemplateParameters)
+		res.IsTemplate = &m.IsTemplate
+		res.TemplateParameters = &templateParameters
+	}
+
+	return res, nil
+}
+
+func FromWorkflowDocument(doc gen.WorkflowDocument) (workflow.Document, error) {
+	data := workflow.Data{}
+	if err := json.Unmarshal(doc.Data, &data); err != nil {
+		return workflow.Document{}, fmt.Errorf("unmarshal workflow data: %w", err)
+	}
+
+	qrLocations := make([]workflow.DocumentQRLocation, len(doc.QrLocations))
+	for i, l := range doc.QrLocations {
+		qrLocations[i] = workflow.DocumentQRLocation{
+			ID:     l.Id,
+			Name:   l.Name,
+			QRCode: l.QrCode,
+		}
+	}
+
+	res := workflow.Document{
+		FormatVersion: doc.FormatVersion,
+		Name:          doc.Name,
+		Description:   doc.Description,
+		ExportedAt:    doc.ExportedAt,
+		Data:          data,
+		QRLocations:   qrLocations,
+	}
+	if doc.IsTemplate != nil {
+		res.IsTemplate = *doc.IsTemplate
+	}
+	if doc.TemplateParameters != nil {
+		res.TemplateParameters = FromWorkflowTemplateParameters(*doc.TemplateParameters)
+	}
+
+	return res, nil
+}
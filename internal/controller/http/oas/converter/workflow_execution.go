@@ -15,16 +15,61 @@ func ToWorkflowExecutionResponse(m workflowexecution.WorkflowExecution) (gen.Wor
 	}
 
 	return gen.WorkflowExecutionResponse{
-		Id:          m.ID,
-		WorkflowId:  m.WorkflowID,
-		Status:      string(m.Status),
-		Data:        data,
-		Inputs:      m.Inputs,
-		Outputs:     m.Outputs,
-		Error:       m.Error,
-		StartedAt:   m.StartedAt,
-		CompletedAt: m.CompletedAt,
-		CreatedAt:   m.CreatedAt,
-		UpdatedAt:   m.UpdatedAt,
+		Id:                m.ID,
+		WorkflowId:        m.WorkflowID,
+		Status:            string(m.Status),
+		Data:              data,
+		Inputs:            m.Inputs,
+		Outputs:           m.Outputs,
+		Error:             m.Error,
+		StartedAt:         m.StartedAt,
+		CompletedAt:       m.CompletedAt,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+		WorkflowVersionId: m.WorkflowVersionID,
+		IsSimulated:       m.IsSimulated,
+		TriggerType:       string(m.TriggerType),
+		RaybotId:          m.RaybotID,
 	}, nil
 }
+
+// ToWorkflowExecutionStatusCountsResponse converts the counts by status, in
+// the order of the statuses of an execution.
+func ToWorkflowExecutionStatusCountsResponse(counts map[workflowexecution.Status]int64) gen.WorkflowExecutionStatusCountsResponse {
+	statuses := []workflowexecution.Status{
+		workflowexecution.StatusPending,
+		workflowexecution.StatusRunning,
+		workflowexecution.StatusCompleted,
+		workflowexecution.StatusFailed,
+		workflowexecution.StatusCancelled,
+	}
+
+	res := gen.WorkflowExecutionStatusCountsResponse{
+		Items: make([]gen.WorkflowExecutionStatusCount, len(statuses)),
+	}
+	for i, status := range statuses {
+		res.Items[i] = gen.WorkflowExecutionStatusCount{
+			Status: string(status),
+			Count:  counts[status],
+		}
+		res.Total += counts[status]
+	}
+	return res
+}
+
+func ToWorkflowExecutionEventResponse(m workflowexecution.Event) gen.WorkflowExecutionEventResponse {
+	res := gen.WorkflowExecutionEventResponse{
+		Id:                  m.ID,
+		WorkflowExecutionId: m.WorkflowExecutionID,
+		StepExecutionId:     m.StepExecutionID,
+		Type:                string(m.Type),
+		Status:              m.Status,
+		Error:               m.Error,
+		CreatedAt:           m.CreatedAt,
+	}
+	if m.Outputs != nil {
+		res.Outputs = &m.Outputs
+	}
+
+	return res
+}
@@ -0,0 +1,35 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+)
+
+func ToWorkflowVersionResponse(m workflow.Version) (gen.WorkflowVersionResponse, error) {
+	data, err := json.Marshal(m.Data)
+	if err != nil {
+		return gen.WorkflowVersionResponse{}, fmt.Errorf("json marshal workflow version data: %w", err)
+	}
+
+	return gen.WorkflowVersionResponse{
+		Id:         m.ID,
+		WorkflowId: m.WorkflowID,
+		Version:    m.Version,
+		Data:       data,
+		CreatedAt:  m.CreatedAt,
+	}, nil
+}
+
+func ToWorkflowVersionDiffResponse(m workflow.DataDiff) gen.WorkflowVersionDiffResponse {
+	return gen.WorkflowVersionDiffResponse{
+		AddedNodes:   m.AddedNodes,
+		RemovedNodes: m.RemovedNodes,
+		ChangedNodes: m.ChangedNodes,
+		AddedEdges:   m.AddedEdges,
+		RemovedEdges: m.RemovedEdges,
+		ChangedEdges: m.ChangedEdges,
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -23,9 +25,39 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// CheckQRCodeInput defines model for CheckQRCodeInput.
+type CheckQRCodeInput struct {
+	// QrCode The QR code of the QR location the raybot must be on.
+	QrCode string `json:"qr_code"`
+}
+
 // ControlMode defines model for ControlMode.
 type ControlMode = string
 
+// CreateCheckQRCodeCommandRequest defines model for CreateCheckQRCodeCommandRequest.
+type CreateCheckQRCodeCommandRequest struct {
+	Type   string           `json:"type"`
+	Inputs CheckQRCodeInput `json:"inputs"`
+}
+
+// CreateDropBoxCommandRequest defines model for CreateDropBoxCommandRequest.
+type CreateDropBoxCommandRequest struct {
+	Type   string        `json:"type"`
+	Inputs *DropBoxInput `json:"inputs,omitempty"`
+}
+
+// CreateLiftBoxCommandRequest defines model for CreateLiftBoxCommandRequest.
+type CreateLiftBoxCommandRequest struct {
+	Type   string        `json:"type"`
+	Inputs *LiftBoxInput `json:"inputs,omitempty"`
+}
+
+// CreateMoveToLocationCommandRequest defines model for CreateMoveToLocationCommandRequest.
+type CreateMoveToLocationCommandRequest struct {
+	Type   string              `json:"type"`
+	Inputs MoveToLocationInput `json:"inputs"`
+}
+
 // CreateQRLocationRequest defines model for CreateQRLocationRequest.
 type CreateQRLocationRequest struct {
 	// Metadata The metadata of the location.
@@ -38,10 +70,17 @@ type CreateQRLocationRequest struct {
 	QrCode string `json:"qrCode"`
 }
 
-// CreateRaybotCommandRequest defines model for CreateRaybotCommandRequest.
+// CreateRaybotCommandRequest The command to create, its `inputs` depend on its `type`.
 type CreateRaybotCommandRequest struct {
-	Inputs json.RawMessage   `json:"inputs"`
-	Type   RaybotCommandType `json:"type"`
+	union json.RawMessage
+}
+
+// CreateRaybotCommandWithoutInputsRequest defines model for CreateRaybotCommandWithoutInputsRequest.
+type CreateRaybotCommandWithoutInputsRequest struct {
+	Type string `json:"type"`
+
+	// Inputs Ignored, the command takes no input.
+	Inputs *map[string]any `json:"inputs,omitempty"`
 }
 
 // CreateRaybotRequest defines model for CreateRaybotRequest.
@@ -50,6 +89,42 @@ type CreateRaybotRequest struct {
 	Name string `json:"name"`
 }
 
+// CreateSpeakCommandRequest defines model for CreateSpeakCommandRequest.
+type CreateSpeakCommandRequest struct {
+	Type   string     `json:"type"`
+	Inputs SpeakInput `json:"inputs"`
+}
+
+// CreateTrackSegmentRequest defines model for CreateTrackSegmentRequest.
+type CreateTrackSegmentRequest struct {
+	// Direction The direction a raybot moves along the rail.
+	Direction MoveDirection `json:"direction"`
+
+	// Distance The length of the segment in millimeters.
+	Distance int32 `json:"distance"`
+
+	// FromLocationId The id of the QR location the segment starts at.
+	FromLocationId string `json:"fromLocationId"`
+
+	// ToLocationId The id of the QR location the segment ends at, different from the start.
+	ToLocationId string `json:"toLocationId"`
+}
+
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	// Url The HTTP or HTTPS URL receiving the events.
+	Url string `json:"url"`
+
+	// Secret The secret signing the requests. The `X-Roboflow-Signature` header of each request is `t=<unix timestamp>,v1=<hex HMAC-SHA256 of "<unix timestamp>.<body>">`.
+	Secret string `json:"secret"`
+
+	// EventTypes The event types sent to the URL, empty for every event. The event types are documented in the AsyncAPI specification.
+	EventTypes []string `json:"eventTypes"`
+
+	// IsActive Whether events are sent to the URL.
+	IsActive *bool `json:"isActive,omitempty"`
+}
+
 // CreateWorkflowRequest defines model for CreateWorkflowRequest.
 type CreateWorkflowRequest struct {
 	// Name The name of the workflow.
@@ -60,6 +135,69 @@ type CreateWorkflowRequest struct {
 
 	// Data The data of the workflow.
 	Data json.RawMessage `json:"data"`
+
+	// IsTemplate Whether the workflow is a template. Defaults to false.
+	IsTemplate *bool `json:"isTemplate,omitempty"`
+
+	// TemplateParameters The parameters of the template. The data references a parameter with the `{{key}}` placeholder where a raybot or QR location id is expected.
+	TemplateParameters *[]WorkflowTemplateParameter `json:"templateParameters,omitempty"`
+}
+
+// DeadLetterMessageResponse A message whose handler still failed after its retries, moved to the dead-letter topic.
+type DeadLetterMessageResponse struct {
+	// Id The id of the resource, in UUID format
+	Id string `json:"id"`
+
+	// MessageId The id of the message on its original topic.
+	MessageId string `json:"messageId"`
+
+	// Topic The original topic of the message.
+	Topic string `json:"topic"`
+
+	// Handler The name of the handler which failed.
+	Handler string `json:"handler"`
+
+	// Subscriber The name of the subscriber of the handler.
+	Subscriber string `json:"subscriber"`
+
+	// Reason The error of the last attempt of the handler.
+	Reason string `json:"reason"`
+
+	// Payload The payload of the message, the event envelope for the domain event topics.
+	Payload string `json:"payload"`
+
+	// Metadata The metadata of the message.
+	Metadata map[string]string `json:"metadata"`
+
+	// ReplayCount The number of times the message was replayed.
+	ReplayCount int32 `json:"replayCount"`
+
+	// LastReplayedAt The time the message was last replayed.
+	LastReplayedAt *time.Time `json:"lastReplayedAt"`
+
+	// CreatedAt The time the message was dead-lettered.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// DeadLetterMessagesListResponse defines model for DeadLetterMessagesListResponse.
+type DeadLetterMessagesListResponse struct {
+	Items      []DeadLetterMessageResponse `json:"items"`
+	TotalItems int64                       `json:"totalItems"`
+}
+
+// DropBoxInput defines model for DropBoxInput.
+type DropBoxInput struct {
+	// Distance How far to drop the box in millimeters, 0 lets the raybot use its default.
+	Distance *int32 `json:"distance,omitempty"`
+}
+
+// DuplicateWorkflowRequest defines model for DuplicateWorkflowRequest.
+type DuplicateWorkflowRequest struct {
+	// Name The name of the new workflow.
+	Name string `json:"name"`
+
+	// Description The description of the new workflow. Defaults to the description of the duplicated workflow.
+	Description *string `json:"description,omitempty"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
@@ -81,6 +219,65 @@ type FieldError struct {
 	Message string `json:"message"`
 }
 
+// ImportQRLocationsRequest defines model for ImportQRLocationsRequest.
+type ImportQRLocationsRequest = []QRLocationImportItem
+
+// ImportQRLocationsResponse defines model for ImportQRLocationsResponse.
+type ImportQRLocationsResponse struct {
+	// DryRun Whether the import was a dry run, which changes nothing.
+	DryRun bool `json:"dryRun"`
+
+	// Created The locations the import creates.
+	Created []QRLocationImportReportItem `json:"created"`
+
+	// Updated The locations whose name or metadata the import updates.
+	Updated []QRLocationImportReportItem `json:"updated"`
+
+	// Unchanged The locations the import leaves unchanged.
+	Unchanged []QRLocationImportReportItem `json:"unchanged"`
+
+	// Conflicts The rows which can not be imported. They prevent the whole import.
+	Conflicts []QRLocationImportConflict `json:"conflicts"`
+}
+
+// ImportWorkflowResponse defines model for ImportWorkflowResponse.
+type ImportWorkflowResponse struct {
+	Workflow WorkflowResponse `json:"workflow"`
+
+	// MissingQrLocations The QR codes of the document that do not exist on this site.
+	MissingQrLocations []string `json:"missingQrLocations"`
+}
+
+// InstantiateWorkflowTemplateRequest defines model for InstantiateWorkflowTemplateRequest.
+type InstantiateWorkflowTemplateRequest struct {
+	// Name The name of the new workflow.
+	Name string `json:"name"`
+
+	// Description The description of the new workflow. Defaults to the description of the template.
+	Description *string `json:"description,omitempty"`
+
+	// Parameters The value of every template parameter, keyed by `WorkflowTemplateParameter.key`. Each value is the id of an existing raybot or QR location, depending on the parameter input type.
+	Parameters map[string]string `json:"parameters"`
+}
+
+// LiftBoxInput defines model for LiftBoxInput.
+type LiftBoxInput struct {
+	// Distance How far to lift the box in millimeters, 0 lets the raybot use its default.
+	Distance *int32 `json:"distance,omitempty"`
+}
+
+// MoveDirection The direction a raybot moves along the rail.
+type MoveDirection = string
+
+// MoveToLocationInput defines model for MoveToLocationInput.
+type MoveToLocationInput struct {
+	// Location The id of the QR location to move to, in UUID format.
+	Location string `json:"location"`
+
+	// Direction The direction a raybot moves along the rail.
+	Direction MoveDirection `json:"direction"`
+}
+
 // NodeType defines model for NodeType.
 type NodeType = string
 
@@ -90,6 +287,70 @@ type Position struct {
 	Y float32 `json:"y"`
 }
 
+// PurgeDeadLetterMessagesResponse defines model for PurgeDeadLetterMessagesResponse.
+type PurgeDeadLetterMessagesResponse struct {
+	// DeletedCount The number of deleted messages.
+	DeletedCount int64 `json:"deletedCount"`
+}
+
+// QRLocationImportConflict defines model for QRLocationImportConflict.
+type QRLocationImportConflict struct {
+	// Row The position of the row in the document, from 1, the CSV header excluded.
+	Row int `json:"row"`
+
+	// QrCode The QR code of the row.
+	QrCode string `json:"qrCode"`
+
+	// Reason Why the row can not be imported.
+	Reason string `json:"reason"`
+}
+
+// QRLocationImportItem defines model for QRLocationImportItem.
+type QRLocationImportItem struct {
+	// Name The name of the location.
+	Name string `json:"name"`
+
+	// QrCode The QR code of the location, which identifies it during the import.
+	QrCode string `json:"qrCode"`
+
+	// Metadata The metadata of the location. Defaults to an empty object.
+	Metadata *map[string]any `json:"metadata,omitempty"`
+}
+
+// QRLocationImportReportItem defines model for QRLocationImportReportItem.
+type QRLocationImportReportItem struct {
+	// Row The position of the row in the document, from 1, the CSV header excluded.
+	Row int `json:"row"`
+
+	// Id The id of the location, in UUID format. Absent from the locations a dry run would create.
+	Id *string `json:"id,omitempty"`
+
+	// Name The name of the location.
+	Name string `json:"name"`
+
+	// QrCode The QR code of the location.
+	QrCode string `json:"qrCode"`
+}
+
+// QRLocationLabelsPDFRequest defines model for QRLocationLabelsPDFRequest.
+type QRLocationLabelsPDFRequest struct {
+	// QrLocationIds The ids of the QR locations to print, in UUID format.
+	QrLocationIds []string `json:"qrLocationIds"`
+
+	// Size The width of a label, in millimetres. Defaults to 50.
+	Size *float64 `json:"size,omitempty"`
+
+	// ErrorCorrection The error-correction level of the QR codes.
+	// Allowed values: `L` (7%), `M` (15%), `Q` (25%), `H` (30%). Defaults to `M`.
+	ErrorCorrection *string `json:"errorCorrection,omitempty"`
+}
+
+// QRLocationOccupantsResponse defines model for QRLocationOccupantsResponse.
+type QRLocationOccupantsResponse struct {
+	// Items The current positions of the raybots standing at the QR location.
+	Items []RaybotPositionResponse `json:"items"`
+}
+
 // QRLocationResponse defines model for QRLocationResponse.
 type QRLocationResponse struct {
 	// Id The id of the resource, in UUID format
@@ -119,21 +380,41 @@ type QRLocationsListResponse struct {
 	TotalItems int64 `json:"totalItems"`
 }
 
+// RaybotCommandInputs The inputs of the command, described by the input schema of its `type`. The commands of the other types have empty inputs.
+type RaybotCommandInputs struct {
+	union json.RawMessage
+}
+
+// RaybotCommandInputs5 defines model for .
+type RaybotCommandInputs5 = map[string]interface{}
+
+// RaybotCommandOutputs The outputs of the command, described by `ScanLocationOutputs` for a succeeded `SCAN_LOCATION` command. The commands of the other types have empty outputs.
+type RaybotCommandOutputs struct {
+	union json.RawMessage
+}
+
+// RaybotCommandOutputs1 defines model for .
+type RaybotCommandOutputs1 = map[string]interface{}
+
 // RaybotCommandResponse defines model for RaybotCommandResponse.
 type RaybotCommandResponse struct {
 	// Id The id of the resource, in UUID format
 	Id string `json:"id"`
 
 	// RaybotId The id of the resource, in UUID format
-	RaybotId    string              `json:"raybotId"`
-	Type        RaybotCommandType   `json:"type"`
-	Status      RaybotCommandStatus `json:"status"`
-	Inputs      json.RawMessage     `json:"inputs"`
-	Outputs     json.RawMessage     `json:"outputs"`
-	Error       *string             `json:"error"`
-	CreatedAt   time.Time           `json:"createdAt"`
-	UpdatedAt   time.Time           `json:"updatedAt"`
-	CompletedAt *time.Time          `json:"completedAt"`
+	RaybotId string              `json:"raybotId"`
+	Type     RaybotCommandType   `json:"type"`
+	Status   RaybotCommandStatus `json:"status"`
+
+	// Inputs The inputs of the command, described by the input schema of its `type`. The commands of the other types have empty inputs.
+	Inputs RaybotCommandInputs `json:"inputs"`
+
+	// Outputs The outputs of the command, described by `ScanLocationOutputs` for a succeeded `SCAN_LOCATION` command. The commands of the other types have empty outputs.
+	Outputs     RaybotCommandOutputs `json:"outputs"`
+	Error       *string              `json:"error"`
+	CreatedAt   time.Time            `json:"createdAt"`
+	UpdatedAt   time.Time            `json:"updatedAt"`
+	CompletedAt *time.Time           `json:"completedAt"`
 }
 
 // RaybotCommandStatus defines model for RaybotCommandStatus.
@@ -144,8 +425,49 @@ type RaybotCommandType = string
 
 // RaybotCommandsListResponse defines model for RaybotCommandsListResponse.
 type RaybotCommandsListResponse struct {
-	Items      []RaybotCommandResponse `json:"items"`
-	TotalItems int64                   `json:"totalItems"`
+	Items []RaybotCommandResponse `json:"items"`
+
+	// NextCursor The cursor of the next page, left out on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalItems The total number of items, left out when `count` is `false`
+	TotalItems *int64 `json:"totalItems,omitempty"`
+}
+
+// RaybotPositionResponse defines model for RaybotPositionResponse.
+type RaybotPositionResponse struct {
+	// Id The id of the resource, in UUID format
+	Id string `json:"id"`
+
+	// RaybotId The id of the raybot.
+	RaybotId string `json:"raybotId"`
+
+	// LocationId The id of the QR location the raybot was seen at.
+	LocationId string `json:"locationId"`
+
+	// Source What located the raybot:
+	//   - **REPORT**: a QR code reported by the raybot.
+	//   - **CHECK_QR**: the QR code of a succeeded `CHECK_QR` command.
+	//   - **SCAN_LOCATION**: the last QR code of a succeeded `SCAN_LOCATION` command.
+	//   - **MOVE_TO_LOCATION**: the destination of a succeeded `MOVE_TO_LOCATION` command.
+	Source RaybotPositionSource `json:"source"`
+
+	// CommandId The id of the command which located the raybot, null when the raybot reported it.
+	CommandId *string   `json:"commandId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// RaybotPositionSource What located the raybot:
+//   - **REPORT**: a QR code reported by the raybot.
+//   - **CHECK_QR**: the QR code of a succeeded `CHECK_QR` command.
+//   - **SCAN_LOCATION**: the last QR code of a succeeded `SCAN_LOCATION` command.
+//   - **MOVE_TO_LOCATION**: the destination of a succeeded `MOVE_TO_LOCATION` command.
+type RaybotPositionSource = string
+
+// RaybotPositionsListResponse defines model for RaybotPositionsListResponse.
+type RaybotPositionsListResponse struct {
+	Items      []RaybotPositionResponse `json:"items"`
+	TotalItems int64                    `json:"totalItems"`
 }
 
 // RaybotResponse defines model for RaybotResponse.
@@ -179,10 +501,53 @@ type RaybotsListResponse struct {
 	TotalItems int64            `json:"totalItems"`
 }
 
+// ReportRaybotPositionRequest defines model for ReportRaybotPositionRequest.
+type ReportRaybotPositionRequest struct {
+	// QrCode The QR code the raybot is on.
+	QrCode string `json:"qrCode"`
+}
+
+// RouteMove defines model for RouteMove.
+type RouteMove struct {
+	// Location The id of the QR location the move ends at.
+	Location string `json:"location"`
+
+	// Direction The direction a raybot moves along the rail.
+	Direction MoveDirection `json:"direction"`
+
+	// Distance The length of the move in millimeters.
+	Distance int64 `json:"distance"`
+}
+
+// RouteResponse The shortest way from a QR location to another along the track map.
+type RouteResponse struct {
+	// FromLocationId The id of the QR location the route starts at.
+	FromLocationId string `json:"fromLocationId"`
+
+	// ToLocationId The id of the QR location the route ends at.
+	ToLocationId string `json:"toLocationId"`
+
+	// Distance The length of the route in millimeters.
+	Distance int64 `json:"distance"`
+
+	// Direction The direction a raybot at the start of the route moves in first, absent when the route starts at its destination.
+	Direction *string `json:"direction,omitempty"`
+
+	// Moves The route split at every change of direction, each move is the input of a `MOVE_TO_LOCATION` command.
+	Moves []RouteMove `json:"moves"`
+
+	// Segments The segments of the route in travel order.
+	Segments []TrackSegmentResponse `json:"segments"`
+}
+
 // RunWorkflowRequest defines model for RunWorkflowRequest.
 type RunWorkflowRequest struct {
-	// RuntimeVariables The runtime variables of the workflow.
+	// RuntimeVariables The runtime variables of the workflow, keyed by `RuntimeVariable.key`.
+	// Each value must match the type and constraints of its runtime variable.
 	RuntimeVariables map[string]any `json:"runtimeVariables"`
+
+	// DryRun Simulate the run: CONTROL_RAYBOT steps run against a simulated raybot instead of real robots and the execution is marked as simulated.
+	DryRun *bool `json:"dryRun,omitempty"`
 }
 
 // RunWorkflowResponse defines model for RunWorkflowResponse.
@@ -191,6 +556,66 @@ type RunWorkflowResponse struct {
 	Id string `json:"id"`
 }
 
+// RuntimeVariable defines model for RuntimeVariable.
+type RuntimeVariable struct {
+	// Key The key of the variable in the runtime variables of the run request.
+	Key string `json:"key"`
+
+	// InputType The type of a runtime variable value.
+	//   - `STRING`, `NUMBER`, `INTEGER`, `BOOLEAN`: JSON scalar of the matching type.
+	//   - `ENUM`: one of the `options`.
+	//   - `QR_LOCATION`: the id of an existing QR location.
+	//   - `RAYBOT`: the id of an existing raybot.
+	//   - `LIST`: a JSON array of `item_type` values.
+	InputType RuntimeVariableInputType `json:"input_type"`
+
+	// Required Whether the variable must be provided.
+	Required bool `json:"required"`
+
+	// DefaultValue The default value of the variable.
+	DefaultValue *any `json:"default_value,omitempty"`
+
+	// ItemType The type of a runtime variable value.
+	//   - `STRING`, `NUMBER`, `INTEGER`, `BOOLEAN`: JSON scalar of the matching type.
+	//   - `ENUM`: one of the `options`.
+	//   - `QR_LOCATION`: the id of an existing QR location.
+	//   - `RAYBOT`: the id of an existing raybot.
+	//   - `LIST`: a JSON array of `item_type` values.
+	ItemType *RuntimeVariableInputType `json:"item_type,omitempty"`
+
+	// Options The allowed values. Required when `input_type` is `ENUM`.
+	Options *[]string `json:"options,omitempty"`
+
+	// Min The minimum length of a `STRING`, value of a `NUMBER` or `INTEGER`, or number of items of a `LIST`.
+	Min *float64 `json:"min,omitempty"`
+
+	// Max The maximum length of a `STRING`, value of a `NUMBER` or `INTEGER`, or number of items of a `LIST`.
+	Max *float64 `json:"max,omitempty"`
+
+	// Pattern The regular expression a `STRING` value must match.
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+// RuntimeVariableInputType The type of a runtime variable value.
+//   - `STRING`, `NUMBER`, `INTEGER`, `BOOLEAN`: JSON scalar of the matching type.
+//   - `ENUM`: one of the `options`.
+//   - `QR_LOCATION`: the id of an existing QR location.
+//   - `RAYBOT`: the id of an existing raybot.
+//   - `LIST`: a JSON array of `item_type` values.
+type RuntimeVariableInputType = string
+
+// ScanLocationOutputs defines model for ScanLocationOutputs.
+type ScanLocationOutputs struct {
+	// Locations The QR codes scanned by the raybot, in scan order.
+	Locations []string `json:"locations"`
+}
+
+// SpeakInput defines model for SpeakInput.
+type SpeakInput struct {
+	// Text The text the raybot says.
+	Text string `json:"text"`
+}
+
 // StepExecutionResponse defines model for StepExecutionResponse.
 type StepExecutionResponse struct {
 	// Id The id of the resource, in UUID format
@@ -212,6 +637,72 @@ type StepExecutionResponse struct {
 // StepExecutionStatus defines model for StepExecutionStatus.
 type StepExecutionStatus = string
 
+// StepExecutionsListResponse defines model for StepExecutionsListResponse.
+type StepExecutionsListResponse struct {
+	Items []StepExecutionResponse `json:"items"`
+
+	// NextCursor The cursor of the next page, left out on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalItems The total number of items, left out when `count` is `false`
+	TotalItems *int64 `json:"totalItems,omitempty"`
+}
+
+// TrackMapResponse The graph of the track, the QR locations are its nodes and the segments its edges.
+type TrackMapResponse struct {
+	Locations []QRLocationResponse   `json:"locations"`
+	Segments  []TrackSegmentResponse `json:"segments"`
+
+	// Connected Whether a raybot can travel from every location to every other one.
+	Connected bool `json:"connected"`
+
+	// Components The ids of the locations grouped by the parts of the map a raybot can travel within, largest first. A connected map has a single part.
+	Components [][]string `json:"components"`
+}
+
+// TrackSegmentResponse A piece of rail a raybot travels from a QR location to another by moving in a direction. The way back is another segment.
+type TrackSegmentResponse struct {
+	// Id The id of the resource, in UUID format
+	Id string `json:"id"`
+
+	// FromLocationId The id of the QR location the segment starts at.
+	FromLocationId string `json:"fromLocationId"`
+
+	// ToLocationId The id of the QR location the segment ends at.
+	ToLocationId string `json:"toLocationId"`
+
+	// Distance The length of the segment in millimeters.
+	Distance int32 `json:"distance"`
+
+	// Direction The direction a raybot moves along the rail.
+	Direction MoveDirection `json:"direction"`
+
+	// CreatedAt The date and time when the segment was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt The date and time when the segment was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TrackSegmentsListResponse defines model for TrackSegmentsListResponse.
+type TrackSegmentsListResponse struct {
+	Items []TrackSegmentResponse `json:"items"`
+
+	// TotalItems The total number of segments.
+	TotalItems int64 `json:"totalItems"`
+}
+
+// TriggerNodeData defines model for TriggerNodeData.
+type TriggerNodeData struct {
+	TriggerType TriggerType `json:"trigger_type"`
+
+	// RuntimeVariables The variables the user must provide when running the workflow.
+	RuntimeVariables *[]RuntimeVariable `json:"runtime_variables,omitempty"`
+}
+
+// TriggerType defines model for TriggerType.
+type TriggerType = string
+
 // UpdateQRLocationRequest defines model for UpdateQRLocationRequest.
 type UpdateQRLocationRequest struct {
 	// Metadata The metadata of the location.
@@ -224,6 +715,36 @@ type UpdateQRLocationRequest struct {
 	QrCode string `json:"qrCode"`
 }
 
+// UpdateTrackSegmentRequest defines model for UpdateTrackSegmentRequest.
+type UpdateTrackSegmentRequest struct {
+	// Direction The direction a raybot moves along the rail.
+	Direction MoveDirection `json:"direction"`
+
+	// Distance The length of the segment in millimeters.
+	Distance int32 `json:"distance"`
+
+	// FromLocationId The id of the QR location the segment starts at.
+	FromLocationId string `json:"fromLocationId"`
+
+	// ToLocationId The id of the QR location the segment ends at, different from the start.
+	ToLocationId string `json:"toLocationId"`
+}
+
+// UpdateWebhookSubscriptionRequest defines model for UpdateWebhookSubscriptionRequest.
+type UpdateWebhookSubscriptionRequest struct {
+	// Url The HTTP or HTTPS URL receiving the events.
+	Url string `json:"url"`
+
+	// Secret The new secret signing the requests, the secret is kept when omitted.
+	Secret *string `json:"secret,omitempty"`
+
+	// EventTypes The event types sent to the URL, empty for every event.
+	EventTypes []string `json:"eventTypes"`
+
+	// IsActive Whether events are sent to the URL. The deliveries of an inactive subscription are kept and sent once it is active again.
+	IsActive bool `json:"isActive"`
+}
+
 // UpdateWorkflowRequest defines model for UpdateWorkflowRequest.
 type UpdateWorkflowRequest struct {
 	// Name The name of the workflow.
@@ -234,6 +755,12 @@ type UpdateWorkflowRequest struct {
 
 	// Data The data of the workflow.
 	Data json.RawMessage `json:"data"`
+
+	// IsTemplate Whether the workflow is a template. Unchanged if omitted.
+	IsTemplate *bool `json:"isTemplate,omitempty"`
+
+	// TemplateParameters The parameters of the template. The data references a parameter with the `{{key}}` placeholder where a raybot or QR location id is expected. Unchanged if omitted.
+	TemplateParameters *[]WorkflowTemplateParameter `json:"templateParameters,omitempty"`
 }
 
 // ViewPort defines model for ViewPort.
@@ -243,6 +770,81 @@ type ViewPort struct {
 	Zoom float32 `json:"zoom"`
 }
 
+// WebhookDeliveriesListResponse defines model for WebhookDeliveriesListResponse.
+type WebhookDeliveriesListResponse struct {
+	Items      []WebhookDeliveryResponse `json:"items"`
+	TotalItems int64                     `json:"totalItems"`
+}
+
+// WebhookDeliveryResponse An event sent to a webhook subscription. The request body is the event envelope.
+type WebhookDeliveryResponse struct {
+	// Id The id of the resource, in UUID format. Sent in the `X-Roboflow-Delivery` header.
+	Id string `json:"id"`
+
+	// SubscriptionId The id of the webhook subscription.
+	SubscriptionId string `json:"subscriptionId"`
+
+	// EventId The id of the event.
+	EventId string `json:"eventId"`
+
+	// EventType The type of the event. Sent in the `X-Roboflow-Event` header.
+	EventType string `json:"eventType"`
+
+	// Status The status of the delivery. A failed attempt is retried with an exponential backoff until the maximum attempts, then the delivery is `DEAD` until redelivered.
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// Attempts The number of attempts.
+	Attempts int32 `json:"attempts"`
+
+	// NextAttemptAt The time of the next attempt of a pending delivery.
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+
+	// LastAttemptAt The time of the last attempt.
+	LastAttemptAt *time.Time `json:"lastAttemptAt"`
+
+	// LastResponseStatus The status code of the last response, null when the URL did not respond.
+	LastResponseStatus *int32 `json:"lastResponseStatus"`
+
+	// LastError The error of the last attempt.
+	LastError *string `json:"lastError"`
+
+	// CreatedAt The time the delivery was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt The time the delivery was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookDeliveryStatus The status of the delivery. A failed attempt is retried with an exponential backoff until the maximum attempts, then the delivery is `DEAD` until redelivered.
+type WebhookDeliveryStatus = string
+
+// WebhookSubscriptionResponse A webhook subscription. The secret is never returned.
+type WebhookSubscriptionResponse struct {
+	// Id The id of the resource, in UUID format
+	Id string `json:"id"`
+
+	// Url The URL receiving the events.
+	Url string `json:"url"`
+
+	// EventTypes The event types sent to the URL, empty for every event.
+	EventTypes []string `json:"eventTypes"`
+
+	// IsActive Whether events are sent to the URL.
+	IsActive bool `json:"isActive"`
+
+	// CreatedAt The time the subscription was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt The time the subscription was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookSubscriptionsListResponse defines model for WebhookSubscriptionsListResponse.
+type WebhookSubscriptionsListResponse struct {
+	Items      []WebhookSubscriptionResponse `json:"items"`
+	TotalItems int64                         `json:"totalItems"`
+}
+
 // WorkflowData defines model for WorkflowData.
 type WorkflowData struct {
 	Edges    *[]WorkflowEdge `json:"edges,omitempty"`
@@ -252,6 +854,43 @@ type WorkflowData struct {
 	Zoom     *float32        `json:"zoom,omitempty"`
 }
 
+// WorkflowDocument The portable representation of a workflow, used to move workflows between sites.
+type WorkflowDocument struct {
+	// FormatVersion The version of the document format.
+	FormatVersion int `json:"formatVersion"`
+
+	// Name The name of the workflow.
+	Name string `json:"name"`
+
+	// Description The description of the workflow.
+	Description *string `json:"description,omitempty"`
+
+	// ExportedAt The date and time when the document was exported.
+	ExportedAt time.Time       `json:"exportedAt"`
+	Data       json.RawMessage `json:"data"`
+
+	// QrLocations The QR locations referenced by the data.
+	QrLocations []WorkflowDocumentQRLocation `json:"qrLocations"`
+
+	// IsTemplate Whether the workflow is a template.
+	IsTemplate *bool `json:"isTemplate,omitempty"`
+
+	// TemplateParameters The parameters of the template.
+	TemplateParameters *[]WorkflowTemplateParameter `json:"templateParameters,omitempty"`
+}
+
+// WorkflowDocumentQRLocation defines model for WorkflowDocumentQRLocation.
+type WorkflowDocumentQRLocation struct {
+	// Id The id of the QR location on the exporting site, as referenced by the data.
+	Id string `json:"id"`
+
+	// Name The name of the QR location.
+	Name string `json:"name"`
+
+	// QrCode The QR code of the location, used to find the location on the importing site.
+	QrCode string `json:"qrCode"`
+}
+
 // WorkflowEdge defines model for WorkflowEdge.
 type WorkflowEdge struct {
 	Id           openapi_types.UUID `json:"id"`
@@ -268,6 +907,28 @@ type WorkflowEdge struct {
 	TargetY      float32            `json:"targetY"`
 }
 
+// WorkflowExecutionEventResponse A change of a workflow execution or of one of its steps, sent as the data of a server-sent event.
+type WorkflowExecutionEventResponse struct {
+	// Id The id of the event, increasing over time. Also sent as the id of the server-sent event.
+	Id int64 `json:"id"`
+
+	// WorkflowExecutionId The id of the resource, in UUID format
+	WorkflowExecutionId string `json:"workflowExecutionId"`
+
+	// StepExecutionId The id of the step execution for `STEP_STATUS_CHANGED` events, in UUID format
+	StepExecutionId *string                    `json:"stepExecutionId"`
+	Type            WorkflowExecutionEventType `json:"type"`
+
+	// Status The new status of the workflow execution or of the step execution
+	Status    string          `json:"status"`
+	Outputs   *map[string]any `json:"outputs"`
+	Error     *string         `json:"error"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// WorkflowExecutionEventType defines model for WorkflowExecutionEventType.
+type WorkflowExecutionEventType = string
+
 // WorkflowExecutionResponse defines model for WorkflowExecutionResponse.
 type WorkflowExecutionResponse struct {
 	// Id The id of the resource, in UUID format
@@ -284,15 +945,47 @@ type WorkflowExecutionResponse struct {
 	UpdatedAt   time.Time               `json:"updatedAt"`
 	StartedAt   *time.Time              `json:"startedAt"`
 	CompletedAt *time.Time              `json:"completedAt"`
-}
+
+	// WorkflowVersionId The id of the workflow version that was run, in UUID format
+	WorkflowVersionId *string `json:"workflowVersionId"`
+
+	// IsSimulated Whether the execution is a dry run, whose CONTROL_RAYBOT steps ran against a simulated raybot.
+	IsSimulated bool `json:"isSimulated"`
+
+	// TriggerType The type of the trigger node of the workflow that was run
+	TriggerType TriggerType `json:"triggerType"`
+
+	// RaybotId The id of the raybot given to the first RAYBOT runtime variable, in UUID format
+	RaybotId *string `json:"raybotId"`
+}
 
 // WorkflowExecutionStatus defines model for WorkflowExecutionStatus.
 type WorkflowExecutionStatus = string
 
+// WorkflowExecutionStatusCount defines model for WorkflowExecutionStatusCount.
+type WorkflowExecutionStatusCount struct {
+	Count  int64                   `json:"count"`
+	Status WorkflowExecutionStatus `json:"status"`
+}
+
+// WorkflowExecutionStatusCountsResponse defines model for WorkflowExecutionStatusCountsResponse.
+type WorkflowExecutionStatusCountsResponse struct {
+	// Items The number of executions of each status, every status included
+	Items []WorkflowExecutionStatusCount `json:"items"`
+
+	// Total The number of executions of all statuses
+	Total int64 `json:"total"`
+}
+
 // WorkflowExecutionsListResponse defines model for WorkflowExecutionsListResponse.
 type WorkflowExecutionsListResponse struct {
-	Items      []WorkflowExecutionResponse `json:"items"`
-	TotalItems int64                       `json:"totalItems"`
+	Items []WorkflowExecutionResponse `json:"items"`
+
+	// NextCursor The cursor of the next page, left out on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalItems The total number of items, left out when `count` is `false`
+	TotalItems *int64 `json:"totalItems,omitempty"`
 }
 
 // WorkflowItemListResponse defines model for WorkflowItemListResponse.
@@ -306,7 +999,7 @@ type WorkflowItemListResponse struct {
 	// Description The description of the workflow.
 	Description *string `json:"description,omitempty"`
 
-	// IsDraft The draft status of the workflow.
+	// IsDraft Whether the workflow has changes that are not published yet.
 	IsDraft bool `json:"isDraft"`
 
 	// CreatedAt The creation date of the workflow.
@@ -314,6 +1007,12 @@ type WorkflowItemListResponse struct {
 
 	// UpdatedAt The last update date of the workflow.
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// PublishedVersion The number of the live version, null if the workflow has never been published.
+	PublishedVersion *int32 `json:"publishedVersion"`
+
+	// IsTemplate Whether the workflow is a template.
+	IsTemplate bool `json:"isTemplate"`
 }
 
 // WorkflowNode defines model for WorkflowNode.
@@ -326,7 +1025,7 @@ type WorkflowNode struct {
 	// Label The label of the node.
 	Label string `json:"label"`
 
-	// Data The data of the node.
+	// Data The data of the node. The data of a `TRIGGER` node is described by `TriggerNodeData`.
 	Data json.RawMessage `json:"data"`
 }
 
@@ -341,7 +1040,7 @@ type WorkflowResponse struct {
 	// Description The description of the workflow.
 	Description *string `json:"description,omitempty"`
 
-	// IsDraft The draft status of the workflow.
+	// IsDraft Whether the workflow has changes that are not published yet.
 	IsDraft bool            `json:"isDraft"`
 	Data    json.RawMessage `json:"data"`
 
@@ -350,6 +1049,70 @@ type WorkflowResponse struct {
 
 	// UpdatedAt The last update date of the workflow.
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// PublishedVersion The number of the live version, null if the workflow has never been published.
+	PublishedVersion *int32 `json:"publishedVersion"`
+
+	// IsTemplate Whether the workflow is a template. Templates are instantiated, not run nor published.
+	IsTemplate bool `json:"isTemplate"`
+
+	// TemplateParameters The parameters of the template, empty if the workflow is not a template.
+	TemplateParameters []WorkflowTemplateParameter `json:"templateParameters"`
+}
+
+// WorkflowTemplateParameter defines model for WorkflowTemplateParameter.
+type WorkflowTemplateParameter struct {
+	// Key The key of the parameter, referenced in the data as `{{key}}`.
+	Key string `json:"key"`
+
+	// InputType The type of the value of the parameter.
+	InputType string `json:"inputType"`
+
+	// Description The description of the parameter.
+	Description *string `json:"description,omitempty"`
+}
+
+// WorkflowVersionDiffResponse defines model for WorkflowVersionDiffResponse.
+type WorkflowVersionDiffResponse struct {
+	// AddedNodes The ids of the nodes only in the version.
+	AddedNodes []string `json:"addedNodes"`
+
+	// RemovedNodes The ids of the nodes only in the base version.
+	RemovedNodes []string `json:"removedNodes"`
+
+	// ChangedNodes The ids of the nodes in both versions with different content.
+	ChangedNodes []string `json:"changedNodes"`
+
+	// AddedEdges The ids of the edges only in the version.
+	AddedEdges []string `json:"addedEdges"`
+
+	// RemovedEdges The ids of the edges only in the base version.
+	RemovedEdges []string `json:"removedEdges"`
+
+	// ChangedEdges The ids of the edges in both versions with different content.
+	ChangedEdges []string `json:"changedEdges"`
+}
+
+// WorkflowVersionResponse defines model for WorkflowVersionResponse.
+type WorkflowVersionResponse struct {
+	// Id The id of the resource, in UUID format
+	Id string `json:"id"`
+
+	// WorkflowId The id of the resource, in UUID format
+	WorkflowId string `json:"workflowId"`
+
+	// Version The number of the version, starting at 1.
+	Version int32           `json:"version"`
+	Data    json.RawMessage `json:"data"`
+
+	// CreatedAt The date and time when the version was published.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// WorkflowVersionsListResponse defines model for WorkflowVersionsListResponse.
+type WorkflowVersionsListResponse struct {
+	Items      []WorkflowVersionResponse `json:"items"`
+	TotalItems int64                     `json:"totalItems"`
 }
 
 // WorkflowsListResponse defines model for WorkflowsListResponse.
@@ -359,12 +1122,65 @@ type WorkflowsListResponse struct {
 	Items      []WorkflowItemListResponse `json:"items"`
 }
 
+// Count defines model for Count.
+type Count = bool
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// LabelErrorCorrection defines model for LabelErrorCorrection.
+type LabelErrorCorrection = string
+
+// LabelSize defines model for LabelSize.
+type LabelSize = int
+
 // Page defines model for Page.
 type Page = uint
 
 // PageSize defines model for PageSize.
 type PageSize = uint
 
+// DeadLetterMessagePurgeParams defines parameters for DeadLetterMessagePurge.
+type DeadLetterMessagePurgeParams struct {
+	// Topic Filter by original topic.
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty"`
+
+	// Handler Filter by handler name.
+	Handler *string `form:"handler,omitempty" json:"handler,omitempty"`
+
+	// Replayed Filter by whether the message was replayed.
+	Replayed *bool `form:"replayed,omitempty" json:"replayed,omitempty"`
+
+	// CreatedBefore Filter by the messages dead-lettered before this time.
+	CreatedBefore *time.Time `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+}
+
+// DeadLetterMessageListParams defines parameters for DeadLetterMessageList.
+type DeadLetterMessageListParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Sort Sort the results by one or more columns.
+	//   - Use a column name for ascending order (e.g., created_at).
+	//   - Prefix with `-` for descending order (e.g., -created_at).
+	//   - Separate multiple columns with a comma (e.g., created_at,-updated_at).
+	//
+	// Allowed columns: `topic`, `handler`, `replay_count`, `last_replayed_at`, `created_at`.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Topic Filter by original topic.
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty"`
+
+	// Handler Filter by handler name.
+	Handler *string `form:"handler,omitempty" json:"handler,omitempty"`
+
+	// Replayed Filter by whether the message was replayed.
+	Replayed *bool `form:"replayed,omitempty" json:"replayed,omitempty"`
+}
+
 // QrLocationListParams defines parameters for QrLocationList.
 type QrLocationListParams struct {
 	// Page The page number
@@ -379,6 +1195,51 @@ type QrLocationListParams struct {
 	//
 	// Allowed columns: `name`, `qr_code`, `created_at`, `updated_at`.
 	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Filter Filter the results by one or more conditions, all of which must match.
+	//   - Use `field:operator:value` (e.g., qr_code:in:dock1|dock2).
+	//   - Operators: `eq`, `ne`, `gt`, `gte`, `lt`, `lte`, and `in` with values separated by `|`.
+	//   - Separate multiple conditions with a comma. Values can not hold commas.
+	//   - Dates are RFC 3339 date-times or dates (e.g., 2026-01-01).
+	//
+	// Allowed fields: `name`, `qr_code`, `created_at`, `updated_at`.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Search Search the names holding, for each word of the search, a word starting with it (e.g., `dock 1` matches `Dock 12`).
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+}
+
+// QrLocationExportParams defines parameters for QrLocationExport.
+type QrLocationExportParams struct {
+	// Format The format of the document.
+	// Allowed values: `json`, `csv`. Defaults to `json`.
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// QrLocationImportParams defines parameters for QrLocationImport.
+type QrLocationImportParams struct {
+	// DryRun Report what the import does without changing anything. Defaults to `false`.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// QrLocationLabelPngParams defines parameters for QrLocationLabelPng.
+type QrLocationLabelPngParams struct {
+	// Size The width of the label in pixels, the QR code being a square of this width above the caption. The modules of the code are scaled by whole pixels, so the code is centered when they do not fill the width.
+	Size *LabelSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// ErrorCorrection The error-correction level of the QR code, the share of a damaged code which can still be read. The higher the level, the denser the code.
+	// Allowed values: `L` (7%), `M` (15%), `Q` (25%), `H` (30%).
+	ErrorCorrection *LabelErrorCorrection `form:"errorCorrection,omitempty" json:"errorCorrection,omitempty"`
+}
+
+// QrLocationLabelSvgParams defines parameters for QrLocationLabelSvg.
+type QrLocationLabelSvgParams struct {
+	// Size The width of the label in pixels, the QR code being a square of this width above the caption. The modules of the code are scaled by whole pixels, so the code is centered when they do not fill the width.
+	Size *LabelSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// ErrorCorrection The error-correction level of the QR code, the share of a damaged code which can still be read. The higher the level, the denser the code.
+	// Allowed values: `L` (7%), `M` (15%), `Q` (25%), `H` (30%).
+	ErrorCorrection *LabelErrorCorrection `form:"errorCorrection,omitempty" json:"errorCorrection,omitempty"`
 }
 
 // RaybotListParams defines parameters for RaybotList.
@@ -413,12 +1274,187 @@ type RaybotCommandListParams struct {
 	// PageSize The number of items per page
 	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 
+	// Cursor The `nextCursor` of the previous page, to read the page after it instead of the `page` one. It must be used with the same `sort` and filters as the previous page.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Count Count the total items, `false` leaves out `totalItems` to read large lists faster
+	Count *Count `form:"count,omitempty" json:"count,omitempty"`
+
 	// Sort Sort the results by one or more columns.
 	//   - Use a column name for ascending order (e.g., created_at).
 	//   - Prefix with `-` for descending order (e.g., -created_at).
 	//   - Separate multiple columns with a comma (e.g., created_at,-updated_at).
 	//
 	// Allowed columns: `type`, `status`, `completed_at`, `created_at`, `updated_at`.
+	// Defaults to `-created_at`, the newest first.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Filter Filter the results by one or more conditions, all of which must match.
+	//   - Use `field:operator:value` (e.g., status:eq:FAILED,created_at:gte:2026-01-01).
+	//   - Operators: `eq`, `ne`, `gt`, `gte`, `lt`, `lte`, and `in` with values separated by `|`.
+	//   - Separate multiple conditions with a comma. Values can not hold commas.
+	//   - Dates are RFC 3339 date-times or dates (e.g., 2026-01-01).
+	//
+	// Allowed fields: `type`, `status`, `completed_at`, `created_at`, `updated_at`.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RaybotPositionListHistoryParams defines parameters for RaybotPositionListHistory.
+type RaybotPositionListHistoryParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Sort Sort the results by one or more columns.
+	//   - Use a column name for ascending order (e.g., created_at).
+	//   - Prefix with `-` for descending order (e.g., -created_at).
+	//   - Separate multiple columns with a comma (e.g., source,-created_at).
+	//
+	// Allowed columns: `source`, `created_at`.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// RoutePlanParams defines parameters for RoutePlan.
+type RoutePlanParams struct {
+	// From The id of the QR location the route starts at.
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// RaybotId The id of the raybot whose position the route starts at.
+	RaybotId *string `form:"raybotId,omitempty" json:"raybotId,omitempty"`
+
+	// To The id of the QR location the route ends at.
+	To string `form:"to" json:"to"`
+}
+
+// TrackSegmentListParams defines parameters for TrackSegmentList.
+type TrackSegmentListParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Sort Sort the results by one or more columns.
+	//   - Use a column name for ascending order (e.g., created_at).
+	//   - Prefix with `-` for descending order (e.g., -created_at).
+	//   - Separate multiple columns with a comma (e.g., created_at,-updated_at).
+	//
+	// Allowed columns: `distance`, `direction`, `created_at`, `updated_at`.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// WebhookSubscriptionListParams defines parameters for WebhookSubscriptionList.
+type WebhookSubscriptionListParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Sort Sort the results by one or more columns.
+	//   - Use a column name for ascending order (e.g., created_at).
+	//   - Prefix with `-` for descending order (e.g., -created_at).
+	//   - Separate multiple columns with a comma (e.g., created_at,-updated_at).
+	//
+	// Allowed columns: `url`, `is_active`, `created_at`, `updated_at`.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// WebhookDeliveryListParams defines parameters for WebhookDeliveryList.
+type WebhookDeliveryListParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Sort Sort the results by one or more columns.
+	//   - Use a column name for ascending order (e.g., created_at).
+	//   - Prefix with `-` for descending order (e.g., -created_at).
+	//   - Separate multiple columns with a comma (e.g., created_at,-updated_at).
+	//
+	// Allowed columns: `event_type`, `status`, `attempts`, `next_attempt_at`, `last_attempt_at`, `created_at`, `updated_at`.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Status Filter by delivery status.
+	// Allowed values: `PENDING`, `SUCCEEDED`, `DEAD`.
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// WorkflowExecutionListAllParams defines parameters for WorkflowExecutionListAll.
+type WorkflowExecutionListAllParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The `nextCursor` of the previous page, to read the page after it instead of the `page` one. It must be used with the same `sort` and filters as the previous page.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Count Count the total items, `false` leaves out `totalItems` to read large lists faster
+	Count *Count `form:"count,omitempty" json:"count,omitempty"`
+
+	// Sort Sort the results by one or more columns.
+	//   - Use a column name for ascending order (e.g., created_at).
+	//   - Prefix with `-` for descending order (e.g., -created_at).
+	//   - Separate multiple columns with a comma (e.g., created_at,-updated_at).
+	//
+	// Allowed columns: `status`, `started_at`, `completed_at`, `created_at`, `updated_at`.
+	// Defaults to `-created_at`, the newest first.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Filter Filter the results by one or more conditions, all of which must match.
+	//   - Use `field:operator:value` (e.g., status:in:FAILED|CANCELLED,started_at:gte:2026-01-01).
+	//   - Operators: `eq`, `ne`, `gt`, `gte`, `lt`, `lte`, and `in` with values separated by `|`.
+	//   - Separate multiple conditions with a comma. Values can not hold commas.
+	//   - Dates are RFC 3339 date-times or dates (e.g., 2026-01-01).
+	//
+	// Allowed fields: `status`, `workflow_id`, `trigger_type`, `raybot_id`, `is_simulated`, `started_at`, `completed_at`, `created_at`. Values of `status` and `trigger_type` must be among their enum values. Dry runs are excluded unless `is_simulated` is filtered (e.g., is_simulated:eq:true).
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// WorkflowExecutionCountByStatusParams defines parameters for WorkflowExecutionCountByStatus.
+type WorkflowExecutionCountByStatusParams struct {
+	// Filter Filter the results by one or more conditions, all of which must match.
+	//   - Use `field:operator:value` (e.g., status:in:FAILED|CANCELLED,started_at:gte:2026-01-01).
+	//   - Operators: `eq`, `ne`, `gt`, `gte`, `lt`, `lte`, and `in` with values separated by `|`.
+	//   - Separate multiple conditions with a comma. Values can not hold commas.
+	//   - Dates are RFC 3339 date-times or dates (e.g., 2026-01-01).
+	//
+	// Allowed fields: `status`, `workflow_id`, `trigger_type`, `raybot_id`, `is_simulated`, `started_at`, `completed_at`, `created_at`. Values of `status` and `trigger_type` must be among their enum values. Dry runs are excluded unless `is_simulated` is filtered (e.g., is_simulated:eq:true).
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// WorkflowExecutionEventsParams defines parameters for WorkflowExecutionEvents.
+type WorkflowExecutionEventsParams struct {
+	// LastEventID Only send the events after this id
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// StepExecutionListByWorkflowExecutionIdParams defines parameters for StepExecutionListByWorkflowExecutionId.
+type StepExecutionListByWorkflowExecutionIdParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The `nextCursor` of the previous page, to read the page after it instead of the `page` one. It must be used with the same `sort` and filters as the previous page.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Count Count the total items, `false` leaves out `totalItems` to read large lists faster
+	Count *Count `form:"count,omitempty" json:"count,omitempty"`
+
+	// Sort Sort the results by one or more columns.
+	//   - Use a column name for ascending order (e.g., created_at).
+	//   - Prefix with `-` for descending order (e.g., -created_at).
+	//   - Separate multiple columns with a comma (e.g., created_at,-updated_at).
+	//
+	// Allowed columns: `status`, `started_at`, `completed_at`, `created_at`, `updated_at`.
+	// Defaults to `-created_at`, the newest first.
 	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
@@ -438,8 +1474,23 @@ type WorkflowListParams struct {
 	// Allowed columns: `name`, `is_draft`, `created_at`, `updated_at`.
 	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 
+	// Filter Filter the results by one or more conditions, all of which must match.
+	//   - Use `field:operator:value` (e.g., is_valid:eq:true,created_at:gte:2026-01-01).
+	//   - Operators: `eq`, `ne`, `gt`, `gte`, `lt`, `lte`, and `in` with values separated by `|`.
+	//   - Separate multiple conditions with a comma. Values can not hold commas.
+	//   - Dates are RFC 3339 date-times or dates (e.g., 2026-01-01).
+	//
+	// Allowed fields: `name`, `is_draft`, `is_valid`, `created_at`, `updated_at`.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Search Search the names holding, for each word of the search, a word starting with it (e.g., `dock 1` matches `Dock 12`).
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
 	// IsDraft Filter by draft status
 	IsDraft *bool `form:"isDraft,omitempty" json:"isDraft,omitempty"`
+
+	// IsTemplate Filter by template status, `true` lists the template catalogue
+	IsTemplate *bool `form:"isTemplate,omitempty" json:"isTemplate,omitempty"`
 }
 
 // WorkflowExecutionListParams defines parameters for WorkflowExecutionList.
@@ -450,18 +1501,64 @@ type WorkflowExecutionListParams struct {
 	// PageSize The number of items per page
 	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 
+	// Cursor The `nextCursor` of the previous page, to read the page after it instead of the `page` one. It must be used with the same `sort` and filters as the previous page.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Count Count the total items, `false` leaves out `totalItems` to read large lists faster
+	Count *Count `form:"count,omitempty" json:"count,omitempty"`
+
 	// Sort Sort the results by one or more columns.
 	//   - Use a column name for ascending order (e.g., created_at).
 	//   - Prefix with `-` for descending order (e.g., -created_at).
 	//   - Separate multiple columns with a comma (e.g., created_at,-updated_at).
 	//
 	// Allowed columns: `status`, `started_at`, `completed_at`, `created_at`, `updated_at`.
+	// Defaults to `-created_at`, the newest first.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// IsSimulated Filter by dry run status, `false` excludes simulated executions
+	IsSimulated *bool `form:"isSimulated,omitempty" json:"isSimulated,omitempty"`
+}
+
+// WorkflowExportParams defines parameters for WorkflowExport.
+type WorkflowExportParams struct {
+	// Format The format of the document.
+	// Allowed values: `json`, `yaml`. Defaults to `json`.
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// WorkflowVersionListParams defines parameters for WorkflowVersionList.
+type WorkflowVersionListParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Sort Sort the results by one or more columns.
+	//   - Use a column name for ascending order (e.g., created_at).
+	//   - Prefix with `-` for descending order (e.g., -created_at).
+	//   - Separate multiple columns with a comma (e.g., created_at,-version).
+	//
+	// Allowed columns: `version`, `created_at`.
 	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
+// WorkflowVersionDiffParams defines parameters for WorkflowVersionDiff.
+type WorkflowVersionDiffParams struct {
+	// Base The number of the version to compare against.
+	Base *int32 `form:"base,omitempty" json:"base,omitempty"`
+}
+
 // QrLocationCreateJSONRequestBody defines body for QrLocationCreate for application/json ContentType.
 type QrLocationCreateJSONRequestBody = CreateQRLocationRequest
 
+// QrLocationImportJSONRequestBody defines body for QrLocationImport for application/json ContentType.
+type QrLocationImportJSONRequestBody = ImportQRLocationsRequest
+
+// QrLocationLabelsPdfJSONRequestBody defines body for QrLocationLabelsPdf for application/json ContentType.
+type QrLocationLabelsPdfJSONRequestBody = QRLocationLabelsPDFRequest
+
 // QrLocationUpdateJSONRequestBody defines body for QrLocationUpdate for application/json ContentType.
 type QrLocationUpdateJSONRequestBody = UpdateQRLocationRequest
 
@@ -471,68 +1568,625 @@ type RaybotCreateJSONRequestBody = CreateRaybotRequest
 // RaybotCommandCreateJSONRequestBody defines body for RaybotCommandCreate for application/json ContentType.
 type RaybotCommandCreateJSONRequestBody = CreateRaybotCommandRequest
 
+// RaybotPositionReportJSONRequestBody defines body for RaybotPositionReport for application/json ContentType.
+type RaybotPositionReportJSONRequestBody = ReportRaybotPositionRequest
+
+// TrackSegmentCreateJSONRequestBody defines body for TrackSegmentCreate for application/json ContentType.
+type TrackSegmentCreateJSONRequestBody = CreateTrackSegmentRequest
+
+// TrackSegmentUpdateJSONRequestBody defines body for TrackSegmentUpdate for application/json ContentType.
+type TrackSegmentUpdateJSONRequestBody = UpdateTrackSegmentRequest
+
+// WebhookSubscriptionCreateJSONRequestBody defines body for WebhookSubscriptionCreate for application/json ContentType.
+type WebhookSubscriptionCreateJSONRequestBody = CreateWebhookSubscriptionRequest
+
+// WebhookSubscriptionUpdateJSONRequestBody defines body for WebhookSubscriptionUpdate for application/json ContentType.
+type WebhookSubscriptionUpdateJSONRequestBody = UpdateWebhookSubscriptionRequest
+
 // WorkflowCreateJSONRequestBody defines body for WorkflowCreate for application/json ContentType.
 type WorkflowCreateJSONRequestBody = CreateWorkflowRequest
 
+// WorkflowImportJSONRequestBody defines body for WorkflowImport for application/json ContentType.
+type WorkflowImportJSONRequestBody = WorkflowDocument
+
 // WorkflowUpdateJSONRequestBody defines body for WorkflowUpdate for application/json ContentType.
 type WorkflowUpdateJSONRequestBody = UpdateWorkflowRequest
 
+// WorkflowDuplicateJSONRequestBody defines body for WorkflowDuplicate for application/json ContentType.
+type WorkflowDuplicateJSONRequestBody = DuplicateWorkflowRequest
+
+// WorkflowInstantiateJSONRequestBody defines body for WorkflowInstantiate for application/json ContentType.
+type WorkflowInstantiateJSONRequestBody = InstantiateWorkflowTemplateRequest
+
 // WorkflowRunJSONRequestBody defines body for WorkflowRun for application/json ContentType.
 type WorkflowRunJSONRequestBody = RunWorkflowRequest
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List QR locations
-	// (GET /qr-locations)
-	QrLocationList(w http.ResponseWriter, r *http.Request, params QrLocationListParams)
-	// Create QR location
-	// (POST /qr-locations)
-	QrLocationCreate(w http.ResponseWriter, r *http.Request)
-	// Delete QR location by id
-	// (DELETE /qr-locations/{qrLocationId})
-	QrLocationDelete(w http.ResponseWriter, r *http.Request, qrLocationId string)
-	// Get QR location by id
-	// (GET /qr-locations/{qrLocationId})
-	QrLocationGet(w http.ResponseWriter, r *http.Request, qrLocationId string)
-	// Update QR location by id
-	// (PUT /qr-locations/{qrLocationId})
-	QrLocationUpdate(w http.ResponseWriter, r *http.Request, qrLocationId string)
-	// Get raybot command by id
-	// (GET /raybot-commands/{raybotCommandId})
-	RaybotCommandGet(w http.ResponseWriter, r *http.Request, raybotCommandId string)
-	// List raybots
-	// (GET /raybots)
-	RaybotList(w http.ResponseWriter, r *http.Request, params RaybotListParams)
-	// Create raybot
-	// (POST /raybots)
-	RaybotCreate(w http.ResponseWriter, r *http.Request)
-	// Delete raybot by id
-	// (DELETE /raybots/{raybotId})
-	RaybotDelete(w http.ResponseWriter, r *http.Request, raybotId string)
-	// Get raybot by id
-	// (GET /raybots/{raybotId})
-	RaybotGet(w http.ResponseWriter, r *http.Request, raybotId string)
-	// List raybot commands
-	// (GET /raybots/{raybotId}/commands)
-	RaybotCommandList(w http.ResponseWriter, r *http.Request, raybotId string, params RaybotCommandListParams)
+// AsCreateRaybotCommandWithoutInputsRequest returns the union data inside the CreateRaybotCommandRequest as a CreateRaybotCommandWithoutInputsRequest
+func (t CreateRaybotCommandRequest) AsCreateRaybotCommandWithoutInputsRequest() (CreateRaybotCommandWithoutInputsRequest, error) {
+	var body CreateRaybotCommandWithoutInputsRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCreateRaybotCommandWithoutInputsRequest overwrites any union data inside the CreateRaybotCommandRequest as the provided CreateRaybotCommandWithoutInputsRequest
+func (t *CreateRaybotCommandRequest) FromCreateRaybotCommandWithoutInputsRequest(v CreateRaybotCommandWithoutInputsRequest) error {
+	v.Type = "SCAN_LOCATION"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCreateRaybotCommandWithoutInputsRequest performs a merge with any union data inside the CreateRaybotCommandRequest, using the provided CreateRaybotCommandWithoutInputsRequest
+func (t *CreateRaybotCommandRequest) MergeCreateRaybotCommandWithoutInputsRequest(v CreateRaybotCommandWithoutInputsRequest) error {
+	v.Type = "SCAN_LOCATION"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCreateMoveToLocationCommandRequest returns the union data inside the CreateRaybotCommandRequest as a CreateMoveToLocationCommandRequest
+func (t CreateRaybotCommandRequest) AsCreateMoveToLocationCommandRequest() (CreateMoveToLocationCommandRequest, error) {
+	var body CreateMoveToLocationCommandRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCreateMoveToLocationCommandRequest overwrites any union data inside the CreateRaybotCommandRequest as the provided CreateMoveToLocationCommandRequest
+func (t *CreateRaybotCommandRequest) FromCreateMoveToLocationCommandRequest(v CreateMoveToLocationCommandRequest) error {
+	v.Type = "MOVE_TO_LOCATION"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCreateMoveToLocationCommandRequest performs a merge with any union data inside the CreateRaybotCommandRequest, using the provided CreateMoveToLocationCommandRequest
+func (t *CreateRaybotCommandRequest) MergeCreateMoveToLocationCommandRequest(v CreateMoveToLocationCommandRequest) error {
+	v.Type = "MOVE_TO_LOCATION"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCreateLiftBoxCommandRequest returns the union data inside the CreateRaybotCommandRequest as a CreateLiftBoxCommandRequest
+func (t CreateRaybotCommandRequest) AsCreateLiftBoxCommandRequest() (CreateLiftBoxCommandRequest, error) {
+	var body CreateLiftBoxCommandRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCreateLiftBoxCommandRequest overwrites any union data inside the CreateRaybotCommandRequest as the provided CreateLiftBoxCommandRequest
+func (t *CreateRaybotCommandRequest) FromCreateLiftBoxCommandRequest(v CreateLiftBoxCommandRequest) error {
+	v.Type = "LIFT_BOX"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCreateLiftBoxCommandRequest performs a merge with any union data inside the CreateRaybotCommandRequest, using the provided CreateLiftBoxCommandRequest
+func (t *CreateRaybotCommandRequest) MergeCreateLiftBoxCommandRequest(v CreateLiftBoxCommandRequest) error {
+	v.Type = "LIFT_BOX"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCreateDropBoxCommandRequest returns the union data inside the CreateRaybotCommandRequest as a CreateDropBoxCommandRequest
+func (t CreateRaybotCommandRequest) AsCreateDropBoxCommandRequest() (CreateDropBoxCommandRequest, error) {
+	var body CreateDropBoxCommandRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCreateDropBoxCommandRequest overwrites any union data inside the CreateRaybotCommandRequest as the provided CreateDropBoxCommandRequest
+func (t *CreateRaybotCommandRequest) FromCreateDropBoxCommandRequest(v CreateDropBoxCommandRequest) error {
+	v.Type = "DROP_BOX"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCreateDropBoxCommandRequest performs a merge with any union data inside the CreateRaybotCommandRequest, using the provided CreateDropBoxCommandRequest
+func (t *CreateRaybotCommandRequest) MergeCreateDropBoxCommandRequest(v CreateDropBoxCommandRequest) error {
+	v.Type = "DROP_BOX"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCreateCheckQRCodeCommandRequest returns the union data inside the CreateRaybotCommandRequest as a CreateCheckQRCodeCommandRequest
+func (t CreateRaybotCommandRequest) AsCreateCheckQRCodeCommandRequest() (CreateCheckQRCodeCommandRequest, error) {
+	var body CreateCheckQRCodeCommandRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCreateCheckQRCodeCommandRequest overwrites any union data inside the CreateRaybotCommandRequest as the provided CreateCheckQRCodeCommandRequest
+func (t *CreateRaybotCommandRequest) FromCreateCheckQRCodeCommandRequest(v CreateCheckQRCodeCommandRequest) error {
+	v.Type = "CHECK_QR"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCreateCheckQRCodeCommandRequest performs a merge with any union data inside the CreateRaybotCommandRequest, using the provided CreateCheckQRCodeCommandRequest
+func (t *CreateRaybotCommandRequest) MergeCreateCheckQRCodeCommandRequest(v CreateCheckQRCodeCommandRequest) error {
+	v.Type = "CHECK_QR"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCreateSpeakCommandRequest returns the union data inside the CreateRaybotCommandRequest as a CreateSpeakCommandRequest
+func (t CreateRaybotCommandRequest) AsCreateSpeakCommandRequest() (CreateSpeakCommandRequest, error) {
+	var body CreateSpeakCommandRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCreateSpeakCommandRequest overwrites any union data inside the CreateRaybotCommandRequest as the provided CreateSpeakCommandRequest
+func (t *CreateRaybotCommandRequest) FromCreateSpeakCommandRequest(v CreateSpeakCommandRequest) error {
+	v.Type = "SPEAK"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCreateSpeakCommandRequest performs a merge with any union data inside the CreateRaybotCommandRequest, using the provided CreateSpeakCommandRequest
+func (t *CreateRaybotCommandRequest) MergeCreateSpeakCommandRequest(v CreateSpeakCommandRequest) error {
+	v.Type = "SPEAK"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t CreateRaybotCommandRequest) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t CreateRaybotCommandRequest) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "CHECK_QR":
+		return t.AsCreateCheckQRCodeCommandRequest()
+	case "DROP_BOX":
+		return t.AsCreateDropBoxCommandRequest()
+	case "LIFT_BOX":
+		return t.AsCreateLiftBoxCommandRequest()
+	case "MOVE_TO_LOCATION":
+		return t.AsCreateMoveToLocationCommandRequest()
+	case "SCAN_LOCATION":
+		return t.AsCreateRaybotCommandWithoutInputsRequest()
+	case "SPEAK":
+		return t.AsCreateSpeakCommandRequest()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t CreateRaybotCommandRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *CreateRaybotCommandRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsMoveToLocationInput returns the union data inside the RaybotCommandInputs as a MoveToLocationInput
+func (t RaybotCommandInputs) AsMoveToLocationInput() (MoveToLocationInput, error) {
+	var body MoveToLocationInput
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMoveToLocationInput overwrites any union data inside the RaybotCommandInputs as the provided MoveToLocationInput
+func (t *RaybotCommandInputs) FromMoveToLocationInput(v MoveToLocationInput) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMoveToLocationInput performs a merge with any union data inside the RaybotCommandInputs, using the provided MoveToLocationInput
+func (t *RaybotCommandInputs) MergeMoveToLocationInput(v MoveToLocationInput) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsLiftBoxInput returns the union data inside the RaybotCommandInputs as a LiftBoxInput
+func (t RaybotCommandInputs) AsLiftBoxInput() (LiftBoxInput, error) {
+	var body LiftBoxInput
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromLiftBoxInput overwrites any union data inside the RaybotCommandInputs as the provided LiftBoxInput
+func (t *RaybotCommandInputs) FromLiftBoxInput(v LiftBoxInput) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeLiftBoxInput performs a merge with any union data inside the RaybotCommandInputs, using the provided LiftBoxInput
+func (t *RaybotCommandInputs) MergeLiftBoxInput(v LiftBoxInput) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDropBoxInput returns the union data inside the RaybotCommandInputs as a DropBoxInput
+func (t RaybotCommandInputs) AsDropBoxInput() (DropBoxInput, error) {
+	var body DropBoxInput
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDropBoxInput overwrites any union data inside the RaybotCommandInputs as the provided DropBoxInput
+func (t *RaybotCommandInputs) FromDropBoxInput(v DropBoxInput) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDropBoxInput performs a merge with any union data inside the RaybotCommandInputs, using the provided DropBoxInput
+func (t *RaybotCommandInputs) MergeDropBoxInput(v DropBoxInput) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCheckQRCodeInput returns the union data inside the RaybotCommandInputs as a CheckQRCodeInput
+func (t RaybotCommandInputs) AsCheckQRCodeInput() (CheckQRCodeInput, error) {
+	var body CheckQRCodeInput
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCheckQRCodeInput overwrites any union data inside the RaybotCommandInputs as the provided CheckQRCodeInput
+func (t *RaybotCommandInputs) FromCheckQRCodeInput(v CheckQRCodeInput) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCheckQRCodeInput performs a merge with any union data inside the RaybotCommandInputs, using the provided CheckQRCodeInput
+func (t *RaybotCommandInputs) MergeCheckQRCodeInput(v CheckQRCodeInput) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSpeakInput returns the union data inside the RaybotCommandInputs as a SpeakInput
+func (t RaybotCommandInputs) AsSpeakInput() (SpeakInput, error) {
+	var body SpeakInput
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSpeakInput overwrites any union data inside the RaybotCommandInputs as the provided SpeakInput
+func (t *RaybotCommandInputs) FromSpeakInput(v SpeakInput) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSpeakInput performs a merge with any union data inside the RaybotCommandInputs, using the provided SpeakInput
+func (t *RaybotCommandInputs) MergeSpeakInput(v SpeakInput) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRaybotCommandInputs5 returns the union data inside the RaybotCommandInputs as a RaybotCommandInputs5
+func (t RaybotCommandInputs) AsRaybotCommandInputs5() (RaybotCommandInputs5, error) {
+	var body RaybotCommandInputs5
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRaybotCommandInputs5 overwrites any union data inside the RaybotCommandInputs as the provided RaybotCommandInputs5
+func (t *RaybotCommandInputs) FromRaybotCommandInputs5(v RaybotCommandInputs5) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRaybotCommandInputs5 performs a merge with any union data inside the RaybotCommandInputs, using the provided RaybotCommandInputs5
+func (t *RaybotCommandInputs) MergeRaybotCommandInputs5(v RaybotCommandInputs5) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t RaybotCommandInputs) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *RaybotCommandInputs) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsScanLocationOutputs returns the union data inside the RaybotCommandOutputs as a ScanLocationOutputs
+func (t RaybotCommandOutputs) AsScanLocationOutputs() (ScanLocationOutputs, error) {
+	var body ScanLocationOutputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromScanLocationOutputs overwrites any union data inside the RaybotCommandOutputs as the provided ScanLocationOutputs
+func (t *RaybotCommandOutputs) FromScanLocationOutputs(v ScanLocationOutputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeScanLocationOutputs performs a merge with any union data inside the RaybotCommandOutputs, using the provided ScanLocationOutputs
+func (t *RaybotCommandOutputs) MergeScanLocationOutputs(v ScanLocationOutputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRaybotCommandOutputs1 returns the union data inside the RaybotCommandOutputs as a RaybotCommandOutputs1
+func (t RaybotCommandOutputs) AsRaybotCommandOutputs1() (RaybotCommandOutputs1, error) {
+	var body RaybotCommandOutputs1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRaybotCommandOutputs1 overwrites any union data inside the RaybotCommandOutputs as the provided RaybotCommandOutputs1
+func (t *RaybotCommandOutputs) FromRaybotCommandOutputs1(v RaybotCommandOutputs1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRaybotCommandOutputs1 performs a merge with any union data inside the RaybotCommandOutputs, using the provided RaybotCommandOutputs1
+func (t *RaybotCommandOutputs) MergeRaybotCommandOutputs1(v RaybotCommandOutputs1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t RaybotCommandOutputs) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *RaybotCommandOutputs) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Purge dead letter messages
+	// (DELETE /dead-letter-messages)
+	DeadLetterMessagePurge(w http.ResponseWriter, r *http.Request, params DeadLetterMessagePurgeParams)
+	// List dead letter messages
+	// (GET /dead-letter-messages)
+	DeadLetterMessageList(w http.ResponseWriter, r *http.Request, params DeadLetterMessageListParams)
+	// Delete dead letter message by id
+	// (DELETE /dead-letter-messages/{deadLetterMessageId})
+	DeadLetterMessageDelete(w http.ResponseWriter, r *http.Request, deadLetterMessageId string)
+	// Get dead letter message by id
+	// (GET /dead-letter-messages/{deadLetterMessageId})
+	DeadLetterMessageGet(w http.ResponseWriter, r *http.Request, deadLetterMessageId string)
+	// Replay dead letter message
+	// (POST /dead-letter-messages/{deadLetterMessageId}/replay)
+	DeadLetterMessageReplay(w http.ResponseWriter, r *http.Request, deadLetterMessageId string)
+	// List QR locations
+	// (GET /qr-locations)
+	QrLocationList(w http.ResponseWriter, r *http.Request, params QrLocationListParams)
+	// Create QR location
+	// (POST /qr-locations)
+	QrLocationCreate(w http.ResponseWriter, r *http.Request)
+	// Export QR locations
+	// (GET /qr-locations/export)
+	QrLocationExport(w http.ResponseWriter, r *http.Request, params QrLocationExportParams)
+	// Import QR locations
+	// (POST /qr-locations/import)
+	QrLocationImport(w http.ResponseWriter, r *http.Request, params QrLocationImportParams)
+	// Get QR location labels as PDF
+	// (POST /qr-locations/labels.pdf)
+	QrLocationLabelsPdf(w http.ResponseWriter, r *http.Request)
+	// Delete QR location by id
+	// (DELETE /qr-locations/{qrLocationId})
+	QrLocationDelete(w http.ResponseWriter, r *http.Request, qrLocationId string)
+	// Get QR location by id
+	// (GET /qr-locations/{qrLocationId})
+	QrLocationGet(w http.ResponseWriter, r *http.Request, qrLocationId string)
+	// Update QR location by id
+	// (PUT /qr-locations/{qrLocationId})
+	QrLocationUpdate(w http.ResponseWriter, r *http.Request, qrLocationId string)
+	// Get QR location label as PNG
+	// (GET /qr-locations/{qrLocationId}/label.png)
+	QrLocationLabelPng(w http.ResponseWriter, r *http.Request, qrLocationId string, params QrLocationLabelPngParams)
+	// Get QR location label as SVG
+	// (GET /qr-locations/{qrLocationId}/label.svg)
+	QrLocationLabelSvg(w http.ResponseWriter, r *http.Request, qrLocationId string, params QrLocationLabelSvgParams)
+	// List QR location occupants
+	// (GET /qr-locations/{qrLocationId}/occupants)
+	RaybotPositionListOccupants(w http.ResponseWriter, r *http.Request, qrLocationId string)
+	// Get raybot command by id
+	// (GET /raybot-commands/{raybotCommandId})
+	RaybotCommandGet(w http.ResponseWriter, r *http.Request, raybotCommandId string)
+	// List raybots
+	// (GET /raybots)
+	RaybotList(w http.ResponseWriter, r *http.Request, params RaybotListParams)
+	// Create raybot
+	// (POST /raybots)
+	RaybotCreate(w http.ResponseWriter, r *http.Request)
+	// Delete raybot by id
+	// (DELETE /raybots/{raybotId})
+	RaybotDelete(w http.ResponseWriter, r *http.Request, raybotId string)
+	// Get raybot by id
+	// (GET /raybots/{raybotId})
+	RaybotGet(w http.ResponseWriter, r *http.Request, raybotId string)
+	// List raybot commands
+	// (GET /raybots/{raybotId}/commands)
+	RaybotCommandList(w http.ResponseWriter, r *http.Request, raybotId string, params RaybotCommandListParams)
 	// Create raybot command
 	// (POST /raybots/{raybotId}/commands)
 	RaybotCommandCreate(w http.ResponseWriter, r *http.Request, raybotId string)
+	// Get raybot position
+	// (GET /raybots/{raybotId}/position)
+	RaybotPositionGet(w http.ResponseWriter, r *http.Request, raybotId string)
+	// Report raybot position
+	// (PUT /raybots/{raybotId}/position)
+	RaybotPositionReport(w http.ResponseWriter, r *http.Request, raybotId string)
+	// List raybot position history
+	// (GET /raybots/{raybotId}/position/history)
+	RaybotPositionListHistory(w http.ResponseWriter, r *http.Request, raybotId string, params RaybotPositionListHistoryParams)
+	// Plan route
+	// (GET /routes)
+	RoutePlan(w http.ResponseWriter, r *http.Request, params RoutePlanParams)
 	// Get step by id
 	// (GET /step-executions/{stepExecutionId})
 	StepExecutionGet(w http.ResponseWriter, r *http.Request, stepExecutionId string)
+	// Get track map
+	// (GET /track-map)
+	TrackMapGet(w http.ResponseWriter, r *http.Request)
+	// List track segments
+	// (GET /track-segments)
+	TrackSegmentList(w http.ResponseWriter, r *http.Request, params TrackSegmentListParams)
+	// Create track segment
+	// (POST /track-segments)
+	TrackSegmentCreate(w http.ResponseWriter, r *http.Request)
+	// Delete track segment by id
+	// (DELETE /track-segments/{trackSegmentId})
+	TrackSegmentDelete(w http.ResponseWriter, r *http.Request, trackSegmentId string)
+	// Get track segment by id
+	// (GET /track-segments/{trackSegmentId})
+	TrackSegmentGet(w http.ResponseWriter, r *http.Request, trackSegmentId string)
+	// Update track segment by id
+	// (PUT /track-segments/{trackSegmentId})
+	TrackSegmentUpdate(w http.ResponseWriter, r *http.Request, trackSegmentId string)
+	// List webhook subscriptions
+	// (GET /webhook-subscriptions)
+	WebhookSubscriptionList(w http.ResponseWriter, r *http.Request, params WebhookSubscriptionListParams)
+	// Create webhook subscription
+	// (POST /webhook-subscriptions)
+	WebhookSubscriptionCreate(w http.ResponseWriter, r *http.Request)
+	// Delete webhook subscription by id
+	// (DELETE /webhook-subscriptions/{webhookSubscriptionId})
+	WebhookSubscriptionDelete(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string)
+	// Get webhook subscription by id
+	// (GET /webhook-subscriptions/{webhookSubscriptionId})
+	WebhookSubscriptionGet(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string)
+	// Update webhook subscription by id
+	// (PUT /webhook-subscriptions/{webhookSubscriptionId})
+	WebhookSubscriptionUpdate(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string)
+	// List webhook deliveries
+	// (GET /webhook-subscriptions/{webhookSubscriptionId}/deliveries)
+	WebhookDeliveryList(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string, params WebhookDeliveryListParams)
+	// Redeliver webhook delivery
+	// (POST /webhook-subscriptions/{webhookSubscriptionId}/deliveries/{webhookDeliveryId}/redeliver)
+	WebhookDeliveryRedeliver(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string, webhookDeliveryId string)
+	// List workflow executions
+	// (GET /workflow-executions)
+	WorkflowExecutionListAll(w http.ResponseWriter, r *http.Request, params WorkflowExecutionListAllParams)
+	// Count workflow executions by status
+	// (GET /workflow-executions/status-counts)
+	WorkflowExecutionCountByStatus(w http.ResponseWriter, r *http.Request, params WorkflowExecutionCountByStatusParams)
 	// Get workflow execution by id
 	// (GET /workflow-executions/{workflowExecutionId})
 	WorkflowExecutionGet(w http.ResponseWriter, r *http.Request, workflowExecutionId string)
+	// Stream workflow execution events
+	// (GET /workflow-executions/{workflowExecutionId}/events)
+	WorkflowExecutionEvents(w http.ResponseWriter, r *http.Request, workflowExecutionId string, params WorkflowExecutionEventsParams)
 	// List steps by workflow execution id
 	// (GET /workflow-executions/{workflowExecutionId}/steps)
-	StepExecutionListByWorkflowExecutionId(w http.ResponseWriter, r *http.Request, workflowExecutionId string)
+	StepExecutionListByWorkflowExecutionId(w http.ResponseWriter, r *http.Request, workflowExecutionId string, params StepExecutionListByWorkflowExecutionIdParams)
 	// List  workflows
 	// (GET /workflows)
 	WorkflowList(w http.ResponseWriter, r *http.Request, params WorkflowListParams)
 	// Create workflow
 	// (POST /workflows)
 	WorkflowCreate(w http.ResponseWriter, r *http.Request)
+	// Import workflow
+	// (POST /workflows/import)
+	WorkflowImport(w http.ResponseWriter, r *http.Request)
 	// Delete workflow by id
 	// (DELETE /workflows/{workflowId})
 	WorkflowDelete(w http.ResponseWriter, r *http.Request, workflowId string)
@@ -542,18 +2196,72 @@ type ServerInterface interface {
 	// Update workflow by id
 	// (PUT /workflows/{workflowId})
 	WorkflowUpdate(w http.ResponseWriter, r *http.Request, workflowId string)
+	// Duplicate workflow by id
+	// (POST /workflows/{workflowId}/duplicate)
+	WorkflowDuplicate(w http.ResponseWriter, r *http.Request, workflowId string)
 	// List workflow executions by workflow id
 	// (GET /workflows/{workflowId}/executions)
 	WorkflowExecutionList(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowExecutionListParams)
+	// Export workflow by id
+	// (GET /workflows/{workflowId}/export)
+	WorkflowExport(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowExportParams)
+	// Instantiate workflow template by id
+	// (POST /workflows/{workflowId}/instantiate)
+	WorkflowInstantiate(w http.ResponseWriter, r *http.Request, workflowId string)
+	// Publish workflow by id
+	// (POST /workflows/{workflowId}/publish)
+	WorkflowPublish(w http.ResponseWriter, r *http.Request, workflowId string)
 	// Run workflow by id
 	// (POST /workflows/{workflowId}/run)
 	WorkflowRun(w http.ResponseWriter, r *http.Request, workflowId string)
+	// List workflow versions by workflow id
+	// (GET /workflows/{workflowId}/versions)
+	WorkflowVersionList(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowVersionListParams)
+	// Get workflow version
+	// (GET /workflows/{workflowId}/versions/{version})
+	WorkflowVersionGet(w http.ResponseWriter, r *http.Request, workflowId string, version int32)
+	// Diff workflow version
+	// (GET /workflows/{workflowId}/versions/{version}/diff)
+	WorkflowVersionDiff(w http.ResponseWriter, r *http.Request, workflowId string, version int32, params WorkflowVersionDiffParams)
+	// Rollback workflow to a version
+	// (POST /workflows/{workflowId}/versions/{version}/rollback)
+	WorkflowVersionRollback(w http.ResponseWriter, r *http.Request, workflowId string, version int32)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Purge dead letter messages
+// (DELETE /dead-letter-messages)
+func (_ Unimplemented) DeadLetterMessagePurge(w http.ResponseWriter, r *http.Request, params DeadLetterMessagePurgeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List dead letter messages
+// (GET /dead-letter-messages)
+func (_ Unimplemented) DeadLetterMessageList(w http.ResponseWriter, r *http.Request, params DeadLetterMessageListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete dead letter message by id
+// (DELETE /dead-letter-messages/{deadLetterMessageId})
+func (_ Unimplemented) DeadLetterMessageDelete(w http.ResponseWriter, r *http.Request, deadLetterMessageId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get dead letter message by id
+// (GET /dead-letter-messages/{deadLetterMessageId})
+func (_ Unimplemented) DeadLetterMessageGet(w http.ResponseWriter, r *http.Request, deadLetterMessageId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replay dead letter message
+// (POST /dead-letter-messages/{deadLetterMessageId}/replay)
+func (_ Unimplemented) DeadLetterMessageReplay(w http.ResponseWriter, r *http.Request, deadLetterMessageId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List QR locations
 // (GET /qr-locations)
 func (_ Unimplemented) QrLocationList(w http.ResponseWriter, r *http.Request, params QrLocationListParams) {
@@ -566,6 +2274,24 @@ func (_ Unimplemented) QrLocationCreate(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export QR locations
+// (GET /qr-locations/export)
+func (_ Unimplemented) QrLocationExport(w http.ResponseWriter, r *http.Request, params QrLocationExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import QR locations
+// (POST /qr-locations/import)
+func (_ Unimplemented) QrLocationImport(w http.ResponseWriter, r *http.Request, params QrLocationImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get QR location labels as PDF
+// (POST /qr-locations/labels.pdf)
+func (_ Unimplemented) QrLocationLabelsPdf(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete QR location by id
 // (DELETE /qr-locations/{qrLocationId})
 func (_ Unimplemented) QrLocationDelete(w http.ResponseWriter, r *http.Request, qrLocationId string) {
@@ -584,6 +2310,24 @@ func (_ Unimplemented) QrLocationUpdate(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get QR location label as PNG
+// (GET /qr-locations/{qrLocationId}/label.png)
+func (_ Unimplemented) QrLocationLabelPng(w http.ResponseWriter, r *http.Request, qrLocationId string, params QrLocationLabelPngParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get QR location label as SVG
+// (GET /qr-locations/{qrLocationId}/label.svg)
+func (_ Unimplemented) QrLocationLabelSvg(w http.ResponseWriter, r *http.Request, qrLocationId string, params QrLocationLabelSvgParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List QR location occupants
+// (GET /qr-locations/{qrLocationId}/occupants)
+func (_ Unimplemented) RaybotPositionListOccupants(w http.ResponseWriter, r *http.Request, qrLocationId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get raybot command by id
 // (GET /raybot-commands/{raybotCommandId})
 func (_ Unimplemented) RaybotCommandGet(w http.ResponseWriter, r *http.Request, raybotCommandId string) {
@@ -626,109 +2370,291 @@ func (_ Unimplemented) RaybotCommandCreate(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get step by id
-// (GET /step-executions/{stepExecutionId})
-func (_ Unimplemented) StepExecutionGet(w http.ResponseWriter, r *http.Request, stepExecutionId string) {
+// Get raybot position
+// (GET /raybots/{raybotId}/position)
+func (_ Unimplemented) RaybotPositionGet(w http.ResponseWriter, r *http.Request, raybotId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get workflow execution by id
-// (GET /workflow-executions/{workflowExecutionId})
-func (_ Unimplemented) WorkflowExecutionGet(w http.ResponseWriter, r *http.Request, workflowExecutionId string) {
+// Report raybot position
+// (PUT /raybots/{raybotId}/position)
+func (_ Unimplemented) RaybotPositionReport(w http.ResponseWriter, r *http.Request, raybotId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List steps by workflow execution id
-// (GET /workflow-executions/{workflowExecutionId}/steps)
-func (_ Unimplemented) StepExecutionListByWorkflowExecutionId(w http.ResponseWriter, r *http.Request, workflowExecutionId string) {
+// List raybot position history
+// (GET /raybots/{raybotId}/position/history)
+func (_ Unimplemented) RaybotPositionListHistory(w http.ResponseWriter, r *http.Request, raybotId string, params RaybotPositionListHistoryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List  workflows
-// (GET /workflows)
-func (_ Unimplemented) WorkflowList(w http.ResponseWriter, r *http.Request, params WorkflowListParams) {
+// Plan route
+// (GET /routes)
+func (_ Unimplemented) RoutePlan(w http.ResponseWriter, r *http.Request, params RoutePlanParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create workflow
-// (POST /workflows)
-func (_ Unimplemented) WorkflowCreate(w http.ResponseWriter, r *http.Request) {
+// Get step by id
+// (GET /step-executions/{stepExecutionId})
+func (_ Unimplemented) StepExecutionGet(w http.ResponseWriter, r *http.Request, stepExecutionId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete workflow by id
-// (DELETE /workflows/{workflowId})
-func (_ Unimplemented) WorkflowDelete(w http.ResponseWriter, r *http.Request, workflowId string) {
+// Get track map
+// (GET /track-map)
+func (_ Unimplemented) TrackMapGet(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get workflow by id
-// (GET /workflows/{workflowId})
-func (_ Unimplemented) WorkflowGet(w http.ResponseWriter, r *http.Request, workflowId string) {
+// List track segments
+// (GET /track-segments)
+func (_ Unimplemented) TrackSegmentList(w http.ResponseWriter, r *http.Request, params TrackSegmentListParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update workflow by id
-// (PUT /workflows/{workflowId})
-func (_ Unimplemented) WorkflowUpdate(w http.ResponseWriter, r *http.Request, workflowId string) {
+// Create track segment
+// (POST /track-segments)
+func (_ Unimplemented) TrackSegmentCreate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List workflow executions by workflow id
-// (GET /workflows/{workflowId}/executions)
-func (_ Unimplemented) WorkflowExecutionList(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowExecutionListParams) {
+// Delete track segment by id
+// (DELETE /track-segments/{trackSegmentId})
+func (_ Unimplemented) TrackSegmentDelete(w http.ResponseWriter, r *http.Request, trackSegmentId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Run workflow by id
-// (POST /workflows/{workflowId}/run)
-func (_ Unimplemented) WorkflowRun(w http.ResponseWriter, r *http.Request, workflowId string) {
+// Get track segment by id
+// (GET /track-segments/{trackSegmentId})
+func (_ Unimplemented) TrackSegmentGet(w http.ResponseWriter, r *http.Request, trackSegmentId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Update track segment by id
+// (PUT /track-segments/{trackSegmentId})
+func (_ Unimplemented) TrackSegmentUpdate(w http.ResponseWriter, r *http.Request, trackSegmentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// List webhook subscriptions
+// (GET /webhook-subscriptions)
+func (_ Unimplemented) WebhookSubscriptionList(w http.ResponseWriter, r *http.Request, params WebhookSubscriptionListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// QrLocationList operation middleware
-func (siw *ServerInterfaceWrapper) QrLocationList(w http.ResponseWriter, r *http.Request) {
+// Create webhook subscription
+// (POST /webhook-subscriptions)
+func (_ Unimplemented) WebhookSubscriptionCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete webhook subscription by id
+// (DELETE /webhook-subscriptions/{webhookSubscriptionId})
+func (_ Unimplemented) WebhookSubscriptionDelete(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get webhook subscription by id
+// (GET /webhook-subscriptions/{webhookSubscriptionId})
+func (_ Unimplemented) WebhookSubscriptionGet(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update webhook subscription by id
+// (PUT /webhook-subscriptions/{webhookSubscriptionId})
+func (_ Unimplemented) WebhookSubscriptionUpdate(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhook deliveries
+// (GET /webhook-subscriptions/{webhookSubscriptionId}/deliveries)
+func (_ Unimplemented) WebhookDeliveryList(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string, params WebhookDeliveryListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Redeliver webhook delivery
+// (POST /webhook-subscriptions/{webhookSubscriptionId}/deliveries/{webhookDeliveryId}/redeliver)
+func (_ Unimplemented) WebhookDeliveryRedeliver(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string, webhookDeliveryId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List workflow executions
+// (GET /workflow-executions)
+func (_ Unimplemented) WorkflowExecutionListAll(w http.ResponseWriter, r *http.Request, params WorkflowExecutionListAllParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Count workflow executions by status
+// (GET /workflow-executions/status-counts)
+func (_ Unimplemented) WorkflowExecutionCountByStatus(w http.ResponseWriter, r *http.Request, params WorkflowExecutionCountByStatusParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get workflow execution by id
+// (GET /workflow-executions/{workflowExecutionId})
+func (_ Unimplemented) WorkflowExecutionGet(w http.ResponseWriter, r *http.Request, workflowExecutionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream workflow execution events
+// (GET /workflow-executions/{workflowExecutionId}/events)
+func (_ Unimplemented) WorkflowExecutionEvents(w http.ResponseWriter, r *http.Request, workflowExecutionId string, params WorkflowExecutionEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List steps by workflow execution id
+// (GET /workflow-executions/{workflowExecutionId}/steps)
+func (_ Unimplemented) StepExecutionListByWorkflowExecutionId(w http.ResponseWriter, r *http.Request, workflowExecutionId string, params StepExecutionListByWorkflowExecutionIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List  workflows
+// (GET /workflows)
+func (_ Unimplemented) WorkflowList(w http.ResponseWriter, r *http.Request, params WorkflowListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create workflow
+// (POST /workflows)
+func (_ Unimplemented) WorkflowCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import workflow
+// (POST /workflows/import)
+func (_ Unimplemented) WorkflowImport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete workflow by id
+// (DELETE /workflows/{workflowId})
+func (_ Unimplemented) WorkflowDelete(w http.ResponseWriter, r *http.Request, workflowId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get workflow by id
+// (GET /workflows/{workflowId})
+func (_ Unimplemented) WorkflowGet(w http.ResponseWriter, r *http.Request, workflowId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update workflow by id
+// (PUT /workflows/{workflowId})
+func (_ Unimplemented) WorkflowUpdate(w http.ResponseWriter, r *http.Request, workflowId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Duplicate workflow by id
+// (POST /workflows/{workflowId}/duplicate)
+func (_ Unimplemented) WorkflowDuplicate(w http.ResponseWriter, r *http.Request, workflowId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List workflow executions by workflow id
+// (GET /workflows/{workflowId}/executions)
+func (_ Unimplemented) WorkflowExecutionList(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowExecutionListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export workflow by id
+// (GET /workflows/{workflowId}/export)
+func (_ Unimplemented) WorkflowExport(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Instantiate workflow template by id
+// (POST /workflows/{workflowId}/instantiate)
+func (_ Unimplemented) WorkflowInstantiate(w http.ResponseWriter, r *http.Request, workflowId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Publish workflow by id
+// (POST /workflows/{workflowId}/publish)
+func (_ Unimplemented) WorkflowPublish(w http.ResponseWriter, r *http.Request, workflowId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run workflow by id
+// (POST /workflows/{workflowId}/run)
+func (_ Unimplemented) WorkflowRun(w http.ResponseWriter, r *http.Request, workflowId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List workflow versions by workflow id
+// (GET /workflows/{workflowId}/versions)
+func (_ Unimplemented) WorkflowVersionList(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowVersionListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get workflow version
+// (GET /workflows/{workflowId}/versions/{version})
+func (_ Unimplemented) WorkflowVersionGet(w http.ResponseWriter, r *http.Request, workflowId string, version int32) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Diff workflow version
+// (GET /workflows/{workflowId}/versions/{version}/diff)
+func (_ Unimplemented) WorkflowVersionDiff(w http.ResponseWriter, r *http.Request, workflowId string, version int32, params WorkflowVersionDiffParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rollback workflow to a version
+// (POST /workflows/{workflowId}/versions/{version}/rollback)
+func (_ Unimplemented) WorkflowVersionRollback(w http.ResponseWriter, r *http.Request, workflowId string, version int32) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// DeadLetterMessagePurge operation middleware
+func (siw *ServerInterfaceWrapper) DeadLetterMessagePurge(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params QrLocationListParams
+	var params DeadLetterMessagePurgeParams
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "topic" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "topic", r.URL.Query(), &params.Topic)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "topic", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	// ------------- Optional query parameter "handler" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	err = runtime.BindQueryParameter("form", true, false, "handler", r.URL.Query(), &params.Handler)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "handler", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	// ------------- Optional query parameter "replayed" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	err = runtime.BindQueryParameter("form", true, false, "replayed", r.URL.Query(), &params.Replayed)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "replayed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBefore", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QrLocationList(w, r, params)
+		siw.Handler.DeadLetterMessagePurge(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -738,36 +2664,64 @@ func (siw *ServerInterfaceWrapper) QrLocationList(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// QrLocationCreate operation middleware
-func (siw *ServerInterfaceWrapper) QrLocationCreate(w http.ResponseWriter, r *http.Request) {
+// DeadLetterMessageList operation middleware
+func (siw *ServerInterfaceWrapper) DeadLetterMessageList(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QrLocationCreate(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeadLetterMessageListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "pageSize" -------------
 
-// QrLocationDelete operation middleware
-func (siw *ServerInterfaceWrapper) QrLocationDelete(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "sort" -------------
 
-	// ------------- Path parameter "qrLocationId" -------------
-	var qrLocationId string
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "qrLocationId", chi.URLParam(r, "qrLocationId"), &qrLocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "topic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "topic", r.URL.Query(), &params.Topic)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "qrLocationId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "topic", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "handler" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "handler", r.URL.Query(), &params.Handler)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "handler", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "replayed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "replayed", r.URL.Query(), &params.Replayed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "replayed", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QrLocationDelete(w, r, qrLocationId)
+		siw.Handler.DeadLetterMessageList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -777,22 +2731,22 @@ func (siw *ServerInterfaceWrapper) QrLocationDelete(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// QrLocationGet operation middleware
-func (siw *ServerInterfaceWrapper) QrLocationGet(w http.ResponseWriter, r *http.Request) {
+// DeadLetterMessageDelete operation middleware
+func (siw *ServerInterfaceWrapper) DeadLetterMessageDelete(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "qrLocationId" -------------
-	var qrLocationId string
+	// ------------- Path parameter "deadLetterMessageId" -------------
+	var deadLetterMessageId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "qrLocationId", chi.URLParam(r, "qrLocationId"), &qrLocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "deadLetterMessageId", chi.URLParam(r, "deadLetterMessageId"), &deadLetterMessageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "qrLocationId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deadLetterMessageId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QrLocationGet(w, r, qrLocationId)
+		siw.Handler.DeadLetterMessageDelete(w, r, deadLetterMessageId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -802,22 +2756,22 @@ func (siw *ServerInterfaceWrapper) QrLocationGet(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// QrLocationUpdate operation middleware
-func (siw *ServerInterfaceWrapper) QrLocationUpdate(w http.ResponseWriter, r *http.Request) {
+// DeadLetterMessageGet operation middleware
+func (siw *ServerInterfaceWrapper) DeadLetterMessageGet(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "qrLocationId" -------------
-	var qrLocationId string
+	// ------------- Path parameter "deadLetterMessageId" -------------
+	var deadLetterMessageId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "qrLocationId", chi.URLParam(r, "qrLocationId"), &qrLocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "deadLetterMessageId", chi.URLParam(r, "deadLetterMessageId"), &deadLetterMessageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "qrLocationId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deadLetterMessageId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QrLocationUpdate(w, r, qrLocationId)
+		siw.Handler.DeadLetterMessageGet(w, r, deadLetterMessageId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -827,22 +2781,22 @@ func (siw *ServerInterfaceWrapper) QrLocationUpdate(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// RaybotCommandGet operation middleware
-func (siw *ServerInterfaceWrapper) RaybotCommandGet(w http.ResponseWriter, r *http.Request) {
+// DeadLetterMessageReplay operation middleware
+func (siw *ServerInterfaceWrapper) DeadLetterMessageReplay(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "raybotCommandId" -------------
-	var raybotCommandId string
+	// ------------- Path parameter "deadLetterMessageId" -------------
+	var deadLetterMessageId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "raybotCommandId", chi.URLParam(r, "raybotCommandId"), &raybotCommandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "deadLetterMessageId", chi.URLParam(r, "deadLetterMessageId"), &deadLetterMessageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotCommandId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deadLetterMessageId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RaybotCommandGet(w, r, raybotCommandId)
+		siw.Handler.DeadLetterMessageReplay(w, r, deadLetterMessageId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -852,13 +2806,13 @@ func (siw *ServerInterfaceWrapper) RaybotCommandGet(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// RaybotList operation middleware
-func (siw *ServerInterfaceWrapper) RaybotList(w http.ResponseWriter, r *http.Request) {
+// QrLocationList operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RaybotListParams
+	var params QrLocationListParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -884,24 +2838,24 @@ func (siw *ServerInterfaceWrapper) RaybotList(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Optional query parameter "isOnline" -------------
+	// ------------- Optional query parameter "filter" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "isOnline", r.URL.Query(), &params.IsOnline)
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isOnline", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "controlMode" -------------
+	// ------------- Optional query parameter "search" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "controlMode", r.URL.Query(), &params.ControlMode)
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlMode", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RaybotList(w, r, params)
+		siw.Handler.QrLocationList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -911,11 +2865,11 @@ func (siw *ServerInterfaceWrapper) RaybotList(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// RaybotCreate operation middleware
-func (siw *ServerInterfaceWrapper) RaybotCreate(w http.ResponseWriter, r *http.Request) {
+// QrLocationCreate operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationCreate(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RaybotCreate(w, r)
+		siw.Handler.QrLocationCreate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -925,22 +2879,24 @@ func (siw *ServerInterfaceWrapper) RaybotCreate(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// RaybotDelete operation middleware
-func (siw *ServerInterfaceWrapper) RaybotDelete(w http.ResponseWriter, r *http.Request) {
+// QrLocationExport operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationExport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "raybotId" -------------
-	var raybotId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QrLocationExportParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RaybotDelete(w, r, raybotId)
+		siw.Handler.QrLocationExport(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -950,22 +2906,24 @@ func (siw *ServerInterfaceWrapper) RaybotDelete(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// RaybotGet operation middleware
-func (siw *ServerInterfaceWrapper) RaybotGet(w http.ResponseWriter, r *http.Request) {
+// QrLocationImport operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationImport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "raybotId" -------------
-	var raybotId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QrLocationImportParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RaybotGet(w, r, raybotId)
+		siw.Handler.QrLocationImport(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -975,49 +2933,86 @@ func (siw *ServerInterfaceWrapper) RaybotGet(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// RaybotCommandList operation middleware
-func (siw *ServerInterfaceWrapper) RaybotCommandList(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// QrLocationLabelsPdf operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationLabelsPdf(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "raybotId" -------------
-	var raybotId string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QrLocationLabelsPdf(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params RaybotCommandListParams
+	handler.ServeHTTP(w, r)
+}
 
-	// ------------- Optional query parameter "page" -------------
+// QrLocationDelete operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationDelete(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	var err error
+
+	// ------------- Path parameter "qrLocationId" -------------
+	var qrLocationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "qrLocationId", chi.URLParam(r, "qrLocationId"), &qrLocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "qrLocationId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QrLocationDelete(w, r, qrLocationId)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QrLocationGet operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "qrLocationId" -------------
+	var qrLocationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "qrLocationId", chi.URLParam(r, "qrLocationId"), &qrLocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "qrLocationId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QrLocationGet(w, r, qrLocationId)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QrLocationUpdate operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "qrLocationId" -------------
+	var qrLocationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "qrLocationId", chi.URLParam(r, "qrLocationId"), &qrLocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "qrLocationId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RaybotCommandList(w, r, raybotId, params)
+		siw.Handler.QrLocationUpdate(w, r, qrLocationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1027,22 +3022,41 @@ func (siw *ServerInterfaceWrapper) RaybotCommandList(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// RaybotCommandCreate operation middleware
-func (siw *ServerInterfaceWrapper) RaybotCommandCreate(w http.ResponseWriter, r *http.Request) {
+// QrLocationLabelPng operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationLabelPng(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "raybotId" -------------
-	var raybotId string
+	// ------------- Path parameter "qrLocationId" -------------
+	var qrLocationId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "qrLocationId", chi.URLParam(r, "qrLocationId"), &qrLocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "qrLocationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QrLocationLabelPngParams
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "errorCorrection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "errorCorrection", r.URL.Query(), &params.ErrorCorrection)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "errorCorrection", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RaybotCommandCreate(w, r, raybotId)
+		siw.Handler.QrLocationLabelPng(w, r, qrLocationId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1052,22 +3066,41 @@ func (siw *ServerInterfaceWrapper) RaybotCommandCreate(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// StepExecutionGet operation middleware
-func (siw *ServerInterfaceWrapper) StepExecutionGet(w http.ResponseWriter, r *http.Request) {
+// QrLocationLabelSvg operation middleware
+func (siw *ServerInterfaceWrapper) QrLocationLabelSvg(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "stepExecutionId" -------------
-	var stepExecutionId string
+	// ------------- Path parameter "qrLocationId" -------------
+	var qrLocationId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "stepExecutionId", chi.URLParam(r, "stepExecutionId"), &stepExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "qrLocationId", chi.URLParam(r, "qrLocationId"), &qrLocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stepExecutionId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "qrLocationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QrLocationLabelSvgParams
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "errorCorrection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "errorCorrection", r.URL.Query(), &params.ErrorCorrection)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "errorCorrection", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StepExecutionGet(w, r, stepExecutionId)
+		siw.Handler.QrLocationLabelSvg(w, r, qrLocationId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1077,22 +3110,22 @@ func (siw *ServerInterfaceWrapper) StepExecutionGet(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// WorkflowExecutionGet operation middleware
-func (siw *ServerInterfaceWrapper) WorkflowExecutionGet(w http.ResponseWriter, r *http.Request) {
+// RaybotPositionListOccupants operation middleware
+func (siw *ServerInterfaceWrapper) RaybotPositionListOccupants(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowExecutionId" -------------
-	var workflowExecutionId string
+	// ------------- Path parameter "qrLocationId" -------------
+	var qrLocationId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowExecutionId", chi.URLParam(r, "workflowExecutionId"), &workflowExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "qrLocationId", chi.URLParam(r, "qrLocationId"), &qrLocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowExecutionId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "qrLocationId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WorkflowExecutionGet(w, r, workflowExecutionId)
+		siw.Handler.RaybotPositionListOccupants(w, r, qrLocationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1102,22 +3135,22 @@ func (siw *ServerInterfaceWrapper) WorkflowExecutionGet(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// StepExecutionListByWorkflowExecutionId operation middleware
-func (siw *ServerInterfaceWrapper) StepExecutionListByWorkflowExecutionId(w http.ResponseWriter, r *http.Request) {
+// RaybotCommandGet operation middleware
+func (siw *ServerInterfaceWrapper) RaybotCommandGet(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowExecutionId" -------------
-	var workflowExecutionId string
+	// ------------- Path parameter "raybotCommandId" -------------
+	var raybotCommandId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowExecutionId", chi.URLParam(r, "workflowExecutionId"), &workflowExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "raybotCommandId", chi.URLParam(r, "raybotCommandId"), &raybotCommandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowExecutionId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotCommandId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StepExecutionListByWorkflowExecutionId(w, r, workflowExecutionId)
+		siw.Handler.RaybotCommandGet(w, r, raybotCommandId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1127,13 +3160,13 @@ func (siw *ServerInterfaceWrapper) StepExecutionListByWorkflowExecutionId(w http
 	handler.ServeHTTP(w, r)
 }
 
-// WorkflowList operation middleware
-func (siw *ServerInterfaceWrapper) WorkflowList(w http.ResponseWriter, r *http.Request) {
+// RaybotList operation middleware
+func (siw *ServerInterfaceWrapper) RaybotList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowListParams
+	var params RaybotListParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -1159,30 +3192,24 @@ func (siw *ServerInterfaceWrapper) WorkflowList(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Optional query parameter "isDraft" -------------
+	// ------------- Optional query parameter "isOnline" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "isDraft", r.URL.Query(), &params.IsDraft)
+	err = runtime.BindQueryParameter("form", true, false, "isOnline", r.URL.Query(), &params.IsOnline)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isDraft", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isOnline", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WorkflowList(w, r, params)
-	}))
+	// ------------- Optional query parameter "controlMode" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "controlMode", r.URL.Query(), &params.ControlMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlMode", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// WorkflowCreate operation middleware
-func (siw *ServerInterfaceWrapper) WorkflowCreate(w http.ResponseWriter, r *http.Request) {
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WorkflowCreate(w, r)
+		siw.Handler.RaybotList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1192,22 +3219,11 @@ func (siw *ServerInterfaceWrapper) WorkflowCreate(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// WorkflowDelete operation middleware
-func (siw *ServerInterfaceWrapper) WorkflowDelete(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
-		return
-	}
+// RaybotCreate operation middleware
+func (siw *ServerInterfaceWrapper) RaybotCreate(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WorkflowDelete(w, r, workflowId)
+		siw.Handler.RaybotCreate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1217,22 +3233,22 @@ func (siw *ServerInterfaceWrapper) WorkflowDelete(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// WorkflowGet operation middleware
-func (siw *ServerInterfaceWrapper) WorkflowGet(w http.ResponseWriter, r *http.Request) {
+// RaybotDelete operation middleware
+func (siw *ServerInterfaceWrapper) RaybotDelete(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId string
+	// ------------- Path parameter "raybotId" -------------
+	var raybotId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WorkflowGet(w, r, workflowId)
+		siw.Handler.RaybotDelete(w, r, raybotId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1242,22 +3258,22 @@ func (siw *ServerInterfaceWrapper) WorkflowGet(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// WorkflowUpdate operation middleware
-func (siw *ServerInterfaceWrapper) WorkflowUpdate(w http.ResponseWriter, r *http.Request) {
+// RaybotGet operation middleware
+func (siw *ServerInterfaceWrapper) RaybotGet(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId string
+	// ------------- Path parameter "raybotId" -------------
+	var raybotId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WorkflowUpdate(w, r, workflowId)
+		siw.Handler.RaybotGet(w, r, raybotId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1267,22 +3283,22 @@ func (siw *ServerInterfaceWrapper) WorkflowUpdate(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// WorkflowExecutionList operation middleware
-func (siw *ServerInterfaceWrapper) WorkflowExecutionList(w http.ResponseWriter, r *http.Request) {
+// RaybotCommandList operation middleware
+func (siw *ServerInterfaceWrapper) RaybotCommandList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId string
+	// ------------- Path parameter "raybotId" -------------
+	var raybotId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
 		return
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowExecutionListParams
+	var params RaybotCommandListParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -1300,6 +3316,22 @@ func (siw *ServerInterfaceWrapper) WorkflowExecutionList(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "sort" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
@@ -1308,8 +3340,16 @@ func (siw *ServerInterfaceWrapper) WorkflowExecutionList(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WorkflowExecutionList(w, r, workflowId, params)
+		siw.Handler.RaybotCommandList(w, r, raybotId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1319,22 +3359,22 @@ func (siw *ServerInterfaceWrapper) WorkflowExecutionList(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// WorkflowRun operation middleware
-func (siw *ServerInterfaceWrapper) WorkflowRun(w http.ResponseWriter, r *http.Request) {
+// RaybotCommandCreate operation middleware
+func (siw *ServerInterfaceWrapper) RaybotCommandCreate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId string
+	// ------------- Path parameter "raybotId" -------------
+	var raybotId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WorkflowRun(w, r, workflowId)
+		siw.Handler.RaybotCommandCreate(w, r, raybotId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1344,900 +3384,4554 @@ func (siw *ServerInterfaceWrapper) WorkflowRun(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// RaybotPositionGet operation middleware
+func (siw *ServerInterfaceWrapper) RaybotPositionGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "raybotId" -------------
+	var raybotId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RaybotPositionGet(w, r, raybotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RaybotPositionReport operation middleware
+func (siw *ServerInterfaceWrapper) RaybotPositionReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "raybotId" -------------
+	var raybotId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RaybotPositionReport(w, r, raybotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RaybotPositionListHistory operation middleware
+func (siw *ServerInterfaceWrapper) RaybotPositionListHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "raybotId" -------------
+	var raybotId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "raybotId", chi.URLParam(r, "raybotId"), &raybotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RaybotPositionListHistoryParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RaybotPositionListHistory(w, r, raybotId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RoutePlan operation middleware
+func (siw *ServerInterfaceWrapper) RoutePlan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoutePlanParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "raybotId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "raybotId", r.URL.Query(), &params.RaybotId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "raybotId", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RoutePlan(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StepExecutionGet operation middleware
+func (siw *ServerInterfaceWrapper) StepExecutionGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "stepExecutionId" -------------
+	var stepExecutionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stepExecutionId", chi.URLParam(r, "stepExecutionId"), &stepExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stepExecutionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StepExecutionGet(w, r, stepExecutionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TrackMapGet operation middleware
+func (siw *ServerInterfaceWrapper) TrackMapGet(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TrackMapGet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TrackSegmentList operation middleware
+func (siw *ServerInterfaceWrapper) TrackSegmentList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TrackSegmentListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TrackSegmentList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TrackSegmentCreate operation middleware
+func (siw *ServerInterfaceWrapper) TrackSegmentCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TrackSegmentCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TrackSegmentDelete operation middleware
+func (siw *ServerInterfaceWrapper) TrackSegmentDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "trackSegmentId" -------------
+	var trackSegmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "trackSegmentId", chi.URLParam(r, "trackSegmentId"), &trackSegmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trackSegmentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TrackSegmentDelete(w, r, trackSegmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TrackSegmentGet operation middleware
+func (siw *ServerInterfaceWrapper) TrackSegmentGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "trackSegmentId" -------------
+	var trackSegmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "trackSegmentId", chi.URLParam(r, "trackSegmentId"), &trackSegmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trackSegmentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TrackSegmentGet(w, r, trackSegmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TrackSegmentUpdate operation middleware
+func (siw *ServerInterfaceWrapper) TrackSegmentUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "trackSegmentId" -------------
+	var trackSegmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "trackSegmentId", chi.URLParam(r, "trackSegmentId"), &trackSegmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trackSegmentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TrackSegmentUpdate(w, r, trackSegmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhookSubscriptionList operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhookSubscriptionListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhookSubscriptionList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhookSubscriptionCreate operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhookSubscriptionCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhookSubscriptionDelete operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookSubscriptionId" -------------
+	var webhookSubscriptionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookSubscriptionId", chi.URLParam(r, "webhookSubscriptionId"), &webhookSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookSubscriptionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhookSubscriptionDelete(w, r, webhookSubscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhookSubscriptionGet operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookSubscriptionId" -------------
+	var webhookSubscriptionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookSubscriptionId", chi.URLParam(r, "webhookSubscriptionId"), &webhookSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookSubscriptionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhookSubscriptionGet(w, r, webhookSubscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhookSubscriptionUpdate operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookSubscriptionId" -------------
+	var webhookSubscriptionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookSubscriptionId", chi.URLParam(r, "webhookSubscriptionId"), &webhookSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookSubscriptionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhookSubscriptionUpdate(w, r, webhookSubscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhookDeliveryList operation middleware
+func (siw *ServerInterfaceWrapper) WebhookDeliveryList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookSubscriptionId" -------------
+	var webhookSubscriptionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookSubscriptionId", chi.URLParam(r, "webhookSubscriptionId"), &webhookSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookSubscriptionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhookDeliveryListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhookDeliveryList(w, r, webhookSubscriptionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhookDeliveryRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) WebhookDeliveryRedeliver(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookSubscriptionId" -------------
+	var webhookSubscriptionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookSubscriptionId", chi.URLParam(r, "webhookSubscriptionId"), &webhookSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookSubscriptionId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhookDeliveryId" -------------
+	var webhookDeliveryId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookDeliveryId", chi.URLParam(r, "webhookDeliveryId"), &webhookDeliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookDeliveryId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhookDeliveryRedeliver(w, r, webhookSubscriptionId, webhookDeliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowExecutionListAll operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowExecutionListAll(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowExecutionListAllParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowExecutionListAll(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowExecutionCountByStatus operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowExecutionCountByStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowExecutionCountByStatusParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowExecutionCountByStatus(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowExecutionGet operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowExecutionGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowExecutionId" -------------
+	var workflowExecutionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowExecutionId", chi.URLParam(r, "workflowExecutionId"), &workflowExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowExecutionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowExecutionGet(w, r, workflowExecutionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowExecutionEvents operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowExecutionEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowExecutionId" -------------
+	var workflowExecutionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowExecutionId", chi.URLParam(r, "workflowExecutionId"), &workflowExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowExecutionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowExecutionEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowExecutionEvents(w, r, workflowExecutionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StepExecutionListByWorkflowExecutionId operation middleware
+func (siw *ServerInterfaceWrapper) StepExecutionListByWorkflowExecutionId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowExecutionId" -------------
+	var workflowExecutionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowExecutionId", chi.URLParam(r, "workflowExecutionId"), &workflowExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowExecutionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StepExecutionListByWorkflowExecutionIdParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StepExecutionListByWorkflowExecutionId(w, r, workflowExecutionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowList operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "isDraft" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isDraft", r.URL.Query(), &params.IsDraft)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isDraft", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "isTemplate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isTemplate", r.URL.Query(), &params.IsTemplate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isTemplate", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowCreate operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowImport operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowImport(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowImport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowDelete operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowDelete(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowGet operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowGet(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowUpdate operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowUpdate(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowDuplicate operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowDuplicate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowDuplicate(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowExecutionList operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowExecutionList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowExecutionListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "isSimulated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isSimulated", r.URL.Query(), &params.IsSimulated)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isSimulated", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowExecutionList(w, r, workflowId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowExport operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowExport(w, r, workflowId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowInstantiate operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowInstantiate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowInstantiate(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowPublish operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowPublish(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowPublish(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowRun(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowVersionList operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowVersionList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowVersionListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowVersionList(w, r, workflowId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowVersionGet operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowVersionGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowVersionGet(w, r, workflowId, version)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowVersionDiff operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowVersionDiff(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowVersionDiffParams
+
+	// ------------- Optional query parameter "base" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "base", r.URL.Query(), &params.Base)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "base", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowVersionDiff(w, r, workflowId, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WorkflowVersionRollback operation middleware
+func (siw *ServerInterfaceWrapper) WorkflowVersionRollback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WorkflowVersionRollback(w, r, workflowId, version)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/dead-letter-messages", wrapper.DeadLetterMessagePurge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dead-letter-messages", wrapper.DeadLetterMessageList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/dead-letter-messages/{deadLetterMessageId}", wrapper.DeadLetterMessageDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dead-letter-messages/{deadLetterMessageId}", wrapper.DeadLetterMessageGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/dead-letter-messages/{deadLetterMessageId}/replay", wrapper.DeadLetterMessageReplay)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/qr-locations", wrapper.QrLocationList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/qr-locations", wrapper.QrLocationCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/qr-locations/export", wrapper.QrLocationExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/qr-locations/import", wrapper.QrLocationImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/qr-locations/labels.pdf", wrapper.QrLocationLabelsPdf)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/qr-locations/{qrLocationId}", wrapper.QrLocationDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/qr-locations/{qrLocationId}", wrapper.QrLocationGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/qr-locations/{qrLocationId}", wrapper.QrLocationUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/qr-locations/{qrLocationId}/label.png", wrapper.QrLocationLabelPng)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/qr-locations/{qrLocationId}/label.svg", wrapper.QrLocationLabelSvg)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/qr-locations/{qrLocationId}/occupants", wrapper.RaybotPositionListOccupants)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/raybot-commands/{raybotCommandId}", wrapper.RaybotCommandGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/raybots", wrapper.RaybotList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/raybots", wrapper.RaybotCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/raybots/{raybotId}", wrapper.RaybotDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/raybots/{raybotId}", wrapper.RaybotGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/raybots/{raybotId}/commands", wrapper.RaybotCommandList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/raybots/{raybotId}/commands", wrapper.RaybotCommandCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/raybots/{raybotId}/position", wrapper.RaybotPositionGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/raybots/{raybotId}/position", wrapper.RaybotPositionReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/raybots/{raybotId}/position/history", wrapper.RaybotPositionListHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/routes", wrapper.RoutePlan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/step-executions/{stepExecutionId}", wrapper.StepExecutionGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/track-map", wrapper.TrackMapGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/track-segments", wrapper.TrackSegmentList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/track-segments", wrapper.TrackSegmentCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/track-segments/{trackSegmentId}", wrapper.TrackSegmentDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/track-segments/{trackSegmentId}", wrapper.TrackSegmentGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/track-segments/{trackSegmentId}", wrapper.TrackSegmentUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook-subscriptions", wrapper.WebhookSubscriptionList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook-subscriptions", wrapper.WebhookSubscriptionCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhook-subscriptions/{webhookSubscriptionId}", wrapper.WebhookSubscriptionDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook-subscriptions/{webhookSubscriptionId}", wrapper.WebhookSubscriptionGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/webhook-subscriptions/{webhookSubscriptionId}", wrapper.WebhookSubscriptionUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook-subscriptions/{webhookSubscriptionId}/deliveries", wrapper.WebhookDeliveryList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook-subscriptions/{webhookSubscriptionId}/deliveries/{webhookDeliveryId}/redeliver", wrapper.WebhookDeliveryRedeliver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflow-executions", wrapper.WorkflowExecutionListAll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflow-executions/status-counts", wrapper.WorkflowExecutionCountByStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflow-executions/{workflowExecutionId}", wrapper.WorkflowExecutionGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflow-executions/{workflowExecutionId}/events", wrapper.WorkflowExecutionEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflow-executions/{workflowExecutionId}/steps", wrapper.StepExecutionListByWorkflowExecutionId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflows", wrapper.WorkflowList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workflows", wrapper.WorkflowCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workflows/import", wrapper.WorkflowImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/workflows/{workflowId}", wrapper.WorkflowDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflows/{workflowId}", wrapper.WorkflowGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/workflows/{workflowId}", wrapper.WorkflowUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workflows/{workflowId}/duplicate", wrapper.WorkflowDuplicate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflows/{workflowId}/executions", wrapper.WorkflowExecutionList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflows/{workflowId}/export", wrapper.WorkflowExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workflows/{workflowId}/instantiate", wrapper.WorkflowInstantiate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workflows/{workflowId}/publish", wrapper.WorkflowPublish)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workflows/{workflowId}/run", wrapper.WorkflowRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflows/{workflowId}/versions", wrapper.WorkflowVersionList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflows/{workflowId}/versions/{version}", wrapper.WorkflowVersionGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflows/{workflowId}/versions/{version}/diff", wrapper.WorkflowVersionDiff)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workflows/{workflowId}/versions/{version}/rollback", wrapper.WorkflowVersionRollback)
+	})
+
+	return r
+}
+
+type DeadLetterMessagePurgeRequestObject struct {
+	Params DeadLetterMessagePurgeParams
+}
+
+type DeadLetterMessagePurgeResponseObject interface {
+	VisitDeadLetterMessagePurgeResponse(w http.ResponseWriter) error
+}
+
+type DeadLetterMessagePurge200JSONResponse PurgeDeadLetterMessagesResponse
+
+func (response DeadLetterMessagePurge200JSONResponse) VisitDeadLetterMessagePurgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterMessagePurge400JSONResponse ErrorResponse
+
+func (response DeadLetterMessagePurge400JSONResponse) VisitDeadLetterMessagePurgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterMessageListRequestObject struct {
+	Params DeadLetterMessageListParams
+}
+
+type DeadLetterMessageListResponseObject interface {
+	VisitDeadLetterMessageListResponse(w http.ResponseWriter) error
+}
+
+type DeadLetterMessageList200JSONResponse DeadLetterMessagesListResponse
+
+func (response DeadLetterMessageList200JSONResponse) VisitDeadLetterMessageListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterMessageList400JSONResponse ErrorResponse
+
+func (response DeadLetterMessageList400JSONResponse) VisitDeadLetterMessageListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterMessageDeleteRequestObject struct {
+	DeadLetterMessageId string `json:"deadLetterMessageId"`
+}
+
+type DeadLetterMessageDeleteResponseObject interface {
+	VisitDeadLetterMessageDeleteResponse(w http.ResponseWriter) error
+}
+
+type DeadLetterMessageDelete204Response struct {
+}
+
+func (response DeadLetterMessageDelete204Response) VisitDeadLetterMessageDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeadLetterMessageDelete404JSONResponse ErrorResponse
+
+func (response DeadLetterMessageDelete404JSONResponse) VisitDeadLetterMessageDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterMessageGetRequestObject struct {
+	DeadLetterMessageId string `json:"deadLetterMessageId"`
+}
+
+type DeadLetterMessageGetResponseObject interface {
+	VisitDeadLetterMessageGetResponse(w http.ResponseWriter) error
+}
+
+type DeadLetterMessageGet200JSONResponse DeadLetterMessageResponse
+
+func (response DeadLetterMessageGet200JSONResponse) VisitDeadLetterMessageGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterMessageGet404JSONResponse ErrorResponse
+
+func (response DeadLetterMessageGet404JSONResponse) VisitDeadLetterMessageGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterMessageReplayRequestObject struct {
+	DeadLetterMessageId string `json:"deadLetterMessageId"`
+}
+
+type DeadLetterMessageReplayResponseObject interface {
+	VisitDeadLetterMessageReplayResponse(w http.ResponseWriter) error
+}
+
+type DeadLetterMessageReplay200JSONResponse DeadLetterMessageResponse
+
+func (response DeadLetterMessageReplay200JSONResponse) VisitDeadLetterMessageReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterMessageReplay404JSONResponse ErrorResponse
+
+func (response DeadLetterMessageReplay404JSONResponse) VisitDeadLetterMessageReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationListRequestObject struct {
+	Params QrLocationListParams
+}
+
+type QrLocationListResponseObject interface {
+	VisitQrLocationListResponse(w http.ResponseWriter) error
+}
+
+type QrLocationList200JSONResponse QRLocationsListResponse
+
+func (response QrLocationList200JSONResponse) VisitQrLocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationList400JSONResponse ErrorResponse
+
+func (response QrLocationList400JSONResponse) VisitQrLocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationCreateRequestObject struct {
+	Body *QrLocationCreateJSONRequestBody
+}
+
+type QrLocationCreateResponseObject interface {
+	VisitQrLocationCreateResponse(w http.ResponseWriter) error
+}
+
+type QrLocationCreate201JSONResponse QRLocationResponse
+
+func (response QrLocationCreate201JSONResponse) VisitQrLocationCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationCreate400JSONResponse ErrorResponse
+
+func (response QrLocationCreate400JSONResponse) VisitQrLocationCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationExportRequestObject struct {
+	Params QrLocationExportParams
+}
+
+type QrLocationExportResponseObject interface {
+	VisitQrLocationExportResponse(w http.ResponseWriter) error
+}
+
+type QrLocationExport200JSONResponse ImportQRLocationsRequest
+
+func (response QrLocationExport200JSONResponse) VisitQrLocationExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationExport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response QrLocationExport200TextcsvResponse) VisitQrLocationExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type QrLocationExport400JSONResponse ErrorResponse
+
+func (response QrLocationExport400JSONResponse) VisitQrLocationExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationImportRequestObject struct {
+	Params   QrLocationImportParams
+	JSONBody *QrLocationImportJSONRequestBody
+	Body     io.Reader
+}
+
+type QrLocationImportResponseObject interface {
+	VisitQrLocationImportResponse(w http.ResponseWriter) error
+}
+
+type QrLocationImport200JSONResponse ImportQRLocationsResponse
+
+func (response QrLocationImport200JSONResponse) VisitQrLocationImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationImport400JSONResponse ErrorResponse
+
+func (response QrLocationImport400JSONResponse) VisitQrLocationImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationImport409JSONResponse ErrorResponse
+
+func (response QrLocationImport409JSONResponse) VisitQrLocationImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationLabelsPdfRequestObject struct {
+	Body *QrLocationLabelsPdfJSONRequestBody
+}
+
+type QrLocationLabelsPdfResponseObject interface {
+	VisitQrLocationLabelsPdfResponse(w http.ResponseWriter) error
+}
+
+type QrLocationLabelsPdf200ApplicationpdfResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response QrLocationLabelsPdf200ApplicationpdfResponse) VisitQrLocationLabelsPdfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type QrLocationLabelsPdf400JSONResponse ErrorResponse
+
+func (response QrLocationLabelsPdf400JSONResponse) VisitQrLocationLabelsPdfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationLabelsPdf404JSONResponse ErrorResponse
+
+func (response QrLocationLabelsPdf404JSONResponse) VisitQrLocationLabelsPdfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationDeleteRequestObject struct {
+	QrLocationId string `json:"qrLocationId"`
+}
+
+type QrLocationDeleteResponseObject interface {
+	VisitQrLocationDeleteResponse(w http.ResponseWriter) error
+}
+
+type QrLocationDelete204Response struct {
+}
+
+func (response QrLocationDelete204Response) VisitQrLocationDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type QrLocationDelete404JSONResponse ErrorResponse
+
+func (response QrLocationDelete404JSONResponse) VisitQrLocationDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationGetRequestObject struct {
+	QrLocationId string `json:"qrLocationId"`
+}
+
+type QrLocationGetResponseObject interface {
+	VisitQrLocationGetResponse(w http.ResponseWriter) error
+}
+
+type QrLocationGet200JSONResponse QRLocationResponse
+
+func (response QrLocationGet200JSONResponse) VisitQrLocationGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationGet404JSONResponse ErrorResponse
+
+func (response QrLocationGet404JSONResponse) VisitQrLocationGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationUpdateRequestObject struct {
+	QrLocationId string `json:"qrLocationId"`
+	Body         *QrLocationUpdateJSONRequestBody
+}
+
+type QrLocationUpdateResponseObject interface {
+	VisitQrLocationUpdateResponse(w http.ResponseWriter) error
+}
+
+type QrLocationUpdate200JSONResponse QRLocationResponse
+
+func (response QrLocationUpdate200JSONResponse) VisitQrLocationUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationUpdate400JSONResponse ErrorResponse
+
+func (response QrLocationUpdate400JSONResponse) VisitQrLocationUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationUpdate404JSONResponse ErrorResponse
+
+func (response QrLocationUpdate404JSONResponse) VisitQrLocationUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationLabelPngRequestObject struct {
+	QrLocationId string `json:"qrLocationId"`
+	Params       QrLocationLabelPngParams
+}
+
+type QrLocationLabelPngResponseObject interface {
+	VisitQrLocationLabelPngResponse(w http.ResponseWriter) error
+}
+
+type QrLocationLabelPng200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response QrLocationLabelPng200ImagepngResponse) VisitQrLocationLabelPngResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type QrLocationLabelPng400JSONResponse ErrorResponse
+
+func (response QrLocationLabelPng400JSONResponse) VisitQrLocationLabelPngResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationLabelPng404JSONResponse ErrorResponse
+
+func (response QrLocationLabelPng404JSONResponse) VisitQrLocationLabelPngResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationLabelSvgRequestObject struct {
+	QrLocationId string `json:"qrLocationId"`
+	Params       QrLocationLabelSvgParams
+}
+
+type QrLocationLabelSvgResponseObject interface {
+	VisitQrLocationLabelSvgResponse(w http.ResponseWriter) error
+}
+
+type QrLocationLabelSvg200ImagesvgXmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response QrLocationLabelSvg200ImagesvgXmlResponse) VisitQrLocationLabelSvgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type QrLocationLabelSvg400JSONResponse ErrorResponse
+
+func (response QrLocationLabelSvg400JSONResponse) VisitQrLocationLabelSvgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QrLocationLabelSvg404JSONResponse ErrorResponse
+
+func (response QrLocationLabelSvg404JSONResponse) VisitQrLocationLabelSvgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotPositionListOccupantsRequestObject struct {
+	QrLocationId string `json:"qrLocationId"`
+}
+
+type RaybotPositionListOccupantsResponseObject interface {
+	VisitRaybotPositionListOccupantsResponse(w http.ResponseWriter) error
+}
+
+type RaybotPositionListOccupants200JSONResponse QRLocationOccupantsResponse
+
+func (response RaybotPositionListOccupants200JSONResponse) VisitRaybotPositionListOccupantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotPositionListOccupants404JSONResponse ErrorResponse
+
+func (response RaybotPositionListOccupants404JSONResponse) VisitRaybotPositionListOccupantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCommandGetRequestObject struct {
+	RaybotCommandId string `json:"raybotCommandId"`
+}
+
+type RaybotCommandGetResponseObject interface {
+	VisitRaybotCommandGetResponse(w http.ResponseWriter) error
+}
+
+type RaybotCommandGet200JSONResponse RaybotCommandResponse
+
+func (response RaybotCommandGet200JSONResponse) VisitRaybotCommandGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCommandGet404JSONResponse ErrorResponse
+
+func (response RaybotCommandGet404JSONResponse) VisitRaybotCommandGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotListRequestObject struct {
+	Params RaybotListParams
+}
+
+type RaybotListResponseObject interface {
+	VisitRaybotListResponse(w http.ResponseWriter) error
+}
+
+type RaybotList200JSONResponse RaybotsListResponse
+
+func (response RaybotList200JSONResponse) VisitRaybotListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotList400JSONResponse ErrorResponse
+
+func (response RaybotList400JSONResponse) VisitRaybotListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCreateRequestObject struct {
+	Body *RaybotCreateJSONRequestBody
+}
+
+type RaybotCreateResponseObject interface {
+	VisitRaybotCreateResponse(w http.ResponseWriter) error
+}
+
+type RaybotCreate201JSONResponse RaybotResponse
+
+func (response RaybotCreate201JSONResponse) VisitRaybotCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCreate400JSONResponse ErrorResponse
+
+func (response RaybotCreate400JSONResponse) VisitRaybotCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCreate409JSONResponse ErrorResponse
+
+func (response RaybotCreate409JSONResponse) VisitRaybotCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotDeleteRequestObject struct {
+	RaybotId string `json:"raybotId"`
+}
+
+type RaybotDeleteResponseObject interface {
+	VisitRaybotDeleteResponse(w http.ResponseWriter) error
+}
+
+type RaybotDelete204Response struct {
+}
+
+func (response RaybotDelete204Response) VisitRaybotDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RaybotDelete404JSONResponse ErrorResponse
+
+func (response RaybotDelete404JSONResponse) VisitRaybotDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotGetRequestObject struct {
+	RaybotId string `json:"raybotId"`
+}
+
+type RaybotGetResponseObject interface {
+	VisitRaybotGetResponse(w http.ResponseWriter) error
+}
+
+type RaybotGet200JSONResponse RaybotResponse
+
+func (response RaybotGet200JSONResponse) VisitRaybotGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotGet404JSONResponse ErrorResponse
+
+func (response RaybotGet404JSONResponse) VisitRaybotGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCommandListRequestObject struct {
+	RaybotId string `json:"raybotId"`
+	Params   RaybotCommandListParams
+}
+
+type RaybotCommandListResponseObject interface {
+	VisitRaybotCommandListResponse(w http.ResponseWriter) error
+}
+
+type RaybotCommandList200JSONResponse RaybotCommandsListResponse
+
+func (response RaybotCommandList200JSONResponse) VisitRaybotCommandListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCommandList400JSONResponse ErrorResponse
+
+func (response RaybotCommandList400JSONResponse) VisitRaybotCommandListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCommandCreateRequestObject struct {
+	RaybotId string `json:"raybotId"`
+	Body     *RaybotCommandCreateJSONRequestBody
+}
+
+type RaybotCommandCreateResponseObject interface {
+	VisitRaybotCommandCreateResponse(w http.ResponseWriter) error
+}
+
+type RaybotCommandCreate201JSONResponse RaybotCommandResponse
+
+func (response RaybotCommandCreate201JSONResponse) VisitRaybotCommandCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCommandCreate400JSONResponse ErrorResponse
+
+func (response RaybotCommandCreate400JSONResponse) VisitRaybotCommandCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotCommandCreate409JSONResponse ErrorResponse
+
+func (response RaybotCommandCreate409JSONResponse) VisitRaybotCommandCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotPositionGetRequestObject struct {
+	RaybotId string `json:"raybotId"`
+}
+
+type RaybotPositionGetResponseObject interface {
+	VisitRaybotPositionGetResponse(w http.ResponseWriter) error
+}
+
+type RaybotPositionGet200JSONResponse RaybotPositionResponse
+
+func (response RaybotPositionGet200JSONResponse) VisitRaybotPositionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotPositionGet404JSONResponse ErrorResponse
+
+func (response RaybotPositionGet404JSONResponse) VisitRaybotPositionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotPositionReportRequestObject struct {
+	RaybotId string `json:"raybotId"`
+	Body     *RaybotPositionReportJSONRequestBody
+}
+
+type RaybotPositionReportResponseObject interface {
+	VisitRaybotPositionReportResponse(w http.ResponseWriter) error
+}
+
+type RaybotPositionReport200JSONResponse RaybotPositionResponse
+
+func (response RaybotPositionReport200JSONResponse) VisitRaybotPositionReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotPositionReport400JSONResponse ErrorResponse
+
+func (response RaybotPositionReport400JSONResponse) VisitRaybotPositionReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotPositionReport404JSONResponse ErrorResponse
+
+func (response RaybotPositionReport404JSONResponse) VisitRaybotPositionReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotPositionListHistoryRequestObject struct {
+	RaybotId string `json:"raybotId"`
+	Params   RaybotPositionListHistoryParams
+}
+
+type RaybotPositionListHistoryResponseObject interface {
+	VisitRaybotPositionListHistoryResponse(w http.ResponseWriter) error
+}
+
+type RaybotPositionListHistory200JSONResponse RaybotPositionsListResponse
+
+func (response RaybotPositionListHistory200JSONResponse) VisitRaybotPositionListHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RaybotPositionListHistory400JSONResponse ErrorResponse
+
+func (response RaybotPositionListHistory400JSONResponse) VisitRaybotPositionListHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutePlanRequestObject struct {
+	Params RoutePlanParams
+}
+
+type RoutePlanResponseObject interface {
+	VisitRoutePlanResponse(w http.ResponseWriter) error
+}
+
+type RoutePlan200JSONResponse RouteResponse
+
+func (response RoutePlan200JSONResponse) VisitRoutePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutePlan400JSONResponse ErrorResponse
+
+func (response RoutePlan400JSONResponse) VisitRoutePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoutePlan404JSONResponse ErrorResponse
+
+func (response RoutePlan404JSONResponse) VisitRoutePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepExecutionGetRequestObject struct {
+	StepExecutionId string `json:"stepExecutionId"`
+}
+
+type StepExecutionGetResponseObject interface {
+	VisitStepExecutionGetResponse(w http.ResponseWriter) error
+}
+
+type StepExecutionGet200JSONResponse StepExecutionResponse
+
+func (response StepExecutionGet200JSONResponse) VisitStepExecutionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepExecutionGet404JSONResponse ErrorResponse
+
+func (response StepExecutionGet404JSONResponse) VisitStepExecutionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackMapGetRequestObject struct {
+}
+
+type TrackMapGetResponseObject interface {
+	VisitTrackMapGetResponse(w http.ResponseWriter) error
+}
+
+type TrackMapGet200JSONResponse TrackMapResponse
+
+func (response TrackMapGet200JSONResponse) VisitTrackMapGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentListRequestObject struct {
+	Params TrackSegmentListParams
+}
+
+type TrackSegmentListResponseObject interface {
+	VisitTrackSegmentListResponse(w http.ResponseWriter) error
+}
+
+type TrackSegmentList200JSONResponse TrackSegmentsListResponse
+
+func (response TrackSegmentList200JSONResponse) VisitTrackSegmentListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentList400JSONResponse ErrorResponse
+
+func (response TrackSegmentList400JSONResponse) VisitTrackSegmentListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentCreateRequestObject struct {
+	Body *TrackSegmentCreateJSONRequestBody
+}
+
+type TrackSegmentCreateResponseObject interface {
+	VisitTrackSegmentCreateResponse(w http.ResponseWriter) error
+}
+
+type TrackSegmentCreate201JSONResponse TrackSegmentResponse
+
+func (response TrackSegmentCreate201JSONResponse) VisitTrackSegmentCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentCreate400JSONResponse ErrorResponse
+
+func (response TrackSegmentCreate400JSONResponse) VisitTrackSegmentCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentCreate409JSONResponse ErrorResponse
+
+func (response TrackSegmentCreate409JSONResponse) VisitTrackSegmentCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentDeleteRequestObject struct {
+	TrackSegmentId string `json:"trackSegmentId"`
+}
+
+type TrackSegmentDeleteResponseObject interface {
+	VisitTrackSegmentDeleteResponse(w http.ResponseWriter) error
+}
+
+type TrackSegmentDelete204Response struct {
+}
+
+func (response TrackSegmentDelete204Response) VisitTrackSegmentDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type TrackSegmentDelete404JSONResponse ErrorResponse
+
+func (response TrackSegmentDelete404JSONResponse) VisitTrackSegmentDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentGetRequestObject struct {
+	TrackSegmentId string `json:"trackSegmentId"`
+}
+
+type TrackSegmentGetResponseObject interface {
+	VisitTrackSegmentGetResponse(w http.ResponseWriter) error
+}
+
+type TrackSegmentGet200JSONResponse TrackSegmentResponse
+
+func (response TrackSegmentGet200JSONResponse) VisitTrackSegmentGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentGet404JSONResponse ErrorResponse
+
+func (response TrackSegmentGet404JSONResponse) VisitTrackSegmentGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentUpdateRequestObject struct {
+	TrackSegmentId string `json:"trackSegmentId"`
+	Body           *TrackSegmentUpdateJSONRequestBody
+}
+
+type TrackSegmentUpdateResponseObject interface {
+	VisitTrackSegmentUpdateResponse(w http.ResponseWriter) error
+}
+
+type TrackSegmentUpdate200JSONResponse TrackSegmentResponse
+
+func (response TrackSegmentUpdate200JSONResponse) VisitTrackSegmentUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentUpdate400JSONResponse ErrorResponse
+
+func (response TrackSegmentUpdate400JSONResponse) VisitTrackSegmentUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentUpdate404JSONResponse ErrorResponse
+
+func (response TrackSegmentUpdate404JSONResponse) VisitTrackSegmentUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrackSegmentUpdate409JSONResponse ErrorResponse
+
+func (response TrackSegmentUpdate409JSONResponse) VisitTrackSegmentUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionListRequestObject struct {
+	Params WebhookSubscriptionListParams
+}
+
+type WebhookSubscriptionListResponseObject interface {
+	VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionList200JSONResponse WebhookSubscriptionsListResponse
+
+func (response WebhookSubscriptionList200JSONResponse) VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionList400JSONResponse ErrorResponse
+
+func (response WebhookSubscriptionList400JSONResponse) VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionCreateRequestObject struct {
+	Body *WebhookSubscriptionCreateJSONRequestBody
+}
+
+type WebhookSubscriptionCreateResponseObject interface {
+	VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionCreate201JSONResponse WebhookSubscriptionResponse
+
+func (response WebhookSubscriptionCreate201JSONResponse) VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionCreate400JSONResponse ErrorResponse
+
+func (response WebhookSubscriptionCreate400JSONResponse) VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionDeleteRequestObject struct {
+	WebhookSubscriptionId string `json:"webhookSubscriptionId"`
+}
+
+type WebhookSubscriptionDeleteResponseObject interface {
+	VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionDelete204Response struct {
+}
+
+func (response WebhookSubscriptionDelete204Response) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type WebhookSubscriptionDelete404JSONResponse ErrorResponse
+
+func (response WebhookSubscriptionDelete404JSONResponse) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionGetRequestObject struct {
+	WebhookSubscriptionId string `json:"webhookSubscriptionId"`
+}
+
+type WebhookSubscriptionGetResponseObject interface {
+	VisitWebhookSubscriptionGetResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionGet200JSONResponse WebhookSubscriptionResponse
+
+func (response WebhookSubscriptionGet200JSONResponse) VisitWebhookSubscriptionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionGet404JSONResponse ErrorResponse
+
+func (response WebhookSubscriptionGet404JSONResponse) VisitWebhookSubscriptionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionUpdateRequestObject struct {
+	WebhookSubscriptionId string `json:"webhookSubscriptionId"`
+	Body                  *WebhookSubscriptionUpdateJSONRequestBody
+}
+
+type WebhookSubscriptionUpdateResponseObject interface {
+	VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionUpdate200JSONResponse WebhookSubscriptionResponse
+
+func (response WebhookSubscriptionUpdate200JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionUpdate400JSONResponse ErrorResponse
+
+func (response WebhookSubscriptionUpdate400JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionUpdate404JSONResponse ErrorResponse
+
+func (response WebhookSubscriptionUpdate404JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryListRequestObject struct {
+	WebhookSubscriptionId string `json:"webhookSubscriptionId"`
+	Params                WebhookDeliveryListParams
+}
+
+type WebhookDeliveryListResponseObject interface {
+	VisitWebhookDeliveryListResponse(w http.ResponseWriter) error
+}
+
+type WebhookDeliveryList200JSONResponse WebhookDeliveriesListResponse
+
+func (response WebhookDeliveryList200JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryList400JSONResponse ErrorResponse
+
+func (response WebhookDeliveryList400JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryList404JSONResponse ErrorResponse
+
+func (response WebhookDeliveryList404JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryRedeliverRequestObject struct {
+	WebhookSubscriptionId string `json:"webhookSubscriptionId"`
+	WebhookDeliveryId     string `json:"webhookDeliveryId"`
+}
+
+type WebhookDeliveryRedeliverResponseObject interface {
+	VisitWebhookDeliveryRedeliverResponse(w http.ResponseWriter) error
+}
+
+type WebhookDeliveryRedeliver200JSONResponse WebhookDeliveryResponse
+
+func (response WebhookDeliveryRedeliver200JSONResponse) VisitWebhookDeliveryRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryRedeliver404JSONResponse ErrorResponse
+
+func (response WebhookDeliveryRedeliver404JSONResponse) VisitWebhookDeliveryRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionListAllRequestObject struct {
+	Params WorkflowExecutionListAllParams
+}
+
+type WorkflowExecutionListAllResponseObject interface {
+	VisitWorkflowExecutionListAllResponse(w http.ResponseWriter) error
+}
+
+type WorkflowExecutionListAll200JSONResponse WorkflowExecutionsListResponse
+
+func (response WorkflowExecutionListAll200JSONResponse) VisitWorkflowExecutionListAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionListAll400JSONResponse ErrorResponse
+
+func (response WorkflowExecutionListAll400JSONResponse) VisitWorkflowExecutionListAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionCountByStatusRequestObject struct {
+	Params WorkflowExecutionCountByStatusParams
+}
+
+type WorkflowExecutionCountByStatusResponseObject interface {
+	VisitWorkflowExecutionCountByStatusResponse(w http.ResponseWriter) error
+}
+
+type WorkflowExecutionCountByStatus200JSONResponse WorkflowExecutionStatusCountsResponse
+
+func (response WorkflowExecutionCountByStatus200JSONResponse) VisitWorkflowExecutionCountByStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionCountByStatus400JSONResponse ErrorResponse
+
+func (response WorkflowExecutionCountByStatus400JSONResponse) VisitWorkflowExecutionCountByStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionGetRequestObject struct {
+	WorkflowExecutionId string `json:"workflowExecutionId"`
+}
+
+type WorkflowExecutionGetResponseObject interface {
+	VisitWorkflowExecutionGetResponse(w http.ResponseWriter) error
+}
+
+type WorkflowExecutionGet200JSONResponse WorkflowExecutionResponse
+
+func (response WorkflowExecutionGet200JSONResponse) VisitWorkflowExecutionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionGet404JSONResponse ErrorResponse
+
+func (response WorkflowExecutionGet404JSONResponse) VisitWorkflowExecutionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionEventsRequestObject struct {
+	WorkflowExecutionId string `json:"workflowExecutionId"`
+	Params              WorkflowExecutionEventsParams
+}
+
+type WorkflowExecutionEventsResponseObject interface {
+	VisitWorkflowExecutionEventsResponse(w http.ResponseWriter) error
+}
+
+type WorkflowExecutionEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response WorkflowExecutionEvents200TexteventStreamResponse) VisitWorkflowExecutionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type WorkflowExecutionEvents400JSONResponse ErrorResponse
+
+func (response WorkflowExecutionEvents400JSONResponse) VisitWorkflowExecutionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionEvents404JSONResponse ErrorResponse
+
+func (response WorkflowExecutionEvents404JSONResponse) VisitWorkflowExecutionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepExecutionListByWorkflowExecutionIdRequestObject struct {
+	WorkflowExecutionId string `json:"workflowExecutionId"`
+	Params              StepExecutionListByWorkflowExecutionIdParams
+}
+
+type StepExecutionListByWorkflowExecutionIdResponseObject interface {
+	VisitStepExecutionListByWorkflowExecutionIdResponse(w http.ResponseWriter) error
+}
+
+type StepExecutionListByWorkflowExecutionId200JSONResponse StepExecutionsListResponse
+
+func (response StepExecutionListByWorkflowExecutionId200JSONResponse) VisitStepExecutionListByWorkflowExecutionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepExecutionListByWorkflowExecutionId400JSONResponse ErrorResponse
+
+func (response StepExecutionListByWorkflowExecutionId400JSONResponse) VisitStepExecutionListByWorkflowExecutionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowListRequestObject struct {
+	Params WorkflowListParams
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+type WorkflowListResponseObject interface {
+	VisitWorkflowListResponse(w http.ResponseWriter) error
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+type WorkflowList200JSONResponse WorkflowsListResponse
+
+func (response WorkflowList200JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+type WorkflowList400JSONResponse ErrorResponse
+
+func (response WorkflowList400JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type WorkflowCreateRequestObject struct {
+	Body *WorkflowCreateJSONRequestBody
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+type WorkflowCreateResponseObject interface {
+	VisitWorkflowCreateResponse(w http.ResponseWriter) error
 }
 
-type RequiredParamError struct {
-	ParamName string
+type WorkflowCreate201JSONResponse WorkflowResponse
+
+func (response WorkflowCreate201JSONResponse) VisitWorkflowCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type WorkflowCreate400JSONResponse ErrorResponse
+
+func (response WorkflowCreate400JSONResponse) VisitWorkflowCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type WorkflowImportRequestObject struct {
+	JSONBody *WorkflowImportJSONRequestBody
+	Body     io.Reader
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type WorkflowImportResponseObject interface {
+	VisitWorkflowImportResponse(w http.ResponseWriter) error
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+type WorkflowImport201JSONResponse ImportWorkflowResponse
+
+func (response WorkflowImport201JSONResponse) VisitWorkflowImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+type WorkflowImport400JSONResponse ErrorResponse
+
+func (response WorkflowImport400JSONResponse) VisitWorkflowImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+type WorkflowDeleteRequestObject struct {
+	WorkflowId string `json:"workflowId"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+type WorkflowDeleteResponseObject interface {
+	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type WorkflowDelete204Response struct {
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type WorkflowDelete404JSONResponse ErrorResponse
+
+func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+}
+
+type WorkflowGetResponseObject interface {
+	VisitWorkflowGetResponse(w http.ResponseWriter) error
+}
+
+type WorkflowGet200JSONResponse WorkflowResponse
+
+func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGet404JSONResponse ErrorResponse
+
+func (response WorkflowGet404JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Body       *WorkflowUpdateJSONRequestBody
+}
+
+type WorkflowUpdateResponseObject interface {
+	VisitWorkflowUpdateResponse(w http.ResponseWriter) error
+}
+
+type WorkflowUpdate200JSONResponse WorkflowResponse
+
+func (response WorkflowUpdate200JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdate400JSONResponse ErrorResponse
+
+func (response WorkflowUpdate400JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDuplicateRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Body       *WorkflowDuplicateJSONRequestBody
+}
+
+type WorkflowDuplicateResponseObject interface {
+	VisitWorkflowDuplicateResponse(w http.ResponseWriter) error
+}
+
+type WorkflowDuplicate201JSONResponse WorkflowResponse
+
+func (response WorkflowDuplicate201JSONResponse) VisitWorkflowDuplicateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDuplicate400JSONResponse ErrorResponse
+
+func (response WorkflowDuplicate400JSONResponse) VisitWorkflowDuplicateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDuplicate404JSONResponse ErrorResponse
+
+func (response WorkflowDuplicate404JSONResponse) VisitWorkflowDuplicateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionListRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Params     WorkflowExecutionListParams
+}
+
+type WorkflowExecutionListResponseObject interface {
+	VisitWorkflowExecutionListResponse(w http.ResponseWriter) error
+}
+
+type WorkflowExecutionList200JSONResponse WorkflowExecutionsListResponse
+
+func (response WorkflowExecutionList200JSONResponse) VisitWorkflowExecutionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExecutionList400JSONResponse ErrorResponse
+
+func (response WorkflowExecutionList400JSONResponse) VisitWorkflowExecutionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExportRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Params     WorkflowExportParams
+}
+
+type WorkflowExportResponseObject interface {
+	VisitWorkflowExportResponse(w http.ResponseWriter) error
+}
+
+type WorkflowExport200JSONResponse WorkflowDocument
+
+func (response WorkflowExport200JSONResponse) VisitWorkflowExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowExport200ApplicationyamlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response WorkflowExport200ApplicationyamlResponse) VisitWorkflowExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type WorkflowExport404JSONResponse ErrorResponse
+
+func (response WorkflowExport404JSONResponse) VisitWorkflowExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowInstantiateRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Body       *WorkflowInstantiateJSONRequestBody
+}
+
+type WorkflowInstantiateResponseObject interface {
+	VisitWorkflowInstantiateResponse(w http.ResponseWriter) error
+}
+
+type WorkflowInstantiate201JSONResponse WorkflowResponse
+
+func (response WorkflowInstantiate201JSONResponse) VisitWorkflowInstantiateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowInstantiate400JSONResponse ErrorResponse
+
+func (response WorkflowInstantiate400JSONResponse) VisitWorkflowInstantiateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowInstantiate404JSONResponse ErrorResponse
+
+func (response WorkflowInstantiate404JSONResponse) VisitWorkflowInstantiateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type WorkflowPublishRequestObject struct {
+	WorkflowId string `json:"workflowId"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type WorkflowPublishResponseObject interface {
+	VisitWorkflowPublishResponse(w http.ResponseWriter) error
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type WorkflowPublish201JSONResponse WorkflowVersionResponse
+
+func (response WorkflowPublish201JSONResponse) VisitWorkflowPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type WorkflowPublish400JSONResponse ErrorResponse
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+func (response WorkflowPublish400JSONResponse) VisitWorkflowPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/qr-locations", wrapper.QrLocationList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/qr-locations", wrapper.QrLocationCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/qr-locations/{qrLocationId}", wrapper.QrLocationDelete)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/qr-locations/{qrLocationId}", wrapper.QrLocationGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/qr-locations/{qrLocationId}", wrapper.QrLocationUpdate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/raybot-commands/{raybotCommandId}", wrapper.RaybotCommandGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/raybots", wrapper.RaybotList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/raybots", wrapper.RaybotCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/raybots/{raybotId}", wrapper.RaybotDelete)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/raybots/{raybotId}", wrapper.RaybotGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/raybots/{raybotId}/commands", wrapper.RaybotCommandList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/raybots/{raybotId}/commands", wrapper.RaybotCommandCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/step-executions/{stepExecutionId}", wrapper.StepExecutionGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/workflow-executions/{workflowExecutionId}", wrapper.WorkflowExecutionGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/workflow-executions/{workflowExecutionId}/steps", wrapper.StepExecutionListByWorkflowExecutionId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/workflows", wrapper.WorkflowList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/workflows", wrapper.WorkflowCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/workflows/{workflowId}", wrapper.WorkflowDelete)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/workflows/{workflowId}", wrapper.WorkflowGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/workflows/{workflowId}", wrapper.WorkflowUpdate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/workflows/{workflowId}/executions", wrapper.WorkflowExecutionList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/workflows/{workflowId}/run", wrapper.WorkflowRun)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return r
+type WorkflowPublish404JSONResponse ErrorResponse
+
+func (response WorkflowPublish404JSONResponse) VisitWorkflowPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationListRequestObject struct {
-	Params QrLocationListParams
+type WorkflowRunRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Body       *WorkflowRunJSONRequestBody
 }
 
-type QrLocationListResponseObject interface {
-	VisitQrLocationListResponse(w http.ResponseWriter) error
+type WorkflowRunResponseObject interface {
+	VisitWorkflowRunResponse(w http.ResponseWriter) error
 }
 
-type QrLocationList200JSONResponse QRLocationsListResponse
+type WorkflowRun201JSONResponse RunWorkflowResponse
 
-func (response QrLocationList200JSONResponse) VisitQrLocationListResponse(w http.ResponseWriter) error {
+func (response WorkflowRun201JSONResponse) VisitWorkflowRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationList400JSONResponse ErrorResponse
+type WorkflowRun400JSONResponse ErrorResponse
 
-func (response QrLocationList400JSONResponse) VisitQrLocationListResponse(w http.ResponseWriter) error {
+func (response WorkflowRun400JSONResponse) VisitWorkflowRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationCreateRequestObject struct {
-	Body *QrLocationCreateJSONRequestBody
+type WorkflowVersionListRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Params     WorkflowVersionListParams
 }
 
-type QrLocationCreateResponseObject interface {
-	VisitQrLocationCreateResponse(w http.ResponseWriter) error
+type WorkflowVersionListResponseObject interface {
+	VisitWorkflowVersionListResponse(w http.ResponseWriter) error
 }
 
-type QrLocationCreate201JSONResponse QRLocationResponse
+type WorkflowVersionList200JSONResponse WorkflowVersionsListResponse
 
-func (response QrLocationCreate201JSONResponse) VisitQrLocationCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionList200JSONResponse) VisitWorkflowVersionListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationCreate400JSONResponse ErrorResponse
+type WorkflowVersionList400JSONResponse ErrorResponse
 
-func (response QrLocationCreate400JSONResponse) VisitQrLocationCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionList400JSONResponse) VisitWorkflowVersionListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationDeleteRequestObject struct {
-	QrLocationId string `json:"qrLocationId"`
+type WorkflowVersionGetRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Version    int32  `json:"version"`
 }
 
-type QrLocationDeleteResponseObject interface {
-	VisitQrLocationDeleteResponse(w http.ResponseWriter) error
+type WorkflowVersionGetResponseObject interface {
+	VisitWorkflowVersionGetResponse(w http.ResponseWriter) error
 }
 
-type QrLocationDelete204Response struct {
-}
+type WorkflowVersionGet200JSONResponse WorkflowVersionResponse
 
-func (response QrLocationDelete204Response) VisitQrLocationDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response WorkflowVersionGet200JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationDelete404JSONResponse ErrorResponse
+type WorkflowVersionGet404JSONResponse ErrorResponse
 
-func (response QrLocationDelete404JSONResponse) VisitQrLocationDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGet404JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationGetRequestObject struct {
-	QrLocationId string `json:"qrLocationId"`
+type WorkflowVersionDiffRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Version    int32  `json:"version"`
+	Params     WorkflowVersionDiffParams
 }
 
-type QrLocationGetResponseObject interface {
-	VisitQrLocationGetResponse(w http.ResponseWriter) error
+type WorkflowVersionDiffResponseObject interface {
+	VisitWorkflowVersionDiffResponse(w http.ResponseWriter) error
 }
 
-type QrLocationGet200JSONResponse QRLocationResponse
+type WorkflowVersionDiff200JSONResponse WorkflowVersionDiffResponse
 
-func (response QrLocationGet200JSONResponse) VisitQrLocationGetResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionDiff200JSONResponse) VisitWorkflowVersionDiffResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationGet404JSONResponse ErrorResponse
+type WorkflowVersionDiff404JSONResponse ErrorResponse
 
-func (response QrLocationGet404JSONResponse) VisitQrLocationGetResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionDiff404JSONResponse) VisitWorkflowVersionDiffResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationUpdateRequestObject struct {
-	QrLocationId string `json:"qrLocationId"`
-	Body         *QrLocationUpdateJSONRequestBody
+type WorkflowVersionRollbackRequestObject struct {
+	WorkflowId string `json:"workflowId"`
+	Version    int32  `json:"version"`
 }
 
-type QrLocationUpdateResponseObject interface {
-	VisitQrLocationUpdateResponse(w http.ResponseWriter) error
+type WorkflowVersionRollbackResponseObject interface {
+	VisitWorkflowVersionRollbackResponse(w http.ResponseWriter) error
 }
 
-type QrLocationUpdate200JSONResponse QRLocationResponse
+type WorkflowVersionRollback200JSONResponse WorkflowResponse
 
-func (response QrLocationUpdate200JSONResponse) VisitQrLocationUpdateResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionRollback200JSONResponse) VisitWorkflowVersionRollbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationUpdate400JSONResponse ErrorResponse
+type WorkflowVersionRollback404JSONResponse ErrorResponse
 
-func (response QrLocationUpdate400JSONResponse) VisitQrLocationUpdateResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionRollback404JSONResponse) VisitWorkflowVersionRollbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QrLocationUpdate404JSONResponse ErrorResponse
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Purge dead letter messages
+	// (DELETE /dead-letter-messages)
+	DeadLetterMessagePurge(ctx context.Context, request DeadLetterMessagePurgeRequestObject) (DeadLetterMessagePurgeResponseObject, error)
+	// List dead letter messages
+	// (GET /dead-letter-messages)
+	DeadLetterMessageList(ctx context.Context, request DeadLetterMessageListRequestObject) (DeadLetterMessageListResponseObject, error)
+	// Delete dead letter message by id
+	// (DELETE /dead-letter-messages/{deadLetterMessageId})
+	DeadLetterMessageDelete(ctx context.Context, request DeadLetterMessageDeleteRequestObject) (DeadLetterMessageDeleteResponseObject, error)
+	// Get dead letter message by id
+	// (GET /dead-letter-messages/{deadLetterMessageId})
+	DeadLetterMessageGet(ctx context.Context, request DeadLetterMessageGetRequestObject) (DeadLetterMessageGetResponseObject, error)
+	// Replay dead letter message
+	// (POST /dead-letter-messages/{deadLetterMessageId}/replay)
+	DeadLetterMessageReplay(ctx context.Context, request DeadLetterMessageReplayRequestObject) (DeadLetterMessageReplayResponseObject, error)
+	// List QR locations
+	// (GET /qr-locations)
+	QrLocationList(ctx context.Context, request QrLocationListRequestObject) (QrLocationListResponseObject, error)
+	// Create QR location
+	// (POST /qr-locations)
+	QrLocationCreate(ctx context.Context, request QrLocationCreateRequestObject) (QrLocationCreateResponseObject, error)
+	// Export QR locations
+	// (GET /qr-locations/export)
+	QrLocationExport(ctx context.Context, request QrLocationExportRequestObject) (QrLocationExportResponseObject, error)
+	// Import QR locations
+	// (POST /qr-locations/import)
+	QrLocationImport(ctx context.Context, request QrLocationImportRequestObject) (QrLocationImportResponseObject, error)
+	// Get QR location labels as PDF
+	// (POST /qr-locations/labels.pdf)
+	QrLocationLabelsPdf(ctx context.Context, request QrLocationLabelsPdfRequestObject) (QrLocationLabelsPdfResponseObject, error)
+	// Delete QR location by id
+	// (DELETE /qr-locations/{qrLocationId})
+	QrLocationDelete(ctx context.Context, request QrLocationDeleteRequestObject) (QrLocationDeleteResponseObject, error)
+	// Get QR location by id
+	// (GET /qr-locations/{qrLocationId})
+	QrLocationGet(ctx context.Context, request QrLocationGetRequestObject) (QrLocationGetResponseObject, error)
+	// Update QR location by id
+	// (PUT /qr-locations/{qrLocationId})
+	QrLocationUpdate(ctx context.Context, request QrLocationUpdateRequestObject) (QrLocationUpdateResponseObject, error)
+	// Get QR location label as PNG
+	// (GET /qr-locations/{qrLocationId}/label.png)
+	QrLocationLabelPng(ctx context.Context, request QrLocationLabelPngRequestObject) (QrLocationLabelPngResponseObject, error)
+	// Get QR location label as SVG
+	// (GET /qr-locations/{qrLocationId}/label.svg)
+	QrLocationLabelSvg(ctx context.Context, request QrLocationLabelSvgRequestObject) (QrLocationLabelSvgResponseObject, error)
+	// List QR location occupants
+	// (GET /qr-locations/{qrLocationId}/occupants)
+	RaybotPositionListOccupants(ctx context.Context, request RaybotPositionListOccupantsRequestObject) (RaybotPositionListOccupantsResponseObject, error)
+	// Get raybot command by id
+	// (GET /raybot-commands/{raybotCommandId})
+	RaybotCommandGet(ctx context.Context, request RaybotCommandGetRequestObject) (RaybotCommandGetResponseObject, error)
+	// List raybots
+	// (GET /raybots)
+	RaybotList(ctx context.Context, request RaybotListRequestObject) (RaybotListResponseObject, error)
+	// Create raybot
+	// (POST /raybots)
+	RaybotCreate(ctx context.Context, request RaybotCreateRequestObject) (RaybotCreateResponseObject, error)
+	// Delete raybot by id
+	// (DELETE /raybots/{raybotId})
+	RaybotDelete(ctx context.Context, request RaybotDeleteRequestObject) (RaybotDeleteResponseObject, error)
+	// Get raybot by id
+	// (GET /raybots/{raybotId})
+	RaybotGet(ctx context.Context, request RaybotGetRequestObject) (RaybotGetResponseObject, error)
+	// List raybot commands
+	// (GET /raybots/{raybotId}/commands)
+	RaybotCommandList(ctx context.Context, request RaybotCommandListRequestObject) (RaybotCommandListResponseObject, error)
+	// Create raybot command
+	// (POST /raybots/{raybotId}/commands)
+	RaybotCommandCreate(ctx context.Context, request RaybotCommandCreateRequestObject) (RaybotCommandCreateResponseObject, error)
+	// Get raybot position
+	// (GET /raybots/{raybotId}/position)
+	RaybotPositionGet(ctx context.Context, request RaybotPositionGetRequestObject) (RaybotPositionGetResponseObject, error)
+	// Report raybot position
+	// (PUT /raybots/{raybotId}/position)
+	RaybotPositionReport(ctx context.Context, request RaybotPositionReportRequestObject) (RaybotPositionReportResponseObject, error)
+	// List raybot position history
+	// (GET /raybots/{raybotId}/position/history)
+	RaybotPositionListHistory(ctx context.Context, request RaybotPositionListHistoryRequestObject) (RaybotPositionListHistoryResponseObject, error)
+	// Plan route
+	// (GET /routes)
+	RoutePlan(ctx context.Context, request RoutePlanRequestObject) (RoutePlanResponseObject, error)
+	// Get step by id
+	// (GET /step-executions/{stepExecutionId})
+	StepExecutionGet(ctx context.Context, request StepExecutionGetRequestObject) (StepExecutionGetResponseObject, error)
+	// Get track map
+	// (GET /track-map)
+	TrackMapGet(ctx context.Context, request TrackMapGetRequestObject) (TrackMapGetResponseObject, error)
+	// List track segments
+	// (GET /track-segments)
+	TrackSegmentList(ctx context.Context, request TrackSegmentListRequestObject) (TrackSegmentListResponseObject, error)
+	// Create track segment
+	// (POST /track-segments)
+	TrackSegmentCreate(ctx context.Context, request TrackSegmentCreateRequestObject) (TrackSegmentCreateResponseObject, error)
+	// Delete track segment by id
+	// (DELETE /track-segments/{trackSegmentId})
+	TrackSegmentDelete(ctx context.Context, request TrackSegmentDeleteRequestObject) (TrackSegmentDeleteResponseObject, error)
+	// Get track segment by id
+	// (GET /track-segments/{trackSegmentId})
+	TrackSegmentGet(ctx context.Context, request TrackSegmentGetRequestObject) (TrackSegmentGetResponseObject, error)
+	// Update track segment by id
+	// (PUT /track-segments/{trackSegmentId})
+	TrackSegmentUpdate(ctx context.Context, request TrackSegmentUpdateRequestObject) (TrackSegmentUpdateResponseObject, error)
+	// List webhook subscriptions
+	// (GET /webhook-subscriptions)
+	WebhookSubscriptionList(ctx context.Context, request WebhookSubscriptionListRequestObject) (WebhookSubscriptionListResponseObject, error)
+	// Create webhook subscription
+	// (POST /webhook-subscriptions)
+	WebhookSubscriptionCreate(ctx context.Context, request WebhookSubscriptionCreateRequestObject) (WebhookSubscriptionCreateResponseObject, error)
+	// Delete webhook subscription by id
+	// (DELETE /webhook-subscriptions/{webhookSubscriptionId})
+	WebhookSubscriptionDelete(ctx context.Context, request WebhookSubscriptionDeleteRequestObject) (WebhookSubscriptionDeleteResponseObject, error)
+	// Get webhook subscription by id
+	// (GET /webhook-subscriptions/{webhookSubscriptionId})
+	WebhookSubscriptionGet(ctx context.Context, request WebhookSubscriptionGetRequestObject) (WebhookSubscriptionGetResponseObject, error)
+	// Update webhook subscription by id
+	// (PUT /webhook-subscriptions/{webhookSubscriptionId})
+	WebhookSubscriptionUpdate(ctx context.Context, request WebhookSubscriptionUpdateRequestObject) (WebhookSubscriptionUpdateResponseObject, error)
+	// List webhook deliveries
+	// (GET /webhook-subscriptions/{webhookSubscriptionId}/deliveries)
+	WebhookDeliveryList(ctx context.Context, request WebhookDeliveryListRequestObject) (WebhookDeliveryListResponseObject, error)
+	// Redeliver webhook delivery
+	// (POST /webhook-subscriptions/{webhookSubscriptionId}/deliveries/{webhookDeliveryId}/redeliver)
+	WebhookDeliveryRedeliver(ctx context.Context, request WebhookDeliveryRedeliverRequestObject) (WebhookDeliveryRedeliverResponseObject, error)
+	// List workflow executions
+	// (GET /workflow-executions)
+	WorkflowExecutionListAll(ctx context.Context, request WorkflowExecutionListAllRequestObject) (WorkflowExecutionListAllResponseObject, error)
+	// Count workflow executions by status
+	// (GET /workflow-executions/status-counts)
+	WorkflowExecutionCountByStatus(ctx context.Context, request WorkflowExecutionCountByStatusRequestObject) (WorkflowExecutionCountByStatusResponseObject, error)
+	// Get workflow execution by id
+	// (GET /workflow-executions/{workflowExecutionId})
+	WorkflowExecutionGet(ctx context.Context, request WorkflowExecutionGetRequestObject) (WorkflowExecutionGetResponseObject, error)
+	// Stream workflow execution events
+	// (GET /workflow-executions/{workflowExecutionId}/events)
+	WorkflowExecutionEvents(ctx context.Context, request WorkflowExecutionEventsRequestObject) (WorkflowExecutionEventsResponseObject, error)
+	// List steps by workflow execution id
+	// (GET /workflow-executions/{workflowExecutionId}/steps)
+	StepExecutionListByWorkflowExecutionId(ctx context.Context, request StepExecutionListByWorkflowExecutionIdRequestObject) (StepExecutionListByWorkflowExecutionIdResponseObject, error)
+	// List  workflows
+	// (GET /workflows)
+	WorkflowList(ctx context.Context, request WorkflowListRequestObject) (WorkflowListResponseObject, error)
+	// Create workflow
+	// (POST /workflows)
+	WorkflowCreate(ctx context.Context, request WorkflowCreateRequestObject) (WorkflowCreateResponseObject, error)
+	// Import workflow
+	// (POST /workflows/import)
+	WorkflowImport(ctx context.Context, request WorkflowImportRequestObject) (WorkflowImportResponseObject, error)
+	// Delete workflow by id
+	// (DELETE /workflows/{workflowId})
+	WorkflowDelete(ctx context.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
+	// Get workflow by id
+	// (GET /workflows/{workflowId})
+	WorkflowGet(ctx context.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
+	// Update workflow by id
+	// (PUT /workflows/{workflowId})
+	WorkflowUpdate(ctx context.Context, request WorkflowUpdateRequestObject) (WorkflowUpdateResponseObject, error)
+	// Duplicate workflow by id
+	// (POST /workflows/{workflowId}/duplicate)
+	WorkflowDuplicate(ctx context.Context, request WorkflowDuplicateRequestObject) (WorkflowDuplicateResponseObject, error)
+	// List workflow executions by workflow id
+	// (GET /workflows/{workflowId}/executions)
+	WorkflowExecutionList(ctx context.Context, request WorkflowExecutionListRequestObject) (WorkflowExecutionListResponseObject, error)
+	// Export workflow by id
+	// (GET /workflows/{workflowId}/export)
+	WorkflowExport(ctx context.Context, request WorkflowExportRequestObject) (WorkflowExportResponseObject, error)
+	// Instantiate workflow template by id
+	// (POST /workflows/{workflowId}/instantiate)
+	WorkflowInstantiate(ctx context.Context, request WorkflowInstantiateRequestObject) (WorkflowInstantiateResponseObject, error)
+	// Publish workflow by id
+	// (POST /workflows/{workflowId}/publish)
+	WorkflowPublish(ctx context.Context, request WorkflowPublishRequestObject) (WorkflowPublishResponseObject, error)
+	// Run workflow by id
+	// (POST /workflows/{workflowId}/run)
+	WorkflowRun(ctx context.Context, request WorkflowRunRequestObject) (WorkflowRunResponseObject, error)
+	// List workflow versions by workflow id
+	// (GET /workflows/{workflowId}/versions)
+	WorkflowVersionList(ctx context.Context, request WorkflowVersionListRequestObject) (WorkflowVersionListResponseObject, error)
+	// Get workflow version
+	// (GET /workflows/{workflowId}/versions/{version})
+	WorkflowVersionGet(ctx context.Context, request WorkflowVersionGetRequestObject) (WorkflowVersionGetResponseObject, error)
+	// Diff workflow version
+	// (GET /workflows/{workflowId}/versions/{version}/diff)
+	WorkflowVersionDiff(ctx context.Context, request WorkflowVersionDiffRequestObject) (WorkflowVersionDiffResponseObject, error)
+	// Rollback workflow to a version
+	// (POST /workflows/{workflowId}/versions/{version}/rollback)
+	WorkflowVersionRollback(ctx context.Context, request WorkflowVersionRollbackRequestObject) (WorkflowVersionRollbackResponseObject, error)
+}
 
-func (response QrLocationUpdate404JSONResponse) VisitQrLocationUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-	return json.NewEncoder(w).Encode(response)
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type RaybotCommandGetRequestObject struct {
-	RaybotCommandId string `json:"raybotCommandId"`
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-type RaybotCommandGetResponseObject interface {
-	VisitRaybotCommandGetResponse(w http.ResponseWriter) error
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type RaybotCommandGet200JSONResponse RaybotCommandResponse
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
 
-func (response RaybotCommandGet200JSONResponse) VisitRaybotCommandGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeadLetterMessagePurge operation middleware
+func (sh *strictHandler) DeadLetterMessagePurge(w http.ResponseWriter, r *http.Request, params DeadLetterMessagePurgeParams) {
+	var request DeadLetterMessagePurgeRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type RaybotCommandGet404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeadLetterMessagePurge(ctx, request.(DeadLetterMessagePurgeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeadLetterMessagePurge")
+	}
 
-func (response RaybotCommandGet404JSONResponse) VisitRaybotCommandGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeadLetterMessagePurgeResponseObject); ok {
+		if err := validResponse.VisitDeadLetterMessagePurgeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotListRequestObject struct {
-	Params RaybotListParams
-}
+// DeadLetterMessageList operation middleware
+func (sh *strictHandler) DeadLetterMessageList(w http.ResponseWriter, r *http.Request, params DeadLetterMessageListParams) {
+	var request DeadLetterMessageListRequestObject
 
-type RaybotListResponseObject interface {
-	VisitRaybotListResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type RaybotList200JSONResponse RaybotsListResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeadLetterMessageList(ctx, request.(DeadLetterMessageListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeadLetterMessageList")
+	}
 
-func (response RaybotList200JSONResponse) VisitRaybotListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeadLetterMessageListResponseObject); ok {
+		if err := validResponse.VisitDeadLetterMessageListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotList400JSONResponse ErrorResponse
+// DeadLetterMessageDelete operation middleware
+func (sh *strictHandler) DeadLetterMessageDelete(w http.ResponseWriter, r *http.Request, deadLetterMessageId string) {
+	var request DeadLetterMessageDeleteRequestObject
 
-func (response RaybotList400JSONResponse) VisitRaybotListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.DeadLetterMessageId = deadLetterMessageId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeadLetterMessageDelete(ctx, request.(DeadLetterMessageDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeadLetterMessageDelete")
+	}
 
-type RaybotCreateRequestObject struct {
-	Body *RaybotCreateJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RaybotCreateResponseObject interface {
-	VisitRaybotCreateResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeadLetterMessageDeleteResponseObject); ok {
+		if err := validResponse.VisitDeadLetterMessageDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotCreate201JSONResponse RaybotResponse
-
-func (response RaybotCreate201JSONResponse) VisitRaybotCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// DeadLetterMessageGet operation middleware
+func (sh *strictHandler) DeadLetterMessageGet(w http.ResponseWriter, r *http.Request, deadLetterMessageId string) {
+	var request DeadLetterMessageGetRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.DeadLetterMessageId = deadLetterMessageId
 
-type RaybotCreate400JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeadLetterMessageGet(ctx, request.(DeadLetterMessageGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeadLetterMessageGet")
+	}
 
-func (response RaybotCreate400JSONResponse) VisitRaybotCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeadLetterMessageGetResponseObject); ok {
+		if err := validResponse.VisitDeadLetterMessageGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotCreate409JSONResponse ErrorResponse
+// DeadLetterMessageReplay operation middleware
+func (sh *strictHandler) DeadLetterMessageReplay(w http.ResponseWriter, r *http.Request, deadLetterMessageId string) {
+	var request DeadLetterMessageReplayRequestObject
 
-func (response RaybotCreate409JSONResponse) VisitRaybotCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	request.DeadLetterMessageId = deadLetterMessageId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeadLetterMessageReplay(ctx, request.(DeadLetterMessageReplayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeadLetterMessageReplay")
+	}
 
-type RaybotDeleteRequestObject struct {
-	RaybotId string `json:"raybotId"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RaybotDeleteResponseObject interface {
-	VisitRaybotDeleteResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeadLetterMessageReplayResponseObject); ok {
+		if err := validResponse.VisitDeadLetterMessageReplayResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotDelete204Response struct {
-}
+// QrLocationList operation middleware
+func (sh *strictHandler) QrLocationList(w http.ResponseWriter, r *http.Request, params QrLocationListParams) {
+	var request QrLocationListRequestObject
 
-func (response RaybotDelete204Response) VisitRaybotDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	request.Params = params
 
-type RaybotDelete404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationList(ctx, request.(QrLocationListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationList")
+	}
 
-func (response RaybotDelete404JSONResponse) VisitRaybotDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationListResponseObject); ok {
+		if err := validResponse.VisitQrLocationListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotGetRequestObject struct {
-	RaybotId string `json:"raybotId"`
-}
+// QrLocationCreate operation middleware
+func (sh *strictHandler) QrLocationCreate(w http.ResponseWriter, r *http.Request) {
+	var request QrLocationCreateRequestObject
 
-type RaybotGetResponseObject interface {
-	VisitRaybotGetResponse(w http.ResponseWriter) error
-}
+	var body QrLocationCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type RaybotGet200JSONResponse RaybotResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationCreate(ctx, request.(QrLocationCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationCreate")
+	}
 
-func (response RaybotGet200JSONResponse) VisitRaybotGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationCreateResponseObject); ok {
+		if err := validResponse.VisitQrLocationCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotGet404JSONResponse ErrorResponse
+// QrLocationExport operation middleware
+func (sh *strictHandler) QrLocationExport(w http.ResponseWriter, r *http.Request, params QrLocationExportParams) {
+	var request QrLocationExportRequestObject
 
-func (response RaybotGet404JSONResponse) VisitRaybotGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationExport(ctx, request.(QrLocationExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationExport")
+	}
 
-type RaybotCommandListRequestObject struct {
-	RaybotId string `json:"raybotId"`
-	Params   RaybotCommandListParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RaybotCommandListResponseObject interface {
-	VisitRaybotCommandListResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationExportResponseObject); ok {
+		if err := validResponse.VisitQrLocationExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotCommandList200JSONResponse RaybotCommandsListResponse
+// QrLocationImport operation middleware
+func (sh *strictHandler) QrLocationImport(w http.ResponseWriter, r *http.Request, params QrLocationImportParams) {
+	var request QrLocationImportRequestObject
 
-func (response RaybotCommandList200JSONResponse) VisitRaybotCommandListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
 
-	return json.NewEncoder(w).Encode(response)
+		var body QrLocationImportJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "text/csv") {
+		request.Body = r.Body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationImport(ctx, request.(QrLocationImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationImport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationImportResponseObject); ok {
+		if err := validResponse.VisitQrLocationImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotCommandList400JSONResponse ErrorResponse
+// QrLocationLabelsPdf operation middleware
+func (sh *strictHandler) QrLocationLabelsPdf(w http.ResponseWriter, r *http.Request) {
+	var request QrLocationLabelsPdfRequestObject
 
-func (response RaybotCommandList400JSONResponse) VisitRaybotCommandListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var body QrLocationLabelsPdfJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationLabelsPdf(ctx, request.(QrLocationLabelsPdfRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationLabelsPdf")
+	}
 
-type RaybotCommandCreateRequestObject struct {
-	RaybotId string `json:"raybotId"`
-	Body     *RaybotCommandCreateJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RaybotCommandCreateResponseObject interface {
-	VisitRaybotCommandCreateResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationLabelsPdfResponseObject); ok {
+		if err := validResponse.VisitQrLocationLabelsPdfResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotCommandCreate201JSONResponse RaybotCommandResponse
+// QrLocationDelete operation middleware
+func (sh *strictHandler) QrLocationDelete(w http.ResponseWriter, r *http.Request, qrLocationId string) {
+	var request QrLocationDeleteRequestObject
 
-func (response RaybotCommandCreate201JSONResponse) VisitRaybotCommandCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.QrLocationId = qrLocationId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationDelete(ctx, request.(QrLocationDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationDeleteResponseObject); ok {
+		if err := validResponse.VisitQrLocationDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RaybotCommandCreate400JSONResponse ErrorResponse
+// QrLocationGet operation middleware
+func (sh *strictHandler) QrLocationGet(w http.ResponseWriter, r *http.Request, qrLocationId string) {
+	var request QrLocationGetRequestObject
 
-func (response RaybotCommandCreate400JSONResponse) VisitRaybotCommandCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.QrLocationId = qrLocationId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationGet(ctx, request.(QrLocationGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationGet")
+	}
 
-type StepExecutionGetRequestObject struct {
-	StepExecutionId string `json:"stepExecutionId"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type StepExecutionGetResponseObject interface {
-	VisitStepExecutionGetResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationGetResponseObject); ok {
+		if err := validResponse.VisitQrLocationGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StepExecutionGet200JSONResponse StepExecutionResponse
+// QrLocationUpdate operation middleware
+func (sh *strictHandler) QrLocationUpdate(w http.ResponseWriter, r *http.Request, qrLocationId string) {
+	var request QrLocationUpdateRequestObject
 
-func (response StepExecutionGet200JSONResponse) VisitStepExecutionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.QrLocationId = qrLocationId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body QrLocationUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type StepExecutionGet404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationUpdate(ctx, request.(QrLocationUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationUpdate")
+	}
 
-func (response StepExecutionGet404JSONResponse) VisitStepExecutionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationUpdateResponseObject); ok {
+		if err := validResponse.VisitQrLocationUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowExecutionGetRequestObject struct {
-	WorkflowExecutionId string `json:"workflowExecutionId"`
-}
+// QrLocationLabelPng operation middleware
+func (sh *strictHandler) QrLocationLabelPng(w http.ResponseWriter, r *http.Request, qrLocationId string, params QrLocationLabelPngParams) {
+	var request QrLocationLabelPngRequestObject
 
-type WorkflowExecutionGetResponseObject interface {
-	VisitWorkflowExecutionGetResponse(w http.ResponseWriter) error
-}
+	request.QrLocationId = qrLocationId
+	request.Params = params
 
-type WorkflowExecutionGet200JSONResponse WorkflowExecutionResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationLabelPng(ctx, request.(QrLocationLabelPngRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationLabelPng")
+	}
 
-func (response WorkflowExecutionGet200JSONResponse) VisitWorkflowExecutionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationLabelPngResponseObject); ok {
+		if err := validResponse.VisitQrLocationLabelPngResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowExecutionGet404JSONResponse ErrorResponse
+// QrLocationLabelSvg operation middleware
+func (sh *strictHandler) QrLocationLabelSvg(w http.ResponseWriter, r *http.Request, qrLocationId string, params QrLocationLabelSvgParams) {
+	var request QrLocationLabelSvgRequestObject
 
-func (response WorkflowExecutionGet404JSONResponse) VisitWorkflowExecutionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.QrLocationId = qrLocationId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QrLocationLabelSvg(ctx, request.(QrLocationLabelSvgRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QrLocationLabelSvg")
+	}
 
-type StepExecutionListByWorkflowExecutionIdRequestObject struct {
-	WorkflowExecutionId string `json:"workflowExecutionId"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type StepExecutionListByWorkflowExecutionIdResponseObject interface {
-	VisitStepExecutionListByWorkflowExecutionIdResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QrLocationLabelSvgResponseObject); ok {
+		if err := validResponse.VisitQrLocationLabelSvgResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StepExecutionListByWorkflowExecutionId200JSONResponse []StepExecutionResponse
+// RaybotPositionListOccupants operation middleware
+func (sh *strictHandler) RaybotPositionListOccupants(w http.ResponseWriter, r *http.Request, qrLocationId string) {
+	var request RaybotPositionListOccupantsRequestObject
 
-func (response StepExecutionListByWorkflowExecutionId200JSONResponse) VisitStepExecutionListByWorkflowExecutionIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	request.QrLocationId = qrLocationId
 
-type StepExecutionListByWorkflowExecutionId400JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotPositionListOccupants(ctx, request.(RaybotPositionListOccupantsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotPositionListOccupants")
+	}
 
-func (response StepExecutionListByWorkflowExecutionId400JSONResponse) VisitStepExecutionListByWorkflowExecutionIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotPositionListOccupantsResponseObject); ok {
+		if err := validResponse.VisitRaybotPositionListOccupantsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowListRequestObject struct {
-	Params WorkflowListParams
-}
+// RaybotCommandGet operation middleware
+func (sh *strictHandler) RaybotCommandGet(w http.ResponseWriter, r *http.Request, raybotCommandId string) {
+	var request RaybotCommandGetRequestObject
 
-type WorkflowListResponseObject interface {
-	VisitWorkflowListResponse(w http.ResponseWriter) error
-}
+	request.RaybotCommandId = raybotCommandId
 
-type WorkflowList200JSONResponse WorkflowsListResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotCommandGet(ctx, request.(RaybotCommandGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotCommandGet")
+	}
 
-func (response WorkflowList200JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotCommandGetResponseObject); ok {
+		if err := validResponse.VisitRaybotCommandGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowList400JSONResponse ErrorResponse
+// RaybotList operation middleware
+func (sh *strictHandler) RaybotList(w http.ResponseWriter, r *http.Request, params RaybotListParams) {
+	var request RaybotListRequestObject
 
-func (response WorkflowList400JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotList(ctx, request.(RaybotListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotListResponseObject); ok {
+		if err := validResponse.VisitRaybotListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowCreateRequestObject struct {
-	Body *WorkflowCreateJSONRequestBody
-}
+// RaybotCreate operation middleware
+func (sh *strictHandler) RaybotCreate(w http.ResponseWriter, r *http.Request) {
+	var request RaybotCreateRequestObject
 
-type WorkflowCreateResponseObject interface {
-	VisitWorkflowCreateResponse(w http.ResponseWriter) error
-}
+	var body RaybotCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type WorkflowCreate201JSONResponse WorkflowResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotCreate(ctx, request.(RaybotCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotCreate")
+	}
 
-func (response WorkflowCreate201JSONResponse) VisitWorkflowCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotCreateResponseObject); ok {
+		if err := validResponse.VisitRaybotCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowCreate400JSONResponse ErrorResponse
+// RaybotDelete operation middleware
+func (sh *strictHandler) RaybotDelete(w http.ResponseWriter, r *http.Request, raybotId string) {
+	var request RaybotDeleteRequestObject
 
-func (response WorkflowCreate400JSONResponse) VisitWorkflowCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.RaybotId = raybotId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotDelete(ctx, request.(RaybotDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotDelete")
+	}
 
-type WorkflowDeleteRequestObject struct {
-	WorkflowId string `json:"workflowId"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type WorkflowDeleteResponseObject interface {
-	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotDeleteResponseObject); ok {
+		if err := validResponse.VisitRaybotDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowDelete204Response struct {
-}
+// RaybotGet operation middleware
+func (sh *strictHandler) RaybotGet(w http.ResponseWriter, r *http.Request, raybotId string) {
+	var request RaybotGetRequestObject
 
-func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	request.RaybotId = raybotId
 
-type WorkflowDelete404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotGet(ctx, request.(RaybotGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotGet")
+	}
 
-func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotGetResponseObject); ok {
+		if err := validResponse.VisitRaybotGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowGetRequestObject struct {
-	WorkflowId string `json:"workflowId"`
-}
+// RaybotCommandList operation middleware
+func (sh *strictHandler) RaybotCommandList(w http.ResponseWriter, r *http.Request, raybotId string, params RaybotCommandListParams) {
+	var request RaybotCommandListRequestObject
 
-type WorkflowGetResponseObject interface {
-	VisitWorkflowGetResponse(w http.ResponseWriter) error
-}
+	request.RaybotId = raybotId
+	request.Params = params
 
-type WorkflowGet200JSONResponse WorkflowResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotCommandList(ctx, request.(RaybotCommandListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotCommandList")
+	}
 
-func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotCommandListResponseObject); ok {
+		if err := validResponse.VisitRaybotCommandListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowGet404JSONResponse ErrorResponse
+// RaybotCommandCreate operation middleware
+func (sh *strictHandler) RaybotCommandCreate(w http.ResponseWriter, r *http.Request, raybotId string) {
+	var request RaybotCommandCreateRequestObject
 
-func (response WorkflowGet404JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RaybotId = raybotId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body RaybotCommandCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type WorkflowUpdateRequestObject struct {
-	WorkflowId string `json:"workflowId"`
-	Body       *WorkflowUpdateJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotCommandCreate(ctx, request.(RaybotCommandCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotCommandCreate")
+	}
 
-type WorkflowUpdateResponseObject interface {
-	VisitWorkflowUpdateResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type WorkflowUpdate200JSONResponse WorkflowResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotCommandCreateResponseObject); ok {
+		if err := validResponse.VisitRaybotCommandCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response WorkflowUpdate200JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// RaybotPositionGet operation middleware
+func (sh *strictHandler) RaybotPositionGet(w http.ResponseWriter, r *http.Request, raybotId string) {
+	var request RaybotPositionGetRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RaybotId = raybotId
 
-type WorkflowUpdate400JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotPositionGet(ctx, request.(RaybotPositionGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotPositionGet")
+	}
 
-func (response WorkflowUpdate400JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotPositionGetResponseObject); ok {
+		if err := validResponse.VisitRaybotPositionGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowExecutionListRequestObject struct {
-	WorkflowId string `json:"workflowId"`
-	Params     WorkflowExecutionListParams
-}
+// RaybotPositionReport operation middleware
+func (sh *strictHandler) RaybotPositionReport(w http.ResponseWriter, r *http.Request, raybotId string) {
+	var request RaybotPositionReportRequestObject
 
-type WorkflowExecutionListResponseObject interface {
-	VisitWorkflowExecutionListResponse(w http.ResponseWriter) error
-}
+	request.RaybotId = raybotId
 
-type WorkflowExecutionList200JSONResponse WorkflowExecutionsListResponse
+	var body RaybotPositionReportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response WorkflowExecutionList200JSONResponse) VisitWorkflowExecutionListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotPositionReport(ctx, request.(RaybotPositionReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotPositionReport")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotPositionReportResponseObject); ok {
+		if err := validResponse.VisitRaybotPositionReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowExecutionList400JSONResponse ErrorResponse
+// RaybotPositionListHistory operation middleware
+func (sh *strictHandler) RaybotPositionListHistory(w http.ResponseWriter, r *http.Request, raybotId string, params RaybotPositionListHistoryParams) {
+	var request RaybotPositionListHistoryRequestObject
 
-func (response WorkflowExecutionList400JSONResponse) VisitWorkflowExecutionListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.RaybotId = raybotId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RaybotPositionListHistory(ctx, request.(RaybotPositionListHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RaybotPositionListHistory")
+	}
 
-type WorkflowRunRequestObject struct {
-	WorkflowId string `json:"workflowId"`
-	Body       *WorkflowRunJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type WorkflowRunResponseObject interface {
-	VisitWorkflowRunResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RaybotPositionListHistoryResponseObject); ok {
+		if err := validResponse.VisitRaybotPositionListHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowRun201JSONResponse RunWorkflowResponse
+// RoutePlan operation middleware
+func (sh *strictHandler) RoutePlan(w http.ResponseWriter, r *http.Request, params RoutePlanParams) {
+	var request RoutePlanRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RoutePlan(ctx, request.(RoutePlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RoutePlan")
+	}
 
-func (response WorkflowRun201JSONResponse) VisitWorkflowRunResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RoutePlanResponseObject); ok {
+		if err := validResponse.VisitRoutePlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WorkflowRun400JSONResponse ErrorResponse
-
-func (response WorkflowRun400JSONResponse) VisitWorkflowRunResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// StepExecutionGet operation middleware
+func (sh *strictHandler) StepExecutionGet(w http.ResponseWriter, r *http.Request, stepExecutionId string) {
+	var request StepExecutionGetRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.StepExecutionId = stepExecutionId
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List QR locations
-	// (GET /qr-locations)
-	QrLocationList(ctx context.Context, request QrLocationListRequestObject) (QrLocationListResponseObject, error)
-	// Create QR location
-	// (POST /qr-locations)
-	QrLocationCreate(ctx context.Context, request QrLocationCreateRequestObject) (QrLocationCreateResponseObject, error)
-	// Delete QR location by id
-	// (DELETE /qr-locations/{qrLocationId})
-	QrLocationDelete(ctx context.Context, request QrLocationDeleteRequestObject) (QrLocationDeleteResponseObject, error)
-	// Get QR location by id
-	// (GET /qr-locations/{qrLocationId})
-	QrLocationGet(ctx context.Context, request QrLocationGetRequestObject) (QrLocationGetResponseObject, error)
-	// Update QR location by id
-	// (PUT /qr-locations/{qrLocationId})
-	QrLocationUpdate(ctx context.Context, request QrLocationUpdateRequestObject) (QrLocationUpdateResponseObject, error)
-	// Get raybot command by id
-	// (GET /raybot-commands/{raybotCommandId})
-	RaybotCommandGet(ctx context.Context, request RaybotCommandGetRequestObject) (RaybotCommandGetResponseObject, error)
-	// List raybots
-	// (GET /raybots)
-	RaybotList(ctx context.Context, request RaybotListRequestObject) (RaybotListResponseObject, error)
-	// Create raybot
-	// (POST /raybots)
-	RaybotCreate(ctx context.Context, request RaybotCreateRequestObject) (RaybotCreateResponseObject, error)
-	// Delete raybot by id
-	// (DELETE /raybots/{raybotId})
-	RaybotDelete(ctx context.Context, request RaybotDeleteRequestObject) (RaybotDeleteResponseObject, error)
-	// Get raybot by id
-	// (GET /raybots/{raybotId})
-	RaybotGet(ctx context.Context, request RaybotGetRequestObject) (RaybotGetResponseObject, error)
-	// List raybot commands
-	// (GET /raybots/{raybotId}/commands)
-	RaybotCommandList(ctx context.Context, request RaybotCommandListRequestObject) (RaybotCommandListResponseObject, error)
-	// Create raybot command
-	// (POST /raybots/{raybotId}/commands)
-	RaybotCommandCreate(ctx context.Context, request RaybotCommandCreateRequestObject) (RaybotCommandCreateResponseObject, error)
-	// Get step by id
-	// (GET /step-executions/{stepExecutionId})
-	StepExecutionGet(ctx context.Context, request StepExecutionGetRequestObject) (StepExecutionGetResponseObject, error)
-	// Get workflow execution by id
-	// (GET /workflow-executions/{workflowExecutionId})
-	WorkflowExecutionGet(ctx context.Context, request WorkflowExecutionGetRequestObject) (WorkflowExecutionGetResponseObject, error)
-	// List steps by workflow execution id
-	// (GET /workflow-executions/{workflowExecutionId}/steps)
-	StepExecutionListByWorkflowExecutionId(ctx context.Context, request StepExecutionListByWorkflowExecutionIdRequestObject) (StepExecutionListByWorkflowExecutionIdResponseObject, error)
-	// List  workflows
-	// (GET /workflows)
-	WorkflowList(ctx context.Context, request WorkflowListRequestObject) (WorkflowListResponseObject, error)
-	// Create workflow
-	// (POST /workflows)
-	WorkflowCreate(ctx context.Context, request WorkflowCreateRequestObject) (WorkflowCreateResponseObject, error)
-	// Delete workflow by id
-	// (DELETE /workflows/{workflowId})
-	WorkflowDelete(ctx context.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
-	// Get workflow by id
-	// (GET /workflows/{workflowId})
-	WorkflowGet(ctx context.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
-	// Update workflow by id
-	// (PUT /workflows/{workflowId})
-	WorkflowUpdate(ctx context.Context, request WorkflowUpdateRequestObject) (WorkflowUpdateResponseObject, error)
-	// List workflow executions by workflow id
-	// (GET /workflows/{workflowId}/executions)
-	WorkflowExecutionList(ctx context.Context, request WorkflowExecutionListRequestObject) (WorkflowExecutionListResponseObject, error)
-	// Run workflow by id
-	// (POST /workflows/{workflowId}/run)
-	WorkflowRun(ctx context.Context, request WorkflowRunRequestObject) (WorkflowRunResponseObject, error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StepExecutionGet(ctx, request.(StepExecutionGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepExecutionGet")
+	}
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	response, err := handler(r.Context(), w, r, request)
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StepExecutionGetResponseObject); ok {
+		if err := validResponse.VisitStepExecutionGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+// TrackMapGet operation middleware
+func (sh *strictHandler) TrackMapGet(w http.ResponseWriter, r *http.Request) {
+	var request TrackMapGetRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TrackMapGet(ctx, request.(TrackMapGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TrackMapGet")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TrackMapGetResponseObject); ok {
+		if err := validResponse.VisitTrackMapGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// QrLocationList operation middleware
-func (sh *strictHandler) QrLocationList(w http.ResponseWriter, r *http.Request, params QrLocationListParams) {
-	var request QrLocationListRequestObject
+// TrackSegmentList operation middleware
+func (sh *strictHandler) TrackSegmentList(w http.ResponseWriter, r *http.Request, params TrackSegmentListParams) {
+	var request TrackSegmentListRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QrLocationList(ctx, request.(QrLocationListRequestObject))
+		return sh.ssi.TrackSegmentList(ctx, request.(TrackSegmentListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QrLocationList")
+		handler = middleware(handler, "TrackSegmentList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QrLocationListResponseObject); ok {
-		if err := validResponse.VisitQrLocationListResponse(w); err != nil {
+	} else if validResponse, ok := response.(TrackSegmentListResponseObject); ok {
+		if err := validResponse.VisitTrackSegmentListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2245,11 +7939,11 @@ func (sh *strictHandler) QrLocationList(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// QrLocationCreate operation middleware
-func (sh *strictHandler) QrLocationCreate(w http.ResponseWriter, r *http.Request) {
-	var request QrLocationCreateRequestObject
+// TrackSegmentCreate operation middleware
+func (sh *strictHandler) TrackSegmentCreate(w http.ResponseWriter, r *http.Request) {
+	var request TrackSegmentCreateRequestObject
 
-	var body QrLocationCreateJSONRequestBody
+	var body TrackSegmentCreateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2257,18 +7951,18 @@ func (sh *strictHandler) QrLocationCreate(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QrLocationCreate(ctx, request.(QrLocationCreateRequestObject))
+		return sh.ssi.TrackSegmentCreate(ctx, request.(TrackSegmentCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QrLocationCreate")
+		handler = middleware(handler, "TrackSegmentCreate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QrLocationCreateResponseObject); ok {
-		if err := validResponse.VisitQrLocationCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(TrackSegmentCreateResponseObject); ok {
+		if err := validResponse.VisitTrackSegmentCreateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2276,25 +7970,25 @@ func (sh *strictHandler) QrLocationCreate(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// QrLocationDelete operation middleware
-func (sh *strictHandler) QrLocationDelete(w http.ResponseWriter, r *http.Request, qrLocationId string) {
-	var request QrLocationDeleteRequestObject
+// TrackSegmentDelete operation middleware
+func (sh *strictHandler) TrackSegmentDelete(w http.ResponseWriter, r *http.Request, trackSegmentId string) {
+	var request TrackSegmentDeleteRequestObject
 
-	request.QrLocationId = qrLocationId
+	request.TrackSegmentId = trackSegmentId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QrLocationDelete(ctx, request.(QrLocationDeleteRequestObject))
+		return sh.ssi.TrackSegmentDelete(ctx, request.(TrackSegmentDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QrLocationDelete")
+		handler = middleware(handler, "TrackSegmentDelete")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QrLocationDeleteResponseObject); ok {
-		if err := validResponse.VisitQrLocationDeleteResponse(w); err != nil {
+	} else if validResponse, ok := response.(TrackSegmentDeleteResponseObject); ok {
+		if err := validResponse.VisitTrackSegmentDeleteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2302,25 +7996,25 @@ func (sh *strictHandler) QrLocationDelete(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// QrLocationGet operation middleware
-func (sh *strictHandler) QrLocationGet(w http.ResponseWriter, r *http.Request, qrLocationId string) {
-	var request QrLocationGetRequestObject
+// TrackSegmentGet operation middleware
+func (sh *strictHandler) TrackSegmentGet(w http.ResponseWriter, r *http.Request, trackSegmentId string) {
+	var request TrackSegmentGetRequestObject
 
-	request.QrLocationId = qrLocationId
+	request.TrackSegmentId = trackSegmentId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QrLocationGet(ctx, request.(QrLocationGetRequestObject))
+		return sh.ssi.TrackSegmentGet(ctx, request.(TrackSegmentGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QrLocationGet")
+		handler = middleware(handler, "TrackSegmentGet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QrLocationGetResponseObject); ok {
-		if err := validResponse.VisitQrLocationGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(TrackSegmentGetResponseObject); ok {
+		if err := validResponse.VisitTrackSegmentGetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2328,13 +8022,13 @@ func (sh *strictHandler) QrLocationGet(w http.ResponseWriter, r *http.Request, q
 	}
 }
 
-// QrLocationUpdate operation middleware
-func (sh *strictHandler) QrLocationUpdate(w http.ResponseWriter, r *http.Request, qrLocationId string) {
-	var request QrLocationUpdateRequestObject
+// TrackSegmentUpdate operation middleware
+func (sh *strictHandler) TrackSegmentUpdate(w http.ResponseWriter, r *http.Request, trackSegmentId string) {
+	var request TrackSegmentUpdateRequestObject
 
-	request.QrLocationId = qrLocationId
+	request.TrackSegmentId = trackSegmentId
 
-	var body QrLocationUpdateJSONRequestBody
+	var body TrackSegmentUpdateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2342,18 +8036,18 @@ func (sh *strictHandler) QrLocationUpdate(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QrLocationUpdate(ctx, request.(QrLocationUpdateRequestObject))
+		return sh.ssi.TrackSegmentUpdate(ctx, request.(TrackSegmentUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QrLocationUpdate")
+		handler = middleware(handler, "TrackSegmentUpdate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QrLocationUpdateResponseObject); ok {
-		if err := validResponse.VisitQrLocationUpdateResponse(w); err != nil {
+	} else if validResponse, ok := response.(TrackSegmentUpdateResponseObject); ok {
+		if err := validResponse.VisitTrackSegmentUpdateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2361,25 +8055,25 @@ func (sh *strictHandler) QrLocationUpdate(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// RaybotCommandGet operation middleware
-func (sh *strictHandler) RaybotCommandGet(w http.ResponseWriter, r *http.Request, raybotCommandId string) {
-	var request RaybotCommandGetRequestObject
+// WebhookSubscriptionList operation middleware
+func (sh *strictHandler) WebhookSubscriptionList(w http.ResponseWriter, r *http.Request, params WebhookSubscriptionListParams) {
+	var request WebhookSubscriptionListRequestObject
 
-	request.RaybotCommandId = raybotCommandId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RaybotCommandGet(ctx, request.(RaybotCommandGetRequestObject))
+		return sh.ssi.WebhookSubscriptionList(ctx, request.(WebhookSubscriptionListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RaybotCommandGet")
+		handler = middleware(handler, "WebhookSubscriptionList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RaybotCommandGetResponseObject); ok {
-		if err := validResponse.VisitRaybotCommandGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(WebhookSubscriptionListResponseObject); ok {
+		if err := validResponse.VisitWebhookSubscriptionListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2387,25 +8081,30 @@ func (sh *strictHandler) RaybotCommandGet(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// RaybotList operation middleware
-func (sh *strictHandler) RaybotList(w http.ResponseWriter, r *http.Request, params RaybotListParams) {
-	var request RaybotListRequestObject
+// WebhookSubscriptionCreate operation middleware
+func (sh *strictHandler) WebhookSubscriptionCreate(w http.ResponseWriter, r *http.Request) {
+	var request WebhookSubscriptionCreateRequestObject
 
-	request.Params = params
+	var body WebhookSubscriptionCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RaybotList(ctx, request.(RaybotListRequestObject))
+		return sh.ssi.WebhookSubscriptionCreate(ctx, request.(WebhookSubscriptionCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RaybotList")
+		handler = middleware(handler, "WebhookSubscriptionCreate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RaybotListResponseObject); ok {
-		if err := validResponse.VisitRaybotListResponse(w); err != nil {
+	} else if validResponse, ok := response.(WebhookSubscriptionCreateResponseObject); ok {
+		if err := validResponse.VisitWebhookSubscriptionCreateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2413,11 +8112,65 @@ func (sh *strictHandler) RaybotList(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// RaybotCreate operation middleware
-func (sh *strictHandler) RaybotCreate(w http.ResponseWriter, r *http.Request) {
-	var request RaybotCreateRequestObject
+// WebhookSubscriptionDelete operation middleware
+func (sh *strictHandler) WebhookSubscriptionDelete(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string) {
+	var request WebhookSubscriptionDeleteRequestObject
 
-	var body RaybotCreateJSONRequestBody
+	request.WebhookSubscriptionId = webhookSubscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookSubscriptionDelete(ctx, request.(WebhookSubscriptionDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookSubscriptionDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhookSubscriptionDeleteResponseObject); ok {
+		if err := validResponse.VisitWebhookSubscriptionDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WebhookSubscriptionGet operation middleware
+func (sh *strictHandler) WebhookSubscriptionGet(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string) {
+	var request WebhookSubscriptionGetRequestObject
+
+	request.WebhookSubscriptionId = webhookSubscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookSubscriptionGet(ctx, request.(WebhookSubscriptionGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookSubscriptionGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhookSubscriptionGetResponseObject); ok {
+		if err := validResponse.VisitWebhookSubscriptionGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WebhookSubscriptionUpdate operation middleware
+func (sh *strictHandler) WebhookSubscriptionUpdate(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string) {
+	var request WebhookSubscriptionUpdateRequestObject
+
+	request.WebhookSubscriptionId = webhookSubscriptionId
+
+	var body WebhookSubscriptionUpdateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2425,18 +8178,18 @@ func (sh *strictHandler) RaybotCreate(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RaybotCreate(ctx, request.(RaybotCreateRequestObject))
+		return sh.ssi.WebhookSubscriptionUpdate(ctx, request.(WebhookSubscriptionUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RaybotCreate")
+		handler = middleware(handler, "WebhookSubscriptionUpdate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RaybotCreateResponseObject); ok {
-		if err := validResponse.VisitRaybotCreateResponse(w); err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhookSubscriptionUpdateResponseObject); ok {
+		if err := validResponse.VisitWebhookSubscriptionUpdateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2444,25 +8197,26 @@ func (sh *strictHandler) RaybotCreate(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RaybotDelete operation middleware
-func (sh *strictHandler) RaybotDelete(w http.ResponseWriter, r *http.Request, raybotId string) {
-	var request RaybotDeleteRequestObject
+// WebhookDeliveryList operation middleware
+func (sh *strictHandler) WebhookDeliveryList(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string, params WebhookDeliveryListParams) {
+	var request WebhookDeliveryListRequestObject
 
-	request.RaybotId = raybotId
+	request.WebhookSubscriptionId = webhookSubscriptionId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RaybotDelete(ctx, request.(RaybotDeleteRequestObject))
+		return sh.ssi.WebhookDeliveryList(ctx, request.(WebhookDeliveryListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RaybotDelete")
+		handler = middleware(handler, "WebhookDeliveryList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RaybotDeleteResponseObject); ok {
-		if err := validResponse.VisitRaybotDeleteResponse(w); err != nil {
+	} else if validResponse, ok := response.(WebhookDeliveryListResponseObject); ok {
+		if err := validResponse.VisitWebhookDeliveryListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2470,25 +8224,26 @@ func (sh *strictHandler) RaybotDelete(w http.ResponseWriter, r *http.Request, ra
 	}
 }
 
-// RaybotGet operation middleware
-func (sh *strictHandler) RaybotGet(w http.ResponseWriter, r *http.Request, raybotId string) {
-	var request RaybotGetRequestObject
+// WebhookDeliveryRedeliver operation middleware
+func (sh *strictHandler) WebhookDeliveryRedeliver(w http.ResponseWriter, r *http.Request, webhookSubscriptionId string, webhookDeliveryId string) {
+	var request WebhookDeliveryRedeliverRequestObject
 
-	request.RaybotId = raybotId
+	request.WebhookSubscriptionId = webhookSubscriptionId
+	request.WebhookDeliveryId = webhookDeliveryId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RaybotGet(ctx, request.(RaybotGetRequestObject))
+		return sh.ssi.WebhookDeliveryRedeliver(ctx, request.(WebhookDeliveryRedeliverRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RaybotGet")
+		handler = middleware(handler, "WebhookDeliveryRedeliver")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RaybotGetResponseObject); ok {
-		if err := validResponse.VisitRaybotGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(WebhookDeliveryRedeliverResponseObject); ok {
+		if err := validResponse.VisitWebhookDeliveryRedeliverResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2496,26 +8251,25 @@ func (sh *strictHandler) RaybotGet(w http.ResponseWriter, r *http.Request, raybo
 	}
 }
 
-// RaybotCommandList operation middleware
-func (sh *strictHandler) RaybotCommandList(w http.ResponseWriter, r *http.Request, raybotId string, params RaybotCommandListParams) {
-	var request RaybotCommandListRequestObject
+// WorkflowExecutionListAll operation middleware
+func (sh *strictHandler) WorkflowExecutionListAll(w http.ResponseWriter, r *http.Request, params WorkflowExecutionListAllParams) {
+	var request WorkflowExecutionListAllRequestObject
 
-	request.RaybotId = raybotId
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RaybotCommandList(ctx, request.(RaybotCommandListRequestObject))
+		return sh.ssi.WorkflowExecutionListAll(ctx, request.(WorkflowExecutionListAllRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RaybotCommandList")
+		handler = middleware(handler, "WorkflowExecutionListAll")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RaybotCommandListResponseObject); ok {
-		if err := validResponse.VisitRaybotCommandListResponse(w); err != nil {
+	} else if validResponse, ok := response.(WorkflowExecutionListAllResponseObject); ok {
+		if err := validResponse.VisitWorkflowExecutionListAllResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2523,32 +8277,25 @@ func (sh *strictHandler) RaybotCommandList(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// RaybotCommandCreate operation middleware
-func (sh *strictHandler) RaybotCommandCreate(w http.ResponseWriter, r *http.Request, raybotId string) {
-	var request RaybotCommandCreateRequestObject
-
-	request.RaybotId = raybotId
+// WorkflowExecutionCountByStatus operation middleware
+func (sh *strictHandler) WorkflowExecutionCountByStatus(w http.ResponseWriter, r *http.Request, params WorkflowExecutionCountByStatusParams) {
+	var request WorkflowExecutionCountByStatusRequestObject
 
-	var body RaybotCommandCreateJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RaybotCommandCreate(ctx, request.(RaybotCommandCreateRequestObject))
+		return sh.ssi.WorkflowExecutionCountByStatus(ctx, request.(WorkflowExecutionCountByStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RaybotCommandCreate")
+		handler = middleware(handler, "WorkflowExecutionCountByStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RaybotCommandCreateResponseObject); ok {
-		if err := validResponse.VisitRaybotCommandCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(WorkflowExecutionCountByStatusResponseObject); ok {
+		if err := validResponse.VisitWorkflowExecutionCountByStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2556,25 +8303,25 @@ func (sh *strictHandler) RaybotCommandCreate(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// StepExecutionGet operation middleware
-func (sh *strictHandler) StepExecutionGet(w http.ResponseWriter, r *http.Request, stepExecutionId string) {
-	var request StepExecutionGetRequestObject
+// WorkflowExecutionGet operation middleware
+func (sh *strictHandler) WorkflowExecutionGet(w http.ResponseWriter, r *http.Request, workflowExecutionId string) {
+	var request WorkflowExecutionGetRequestObject
 
-	request.StepExecutionId = stepExecutionId
+	request.WorkflowExecutionId = workflowExecutionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.StepExecutionGet(ctx, request.(StepExecutionGetRequestObject))
+		return sh.ssi.WorkflowExecutionGet(ctx, request.(WorkflowExecutionGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepExecutionGet")
+		handler = middleware(handler, "WorkflowExecutionGet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(StepExecutionGetResponseObject); ok {
-		if err := validResponse.VisitStepExecutionGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(WorkflowExecutionGetResponseObject); ok {
+		if err := validResponse.VisitWorkflowExecutionGetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2582,25 +8329,26 @@ func (sh *strictHandler) StepExecutionGet(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// WorkflowExecutionGet operation middleware
-func (sh *strictHandler) WorkflowExecutionGet(w http.ResponseWriter, r *http.Request, workflowExecutionId string) {
-	var request WorkflowExecutionGetRequestObject
+// WorkflowExecutionEvents operation middleware
+func (sh *strictHandler) WorkflowExecutionEvents(w http.ResponseWriter, r *http.Request, workflowExecutionId string, params WorkflowExecutionEventsParams) {
+	var request WorkflowExecutionEventsRequestObject
 
 	request.WorkflowExecutionId = workflowExecutionId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowExecutionGet(ctx, request.(WorkflowExecutionGetRequestObject))
+		return sh.ssi.WorkflowExecutionEvents(ctx, request.(WorkflowExecutionEventsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowExecutionGet")
+		handler = middleware(handler, "WorkflowExecutionEvents")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(WorkflowExecutionGetResponseObject); ok {
-		if err := validResponse.VisitWorkflowExecutionGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(WorkflowExecutionEventsResponseObject); ok {
+		if err := validResponse.VisitWorkflowExecutionEventsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2609,10 +8357,11 @@ func (sh *strictHandler) WorkflowExecutionGet(w http.ResponseWriter, r *http.Req
 }
 
 // StepExecutionListByWorkflowExecutionId operation middleware
-func (sh *strictHandler) StepExecutionListByWorkflowExecutionId(w http.ResponseWriter, r *http.Request, workflowExecutionId string) {
+func (sh *strictHandler) StepExecutionListByWorkflowExecutionId(w http.ResponseWriter, r *http.Request, workflowExecutionId string, params StepExecutionListByWorkflowExecutionIdParams) {
 	var request StepExecutionListByWorkflowExecutionIdRequestObject
 
 	request.WorkflowExecutionId = workflowExecutionId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.StepExecutionListByWorkflowExecutionId(ctx, request.(StepExecutionListByWorkflowExecutionIdRequestObject))
@@ -2691,6 +8440,43 @@ func (sh *strictHandler) WorkflowCreate(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// WorkflowImport operation middleware
+func (sh *strictHandler) WorkflowImport(w http.ResponseWriter, r *http.Request) {
+	var request WorkflowImportRequestObject
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body WorkflowImportJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/yaml") {
+		request.Body = r.Body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowImport(ctx, request.(WorkflowImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowImport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WorkflowImportResponseObject); ok {
+		if err := validResponse.VisitWorkflowImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // WorkflowDelete operation middleware
 func (sh *strictHandler) WorkflowDelete(w http.ResponseWriter, r *http.Request, workflowId string) {
 	var request WorkflowDeleteRequestObject
@@ -2776,6 +8562,39 @@ func (sh *strictHandler) WorkflowUpdate(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// WorkflowDuplicate operation middleware
+func (sh *strictHandler) WorkflowDuplicate(w http.ResponseWriter, r *http.Request, workflowId string) {
+	var request WorkflowDuplicateRequestObject
+
+	request.WorkflowId = workflowId
+
+	var body WorkflowDuplicateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowDuplicate(ctx, request.(WorkflowDuplicateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowDuplicate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WorkflowDuplicateResponseObject); ok {
+		if err := validResponse.VisitWorkflowDuplicateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // WorkflowExecutionList operation middleware
 func (sh *strictHandler) WorkflowExecutionList(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowExecutionListParams) {
 	var request WorkflowExecutionListRequestObject
@@ -2803,6 +8622,92 @@ func (sh *strictHandler) WorkflowExecutionList(w http.ResponseWriter, r *http.Re
 	}
 }
 
+// WorkflowExport operation middleware
+func (sh *strictHandler) WorkflowExport(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowExportParams) {
+	var request WorkflowExportRequestObject
+
+	request.WorkflowId = workflowId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowExport(ctx, request.(WorkflowExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WorkflowExportResponseObject); ok {
+		if err := validResponse.VisitWorkflowExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WorkflowInstantiate operation middleware
+func (sh *strictHandler) WorkflowInstantiate(w http.ResponseWriter, r *http.Request, workflowId string) {
+	var request WorkflowInstantiateRequestObject
+
+	request.WorkflowId = workflowId
+
+	var body WorkflowInstantiateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowInstantiate(ctx, request.(WorkflowInstantiateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowInstantiate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WorkflowInstantiateResponseObject); ok {
+		if err := validResponse.VisitWorkflowInstantiateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WorkflowPublish operation middleware
+func (sh *strictHandler) WorkflowPublish(w http.ResponseWriter, r *http.Request, workflowId string) {
+	var request WorkflowPublishRequestObject
+
+	request.WorkflowId = workflowId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowPublish(ctx, request.(WorkflowPublishRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowPublish")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WorkflowPublishResponseObject); ok {
+		if err := validResponse.VisitWorkflowPublishResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // WorkflowRun operation middleware
 func (sh *strictHandler) WorkflowRun(w http.ResponseWriter, r *http.Request, workflowId string) {
 	var request WorkflowRunRequestObject
@@ -2836,74 +8741,360 @@ func (sh *strictHandler) WorkflowRun(w http.ResponseWriter, r *http.Request, wor
 	}
 }
 
+// WorkflowVersionList operation middleware
+func (sh *strictHandler) WorkflowVersionList(w http.ResponseWriter, r *http.Request, workflowId string, params WorkflowVersionListParams) {
+	var request WorkflowVersionListRequestObject
+
+	request.WorkflowId = workflowId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowVersionList(ctx, request.(WorkflowVersionListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowVersionList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WorkflowVersionListResponseObject); ok {
+		if err := validResponse.VisitWorkflowVersionListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WorkflowVersionGet operation middleware
+func (sh *strictHandler) WorkflowVersionGet(w http.ResponseWriter, r *http.Request, workflowId string, version int32) {
+	var request WorkflowVersionGetRequestObject
+
+	request.WorkflowId = workflowId
+	request.Version = version
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowVersionGet(ctx, request.(WorkflowVersionGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowVersionGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WorkflowVersionGetResponseObject); ok {
+		if err := validResponse.VisitWorkflowVersionGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WorkflowVersionDiff operation middleware
+func (sh *strictHandler) WorkflowVersionDiff(w http.ResponseWriter, r *http.Request, workflowId string, version int32, params WorkflowVersionDiffParams) {
+	var request WorkflowVersionDiffRequestObject
+
+	request.WorkflowId = workflowId
+	request.Version = version
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowVersionDiff(ctx, request.(WorkflowVersionDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowVersionDiff")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WorkflowVersionDiffResponseObject); ok {
+		if err := validResponse.VisitWorkflowVersionDiffResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WorkflowVersionRollback operation middleware
+func (sh *strictHandler) WorkflowVersionRollback(w http.ResponseWriter, r *http.Request, workflowId string, version int32) {
+	var request WorkflowVersionRollbackRequestObject
+
+	request.WorkflowId = workflowId
+	request.Version = version
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowVersionRollback(ctx, request.(WorkflowVersionRollbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowVersionRollback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WorkflowVersionRollbackResponseObject); ok {
+		if err := validResponse.VisitWorkflowVersionRollbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xd6VfbuBb/V3TU+fAez1mglGnzLQ1pJ6eQpCFMZ17hBREr4BnbCrbMMpz87+9o8ypv",
-	"FNLQ8onY1nJ1709308I9nBNnSVzsUh927uESecjBFHv8aYwuMPtrYn/uWUtqERd24PQSgyW6wMANnHPs",
-	"QQNa7PVVgL07aEAXORh2ICsBDejPL7GDRCMLFNgUdrYNuCCegyjswMByKTSgY7mWEzj8G71bsvqWS/EF",
-	"9uBqZXA6jqx/cmgRZACyABbFjg+W2AOy9zzCeGN64to1qVupZjjHesSlHrEPicmJxS6r9hUedofH3QNo",
-	"wC+jyacPB6Mv8DRsyqee5V5AA942Lkgj+XJlwJ6HEcWfJwdkjtigJ/gqwD7lwvLIEnvUwrxrB1NkIor0",
-	"TFJfGZvoJQa2bK4JDYhvkbO0OcF/4zvYgdfIDjDrXFJDzv/Cc5oi0UHLr4LMU+TesdKCwVoRIQcX9gzV",
-	"8MA2Yzi6PcDuBb2EnZ03b7gA1PO2AZeIUuyxpv/3FTX+6Tb+2268A6f/+QWmeboy4JXXk7LIUvV5AubE",
-	"LCFMvW2kCNtutysRNmtoKVsZ0MNXgeVhkwGEsy6k1oiEeZoWQgiJCbo7J7RHHAe5Zi4qLHcZiKldJMu/",
-	"fOI2J+jmEPs+mzmh6O/hLx5ewA581YoURUsCvpUgYcoqpMfl8RIDUzHAUASVjSt3QNVAJvpNStK5k68f",
-	"E2E6OeaP7Qvx/l7Y5CZ3dGoCI9seLWDnazH/VXP7rNbKuC+W6amhYVpcJ9zI5pocHcQzsQc7r1epajrW",
-	"x97oGstqOtn4TmWtEW8uIVL14RGFGiNwO2ei5s7NvucRb4L9JXF9nJXvXKuM5oFPiQM8ck7YUABmjXDd",
-	"lBgtM2/NIaEfSOCaxVQzmVFk2UIHMLNYNpc/WNg2OfUxxY88D92l0eBIQFUchioeH8mAYge4hIJF2VB2",
-	"0gKYKwUpWtXJIDaUjAAW7FuWdP4aSOFGdMoXhYzOZUf+8IcM2JYPwnHVYYAYQTEHhsTEU6nAlQ/SPxxP",
-	"/4QGnE4GHz/2J9CAvdFwOhkdzCbdP9+PptVdkjHxLaULkty95SxW7tPCJohGY5Pu4sqAd1WKpYZ9C1k9",
-	"3WDjvlHuvOPq1+zSHP2FKAbINQG1HAxuLrGb8AjADfKBbIIpoJB0Vq/B6hRJ8M3KgJap79gyQ4uFfRJ4",
-	"c2wAywXHx4N9IHuJA2d75zXefbP3awO/fXfe2N4xXzfQ7pu9xu7O3t727vavu+12uxyvD/UTa3iDsT53",
-	"H+QZFpqMBzp1uW0yrRYszW9FiI18CmQ79WGyl0a8ZaqgRecYGjFMx6kvniH+geXT/GkSWopKJkMz8dKm",
-	"gz0TiuyBai/LWf49FsQplvoJFlou3duF2ugwzrNYZ4YchI4hKd8531qzeReiQitPN7BtdM5mJ/UCXDT3",
-	"2isjqYfq4ePtyhAmhXvBVXv9dbOUz8PCkZQyJQH9xlb2GGxUaLIJvGFazaeIBn6tmOtIVHl4uJbSe/UQ",
-	"+U6rsbIhnxxYKP1IggrRRmKq1VFtOnbEnJ5xf7g/GH6EBhwMZ+PJ6OOkf3QEDXh03Ov19/f7+9CAH7qD",
-	"g/5+dfcny8ZYh0fT0Rga8HD0e3/2YTT50p3sq8f33d6n+PN0NDsY9brTwWgIDTga94ez96M/mF92MDrq",
-	"y98Hgw9T+XN/MhqrEr/1e59mn5kT96U7mM4+9qezwbR/yEbW6w7j7R6N+91PDxzco1oLvc4tNRhPagGK",
-	"VH8ikVc0sHjOL63iNeaO+Q9RiuLbXMtN0+7Lrml62M+x9IMxQOJ7Nk0TiXl5vVvHrHLv2h+5tuXmeISE",
-	"fwNCC2V7li2fE2Jj5KY9V+bT9Yjr4nmBTLnjpxWsqpkr2srj3KvsRmeGpjc3JQ6vbjjf5uC+LXJw4/Mt",
-	"JtA4prLCqG8onkCjfX9VFrileUUvcJl4fkeexdCWM0FlKXCtihUk82pGgZlEWoai0qHlCm1TFGAW3rox",
-	"HVG87N/ieVCSsXjU2GP7JfaoGHvkQ5hZGlf6A/Xy80PhGpTn5xMZiYphTj7BeyKo8B4zgK0WpCQwHgUp",
-	"3xRrGFBpobDljQnedIZNR20sFnKlpcsPiSLRPTw80glCGx5NjodD8as3Ohwf9KcPCo2OOTEvi9Uvi9Uh",
-	"AgUkXhY+f/SFz98tfDMmHn3aRSED/kOI8+DlI1ldR38CUpkxYPMCVw8MVFt980IbFjDdX7816UVkWlvG",
-	"luOsTKyRy8h0M9cWvllKARaREwq6njByGc6ZlGE4ci2H2bWY96OJ0N+GHma0ZyuwStfGbXSO7VjLetdV",
-	"eA6FxV6HxX5DrmkXF34TFv6jAs9Svo+o+Ge9itxjo8i7wLSQst2wWIVh7IWFaw6DByGiZt1x7MQy3DXd",
-	"MJWDFsIM2ZGSW2r8CiJGhMNIdpEwIkZEAyvSLc8x7Htiw5zA4EuI+bzDvgzOHz30exYRXzLQk6v1Txzo",
-	"5bG+frBnwF532Osf1Ar8Mt0/aqI1X39+r5yrooiVLB5pyXIQ/8yiB77BROPz199r9GRBy+sNU7b+vocW",
-	"eft12KfUmo9mkDmrPvVDs29ZaoktqzwSDAr3Eim+PUS9DGV+Qh+8F0fZLOopW0Ao2fmRh77Ata4CBkLs",
-	"UmthiY1E8T4fFh7oRHWO7ZwB6f3qeHRWpOPCTZUVN3OEWzyLvN6w88ihzQ3gyxda1qvK9tbofL6ozRe1",
-	"yaOJSmqz9v5LBcwncYoyLkjB9v2dB+zH5MRU2YtZlD2s5FateAS1IHq6JupYQXc8YPrMmmPJRXmw73Aw",
-	"ZZLwbNiBl5Qu/U6rRZbYFfOqSbyLlqzkt1hZxguL8nmVavsae77ouN1sN7dZSdYQWlqwA1832002yZaI",
-	"XnIWtq68Rrhplb2Q6ZbkEJiIwOdJtL0V8kY9pBay4GdPrSuwsryH6DBmjuqLirTG4uBWpXL8/CMrm6Tx",
-	"iHhUKaPApj44vwPExYB4wCEeBnNiB47rN09cABrg2McAyXdigi+IB5A/x65puReAYwH8Czcvmoba4jRD",
-	"9N+y+tjDC+sW3Fj0Epw1znhlRo6udiNZ/cTt2ja5waaiqAPOGAFnBji78mZzYvKfUSX2JKcoe2qeuDmH",
-	"Q33i0cTB0PRKyCnDtZhoXNY77bbaLoZdLna0XNqWkGOLGR32Lmqv2n7qpKLgEyMpqS6wGZ7IIgmplQF3",
-	"H5Gg5GEmDRnvkckPsWCfcj/EDxwHeXc5cKfogiEZXoVAh6fCQ9LMF3FgDqB4IwVTRhSHQutgn74n5t2j",
-	"8SHvDO4qqeaoF+BVBh/bT4CPIpkcBfM59v1FYNt3atolmLg5IJEyTkpYi5KVkVSzrfvo88BcCfjYmGrc",
-	"j33+Pgkkpti4bc+Dk6iU1cFcZzDNH6mMOCEwDYfkGfPv4f3pVNZuHpcSQAF+DEkCNbvrQ80wPB2YxIwU",
-	"p06YOfpFa44/YloTEh8x/VHx0F6zimLM33igpYksQdky0KBMbH6oCTRR6QfC2uMb5Lx9RpUM8rrRLogt",
-	"U63fyyBvyGyTM6XyhGMOgdiZ3pjLwyqtey9+xES6BbnaX+5rl7VzZmXi0EpVC5Ai46czAjknffR2ICWG",
-	"h5mC2KZAccMCdAmdqcsFwhP6cfQZ67AfORhTiE4AJQHqkjyCKqSH60v6oDh9wKofYTYmioET2NRa2iF1",
-	"okUkZJbt3mhEeYSyVIQ80zJzZD7C8mfiLBJ/WM7kYSj2ZCOfzsLTQjJj8RT5i4zQPlg2xR4TlQRr4rhU",
-	"fk+xIzqZ3lR6uEp3kkmAMakZcZPv6mXMFFdnMQZ0j6ejgqEnTxB9nwyO7pCRRmXE5/AGuQLZBE6kZ5Iq",
-	"qzxvE960pLWnT5+sSd4iteZETfpgWJbvPZmXkZNgs9zBd+vru0fchW3NcxJDIYgy6ItZSuX2VUsDSYYX",
-	"eXs1cj+xs+7PPO+TC8TNSfmkRKfRSGW+fpHU6zn3P61XX9Gd39iMThmI9IqlpWLMKm45CAsXRpPr89Kf",
-	"G4pfAoucwIIxinnCwjcXIYbcLvqEIcPaUgV1/OZwkj0D/zmuEPJC/9Jl0DCHgHxsAiKuH/OXeG4tLGyC",
-	"s8ytNGdN0Efzy3QOgkkXmHhhudgXbVAvmNPAw2Kzhw/4TuXmifvq1SsgWgWyWcDa9fk1aAO+nbkjML+1",
-	"dTQdjbe2OmBIRPXwasOmKhG/EadCSXVZTnFRdWlOcanwPp3iYombdIqLqqt4WCmmFxeEzVWmHkQFywdb",
-	"W4TLENlbW5xNAJydnTH4iYd78QeAE2haPkXuHJ/ADth+3W4b0afAx7P45wWyfSw+r8JGFVXqrqDNoip9",
-	"11EhdYrTpdSpLDHr/kTqqhNoxMn38DwqIZHHTIaC1gnMI1ldr/Q4pModKUlK87rm1zU9Tr8U39KyTk9c",
-	"1s8Zb/4MiFtQncCnwEF0filtrugvpiiYSeSqA9s8X6XXPl0XWO41spnT4AHH8v1oJDeWbUtrzhwI5IrL",
-	"YoWVKnCawszBj+CoP23uI3Uz+HdJgVRYA9BuV0narM3bsZKirzij71O8bODwYEzr3o9fylC2TMUK+zmR",
-	"a+J2h6oBbKr3ny6O1d+/o0FB/3YptJsiBVACEBAabdPWTxVUMpFtQtwSkWqHdAKVmvtKipGpKoCwlRyY",
-	"Zo5vVYWq/gqVnwuuBWff9BkYjVg2NhtTACGF3wwG6mKY69+SxE2oZDUEleld1sD7uy9aqL5AXNFd6UhD",
-	"jmpOn/HMSQwIIW5yOqAUZlWUdgmUo2J5avhle8BmbA+w/Bk/bvUdlvrjx7xy1/fVcaeC5f11GL5qWcEQ",
-	"9hutABKzM23hqmQBY5c+6Sf3GpbW03d5rTmyzJxTrRpUhrzbuHAyJlUNKBLKP/Jxqq13h5am2DWvsead",
-	"uOXima96h9zZ5HXvjAi1iqM8TCtGQN2Y7CcOxSpHYJsfdxVCquhIQ0VU1TjL8ByA9VQnGR5kUtcLbHWK",
-	"oQDcG2FS8/BZy7C2oqRCtWgrCuSS8V2VbJg+Hnt+M8R4CSLXF0RGW0Dk7WFqA8gz3xBScpFYWfgXn4eb",
-	"HAhW0htVU6Ap3eUF4j8zaiPJSeDGwsgS6z0J3BfTnb/Emf2/G+teZNX8ewwNFpnMN91sJ2gsstmsFvau",
-	"FRrFdS8ttLRa19twdbr6fwAAAP//9Fv8p9J+AAA=",
+	"H4sIAAAAAAAC/+y9e1cjN7Y4+lW0an5nrZmcwkC/krDW/YOA0+EODbShk5mbzsXCJds6XS5VJBXg6cN3",
+	"/y09S1WlehkDJs0/SePSY2tra2u/9TWYkEVKEpRwFux9DVJI4QJxROVfByRLuPhHhNiE4pRjkgR76mfA",
+	"5whwwmEMMEcLFoLxFMYMjUGM4DVigGQcjGWDI/F9DDgBFMEIxJDOEIgx4wxMIeOIBmGAxcB/ZogugzBI",
+	"4AIFe8FETh8GbDJHC6jgmMIs5sEepxkKA75MRbsrQmIEk+DuLgwOMsoIrcJ8MUdgnKBbrhqMAZnKFaQU",
+	"XWOSMZDCGQotjPITnCEApxxRgDnACePii+43Fl/HgCRoAI44WGSMgysEMoYicIP5XDZicIHAmBHKxwAm",
+	"EZjiWKAWQFade/A5qUODWpKLB71wxilOZnLdx/AKxUNKCT0glKKJWrYPC0g02prYViBG1yg26/o4AhMS",
+	"oVDBP4cUiS8QRHABZyiSH8HNHE/mYAITwDiOY7FwgbUBEOPP8WyOqOwvR1ZDRShh+lcxxOBzsh/H5AZF",
+	"4BrGGWJ7YHw8Bn///r/+EYLxhzH4++5b+c+PY/D3V+qfv4zB31/v/Nc/6jGFSuv3kk7wIQjrEHiO/4P8",
+	"WLvBEZ8bJMWiLcAJSPEtilnoYg5cIZzMAATsz0xjj88x0wPAK3KNFBagHF7hbEGiLEZMNVYYAqIzm8AY",
+	"ReBqCW7mJEZ2PkbydpiBCUo4ooLy5igRX5YgIiAhXFBcLJvK6esxx8TCveh69fZdGCzgLV5ki2Dv1c6b",
+	"H8JggRP157s3FpU44WiGqMTlGZzVoFGeqSRbXNUeetHCD8luGEwJXUCxhxmWnMECslsLR/2WKjAEziUD",
+	"AymiQM9eB9h5LZp2d3pCd2eGUZx2jiZfPo4OSISOkjSTTDelJEWUYyRb/EkvxXb7l2JoLz/EMZlAebrF",
+	"3xQur0jOpEgyCMIA3cJFGgugPo52dnYVvMcomfG5C7E+ImFwu0VohGiwtytgp+jPDFMUBXu/W9D+sJ3I",
+	"1f+gCQ8EPyYJpyT+oEFHicDH78GH/ZNP+8dBGPx2Ovrnz8envwV/+Gacka3SSQ2DA4ogRw7CDshiAZNo",
+	"hP7MEPNgDguEyn/9H4qmwV7wt+380tvWm7Bd2YE7A1AO9cEvw4N/Xn4cdQS2CWeyZWhg82JOrvOQkvQn",
+	"crueNerBatd3ODo9u/zp9F/rWl/9so7xlK9tWXqw2mUdH/188TjL+kCu0QU51mdvPasrjlm7yA+nvw4v",
+	"L04vj08P9i+OTk8ekUY/jgx0tUtdIA4jyKGffZmv9n7VwxXY1NfgC1oGe4GUF4K7MjTF1S1g+rta4R8w",
+	"WQZ3hot77wEhpTXNHJjlAckl4a3hkq/evi1zzRRyjqgY+v//HW79Z3/r/9vZ+hH88d//pyp0hMGf9KAr",
+	"S/cDZn7dKgG2u7PTCbDLLS9kJTqQqLPQhvlm1pPESN441QNQXedEtRGS90R2DQHmDIwV1Y1BhFKURIAk",
+	"6mcx31ggIcJipAVOIFfy/gKmqYB+72vOp+t4fcsVEgYHx6fnQ8kzGocoLPM3zOck4/KEsnwoy1QbR/Lz",
+	"+TDnXY29/ew0VEzhp/2Df/62Pzq8/1LkcD+fjtY4msuxGkdsZK1hcHo2PFnPfp0f7J90hKnTcGfD/X82",
+	"D3OeIvilsqLzi9Oz+0//2/7RxeX74cXl0cXww32HuwsNW1+eKKFYHv+7MCAJOp0Ge7+3yFid5+kyTiNF",
+	"dBvCf2669fWf2G5961lPt/4+irn7w899vRhukEWKLPpolhCKolDrm5pXwy+IgYQA2WsQ9LqLHYnjlUeO",
+	"kWRfYjVlRuYcd5dVl6m9fJYfXPxTeK/FcTcRRClrxXt+sdQ/r1P+8N3y9WvzkdyKEq0cqlaQVQzz8aTX",
+	"CwonX87RbIGS+q2LsGNTa5PWD23jOymncJhMajY+ljtntp4pKIRlaYHjGCtLbIEUdt/uuJYGnPDXr1pM",
+	"DWEwpWRhFYjIDwmO6qwHBirGIeUMwBJt7r56jd68fff9Fvrhx6ut3VfR6y345u27rTev3r3bfbP7/Zud",
+	"nR2f8MvJ/UFCSSQACkGEp1NExU9iraqNALc3qLut56SEzNJCnA0PHaqpJ7/f0NWckC/n2ZVdfi0VomuU",
+	"8ItlipgfYfI7EBMxwOQ/lZ3w0+g4BGiR8iWYEiqa0aVqrMyPbj9IEYjIJBP4RZEgRTHCPlsmk/2zI8BS",
+	"NMFTrJURZU/kaKHAs4i+IfTLNCY3l+gWTTLRdE+clRhxFPloYQFvj9QoUnPR3yGlsHBfvL4LA8z2Jxxf",
+	"I487oIiQ3+aIzxFVi1PrKuFkEFRcCM5kb4SFDk0oqlFc1DfA8CwRBl8xJlUbxxRWx//aGpErIvCwdY5n",
+	"CeQZRWMwRzBSZkcEJ3PTR5hxx/z/+Zzt7LyeZAm+BRwvEONwkcrfUHi9q7/O0S345cP+wdb5L/uv3r4T",
+	"I30O6jsO1KcrEi3VD7oxGqvta7pP3jWYAcXlndHYj5xfLi7OAKHy/+cC14CiCcLXBlNqU4qnc855yva2",
+	"t28WbKB/HUzIYptqJG6rTiWQpUG6+60gILb7GronquGIamquvx20ZQPGcQcp2Ax3KHoJec+92P6HkWQw",
+	"gjcfEGNwhoK7P0IPel1jiTlrg/JJKXTzbZLzi2+wxp3H7AIt0hhyz8VmTp47oKBvCLjuMwCH6ugycRyl",
+	"37D9MHYTn9wVFAQo82GNIlSB0MLArO6s4ET1OUHMdwN0jhe7uxTJC20iWHLeI/ctjr9+/YKWd3djkMZw",
+	"guYkFlzlZo4oAtBY/AktXJ04EtuAblM04Sgqce8uJHtRXmFwV8+t39bYkWpNR4cIRsdIIF9T/wixlCTM",
+	"s+v7YKGaCMcYQ2AOkyhGVPsjpxALt5nx3TJAEacYsRAsyDWKzBUQIRhtxXJCwEmKJwojxbOtjFLRfs0t",
+	"IJitHMzCA5k7MIoGgSOvRZCjLdGnkZYEMekVtRO8WbpyyqqlF4k/pWSCGLukWXJZvZmbIBHHDrdKZxQx",
+	"ktEJCoW08OnT0SHQ6723mFg6YDFkfITSGC57bojoCKjuWbshSRbH8CpGRp6oh2VHyCxq/Hbh1QCiTZiE",
+	"4hlOYKxJbq1IenUXFkztMIqwgAnGZwWirghg7TZ5vYoeyn4VvB+EAQkuYwKjOs4oP5bmDHORAaDkGsUk",
+	"RVKQFT9HZAFxor9KlLLGy+t7yZQgawyPyB39jAPIBXvmpfPWOMk7OYkgt5oQmqL/WUpsFaL10qtR+EpK",
+	"njP3j0JuVdrEVRf+kbfts8K3UoNL8cQ/QZHIPTTUrCsonts0/+vy5YKjwD2TBrqckRawYmkgJ0jn5BQ3",
+	"r8J3QudS6HSPsWPMuHuZlawm5grudBfX35Llu1j8beOvxLAuFb17E3hjJlycOr2NoOBdr+tW9phO6gwg",
+	"v5AbMIXi7gURJakkkStyWzJ/hGAHxIgzN5IhY0jyUq0AFi0kr/0WEhPC8nZHO8fUnztNZ0mqD9X1ZmmM",
+	"J50UgxVE8ATd5EJsQU7m/g6RASfqLr53k6ULoNTJ02BC0uVq7sd2obqrjVJGvtWfMH/kzCRjnCyA0S81",
+	"658oJ2e+VkH4gxPCfyZZErWJKBHiEMfdT/PPGMWRhL5JlH6dyxtdl2GauysRR1mFhbUt5VUZ8xPj+lWj",
+	"+vbAWUplA6biWxV0+TPQekEOp/6hEdG16KhfvvBZCQXIrqsPAtQKmjFwtEgJ5Xk4hOtu6UQNeVc1lNiw",
+	"in1MHy7zQ5Xpe8CoPxjJNMYTXqOpUnLDnGhPQTpXCGA5PlLxnksZxop0QLAKU1QNBl21y/KqDzRQzcql",
+	"vYVrLOtm8RIuBZGOMGArQzZC7q7UwSYYbESXoyxpto1ooISsB0FEl4BmSWjQPYfJTDrZ+Bwns2briDgP",
+	"WaK69EGHjtS2XR8YL0KbzNKoy54prV7dRTTXRRzY1UAPvZUVMVPva058+ZLcPQidk1XPKXL5oe58LjBj",
+	"OJl9pPYsN0YLWYOSseADPofcBAOjW8w4kC4UzADDHBWwV/XQNNC3taZ1tBzlQmoJoa5ZrrpYL+4SIVBy",
+	"7Ahgxi71tIKYteI9ovT1UIJXWEoFuYclQQbsiUUpv5PBUm7UDMEXtFRh7uNaS+PgC1qOB2AofCZqRKx5",
+	"mTQXwESRt3AweI2foY4kEw20F9ECoOIYpANMmQCdoEMhVvGuphlvVGIdK9FSjoNnH7UXgltXU65iPOXP",
+	"RrkqOtD9Z9V8zg3dwq7LAIyJ8cRBHEvAdVBBHklig0g6B5z7AnDXGCBgCLSXD5zIFQNOyjbXNTi8mxQw",
+	"C2ybc/uEROiiFNkx/HB28e8gDC5GR+/fD0dBGBycnlyMTo8vR/v//un0ovuWnBGGDc6K+3BbsHNMYyLN",
+	"0HoAnXhyFwbLLs1Ka78NRD/fYs8yOkNVq0/9tR4h6QrvZBzUbY1Gw8rmwC6GnMJ8vhXUiuCePJTOMcu0",
+	"gyGizhT723xpxvCqHqUEFjWvoc5dcTfIVLyrpdJhft8Fb/5otieGASU3VUDEulJNbs7CTGCEEbRCFXiy",
+	"q2zVB+e/Gj8/up3EWaQgbuGF7o5RebXbkGuNpi5bJ0XadQXgF8QemOggEjX52qLzS9uwtkj9RvFmhdh7",
+	"o5vhCCUcTzFiAHMQZdRENeR6bzVEf7eXtaEYct9l20eofvPbXXj5EkvXCdi/YoWwqlxHszoruCFZHGnl",
+	"uiRArcWl1Z8iGs/5imkXjV7SzeMdfUhIJr+ys8Of60PP1pZazO6V+1tgSOMP4zK5fWjf/Dxaj9UdC+YR",
+	"uuSMKcUJrxwSfxDcqoGRubHvbbOpr8TRWHvyMlSpy6GrBVDEilh9u1PgYG9dgT8imXCQOxL/7o+uwP9q",
+	"pyxFNXG54l40k+jpZJKlMOGsgx+tioFJRmV0qDmNrBhtzYBQo6RuCHl54zubmFT4t5FN691yZc9lrWPN",
+	"TayrteA2x6dEkCNZe0DGRZj88FyTEMZHPUT/QJW3mxUesnqS4WrCypv1Xk2vHuBqep2bXO9DITIOQo/T",
+	"n0zeeX31tVmFrnvdhb75hKzVx+45eK3O9Spm5XdHk7M3ySoqXEdffCH158imY8Bk2SEy1Z9q3Cv5ulcC",
+	"eu+M/B55JV/LuPEG0qrskLzuhkRbqI27V0qB5KYdUBMBWTHC5qICJ4vVjkSUk0dG1M/hNdKKk5pOyCzl",
+	"rTrNeL+9Op/AxN6NunPXVRPVvnnZY88MYxl2BQHLJhOEIhSBcSHFamzG6oUVDY4PLU2eS51PoPjaGgL6",
+	"CjdpPw4nAtuQ8Xx3nvX7zbo+uyVv+RjMXRiQjPfunZNtoMSwo81AhriIGYc867ecc9XFSWnr3PVCp9EW",
+	"rup+JPij95K1eNU97cLsdudbZ0g4LJytPrexDx2O/ffjp+GnobDBnw1PDo9O3gdhcHRyeTY6fT8anp+L",
+	"dM1PBwfDw0PZ5uf9o+NhDyt9FZ+9k0orCem1eaY2K99J7w/zsgNtiahhryTH8uLWKun4ua1H2Mlrp9Vq",
+	"WCwPnBWtdUm1GE25LAanvW5SltR1ljxJgf1EKl16zs4hxdexLBw3lilVqibdCvJWm4hVUfN8N5Tkka1M",
+	"TTfU1kUpJaLI0U5DIK6UXDRXvwKKlC0cYGmB6HztvL3fXfduw3ICVk4i1WgU+g1DKAHrdp697nOveZK+",
+	"13KNyU3oZ7g4V33a7pPYTXrV84QO0bdFSXsn9fiBIPccib3PCQBb4LvvRsOz09HFd9/tAWgVZHswrpZO",
+	"l4HpYti06MSLenVBsjXtcqHWjFDg5mYYydfqxqqRks2A5ZvHjBkhxnECjf24MGS5jzuq4/lWCCreTuXb",
+	"aMXaUZVNfICbqd2a9khB7qa2Q0MkpVvorlGxdZqWeXFDQpPDr1a22G2aypHuRxFFrOa2PzoDUH2v8sl8",
+	"m9PrN32vP8xOkxgnNYY2Ir8BJSlXZ27OUhVs4IAkCZo07Klo5N9Y0/P+SWrvOlsnK0urSfZutiP6lnM/",
+	"u+EPTXZD97w5G+rSVHUz+iszD8DSnp6VyfuxzF9rHH9dLNLOrmNWW160uaxGg59yRDJV7emp6rLIWKte",
+	"RVl8u1USDVeK+zLA6MInTxHt5aCudrPqE7cvZFlpQjligkUslesblqPbYKJshXlEHxdlesACpoNKinbU",
+	"O1wQ8rxETO6YzzjSgYQ4AVNMGQ8BVOEPue4lW9lSODpQ0kppRdlr5ajD0p3Sh1YVgA3E+noVYr1fBaES",
+	"0gbr9/yJXatLYZFzpzGW265ikFWYvgDXEkeoyrGoo84co7+Uu9uk7W7s3/KxlsQWXd6oZkXma2XHOYUy",
+	"3EIM1NlpXSx/VXMzlcSK+5RuUtA+CP96dY9STYqCHNR7eVuWtKd8Ook/ujqRtEGV/TDneJHJMHiJlCzZ",
+	"A8VgWMA4Spn4AuAM4oRxof7pTpG9bvNXCSiCsUwC5Ey5c+cI2JRqQdILSL+gCECWD6OIt16glRGaWcLx",
+	"Av0KKRZyZ90xU63AtWlWrsfiBvmPikOq0P7PiRPbL0uVLyCfqGInAkK5qAlJGKcQa/IX3Lc8c2FNPT36",
+	"1VCq8uJbyaJWUtwUrasqU9esyV24h84VdV/K/aq5eFWTPAGEz51tKm2KJ7ZC8t/LTs6UIrTSLWV8KoIL",
+	"3nsQGdzqW+MXtCyvzIT31R4JcaZ12a/Bw+XvLOCtH2QduuXIDRCMzy9GRyfvx2G+WRCMTz59+Gk4GgNC",
+	"wfjo5GL4fjgah+Kv8tMJqvnx0fnFeBD4wsVqY8KE53SBayQ3HVa2GZAK0wlJG3LwYCGocQBG+pRpp0RO",
+	"zcozMTz59GG8WgLeuzuHLrzcGM2yGFKAblOKGFOyr8FchcUOmv2KLr9oSme19G/emUgpucbF8FXPHVPJ",
+	"ixJnrXD4nc8dWFV+br2oEd0VFZTPp8KLtsnmZGZoK3Qpa/zT6enxcP9kvAf+3/PTE/lQC7TeL4lVGRS+",
+	"TO2Acr/3AEksKxxrchqbJh9HuXy5V5Pc5gYm6m5KYKjtUTB/K9IX1nIJtyQt0WFs2eTYEHBBlVHoCMJA",
+	"YUO6biUyhHKjcBGEgVhjEAbOOoIwUOBJr+l5jxwfX4BL5RaKO6bFsglMkrJHQF604otHZO58FmuVZr+s",
+	"4MQoVRbD0W2dbU24U3PAAYPLolIX/JtkFKRw8gXOEJiLjHJK8TWK7vGsiwTHuwaO0qGRLB8rQmf3JULH",
+	"idBZTbwVumWirXn9alWeKF9Fe63KgtXACQpaDeB3KhKHrjPMq1tkT4HG88ieewXo5FnzduSNiXjyWdp9",
+	"0DoBRIk2vdfHEeVbt3pMkW8jnMiePJRo9OnkRP3r4PTD2fHwYqUwosJ0azX++7nmS6RNg6NC2qY+wLTZ",
+	"nDyjMLXmT2kmDqu5O5CqpPZEigPGPmJNaeITimZG6qleZPkTmY35QvmEM0qyNJc4Umn7tPJhmtuiJ9Ba",
+	"7kRxV5yE6oVMxpUNegD2c7eg7CqvdyBKZcRq4JINsqMQ01Kpxc5ZL/X71iDt+crK6hr01S/KqE8S1KYS",
+	"hEXJbl1B++WMKcfO+iDG0lf1wqEzu4vr0KW22jNRBsFTHzfFaCI1DQpxnO+U2iXW4na5WgpDuFAesNAc",
+	"rZFchXULt82V8MZgZnvoxaxQQbcm/0QPeL9wh3d34cY/3VDveHmzkU83lAuBb454vMYXJdaLp9WzsNxT",
+	"cL9giu+9It5Kj1n0Ed9cdrVWcaoTK15BzjFc+QFztC4ons0QFTrVoU5XLGJCW6Yur5t9LvazJJWMIapM",
+	"b9rupqiIZol9n8KtJtXNY1nyArQUBeNqYZ2s7RoJysBexqM7TgMGy2H+pyeXh8MP+yc95P1PknpfXrB8",
+	"ecHSEpciiZdnoV6ehXqSZ6EU+T3xs1BP/KxTz3ec1KspKMbXiGLlZ4UJwAmUQ5oK96mKAaPCaZtyKWzJ",
+	"gUgyQQDL0EXdQQZctMVGtL0PJco3NrwRFWqKlQ0wUzDJG5ssMNci3rf4NpNDzQ5ZNJyUl9eZ1v460ydT",
+	"yBbgqUuPLw80PeoDTf592LRnm37F6OaMUP6wxRjD4D+ELFYu26i7++DXV+2hvT/WqaYWB18+eex/HTxV",
+	"C6J5U8dcthDcqL6F+1QdCPOIoXhk0ETPFt/r8RkG9cM6rK0CpmnX8yGcdz1yq7TwsLyfuVE6qeW622VX",
+	"K2c1Wo3sZdgczJIPCM61psBLL1AOxWfz+mT7Czy1Yt3aXgerB9UQpx/adT0ktq+oqpE0PM9A3T8x6wcN",
+	"gH0qo8cjVIPe7m71dpE65bn7tDqlznYraOxQvpqm+paT0IXcGOFIFmZVbfwPVdVAW/NwlfBm9tgY0dx9",
+	"nwsCU+LbHOjVUiS7xQiUeGkeJeAyyHZO4GWra7fTd83eK3DC+5mcd/1hBSXs5BzTZXdOnIG9JsrkUT7H",
+	"XmJ3z1ofw7V/c5uODpkWECj8tuYhSk2h2LxEGSkxUUbpKarCMJY+NTKdAmF0jQF3QnUNCqTSmBT3Sfje",
+	"D4f7h2PdjyL9CZUywfNQCVlnZajqrIiu3c2lXrNEvROyXmLIFd9E2B0EXjKaoMgnJ3S9xN1Z7l/YcGOt",
+	"Ko1mlA0KVruPRadV1+zKzio0cd9Shg2GlXWbUnqGazWZT1bgfO4ZfwiVyMtDnkwtcq0+lfXJyKDu6zNh",
+	"c9HMuyAZgtR7NB2BWRktdZ5CwBUs1SrT5WGuMbpJtRLfBI5V9vsp5PUI1zWw64pnUy5D8ylKKRJMwqmF",
+	"kmeWyVL/5k0M8zMDV4jfIJTIl468gV0PbBksXCcPZu+TauKtqnjTM7rAvhIlGKMZoz9TfHNnevyKKKtd",
+	"4LX6WHmiyvNwyW5zvfN7Gzgbr5fvVzJltpT1bX+/Kw8dtJZFGz0oCHXQ1+pnjlbu3G7N672/ffRBTZOV",
+	"giBFqrO1QZzjoO2WxS34owNDcrC2Qi6na9TVzkkFlJAMBD8KAWza6PWKYt2ouVTyfN11qnM2PcVJVPhk",
+	"UIQXLooG/d9/bn/zoHA5VzYWJnhhniesZxA/WEHb8sksw1G70ekKxdWw3LLpwRZCa+T4qtkv8m3rxsZv",
+	"beN/dbiry++Iy47/7tdRmp04pDPlGW28NlSzDst4Zxv3XMburu3Zdx2vnFKuPWVxU2zVlMXT6CjtW2n9",
+	"hkTCnA7zvcs3I0dEvrBGUjdqpTQBN6nreVmMXLpyaggok6ROYMTy0QSUslApb5BZBqYGYIheI7olPyr1",
+	"t02135yMLgmv0JAFfCLQHhBhpBAgDcB+zEhhzXm/6pqDXqVWdotJUzWrWi2JqtZ8JQMVCias2r2Xa+Qo",
+	"zT+0WVGYm/XSbgstDi4NKOPzi+HZ5fnF/sWn88uDX/ZP3g8Px1qz9hgxOlPC646Fmv3HyBQG+GtkdBX3",
+	"qFor2lMiurG2ZwPO3PcA/zU8+CSShEu7KyyU1T3vYaQsz/6MclQfSyV985IPa0pBmko8LeUNCrV0Cu91",
+	"E4ZqivfApuI9LW96ry2Btl8NYjDD1ygxtliZgQb0osoFE+7DfXffPllqb4U9OIX7i3Hl3Q5hIZLdGxDm",
+	"uun1FDIRsXLfyte6hTGGZkVieLO+3OMNemTBgKQV+A6uUoMoY0tyEXYvcmy+L4uJz9qisMbEZx8qiqyp",
+	"SJvOoe50/a6cNx0GB/snB8Pj43vdv2p6+9pt+QrWP7ea9u99wEtbbDd0UvsubtNSVnuJLg9rsheKlLtl",
+	"9UEFUaidhuovoYXIVyD7mta86K9zsPQDFsaxhk66mVZyynTyxzxMKn69gPiSjt9hUwSYzdvR4rGXnwX7",
+	"ln4JjzG9v7f+Qd0rG+VVP6Rwyjt6PUSlAGXUYeqqhBTJiK00u4oxm6MILFGHouYP6W75Yf3uFru6Rn9U",
+	"fpjk6RSJH1qs0LFueFrFpopWuUIoyXF4z7i379vDGZyghTWdmcY3GQ2R1YsrFQwXaKSJeZyQyMMwrNqb",
+	"LLtL3DaBtuu7d25iRkIiN5pf1QW8GB29FwXe5FdBxMUX8Urzjss1LMsad8enUrME/5mh/FlxWoAxCFd0",
+	"M/jI6ArF5cEb7fNudEHTjpgy8l1fPxMo9Kb+utZzO3luGK9NQmgvwPq4d9K7R7TlvNx/G37/AdNb1yFK",
+	"GIcJx4IcQwkOzRKQEFq8UupB+vFbvzJ/WEuwggnTLAMtglIJLwkwDxfVIE1Xjy4AfN9NAGh8kblNDvBu",
+	"UhP3ruKtKimswOgsCbRzum7FY1W5W6eqdWEG+/SWKXzq1kPt/QDEq47lpy0IoRtXotNrpIwDWZ6U+GCF",
+	"p+uL+V7UVfP4rWj7OsTTaf01DqMIRUMTkNlYjE2GbQKSxEuDBs2sVqv0Ki4COfsJiTrMrirNrW126VtS",
+	"yaB9Vo8TcEX43MzNVOJBXhdhQhJeDk3vVYdaw9QHJw8K02tJgCISdGUquYLsnpv1NgdiVWK5PxAVk7ZD",
+	"vCXwStsYuqeshM4SFXY4zyuL5DVRq8b2L8z+foGiu9XoEb2tGyREX3eX8ax4J10aIhIGcrDbMxP39Sa6",
+	"n1r9PddWpKkIQR2o/kEM1uUT9dTpEg+yyIp5ua3q2Cqm896BWasg6k5KlFPih8vkO4P9s6MgDGI8QRqL",
+	"SrcLPhxd6LSePGuIpChRx2NA6Gxbd2Lboq3ABebyeJTGtgc+2BnsDHYD+ZQFSmCKg73g9WBnIM5KCvlc",
+	"onA7QjDaihHniG4tFD/TuI2RTwU+lL/rtEQYAdUVmK7OewgypCDmiFonl26k2HtC9GehgzGkQwYFSdmK",
+	"UMEhgtGxnECz2rOMSltb6iiCv5dB/FkNe7UEhOIZTmAMOEnxRM2ARZM/M0SXRgfaC+TnIAwUeXpu3ruw",
+	"fpK5jOikUhevn0K3WnWSG8fyYNEow8rTGC5tpRDPxKaFb2Zjb2ic2pmSAYdchKEUTQkVxICZCpishULz",
+	"059khwIoHW7yO3HBmuR0SZ2vdnbMQ7w6oQimaYxViPm2uJ/Fb/kkjfZMQVMVSss9vvJoF5Eju0T+AyCf",
+	"bWZsmsWxZNJv1giqTG1uAuwnGJkCHZLfs2yxgHRpQPZCLNANZ+IgBZHFQvDHXRjMfPWuBLsu0oSKijLH",
+	"gHEcxzYbeioPODfJ0Kz9iIvxqyfch5W8yfaZFMPCTu3O8X9U29IjbYRyI5NkMWeSfyQIEAoWgsgnJM4W",
+	"CdNvq3xiCED9m7LCifhVyCa6JoG8S8Df0WA2CE1u8iXk/9Ddzyia4lulDI23xrJzhPy9t6rdz5FYE0dg",
+	"kcUcp7GFTo0I1YOF1enDLW3Q0WN9Tvb1Q0a6/x4YS14onr3RGyr+qXjIpXI5h2AsTFSXhrFcQvlbPsu4",
+	"ng0wQvmqLPCFmXdl5g/JLauMsii/VXmS+P6sWGUtwHWc8i70y1HbX6Myto6iuw7SFfRNLygHR+0MVI1R",
+	"ZaGSnoTgl5OTBzz3BS5tm88R/jSqnI+g39ThznsreyjtzeNR2gkRia9ZEpXoTG9201b3uZjfI95EOKG6",
+	"G8RdnMJlTGAk7S22PG4rXb1H/C9OVA/IJZvoQ2zcsyHaOmAbKbYfg9xWt5xYQkqYh9LPlCWwhtplFD7g",
+	"RJJ6UWgIQZZE8jETkQhlOuBoAIbqrWiSsGyRW8RkJ13nAzGAuXLhStut+G6khZs5nsy1zGsq1FYBM5Vf",
+	"Ve2eSLMr6QqmM3PrtxzCkULNyzl8gHM40sLV8zmMCmIfvE0n8U+6VXhxp17Nc0sVVGjzo82yf1HZmlU2",
+	"n5olABBq05/0ckIiVNKgQjDONbWH0KcasZZEMhaLhTL4m0w1f3Nekc0xOp5iFEd7ijQI3ZOu8rFBhF7d",
+	"Hk72IjL5svu/4r+vDEpPdSeBD/SnWHQiETHj6r/yj5ir/4o/hMAyxslY7YKcigGmlWEVt/e/4wYd2Sys",
+	"oCYPwK9qIPGylWDvonyv+mRI59DG84x+PgCvX7/+EVhrlbhj5F/MLPrVzqt3Wzu7Wzu7xc2XmFrf3ivr",
+	"ab/dP0eQ6nfWxShMrhUns1CSs8xLuCHUyXEWzUOVIh7lfiEtSZoFj8Wugt2xIg4kitTJH16N/9FAunLs",
+	"AvjlIIXHvCnyGiCtCu0+iAVzJNMif9wwBbbEu81tkNdGkZqEX8A6kNRYfDOsgf+r5lq4QIz/RKLl2vCg",
+	"Bq++2nJ3d1eWZu4q9LH7APTRtCfnjpRg7pACEjeHSPQeF3fYSyVlmWFblbepFR2G8rP2+Tjjh+qSVIza",
+	"xODK4jgwrxA1Pjs9vwDF+VStmDGAkwkStaE/NxGjmr3NRyTEWyXClmtUDXKGrS6YPTAWmyK5NLseD8Ah",
+	"mkJ5cXKiPzUwaSMm1zPph+RqRxJzDm8bObTA0S3fnrDr4nBl8Cokpbe3UMZqQy15HlA7E7kiunol9FPK",
+	"UBkN+qVF97l1oWwenP/q1F+zjwaUxQAl3hiDzNgIi6G2E6uf9a/m4jazKTe00j2LL6GqO9lUncLU1Gza",
+	"01qw/lNzK3EW8/PqPi1PEqSjUtUTqlKCdi1Ig8+JmJ2KcnxsDqmCzgxPqNSPxY/XMMYRtC+0TEgyjfGE",
+	"MyXfQfsDSKkuGyrDX+ckNmWjFBLf7Pw4AKMsyTP0dQ47J2CGjGYg2+szrtcY2oWICe38clSScRVGLsFP",
+	"llw4s5s5ztGiC8cZKUhuRGh6XgALRAQ1TVxkNirRb1DDayK6HMlc8jbXwPov6RX4TG43EOCH+hCEhpo+",
+	"J/k7ZqGhyN3wc/D1c/BZhJqK/+2J/0guLf5x9zn4nHgMDB3khAfluB3FBUUQRXmBiTuzRMiW2p+W0Yq5",
+	"f3y8uc/JQvMWc16lLU6GmgjfmcFe6QZQ27HaDSATkdggjab1t8AISYMiNxlXrKwWAJKAlOJE1TbdfwPY",
+	"HCFdzUgsJzRBoMqAoBkVjtgA7MtHYlSNjoWKs4CJesJKjsiE6LSAyVJP3cyljmWbs2j6QHL6x1FppsOf",
+	"e4nqTXSst8ATNHKFE0iX/kNfkxTHnv7cbIgd36FTQ72QgbPDnzsfka/5566eTXdS1zFlX3TPX2q39gZC",
+	"gTazQQ4wb6DyHs5PF/Tn7/V00brJ3s7K9tfbJhq8nL5R6kiiq9/yGdLDziObN8pMY2M9lD2oLM28yl0E",
+	"OepJaKrTX4jW1i8k1D3B/MhCejdqV8C2sdZvXI7QJ6XzgWsRIZTQPUiTWa150RG5nfLTxaMqbYpnJ+91",
+	"GYQJlJ3NWzzGfKG8HozjyRdl4VDHCuK4gyh9lsz+Gke93QUr12t9sF0aS3I6INS+gt1+d+EFnKFtvfHr",
+	"kfRfDmidoC/l/JP39zuk7HoNh/T814c8pOfXL4d0/YeUXc/++3YR9/QbvBzLTsfy/NeVjyWZTLIUJrwl",
+	"qofL13YYtiX+8oqwDEwySlHCY1mS0OrcnjcjiuduJLub0kBimlMLzIvy1VkctUhrDah3acfu+2YqZPXw",
+	"OoROCwSkiV39uCVjcZKIbX9VPxyov7XFqdZMoBoD3btGfRu5I3Y1FZTA+OYItoC0NoNBaRtWI1GLDtEy",
+	"EgtNCL9U1BYGJuixQIPhY7DwGhorUrVGVIGoW3i0aeQn15eAy6fNkTOee0G0lMSXCx3Fh9klSWKsohhx",
+	"egmjiCLGbMrchCQJmujIvkeO+LxaGmJVIOqivvUzYXYqG66cOGzPhkQSEEjyBdd82D/5tH8sELD/6eK0",
+	"Yel6oA8k8sL0iJyvW86bEak2Oc0t5zNFltUeHKjb1dynDx8RqCZ6omhAM3k93hWQ5v2HDbMbPqLf/kB7",
+	"6/3Rh5aIKtTn3JRG7OvmYdQIb5L2ejgJzczP30FYS4ib4xssbZ2HI7XJ+k273k+4/2al+o7i/Ma6/tqI",
+	"yM9Yto2O2UUsB7Zxozb5eFJ6S1v9pEGXlvrhiOd3MF50lbp6HstUqiNK3Fdai36lpoMWUgg/3Sq0VE9g",
+	"3CDGVdjt881SU6jZQ3/uqadwwnydezOO9ooJXd9y5tr9iGn1RLZHM2b10ezsNfAMNDz3yqozTrVmg1kr",
+	"F2QoMp44lqKJeMkgAuMCJkUJ4PEADEVOYREGVVo5QlOcIBXbzzjNJjyj9rVZWUR48Dn529/+BtSoQA8L",
+	"xLgqcP9INGJ7itC/++784vTsu+/2wAlR3YG5pAamxYfTX4eXP5+OftsfHXZo+dP+wT/bm56eDU8ufzr9",
+	"V3Org+PT82F7s9/2jy4u3w8vLo8uhh+amx6OTs/MgCqbSRxWcduoDpiB774jcg9h/N13Ek0AjMcyY0n9",
+	"8VX9D4DPQYQZh8kEfQ72wO7rnZ0w/5QxdOl+lkkI6vOdHVT9Q8AxNm3l20Q4AQscx1jJF6HKkNkBnIC3",
+	"Ozs7A7ADYqQTPDSRZAxJEojUzWPXe3z088W3tF5Jghentpp447oNdbSu2/hgxMI+a/76OQhdxGh3sGqh",
+	"T4u4KcxxEFkWtcgw40tk5A8mu4lErh/R6SnqDrOxaDx+P7wA25RkXPwAqao2KVdr3ZWW9VKRtKxSjZxR",
+	"83HlMKVnL/WDl6a9AJUi+Z6zU9LQziE+R5ilKo8qBIyAhADC54iqacyoFF6jmAHMjceUCTlSPSR9URxv",
+	"/PHT8JN42/hmjmMEYCKHMyNJzyuzoQ5iBWomQuUVzwAEKVTZIJgr4eIKTYi40sf6tb+xShSwA4hZpxRp",
+	"UOxPFMVIcnNTcllNVHDWoIiZ9C2RlQDETmlha0KyOLKvPhTQdIUmMGMaAm0JvZHNbyAW6pvGntg/hc1Q",
+	"AfQ/0kKuZek3Oz+Ocyb6y/Dgn5cfR+s5CjrjqHgSGkjbpunlEmYlvqWBzOUVdTbc/+d6oBdJVW2g64Q8",
+	"JRuI8fV77jL7UN3q31knnmw0BlrsCMFkThhSwXycWclP069O4UORffMWc5WwkshPUkzHjNnVyTwmMb15",
+	"r0B8gQnIki8JuUmK7mGbo+iniB0Nh1iGfIVT1zJQFWdR7K2kU5BNrHn6r2ANelgDe5E+nsbO3sHR7E28",
+	"L4qdL2b3ktndIqaTv9o1lrlPhdWaZEsxQ+4FLBL25DM3DKEEQN4WT/Riuu10Tgy6OppwzS4+rS33wolG",
+	"K8lpmJn7od7O6zwcVxtKVJPkoTOy3Tu8ODlJlLRkvio5kcTXzr3n9LbCaTGB11xVeXy8FtV47coHn5Nz",
+	"JXkJtdqIPcKgcn6wf2JVAl0xoKwpjB3DBOK+abAVNa2YegOX9ddmTlj+TPeXe1OdQomeMsqeJMOlO0PQ",
+	"p6CdJ+w8Lk8wh04WSEiIkbhlunOhms5m1OHz4LBbdKP3Yt2eY8YJXbYH8hZyzUtXbMfbVYz1i57vUdxV",
+	"L76ljfUtaSR2KGCoWq6v8vvjiUe9bPyWIeoT+Rxs/WWYWxmRNLW1CvNsTihHYhrRHlwhfoNQAvgNKXIh",
+	"GJNk5uTzL2Dq5PhbAyPgRJmScCKNarBBkslvgTm8RqLnLEOMKauDhscWz8lnwAywNMYc4IQTwISZCsZy",
+	"UmarFYmustAAA5CDsTDYjqW1AvIGCQ2MDccSPo5bOOGxZgGy1ULJitZkX+XAYt6zWL712lqoLOdyFX2q",
+	"CH5dZSCxqMLhWz9LLHFjfQnJl0EsBntA7NwHK0L9qj/UfuyiJGqClJPGO6sXzLuPrTaKBTY+dBPDxGzZ",
+	"t5v5rDEQIxixsvPAFmjqq8PmqHXZs/xbcWXGUbqFbtEk08le4oeh+bst+UU0ZjXxcOfuQF1tK6XZvzkT",
+	"SwFpTfQyvE2VvdqAIkgGKpP5RqYhip2txMsVtltTpLzOtxYwbRUVnIu/UgY0FwVsoR8rSszRQhlMCk/k",
+	"wFQcI5s84btPL8SEH2CqqPnBiMBM02Zhs+svsc0K7m1DB/VcT1LAusFVi0ZYKKDkx9K5+vqSQ/S0cXk2",
+	"bEDUzzYS60MlBf3x0GdCE1WP2tklUt0wTapykDyHszVeSnev1ZSkdZlKuzLkYEEEYhLLFk012VwkJSZQ",
+	"oJYD6m14hPQbd7oncg4WQejpGyxs8DflGtyv0uUcsULZ4JgiGC1VLAPzuxCL+Ot4eW1/5c6eda0YWJiq",
+	"RqR1aaFHek8RnOef5FNE1Sbn+vg31ctlG+oA9iWNrsrOs6SLnUfnrLkEu9EkVwWzkd6aKwL2JbkeVQGf",
+	"C9U9VF3AlWWKx6d8Uxuwjfq/vSpHz0ua0ce6M3cQMs0NupoT8mWLZVcWkha9XHcBxS5ltvGbanXuNHrR",
+	"0p9WS89orAt7wAnH1+g5KugesurmAvVS7UY7QOvOmTnJ+nsX5d03lH7bkywgToB+m0SGpWDt8JS/yfwm",
+	"lXQn5kCR8Rl8Gh2HgOGZrXHIpRF0QhH3afSefXsExd4z6xPp915I2itu+DZuU4lWU5sPZC/V1l4/219v",
+	"qtjqqmB7MSYj/WVeUoyvEcWozpvk2aYeGrgX7OeviHckws3Rx70AlwUhl33WauWNI7WSTlcN/TnTzc5T",
+	"scja7dlYtb03WTYr7/ejzR6q/DMjz4fS6O8rTDzZSTH6/YYLE5v1CEDPw9pbmNnOZZH2wOi8rao8XiNj",
+	"eQ/9oeq7fDzt+1mzkBfrQY31QKqDl9WiKZBztEi5/HeCbvml/kGbFGSt0OJPj1wpVB+eZV4ktFK20+SZ",
+	"i6SkTwcHw+Hh8FD8cTjcP2yCSg741LaQQ8scehlCHJ7ycgc02V9yRK2d89smhkmLzxTpBvUvNZ4j+bis",
+	"pWyZwAcgA4yotyhSwhi+ilEo32iVJQ0wZ+YESMuPObfStkMRQ9xErkuqzwefmSIfgj4ARVwcJVEXIYtR",
+	"g6nHLGlkl/PXkjUbL7p8P79VNS7f/qZ0PU0ajnCac+tNVOMsxBV463kDoV+mMblxwp/bBT7TCeSdpOQX",
+	"x/aTx+Wiv9hQVzHcfhw/27qNL4KYN1nNil4y8ySvVvdSCtFbChEnuhTi/x7snxwMj0VRxBxzL0URC0UR",
+	"c+IyfOYSR+JPTvFshqhVAFRKiP6I2SXDiywWS+1FmHaBZGrnVqgszKd2/woBuNDpeJgClGQLjegBOFTP",
+	"iCtMoNtJnEUoAlkSI8ZK8MnqUZIQUWTw4jYQtTPFRf2Pja3yWGH0HSV/z6Wy0Q7QKrzuLVtGQv19u60o",
+	"a2sibpb621dePJ2vX3Gk1bjqVbcIsvkVgTTqcDHLmX5anhv9sTFr8oVpvjDNF6a5fqapTp88iqy5/JRg",
+	"C8+IedYDbFnWinz0a6VxW+pmFYo6F1V55M7O0ypI357OXcZBq+O0ui2b6zatJ6G10fC2CoCqJeVzThFc",
+	"SBqRpRmsI6YKm2DEuvKzyluWxWvoNaJbDCVch1rpYtLyDzCHbn3ZfJJQjiIISQyi2kpVFkrz37iy7cNr",
+	"J9R3LDpFkENdHoJxIpi3ml+yfAmPVPKk2qcKBQhzBiAJ0mZCplauCgdMjSCSL1cms+qrKpR1VZGsmDmB",
+	"yQTFMYoG4IJIwWUh08jkcKGYOiqtWRbRo2iC8LUBUxxD8Wl8DBnfkqvbOjocgzmCkT9zzI8S9pfiJBW1",
+	"+jSJlzlGzQbrzcJMnRW5YIW4fMkFvBauZvtWNU74uzc5FDjhaIZoJ4Ym6rqqk7Wl9v0eHK1A2t7MOEWo",
+	"nhOpEfLi5Sgx1zaMrZXBSl7YbPi0ZR48ELVVfhAD/LT8zXuC/zKP1jxbBvViun1upttHqz7SzXSlWMMm",
+	"G6tamVdTMRLTpy3zptX185JisxmP6WJ2GVE4ffRwl0cyEmJ2KUsAGavPyyNTdaZBH0EY7D3cE1PV84kg",
+	"1U88qPcFxMJxMlOma/lsxQ2hVjhgsnmoFNxIVbgTR0viEnOz+nFEJl/A7lhRj3iw41D+8GrcYAZUYxfA",
+	"X8DbY5TM+DzY293ZCXsFdwmk5kYt/9vPh6LRyk8/c7RIhY3TWvvHguTHsviL0tttiwnkMCazDNWCcqGb",
+	"NkPzGLaifj6jzb58CzdjWWfplCBn2tZdrI+RrKaneqoMNTt9z+ozFnebl4qW76qHKAqC1zZepITy+pC7",
+	"CqmYkkYRmWQy0xrdihH03SSffcpHtzqxtDXKhuMBOCGRsuWhaCZ0MxOLN0MJUtec+FisR0tRXrf/aqm9",
+	"OhPxHL0011nohF1Ob5DQ5gGeAkYWpRrbEZG3oMwmV080YQYY5kjqEks5m2BzKBJa4li/gvORHpsRxvZF",
+	"ADuxuVqvEEizqxizufQpcRznY07xrb/0nSHCo4Uuzv8Qx81Mcqh3ThKPO9wSLuJ7DfeoB1fhqvfxVQS/",
+	"medXLanX+XVPWIdEUUuuzd6oPsmfdv6/QManwc5GZ3mWt9B78bd7JpspoK8b8hv2PnZ2Om6+q7GRpJrS",
+	"MjtSVZ/sy2dAWA+WcrmKSPy4hG2TK+uJeyOu1Dr67HWxbkeZAh11EJQTdKPV87LIPCHp0tCmalFwpusH",
+	"FkT/pCwh19/UFrKXQ1VHPhZHz0DVtLBu4sl65CvL4AskNXeXB1mrne+uOSo1wWX25y4BXn5fxfM7rOFL",
+	"Ps2LU/ZJ82mkHVyG8uY2avnS+9hE9TJgI3WL0fR+K/W5afz0ZupvL8ehyki7RuF47Yy1jHx4a58M9Qhh",
+	"AMqX2Qnl8CpG1soZSnugKRFnHsBGJSvl1VKZJBsD9Do/v7n5/L86tRreCrkae77aA4LeBEMRRsfxABQ4",
+	"ifzW4Ak0a3jaiPoHtaN6aXazLQllIFcTxXDCOEw4vq+yZZyTIaAojeHEnF3xbyTcwIgyE7Ccn8W8puMM",
+	"C/eBfgGq5I9o1MiOnAW86GS11vscSwZxxke8wdqZA3VOcLmj/EVRq2ENzXhbjVFoL1s9kzhTDZpvesFA",
+	"8GKRqev+GlFma8SKT/qHwnvfMlPAfMiYckjSTKoMtVxBA/PXtKmv/xD+qtDb+K6h3t4XO0ndhVzBUN1B",
+	"09huPm80S+rP2ihLAKxO5D8Loyx5uRnrH/TMkie2UxYgaChjkyUbb/4vwLjaPaM5fYfqNXnch+lTvHFq",
+	"D4Q+fy+WwZfQ65IZTxNSnQ1Pf34eL7iXqL2nYcseqWdh1rLQthq1Ot29Zrjtr/pfzdnnsMqKSrKvYIWc",
+	"gSRbXCHaxpf+IrEg/uQyjZ+eECvEGajzMdz00devgjBY4AQvskWwt7tSKumjSNKFEBBDL5sfrJJjfU1n",
+	"ajvC02n7NZ8Q4Vgw3nkGYBSJHHCKFuRaJ4HLNHYZPAotQgUeIFUviUBwBVlJ3XR/AZFjDhVzphRdY5Ix",
+	"00D5V6RjxfbBrDhHAtAi5Tn/aVJPNcYOBQJejvoDHPWw89Bi7/Q+qgKnjA9qrnNBMrXZ6xvFfgRhNYY9",
+	"4On0mfAgL6hrZEKUxPEVnHzpaNiCHKq7vcwicvuW/SGJtDEcOUYxndw0AMNb7eKywosgwURW0V2QCE9x",
+	"c/C6uWkM/C985JmKDI1Kv97dDfdFVcGUt26n0yoGkoVrFNVmNA72gm2Y4u3r3eDuj7v/OwDFmOUThbkB",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+)
+
+// requestValidator returns a middleware validating the requests against the
+// OpenAPI spec the server is generated from, so the handlers only get the
+// query parameters and bodies their schema describes, such as the inputs
+// matching the type of a raybot command.
+func (s HTTPService) requestValidator() (func(http.Handler) http.Handler, error) {
+	spec, err := gen.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("get openapi spec: %w", err)
+	}
+
+	router, err := legacy.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("new openapi router: %w", err)
+	}
+
+	opts := &openapi3filter.Options{
+		MultiError: true,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				// The routes missing from the spec are answered by the
+				// router, with 404 or 405.
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    opts,
+			}); err != nil {
+				s.handleRequestError(w, r, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
@@ -0,0 +1,82 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+)
+
+func TestRequestValidator(t *testing.T) {
+	const raybotID = "123e4567-e89b-12d3-a456-426614174000"
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		wantDetails []gen.FieldError
+	}{
+		{
+			name:   "Body not matching the command type",
+			method: http.MethodPost,
+			path:   "/api/v1/raybots/" + raybotID + "/commands",
+			body:   `{"type": "LIFT_BOX", "inputs": {"distance": 6000}}`,
+			wantDetails: []gen.FieldError{
+				{Field: "inputs.distance", Message: "number must be at most 5000"},
+			},
+		},
+		{
+			name:   "Query parameter out of range",
+			method: http.MethodGet,
+			path:   "/api/v1/raybots/" + raybotID + "/commands?page=0",
+			wantDetails: []gen.FieldError{
+				{Field: "page", Message: "number must be at least 1"},
+			},
+		},
+		{
+			name:   "Query parameter of the wrong type",
+			method: http.MethodGet,
+			path:   "/api/v1/raybots/" + raybotID + "/commands?count=maybe",
+			wantDetails: []gen.FieldError{
+				{Field: "count", Message: "value maybe: an invalid boolean: invalid syntax"},
+			},
+		},
+		{
+			name:   "Several invalid query parameters",
+			method: http.MethodGet,
+			path:   "/api/v1/raybots/" + raybotID + "/commands?page=0&pageSize=0",
+			wantDetails: []gen.FieldError{
+				{Field: "page", Message: "number must be at least 1"},
+				{Field: "pageSize", Message: "number must be at least 1"},
+			},
+		},
+	}
+
+	srv := newTestServer(t, fakeService{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, srv.URL+tt.path, strings.NewReader(tt.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+			var errRes gen.ErrorResponse
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+			assert.Equal(t, "validationError", errRes.Code)
+			require.NotNil(t, errRes.Details)
+			assert.Equal(t, tt.wantDetails, *errRes.Details)
+		})
+	}
+}
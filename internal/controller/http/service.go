@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	httphandler "github.com/tuanvumaihuynh/roboflow/internal/controller/http/handler"
 	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
@@ -25,6 +26,11 @@ type HTTPService struct {
 	config  config.HTTPServerConfig
 	service service.Service
 	log     *slog.Logger
+
+	// shutdownCtx is done when the server starts shutting down, so that
+	// event streams end instead of blocking the shutdown.
+	shutdownCtx context.Context
+	shutdown    context.CancelFunc
 }
 
 func NewHTTPService(
@@ -32,16 +38,30 @@ func NewHTTPService(
 	service service.Service,
 	log *slog.Logger,
 ) *HTTPService {
+	shutdownCtx, shutdown := context.WithCancel(context.Background())
 	return &HTTPService{
-		config:  config,
-		service: service,
-		log:     log.With(slog.String("service", "http_service")),
+		config:      config,
+		service:     service,
+		log:         log.With(slog.String("service", "http_service")),
+		shutdownCtx: shutdownCtx,
+		shutdown:    shutdown,
 	}
 }
 
 type CleanupFunc func(ctx context.Context) error
 
 func (s HTTPService) Run() (CleanupFunc, error) {
+	r, err := s.Router()
+	if err != nil {
+		return nil, fmt.Errorf("router: %w", err)
+	}
+
+	return s.RunWithServer(r)
+}
+
+// Router returns the router serving the API, and the swagger and metrics
+// handlers when they are enabled.
+func (s HTTPService) Router() (chi.Router, error) {
 	r := chi.NewRouter()
 
 	s.registerMiddlewares(r)
@@ -50,9 +70,15 @@ func (s HTTPService) Run() (CleanupFunc, error) {
 		s.registerSwaggerHandler(r)
 	}
 
-	s.registerAPIHandler(r)
+	if s.config.EnableMetrics {
+		s.registerMetricsHandler(r)
+	}
 
-	return s.RunWithServer(r)
+	if err := s.registerAPIHandler(r); err != nil {
+		return nil, fmt.Errorf("register API handler: %w", err)
+	}
+
+	return r, nil
 }
 
 func (s HTTPService) RunWithServer(r chi.Router) (CleanupFunc, error) {
@@ -68,6 +94,7 @@ func (s HTTPService) RunWithServer(r chi.Router) (CleanupFunc, error) {
 		Handler:           r,
 		ReadHeaderTimeout: 10 * time.Second,
 	}
+	srv.RegisterOnShutdown(s.shutdown)
 
 	go func() {
 		s.log.Info(fmt.Sprintf("starting HTTP server at %s", srv.Addr))
@@ -89,8 +116,13 @@ func (s HTTPService) RunWithServer(r chi.Router) (CleanupFunc, error) {
 	return cleanup, nil
 }
 
-func (s HTTPService) registerAPIHandler(r chi.Router) {
-	apiHandler := httphandler.NewAPIHandler(s.service)
+func (s HTTPService) registerAPIHandler(r chi.Router) error {
+	validateRequest, err := s.requestValidator()
+	if err != nil {
+		return fmt.Errorf("request validator: %w", err)
+	}
+
+	apiHandler := httphandler.NewAPIHandler(s.shutdownCtx, s.service)
 	strictAPIHandler := gen.NewStrictHandlerWithOptions(
 		apiHandler,
 		[]gen.StrictMiddlewareFunc{},
@@ -100,7 +132,12 @@ func (s HTTPService) registerAPIHandler(r chi.Router) {
 		},
 	)
 
-	gen.HandlerFromMuxWithBaseURL(strictAPIHandler, r, "/api/v1")
+	r.Group(func(r chi.Router) {
+		r.Use(validateRequest)
+		gen.HandlerFromMuxWithBaseURL(strictAPIHandler, r, "/api/v1")
+	})
+
+	return nil
 }
 
 func (s HTTPService) registerMiddlewares(r chi.Router) {
@@ -113,3 +150,8 @@ func (s HTTPService) registerMiddlewares(r chi.Router) {
 func (s HTTPService) registerSwaggerHandler(r chi.Router) {
 	swagger.Register(r, "/docs/openapi.yml")
 }
+
+// registerMetricsHandler serves the Prometheus metrics of the process.
+func (s HTTPService) registerMetricsHandler(r chi.Router) {
+	r.Handle("/metrics", promhttp.Handler())
+}
@@ -0,0 +1,88 @@
+package http_test
+
+import (
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	httpcontroller "github.com/tuanvumaihuynh/roboflow/internal/controller/http"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+// fakeService serves the services a test sets, the others are nil.
+type fakeService struct {
+	workflowSvc      service.WorkflowService
+	raybotCommandSvc service.RaybotCommandService
+}
+
+func (s fakeService) QRLocation() service.QRLocationService {
+	return nil
+}
+
+func (s fakeService) TrackMap() service.TrackMapService {
+	return nil
+}
+
+func (s fakeService) Route() service.RouteService {
+	return nil
+}
+
+func (s fakeService) Raybot() service.RaybotService {
+	return nil
+}
+
+func (s fakeService) RaybotCommand() service.RaybotCommandService {
+	return s.raybotCommandSvc
+}
+
+func (s fakeService) RaybotPosition() service.RaybotPositionService {
+	return nil
+}
+
+func (s fakeService) Workflow() service.WorkflowService {
+	return s.workflowSvc
+}
+
+func (s fakeService) WorkflowVersion() service.WorkflowVersionService {
+	return nil
+}
+
+func (s fakeService) WorkflowExecution() service.WorkflowExecutionService {
+	return nil
+}
+
+func (s fakeService) StepExecution() service.StepExecutionService {
+	return nil
+}
+
+func (s fakeService) Outbox() service.OutboxService {
+	return nil
+}
+
+func (s fakeService) Webhook() service.WebhookService {
+	return nil
+}
+
+func (s fakeService) DeadLetter() service.DeadLetterService {
+	return nil
+}
+
+func (s fakeService) Retention() service.RetentionService {
+	return nil
+}
+
+// newTestServer serves the API of svc.
+func newTestServer(t *testing.T, svc service.Service) *httptest.Server {
+	t.Helper()
+
+	r, err := httpcontroller.NewHTTPService(config.HTTPServerConfig{}, svc, slog.Default()).Router()
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
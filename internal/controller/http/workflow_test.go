@@ -0,0 +1,73 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/http/oas/gen"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+)
+
+type fakeWorkflowService struct {
+	service.WorkflowService
+}
+
+func (fakeWorkflowService) ImportWorkflow(_ context.Context, params service.ImportWorkflowParams) (service.ImportWorkflowResult, error) {
+	return service.ImportWorkflowResult{
+		Workflow:           workflow.NewWorkflow(params.Document.Name, nil, true, params.Document.Data),
+		MissingQRLocations: []string{},
+	}, nil
+}
+
+func TestWorkflowImport(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "Valid document",
+			data:       `{"nodes": [], "edges": [], "position": [0, 0], "zoom": 1}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Data not matching the workflow data",
+			data:       `{"nodes": [], "edges": [], "position": [0, 0], "zoom": 1e300}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "validationFailed",
+		},
+	}
+
+	srv := newTestServer(t, fakeService{workflowSvc: fakeWorkflowService{}})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{
+				"formatVersion": 1,
+				"name": "Deliver",
+				"exportedAt": "2026-10-19T00:00:00Z",
+				"qrLocations": [],
+				"data": ` + tt.data + `
+			}`
+			res, err := http.Post(srv.URL+"/api/v1/workflows/import", "application/json", strings.NewReader(body))
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, res.StatusCode)
+			if tt.wantCode != "" {
+				var errRes gen.ErrorResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+				assert.Equal(t, tt.wantCode, errRes.Code)
+				assert.Contains(t, errRes.Message, "Invalid JSON document")
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package outbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	pendingMessages = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "roboflow",
+		Subsystem: "outbox",
+		Name:      "pending_messages",
+		Help:      "Number of outbox messages not yet published.",
+	})
+	lagSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "roboflow",
+		Subsystem: "outbox",
+		Name:      "lag_seconds",
+		Help:      "Age of the oldest outbox message not yet published.",
+	})
+	publishedMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "roboflow",
+		Subsystem: "outbox",
+		Name:      "published_messages_total",
+		Help:      "Number of outbox messages published by the relay.",
+	})
+	deletedMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "roboflow",
+		Subsystem: "outbox",
+		Name:      "deleted_messages_total",
+		Help:      "Number of published outbox messages deleted after the retention.",
+	})
+	relayErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "roboflow",
+		Subsystem: "outbox",
+		Name:      "relay_errors_total",
+		Help:      "Number of failed relay runs.",
+	})
+)
@@ -0,0 +1,113 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+// RelayService publishes the messages written to the outbox by the services,
+// and deletes them once they are past the retention. Running it on several
+// instances is safe, each message batch is locked by a single relay.
+type RelayService struct {
+	config  config.OutboxConfig
+	service service.Service
+	log     *slog.Logger
+}
+
+func NewRelayService(
+	config config.OutboxConfig,
+	service service.Service,
+	log *slog.Logger,
+) *RelayService {
+	return &RelayService{
+		config:  config,
+		service: service,
+		log:     log.With(slog.String("service", "outbox_relay_service")),
+	}
+}
+
+type CleanupFunc func() error
+
+func (s RelayService) Run() (CleanupFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		s.runEvery(ctx, s.config.RelayInterval, s.relay)
+	}()
+	go func() {
+		defer wg.Done()
+		s.runEvery(ctx, s.config.CleanupInterval, s.cleanup)
+	}()
+	s.log.Info("starting outbox relay")
+
+	cleanup := func() error {
+		cancel()
+		wg.Wait()
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (s RelayService) runEvery(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
+
+// relay publishes batches of messages until the outbox is drained, then
+// updates the metrics.
+func (s RelayService) relay(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := s.service.Outbox().RelayOutboxMessages(ctx, service.RelayOutboxMessagesParams{
+			BatchSize: s.config.RelayBatchSize,
+		})
+		publishedMessages.Add(float64(n))
+		if err != nil {
+			relayErrors.Inc()
+			s.log.Error("error relaying outbox messages", slog.Any("error", err))
+			break
+		}
+		if n < int(s.config.RelayBatchSize) {
+			break
+		}
+	}
+
+	stats, err := s.service.Outbox().GetOutboxStats(ctx)
+	if err != nil {
+		s.log.Error("error getting outbox stats", slog.Any("error", err))
+		return
+	}
+	pendingMessages.Set(float64(stats.PendingCount))
+	lagSeconds.Set(stats.Lag.Seconds())
+}
+
+func (s RelayService) cleanup(ctx context.Context) {
+	n, err := s.service.Outbox().CleanupOutboxMessages(ctx, service.CleanupOutboxMessagesParams{
+		PublishedBefore: time.Now().Add(-s.config.Retention),
+	})
+	if err != nil {
+		s.log.Error("error cleaning up outbox messages", slog.Any("error", err))
+		return
+	}
+	deletedMessages.Add(float64(n))
+	if n > 0 {
+		s.log.Debug("deleted published outbox messages", slog.Int64("count", n))
+	}
+}
@@ -0,0 +1,27 @@
+package retention
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	purgedRows = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "roboflow",
+		Subsystem: "retention",
+		Name:      "purged_rows_total",
+		Help:      "Number of rows purged after the retention, by table.",
+	}, []string{"table"})
+	purgeErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "roboflow",
+		Subsystem: "retention",
+		Name:      "purge_errors_total",
+		Help:      "Number of failed purge batches.",
+	})
+	lastPurgeTimestamp = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "roboflow",
+		Subsystem: "retention",
+		Name:      "last_purge_timestamp_seconds",
+		Help:      "Time the last purge finished, in seconds since the epoch.",
+	})
+)
@@ -0,0 +1,166 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/retention"
+	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+// PurgerService purges the workflow executions and raybot commands past
+// their retention, a batch at a time until none is left. Running it on
+// several instances is safe, each batch is locked by a single purger.
+type PurgerService struct {
+	config  config.RetentionConfig
+	service service.Service
+	log     *slog.Logger
+}
+
+func NewPurgerService(
+	config config.RetentionConfig,
+	service service.Service,
+	log *slog.Logger,
+) *PurgerService {
+	return &PurgerService{
+		config:  config,
+		service: service,
+		log:     log.With(slog.String("service", "retention_purger_service")),
+	}
+}
+
+type CleanupFunc func() error
+
+func (s PurgerService) Run() (CleanupFunc, error) {
+	if err := validateConfig(s.config); err != nil {
+		return nil, fmt.Errorf("validate config: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(s.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.purge(ctx)
+			}
+		}
+	}()
+	s.log.Info("starting retention purger")
+
+	cleanup := func() error {
+		cancel()
+		<-done
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// validateConfig checks that the retentions are set on finished statuses,
+// the others are rejected by every purge.
+func validateConfig(conf config.RetentionConfig) error {
+	if conf.Interval <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	if conf.BatchSize < 1 || conf.BatchSize > 10000 {
+		return fmt.Errorf("batch size must be between 1 and 10000")
+	}
+	for status, maxAge := range conf.WorkflowExecutions {
+		if !workflowexecution.Status(status).IsFinished() {
+			return fmt.Errorf("workflow execution status %s is not a finished status", status)
+		}
+		if maxAge <= 0 {
+			return fmt.Errorf("retention of workflow execution status %s must be positive", status)
+		}
+	}
+	for status, maxAge := range conf.RaybotCommands {
+		if !raybotcommand.Status(status).IsFinished() {
+			return fmt.Errorf("raybot command status %s is not a finished status", status)
+		}
+		if maxAge <= 0 {
+			return fmt.Errorf("retention of raybot command status %s must be positive", status)
+		}
+	}
+
+	return nil
+}
+
+// purge purges the history past the retention of each status.
+func (s PurgerService) purge(ctx context.Context) {
+	now := time.Now()
+
+	for _, status := range slices.Sorted(maps.Keys(s.config.WorkflowExecutions)) {
+		params := service.PurgeWorkflowExecutionsParams{
+			Status:    workflowexecution.Status(status),
+			Before:    now.Add(-s.config.WorkflowExecutions[status]),
+			BatchSize: s.config.BatchSize,
+		}
+		s.purgeBatches(ctx, retention.TableWorkflowExecutions, status, func() (retention.Purged, error) {
+			return s.service.Retention().PurgeWorkflowExecutions(ctx, params)
+		})
+	}
+
+	for _, status := range slices.Sorted(maps.Keys(s.config.RaybotCommands)) {
+		params := service.PurgeRaybotCommandsParams{
+			Status:    raybotcommand.Status(status),
+			Before:    now.Add(-s.config.RaybotCommands[status]),
+			BatchSize: s.config.BatchSize,
+		}
+		s.purgeBatches(ctx, retention.TableRaybotCommands, status, func() (retention.Purged, error) {
+			return s.service.Retention().PurgeRaybotCommands(ctx, params)
+		})
+	}
+
+	if ctx.Err() == nil {
+		lastPurgeTimestamp.SetToCurrentTime()
+	}
+}
+
+// purgeBatches purges batches of the table until a batch is not full,
+// pausing between two batches.
+func (s PurgerService) purgeBatches(ctx context.Context, table, status string, purgeBatch func() (retention.Purged, error)) {
+	var total int64
+	for ctx.Err() == nil {
+		purged, err := purgeBatch()
+		if err != nil {
+			purgeErrors.Inc()
+			s.log.Error("error purging history",
+				slog.String("table", table), slog.String("status", status), slog.Any("error", err))
+			return
+		}
+		for t, n := range purged {
+			purgedRows.WithLabelValues(t).Add(float64(n))
+		}
+		total += purged[table]
+
+		if purged[table] < int64(s.config.BatchSize) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.config.BatchPause):
+		}
+	}
+
+	if total > 0 {
+		s.log.Info("purged history",
+			slog.String("table", table), slog.String("status", status), slog.Int64("count", total))
+	}
+}
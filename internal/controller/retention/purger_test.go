@@ -0,0 +1,174 @@
+package retention_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/controller/retention"
+	retentionmodel "github.com/tuanvumaihuynh/roboflow/internal/model/retention"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+// fakeService serves the retention service, the others are nil.
+type fakeService struct {
+	service.Service
+	retentionSvc service.RetentionService
+}
+
+func (s fakeService) Retention() service.RetentionService {
+	return s.retentionSvc
+}
+
+// fakeRetentionService returns the batches in order, then empty batches. It
+// records the Before of each call, which is the same for the calls of a purge.
+type fakeRetentionService struct {
+	service.RetentionService
+	batches []int64
+	err     error
+
+	mu      sync.Mutex
+	befores []time.Time
+}
+
+func (s *fakeRetentionService) PurgeWorkflowExecutions(
+	_ context.Context,
+	params service.PurgeWorkflowExecutionsParams,
+) (retentionmodel.Purged, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	call := len(s.befores)
+	s.befores = append(s.befores, params.Before)
+	if s.err != nil {
+		return nil, s.err
+	}
+	if call >= len(s.batches) {
+		return retentionmodel.Purged{}, nil
+	}
+	return retentionmodel.Purged{retentionmodel.TableWorkflowExecutions: s.batches[call]}, nil
+}
+
+// callsOfFirstPurge returns the number of calls made by the first purge.
+func (s *fakeRetentionService) callsOfFirstPurge() (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, before := range s.befores {
+		if !before.Equal(s.befores[0]) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func TestPurgerServiceRun(t *testing.T) {
+	tests := []struct {
+		name               string
+		workflowExecutions map[string]time.Duration
+		raybotCommands     map[string]time.Duration
+		expectErr          bool
+		errMessage         string
+	}{
+		{
+			name:               "Finished statuses",
+			workflowExecutions: map[string]time.Duration{"COMPLETED": 168 * time.Hour, "FAILED": 720 * time.Hour},
+			raybotCommands:     map[string]time.Duration{"SUCCEEDED": 168 * time.Hour},
+		},
+		{
+			name:               "Unfinished workflow execution status",
+			workflowExecutions: map[string]time.Duration{"RUNNING": 24 * time.Hour},
+			expectErr:          true,
+			errMessage:         "workflow execution status RUNNING is not a finished status",
+		},
+		{
+			name:           "Mistyped raybot command status",
+			raybotCommands: map[string]time.Duration{"SUCCEDED": 24 * time.Hour},
+			expectErr:      true,
+			errMessage:     "raybot command status SUCCEDED is not a finished status",
+		},
+		{
+			name:               "Zero retention",
+			workflowExecutions: map[string]time.Duration{"COMPLETED": 0},
+			expectErr:          true,
+			errMessage:         "retention of workflow execution status COMPLETED must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := retention.NewPurgerService(config.RetentionConfig{
+				Interval:           time.Hour,
+				BatchSize:          500,
+				WorkflowExecutions: tt.workflowExecutions,
+				RaybotCommands:     tt.raybotCommands,
+			}, nil, slog.Default())
+
+			cleanup, err := svc.Run()
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, cleanup())
+			}
+		})
+	}
+}
+
+func TestPurgerServicePurgeBatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		batches   []int64
+		err       error
+		wantCalls int
+	}{
+		{
+			name:      "Stops on a short batch",
+			batches:   []int64{500, 500, 20},
+			wantCalls: 3,
+		},
+		{
+			name:      "Stops on an empty batch",
+			batches:   []int64{500},
+			wantCalls: 2,
+		},
+		{
+			name:      "Stops on an error",
+			batches:   []int64{500, 500},
+			err:       errors.New("connection lost"),
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retentionSvc := &fakeRetentionService{batches: tt.batches, err: tt.err}
+			svc := retention.NewPurgerService(config.RetentionConfig{
+				Interval:           20 * time.Millisecond,
+				BatchSize:          500,
+				BatchPause:         time.Millisecond,
+				WorkflowExecutions: map[string]time.Duration{"COMPLETED": time.Hour},
+			}, fakeService{retentionSvc: retentionSvc}, slog.Default())
+
+			cleanup, err := svc.Run()
+			require.NoError(t, err)
+			t.Cleanup(func() { require.NoError(t, cleanup()) })
+
+			var calls int
+			require.Eventually(t, func() bool {
+				var ok bool
+				calls, ok = retentionSvc.callsOfFirstPurge()
+				return ok
+			}, time.Second, 5*time.Millisecond)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+// leaseMargin is added to the request timeout to lease the deliveries being
+// sent, so they are recorded before another instance claims them again.
+const leaseMargin = 30 * time.Second
+
+// DeliveryService turns the domain events into deliveries to the webhook
+// subscriptions, and sends the deliveries due for an attempt. Running it on
+// several instances is safe, each delivery is claimed by a single instance.
+type DeliveryService struct {
+	config     config.WebhookConfig
+	subscriber message.Subscriber
+	publisher  message.Publisher
+	service    service.Service
+	log        *slog.Logger
+}
+
+// NewDeliveryService creates a DeliveryService, the events it fails to
+// dispatch are published to the dead-letter topic with publisher.
+func NewDeliveryService(
+	config config.WebhookConfig,
+	subscriber message.Subscriber,
+	publisher message.Publisher,
+	service service.Service,
+	log *slog.Logger,
+) *DeliveryService {
+	return &DeliveryService{
+		config:     config,
+		subscriber: subscriber,
+		publisher:  publisher,
+		service:    service,
+		log:        log.With(slog.String("service", "webhook_delivery_service")),
+	}
+}
+
+type CleanupFunc func() error
+
+func (s DeliveryService) Run() (CleanupFunc, error) {
+	router, err := pubsub.NewRouter(s.log, pubsub.WithPoisonQueue(s.publisher))
+	if err != nil {
+		return nil, fmt.Errorf("error creating router: %w", err)
+	}
+
+	for _, event := range pubsub.Catalogue {
+		router.AddNoPublisherHandler(
+			"dispatch_webhook_"+event.EventType(),
+			event.EventType(),
+			s.subscriber,
+			s.handleEvent,
+		)
+	}
+
+	go func() {
+		s.log.Info("starting webhook dispatcher")
+		if err := router.Run(context.Background()); err != nil {
+			s.log.Error("error running webhook dispatcher", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}()
+	<-router.Running()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.runDeliveries(ctx)
+	}()
+	s.log.Info("starting webhook delivery")
+
+	cleanup := func() error {
+		cancel()
+		wg.Wait()
+
+		if err := router.Close(); err != nil {
+			s.log.Error("error closing webhook dispatcher", slog.Any("error", err))
+			return err
+		}
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (s DeliveryService) handleEvent(msg *message.Message) error {
+	env, err := pubsub.UnmarshalEnvelope(msg)
+	if err != nil {
+		return fmt.Errorf("unmarshal envelope: %w", err)
+	}
+
+	// The whole envelope is the body of the webhook request
+	if err := s.service.Webhook().DispatchWebhookEvent(msg.Context(), service.DispatchWebhookEventParams{
+		EventID:   env.ID,
+		EventType: env.Type,
+		Payload:   msg.Payload,
+	}); err != nil {
+		return fmt.Errorf("dispatch webhook event: %w", err)
+	}
+
+	return nil
+}
+
+func (s DeliveryService) runDeliveries(ctx context.Context) {
+	ticker := time.NewTicker(s.config.DeliveryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.deliver(ctx)
+		}
+	}
+}
+
+// deliver sends batches of deliveries until none is due.
+func (s DeliveryService) deliver(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := s.service.Webhook().DeliverWebhooks(ctx, service.DeliverWebhooksParams{
+			BatchSize: s.config.DeliveryBatchSize,
+			Lease:     s.config.Timeout + leaseMargin,
+		})
+		if err != nil {
+			s.log.Error("error delivering webhooks", slog.Any("error", err))
+			return
+		}
+		if n < int(s.config.DeliveryBatchSize) {
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+)
+
+// WorkerService handles the messages that trigger background work, such as
+// running workflow executions.
+//
+//nolint:revive
+type WorkerService struct {
+	subscriber message.Subscriber
+	publisher  message.Publisher
+	service    service.Service
+	log        *slog.Logger
+}
+
+// NewWorkerService creates a WorkerService, the messages it fails to handle
+// are published to the dead-letter topic with publisher.
+func NewWorkerService(
+	subscriber message.Subscriber,
+	publisher message.Publisher,
+	service service.Service,
+	log *slog.Logger,
+) *WorkerService {
+	return &WorkerService{
+		subscriber: subscriber,
+		publisher:  publisher,
+		service:    service,
+		log:        log.With(slog.String("service", "worker_service")),
+	}
+}
+
+type CleanupFunc func() error
+
+func (s WorkerService) Run() (CleanupFunc, error) {
+	router, err := pubsub.NewRouter(s.log, pubsub.WithPoisonQueue(s.publisher))
+	if err != nil {
+		return nil, fmt.Errorf("error creating router: %w", err)
+	}
+
+	router.AddNoPublisherHandler(
+		"process_run_workflow_execution",
+		pubsub.WorkflowExecutionCreatedTopic,
+		s.subscriber,
+		s.handleWorkflowExecutionCreated,
+	)
+
+	go func() {
+		s.log.Info("starting worker")
+		if err := router.Run(context.Background()); err != nil {
+			s.log.Error("error running worker", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}()
+	<-router.Running()
+
+	cleanup := func() error {
+		if err := router.Close(); err != nil {
+			s.log.Error("error closing worker", slog.Any("error", err))
+			return err
+		}
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (s WorkerService) handleWorkflowExecutionCreated(msg *message.Message) error {
+	var ev pubsub.WorkflowExecutionCreated
+	env, err := pubsub.UnmarshalEvent(msg, &ev)
+	if err != nil {
+		return fmt.Errorf("unmarshal event: %w", err)
+	}
+
+	// The message context is cancelled when the ack deadline passes, an
+	// execution can run for much longer. Events of the execution share the
+	// correlation ID of the request that created it.
+	ctx := pubsub.WithCorrelationID(context.WithoutCancel(msg.Context()), env.CorrelationID)
+	if err := s.service.WorkflowExecution().ProcessRunWorkflowExecution(ctx, service.ProcessRunWorkflowExecutionParams{
+		WorkflowExecutionID: ev.WorkflowExecutionID,
+	}); err != nil {
+		return fmt.Errorf("process run workflow execution: %w", err)
+	}
+
+	return nil
+}
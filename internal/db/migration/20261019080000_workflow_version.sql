@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "workflow_versions" (
+    "id" UUID NOT NULL PRIMARY KEY,
+    "workflow_id" UUID NOT NULL,
+    "version" INTEGER NOT NULL,
+    "data" JSON NOT NULL DEFAULT '{}',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+	FOREIGN KEY("workflow_id") REFERENCES "workflows"("id") ON DELETE CASCADE,
+	CONSTRAINT "workflow_versions_workflow_id_version_key" UNIQUE ("workflow_id", "version")
+);
+
+ALTER TABLE "workflows" ADD COLUMN "published_version" INTEGER;
+
+-- The valid workflows published before versioning keep running from
+-- version 1, their current data. The invalid ones become drafts that were
+-- never published, as publishing would refuse them.
+INSERT INTO "workflow_versions" ("id", "workflow_id", "version", "data", "created_at")
+SELECT gen_random_uuid(), "id", 1, "data", "updated_at"
+FROM "workflows"
+WHERE "is_draft" = false AND "is_valid" = true;
+
+UPDATE "workflows" SET "published_version" = 1 WHERE "is_draft" = false AND "is_valid" = true;
+UPDATE "workflows" SET "is_draft" = true WHERE "is_draft" = false AND "is_valid" = false;
+
+ALTER TABLE "workflow_executions" ADD COLUMN "workflow_version_id" UUID
+	REFERENCES "workflow_versions"("id") ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "workflow_executions" DROP COLUMN IF EXISTS "workflow_version_id";
+ALTER TABLE "workflows" DROP COLUMN IF EXISTS "published_version";
+DROP TABLE IF EXISTS "workflow_versions";
+-- +goose StatementEnd
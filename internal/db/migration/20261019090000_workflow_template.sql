@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "workflows" ADD COLUMN "is_template" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "workflows" ADD COLUMN "template_parameters" JSON NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "workflows" DROP COLUMN IF EXISTS "template_parameters";
+ALTER TABLE "workflows" DROP COLUMN IF EXISTS "is_template";
+-- +goose StatementEnd
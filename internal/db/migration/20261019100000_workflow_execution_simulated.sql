@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "workflow_executions" ADD COLUMN "is_simulated" BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "workflow_executions" DROP COLUMN IF EXISTS "is_simulated";
+-- +goose StatementEnd
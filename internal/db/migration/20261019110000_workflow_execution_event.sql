@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "workflow_execution_events" (
+    "id" BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    "workflow_execution_id" UUID NOT NULL,
+    "step_execution_id" UUID,
+    "type" TEXT NOT NULL,
+    "status" TEXT NOT NULL,
+	"outputs" JSON,
+	"error" TEXT,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+	FOREIGN KEY("workflow_execution_id") REFERENCES "workflow_executions"("id") ON DELETE CASCADE
+);
+
+CREATE INDEX ON "workflow_execution_events" ("workflow_execution_id", "id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "workflow_execution_events";
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "outbox_messages" (
+    "id" BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    "message_id" TEXT NOT NULL,
+    "topic" TEXT NOT NULL,
+    "payload" BYTEA NOT NULL,
+    "metadata" JSONB NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "published_at" TIMESTAMPTZ
+);
+
+CREATE INDEX ON "outbox_messages" ("id") WHERE "published_at" IS NULL;
+CREATE INDEX ON "outbox_messages" ("published_at") WHERE "published_at" IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "outbox_messages";
+-- +goose StatementEnd
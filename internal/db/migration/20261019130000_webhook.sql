@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "webhook_subscriptions" (
+    "id" UUID NOT NULL PRIMARY KEY,
+    "url" TEXT NOT NULL,
+    "secret" TEXT NOT NULL,
+    "event_types" TEXT[] NOT NULL,
+    "is_active" BOOLEAN NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE "webhook_deliveries" (
+    "id" UUID NOT NULL PRIMARY KEY,
+    "subscription_id" UUID NOT NULL REFERENCES "webhook_subscriptions" ("id") ON DELETE CASCADE,
+    "event_id" TEXT NOT NULL,
+    "event_type" TEXT NOT NULL,
+    "payload" BYTEA NOT NULL,
+    "status" TEXT NOT NULL,
+    "attempts" INT NOT NULL,
+    "next_attempt_at" TIMESTAMPTZ NOT NULL,
+    "last_attempt_at" TIMESTAMPTZ,
+    "last_response_status" INT,
+    "last_error" TEXT,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE ("subscription_id", "event_id")
+);
+
+CREATE INDEX ON "webhook_deliveries" ("next_attempt_at") WHERE "status" = 'PENDING';
+CREATE INDEX ON "webhook_deliveries" ("subscription_id", "created_at");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "webhook_deliveries";
+DROP TABLE IF EXISTS "webhook_subscriptions";
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "dead_letter_messages" (
+    "id" UUID NOT NULL PRIMARY KEY,
+    "message_id" TEXT NOT NULL,
+    "topic" TEXT NOT NULL,
+    "handler" TEXT NOT NULL,
+    "subscriber" TEXT NOT NULL,
+    "reason" TEXT NOT NULL,
+    "payload" BYTEA NOT NULL,
+    "metadata" JSONB NOT NULL,
+    "replay_count" INT NOT NULL DEFAULT 0,
+    "last_replayed_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX ON "dead_letter_messages" ("created_at");
+CREATE INDEX ON "dead_letter_messages" ("topic", "created_at");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "dead_letter_messages";
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "track_segments" (
+    "id" UUID NOT NULL PRIMARY KEY,
+    "from_location_id" UUID NOT NULL REFERENCES "qr_locations" ("id") ON DELETE CASCADE,
+    "to_location_id" UUID NOT NULL REFERENCES "qr_locations" ("id") ON DELETE CASCADE,
+    "distance" INT NOT NULL CHECK ("distance" > 0),
+    "direction" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT "track_segments_from_to_key" UNIQUE ("from_location_id", "to_location_id"),
+    CHECK ("from_location_id" <> "to_location_id")
+);
+
+CREATE INDEX ON "track_segments" ("to_location_id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "track_segments";
+-- +goose StatementEnd
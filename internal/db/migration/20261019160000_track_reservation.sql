@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "track_reservations" (
+    "command_id" UUID NOT NULL PRIMARY KEY REFERENCES "raybot_commands" ("id") ON DELETE CASCADE,
+    "raybot_id" UUID NOT NULL REFERENCES "raybots" ("id") ON DELETE CASCADE,
+    "to_location_id" UUID NOT NULL REFERENCES "qr_locations" ("id") ON DELETE CASCADE,
+    "status" TEXT NOT NULL,
+    "location_ids" UUID[] NOT NULL DEFAULT '{}',
+    "segment_ids" UUID[] NOT NULL DEFAULT '{}',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX ON "track_reservations" ("raybot_id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "track_reservations";
+-- +goose StatementEnd
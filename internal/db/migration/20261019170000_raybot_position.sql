@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "raybot_positions" (
+    "id" UUID NOT NULL,
+    "raybot_id" UUID NOT NULL PRIMARY KEY REFERENCES "raybots" ("id") ON DELETE CASCADE,
+    "location_id" UUID NOT NULL REFERENCES "qr_locations" ("id") ON DELETE CASCADE,
+    "source" TEXT NOT NULL,
+    "command_id" UUID REFERENCES "raybot_commands" ("id") ON DELETE SET NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX ON "raybot_positions" ("location_id");
+
+CREATE TABLE "raybot_position_history" (
+    "id" UUID NOT NULL PRIMARY KEY,
+    "raybot_id" UUID NOT NULL REFERENCES "raybots" ("id") ON DELETE CASCADE,
+    "location_id" UUID NOT NULL REFERENCES "qr_locations" ("id") ON DELETE CASCADE,
+    "source" TEXT NOT NULL,
+    "command_id" UUID REFERENCES "raybot_commands" ("id") ON DELETE SET NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX ON "raybot_position_history" ("raybot_id", "created_at");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "raybot_position_history";
+DROP TABLE IF EXISTS "raybot_positions";
+-- +goose StatementEnd
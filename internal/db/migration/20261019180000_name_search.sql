@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX "workflows_name_search_idx" ON "workflows" USING GIN (to_tsvector('simple', "name"));
+CREATE INDEX "qr_locations_name_search_idx" ON "qr_locations" USING GIN (to_tsvector('simple', "name"));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "qr_locations_name_search_idx";
+DROP INDEX IF EXISTS "workflows_name_search_idx";
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX "raybot_commands_raybot_id_created_at_idx" ON "raybot_commands" ("raybot_id", "created_at", "id");
+CREATE INDEX "workflow_executions_workflow_id_created_at_idx" ON "workflow_executions" ("workflow_id", "created_at", "id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "workflow_executions_workflow_id_created_at_idx";
+DROP INDEX IF EXISTS "raybot_commands_raybot_id_created_at_idx";
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "workflow_executions" ADD COLUMN "trigger_type" TEXT NOT NULL DEFAULT 'ON_DEMAND';
+
+UPDATE "workflow_executions" we
+SET "trigger_type" = n->'data'->>'trigger_type'
+FROM json_array_elements(we."data"->'nodes') n
+WHERE n->>'type' = 'TRIGGER' AND n->'data'->>'trigger_type' IS NOT NULL;
+
+CREATE INDEX "workflow_executions_created_at_idx" ON "workflow_executions" ("created_at", "id");
+CREATE INDEX "workflow_executions_status_idx" ON "workflow_executions" ("status");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "workflow_executions_status_idx";
+DROP INDEX IF EXISTS "workflow_executions_created_at_idx";
+ALTER TABLE "workflow_executions" DROP COLUMN IF EXISTS "trigger_type";
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX "step_executions_workflow_execution_id_created_at_idx" ON "step_executions" ("workflow_execution_id", "created_at", "id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "step_executions_workflow_execution_id_created_at_idx";
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "workflow_executions" ADD COLUMN "raybot_id" UUID REFERENCES "raybots"("id") ON DELETE SET NULL;
+
+UPDATE "workflow_executions" we
+SET "raybot_id" = r."id"
+FROM "raybots" r
+WHERE r."id"::TEXT = (
+	SELECT we."inputs"->>(v->>'key')
+	FROM json_array_elements(we."data"->'nodes') n,
+		json_array_elements(n->'data'->'runtime_variables') WITH ORDINALITY AS rv(v, ord)
+	WHERE n->>'type' = 'TRIGGER'
+		AND v->>'input_type' = 'RAYBOT'
+		AND we."inputs"->>(v->>'key') IS NOT NULL
+	ORDER BY ord
+	LIMIT 1
+);
+
+CREATE INDEX "workflow_executions_raybot_id_idx" ON "workflow_executions" ("raybot_id", "created_at", "id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "workflow_executions_raybot_id_idx";
+ALTER TABLE "workflow_executions" DROP COLUMN IF EXISTS "raybot_id";
+-- +goose StatementEnd
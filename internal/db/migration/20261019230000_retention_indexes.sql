@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX "workflow_executions_status_expired_at_idx" ON "workflow_executions" ("status", (COALESCE("completed_at", "updated_at")));
+CREATE INDEX "raybot_commands_status_expired_at_idx" ON "raybot_commands" ("status", (COALESCE("completed_at", "updated_at")));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "raybot_commands_status_expired_at_idx";
+DROP INDEX IF EXISTS "workflow_executions_status_expired_at_idx";
+-- +goose StatementEnd
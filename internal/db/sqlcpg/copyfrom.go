@@ -9,6 +9,43 @@ import (
 	"context"
 )
 
+// iteratorForQRLocationBatchInsert implements pgx.CopyFromSource.
+type iteratorForQRLocationBatchInsert struct {
+	rows                 []QRLocationBatchInsertParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForQRLocationBatchInsert) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForQRLocationBatchInsert) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].Name,
+		r.rows[0].QrCode,
+		r.rows[0].Metadata,
+		r.rows[0].CreatedAt,
+		r.rows[0].UpdatedAt,
+	}, nil
+}
+
+func (r iteratorForQRLocationBatchInsert) Err() error {
+	return nil
+}
+
+func (q *Queries) QRLocationBatchInsert(ctx context.Context, db DBTX, arg []QRLocationBatchInsertParams) (int64, error) {
+	return db.CopyFrom(ctx, []string{"qr_locations"}, []string{"id", "name", "qr_code", "metadata", "created_at", "updated_at"}, &iteratorForQRLocationBatchInsert{rows: arg})
+}
+
 // iteratorForStepExecutionBatchInsert implements pgx.CopyFromSource.
 type iteratorForStepExecutionBatchInsert struct {
 	rows                 []StepExecutionBatchInsertParams
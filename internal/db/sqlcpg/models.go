@@ -9,6 +9,30 @@ import (
 	"time"
 )
 
+type DeadLetterMessage struct {
+	ID             string          `json:"id"`
+	MessageID      string          `json:"message_id"`
+	Topic          string          `json:"topic"`
+	Handler        string          `json:"handler"`
+	Subscriber     string          `json:"subscriber"`
+	Reason         string          `json:"reason"`
+	Payload        []byte          `json:"payload"`
+	Metadata       json.RawMessage `json:"metadata"`
+	ReplayCount    int32           `json:"replay_count"`
+	LastReplayedAt *time.Time      `json:"last_replayed_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type OutboxMessage struct {
+	ID          int64           `json:"id"`
+	MessageID   string          `json:"message_id"`
+	Topic       string          `json:"topic"`
+	Payload     []byte          `json:"payload"`
+	Metadata    json.RawMessage `json:"metadata"`
+	CreatedAt   time.Time       `json:"created_at"`
+	PublishedAt *time.Time      `json:"published_at"`
+}
+
 type QrLocation struct {
 	ID        string          `json:"id"`
 	Name      string          `json:"name"`
@@ -42,6 +66,24 @@ type RaybotCommand struct {
 	CompletedAt *time.Time      `json:"completed_at"`
 }
 
+type RaybotPosition struct {
+	ID         string    `json:"id"`
+	RaybotID   string    `json:"raybot_id"`
+	LocationID string    `json:"location_id"`
+	Source     string    `json:"source"`
+	CommandID  *string   `json:"command_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type RaybotPositionHistory struct {
+	ID         string    `json:"id"`
+	RaybotID   string    `json:"raybot_id"`
+	LocationID string    `json:"location_id"`
+	Source     string    `json:"source"`
+	CommandID  *string   `json:"command_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
 type StepExecution struct {
 	ID                  string          `json:"id"`
 	WorkflowExecutionID string          `json:"workflow_execution_id"`
@@ -56,27 +98,100 @@ type StepExecution struct {
 	CompletedAt         *time.Time      `json:"completed_at"`
 }
 
+type TrackSegment struct {
+	ID             string    `json:"id"`
+	FromLocationID string    `json:"from_location_id"`
+	ToLocationID   string    `json:"to_location_id"`
+	Distance       int32     `json:"distance"`
+	Direction      string    `json:"direction"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type TrackReservation struct {
+	CommandID    string    `json:"command_id"`
+	RaybotID     string    `json:"raybot_id"`
+	ToLocationID string    `json:"to_location_id"`
+	Status       string    `json:"status"`
+	LocationIds  []string  `json:"location_ids"`
+	SegmentIds   []string  `json:"segment_ids"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID                 string     `json:"id"`
+	SubscriptionID     string     `json:"subscription_id"`
+	EventID            string     `json:"event_id"`
+	EventType          string     `json:"event_type"`
+	Payload            []byte     `json:"payload"`
+	Status             string     `json:"status"`
+	Attempts           int32      `json:"attempts"`
+	NextAttemptAt      time.Time  `json:"next_attempt_at"`
+	LastAttemptAt      *time.Time `json:"last_attempt_at"`
+	LastResponseStatus *int32     `json:"last_response_status"`
+	LastError          *string    `json:"last_error"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+type WebhookSubscription struct {
+	ID         string    `json:"id"`
+	Url        string    `json:"url"`
+	Secret     string    `json:"secret"`
+	EventTypes []string  `json:"event_types"`
+	IsActive   bool      `json:"is_active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
 type Workflow struct {
-	ID          string          `json:"id"`
-	Name        string          `json:"name"`
-	Description *string         `json:"description"`
-	IsDraft     bool            `json:"is_draft"`
-	IsValid     bool            `json:"is_valid"`
-	Data        json.RawMessage `json:"data"`
-	CreatedAt   time.Time       `json:"created_at"`
-	UpdatedAt   time.Time       `json:"updated_at"`
+	ID                 string          `json:"id"`
+	Name               string          `json:"name"`
+	Description        *string         `json:"description"`
+	IsDraft            bool            `json:"is_draft"`
+	IsValid            bool            `json:"is_valid"`
+	Data               json.RawMessage `json:"data"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+	PublishedVersion   *int32          `json:"published_version"`
+	IsTemplate         bool            `json:"is_template"`
+	TemplateParameters json.RawMessage `json:"template_parameters"`
 }
 
 type WorkflowExecution struct {
-	ID          string          `json:"id"`
-	WorkflowID  string          `json:"workflow_id"`
-	Status      string          `json:"status"`
-	Data        json.RawMessage `json:"data"`
-	Inputs      json.RawMessage `json:"inputs"`
-	Outputs     json.RawMessage `json:"outputs"`
-	Error       *string         `json:"error"`
-	CreatedAt   time.Time       `json:"created_at"`
-	UpdatedAt   time.Time       `json:"updated_at"`
-	StartedAt   *time.Time      `json:"started_at"`
-	CompletedAt *time.Time      `json:"completed_at"`
+	ID                string          `json:"id"`
+	WorkflowID        string          `json:"workflow_id"`
+	Status            string          `json:"status"`
+	Data              json.RawMessage `json:"data"`
+	Inputs            json.RawMessage `json:"inputs"`
+	Outputs           json.RawMessage `json:"outputs"`
+	Error             *string         `json:"error"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+	StartedAt         *time.Time      `json:"started_at"`
+	CompletedAt       *time.Time      `json:"completed_at"`
+	WorkflowVersionID *string         `json:"workflow_version_id"`
+	IsSimulated       bool            `json:"is_simulated"`
+	TriggerType       string          `json:"trigger_type"`
+	RaybotID          *string         `json:"raybot_id"`
+}
+
+type WorkflowExecutionEvent struct {
+	ID                  int64           `json:"id"`
+	WorkflowExecutionID string          `json:"workflow_execution_id"`
+	StepExecutionID     *string         `json:"step_execution_id"`
+	Type                string          `json:"type"`
+	Status              string          `json:"status"`
+	Outputs             json.RawMessage `json:"outputs"`
+	Error               *string         `json:"error"`
+	CreatedAt           time.Time       `json:"created_at"`
+}
+
+type WorkflowVersion struct {
+	ID         string          `json:"id"`
+	WorkflowID string          `json:"workflow_id"`
+	Version    int32           `json:"version"`
+	Data       json.RawMessage `json:"data"`
+	CreatedAt  time.Time       `json:"created_at"`
 }
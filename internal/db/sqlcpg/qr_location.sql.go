@@ -11,6 +11,15 @@ import (
 	"time"
 )
 
+type QRLocationBatchInsertParams struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	QrCode    string          `json:"qr_code"`
+	Metadata  json.RawMessage `json:"metadata"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
 const qRLocationDelete = `-- name: QRLocationDelete :exec
 DELETE FROM qr_locations
 WHERE id = $1
@@ -40,6 +49,25 @@ func (q *Queries) QRLocationGetByID(ctx context.Context, db DBTX, id string) (Qr
 	return i, err
 }
 
+const qRLocationGetByQRCode = `-- name: QRLocationGetByQRCode :one
+SELECT id, name, qr_code, metadata, created_at, updated_at FROM qr_locations
+WHERE qr_code = $1
+`
+
+func (q *Queries) QRLocationGetByQRCode(ctx context.Context, db DBTX, qrCode string) (QrLocation, error) {
+	row := db.QueryRow(ctx, qRLocationGetByQRCode, qrCode)
+	var i QrLocation
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.QrCode,
+		&i.Metadata,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const qRLocationInsert = `-- name: QRLocationInsert :exec
 INSERT INTO qr_locations (
     id,
@@ -80,6 +108,70 @@ func (q *Queries) QRLocationInsert(ctx context.Context, db DBTX, arg QRLocationI
 	return err
 }
 
+const qRLocationListAll = `-- name: QRLocationListAll :many
+SELECT id, name, qr_code, metadata, created_at, updated_at FROM qr_locations
+ORDER BY created_at, id
+`
+
+func (q *Queries) QRLocationListAll(ctx context.Context, db DBTX) ([]QrLocation, error) {
+	rows, err := db.Query(ctx, qRLocationListAll)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []QrLocation{}
+	for rows.Next() {
+		var i QrLocation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.QrCode,
+			&i.Metadata,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const qRLocationListByIDs = `-- name: QRLocationListByIDs :many
+SELECT id, name, qr_code, metadata, created_at, updated_at FROM qr_locations
+WHERE id = ANY($1::UUID[])
+`
+
+func (q *Queries) QRLocationListByIDs(ctx context.Context, db DBTX, ids []string) ([]QrLocation, error) {
+	rows, err := db.Query(ctx, qRLocationListByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []QrLocation{}
+	for rows.Next() {
+		var i QrLocation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.QrCode,
+			&i.Metadata,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const qRLocationUpdate = `-- name: QRLocationUpdate :one
 UPDATE qr_locations
 SET
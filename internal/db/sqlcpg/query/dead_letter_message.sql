@@ -0,0 +1,45 @@
+-- name: DeadLetterMessageGetByID :one
+SELECT * FROM dead_letter_messages
+WHERE id = @id;
+
+-- name: DeadLetterMessageInsert :exec
+-- A dead-lettered message redelivered by the broker is only stored once.
+INSERT INTO dead_letter_messages (
+	id,
+	message_id,
+	topic,
+	handler,
+	subscriber,
+	reason,
+	payload,
+	metadata,
+	replay_count,
+	last_replayed_at,
+	created_at
+)
+VALUES (
+	@id,
+	@message_id,
+	@topic,
+	@handler,
+	@subscriber,
+	@reason,
+	@payload,
+	@metadata,
+	@replay_count,
+	@last_replayed_at,
+	@created_at
+)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: DeadLetterMessageMarkReplayed :one
+UPDATE dead_letter_messages
+SET
+	replay_count = replay_count + 1,
+	last_replayed_at = @replayed_at
+WHERE id = @id
+RETURNING *;
+
+-- name: DeadLetterMessageDelete :execrows
+DELETE FROM dead_letter_messages
+WHERE id = @id;
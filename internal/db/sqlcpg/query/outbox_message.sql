@@ -0,0 +1,38 @@
+-- name: OutboxMessageInsert :exec
+INSERT INTO outbox_messages (
+	message_id,
+	topic,
+	payload,
+	metadata,
+	created_at
+)
+VALUES (
+	@message_id,
+	@topic,
+	@payload,
+	@metadata,
+	@created_at
+);
+
+-- name: OutboxMessageListPendingForUpdate :many
+SELECT * FROM outbox_messages
+WHERE published_at IS NULL
+ORDER BY id
+LIMIT @limit_count
+FOR UPDATE SKIP LOCKED;
+
+-- name: OutboxMessageMarkPublished :exec
+UPDATE outbox_messages
+SET published_at = @published_at
+WHERE id = ANY(@ids::BIGINT[]);
+
+-- name: OutboxMessageDeletePublishedBefore :execrows
+DELETE FROM outbox_messages
+WHERE published_at < @published_before;
+
+-- name: OutboxMessagePendingStats :one
+SELECT
+	COUNT(*) AS pending_count,
+	COALESCE(EXTRACT(EPOCH FROM NOW() - MIN(created_at)), 0)::FLOAT8 AS lag_seconds
+FROM outbox_messages
+WHERE published_at IS NULL;
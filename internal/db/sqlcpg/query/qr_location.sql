@@ -2,6 +2,18 @@
 SELECT * FROM qr_locations
 WHERE id = @id;
 
+-- name: QRLocationGetByQRCode :one
+SELECT * FROM qr_locations
+WHERE qr_code = @qr_code;
+
+-- name: QRLocationListAll :many
+SELECT * FROM qr_locations
+ORDER BY created_at, id;
+
+-- name: QRLocationListByIDs :many
+SELECT * FROM qr_locations
+WHERE id = ANY(@ids::UUID[]);
+
 -- name: QRLocationInsert :exec
 INSERT INTO qr_locations (
     id,
@@ -20,6 +32,24 @@ VALUES (
     @updated_at
 );
 
+-- name: QRLocationBatchInsert :copyfrom
+INSERT INTO qr_locations (
+    id,
+    name,
+    qr_code,
+    metadata,
+    created_at,
+    updated_at
+)
+VALUES (
+    @id,
+    @name,
+    @qr_code,
+    @metadata,
+    @created_at,
+    @updated_at
+);
+
 -- name: QRLocationUpdate :one
 UPDATE qr_locations
 SET
@@ -60,4 +60,15 @@ SET
     error = @error,
     updated_at = NOW()
 WHERE raybot_id = @raybot_id
-    AND (status = 'PENDING' OR status = 'IN_PROGRESS');
+    AND status IN ('QUEUED', 'PENDING', 'IN_PROGRESS');
+
+-- name: RaybotCommandListExpired :many
+SELECT * FROM raybot_commands
+WHERE status = @status AND COALESCE(completed_at, updated_at) < @before
+ORDER BY created_at
+LIMIT @batch_size
+FOR UPDATE SKIP LOCKED;
+
+-- name: RaybotCommandDeleteByIDs :execrows
+DELETE FROM raybot_commands
+WHERE id = ANY(@ids::UUID[]);
@@ -0,0 +1,55 @@
+-- name: RaybotPositionGetByRaybotID :one
+SELECT * FROM raybot_positions
+WHERE raybot_id = @raybot_id;
+
+-- name: RaybotPositionListAll :many
+SELECT * FROM raybot_positions
+ORDER BY raybot_id;
+
+-- name: RaybotPositionListByLocationID :many
+SELECT * FROM raybot_positions
+WHERE location_id = @location_id
+ORDER BY created_at, raybot_id;
+
+-- name: RaybotPositionUpsert :exec
+INSERT INTO raybot_positions (
+	id,
+	raybot_id,
+	location_id,
+	source,
+	command_id,
+	created_at
+)
+VALUES (
+	@id,
+	@raybot_id,
+	@location_id,
+	@source,
+	@command_id,
+	@created_at
+)
+ON CONFLICT (raybot_id) DO UPDATE
+SET
+	id = EXCLUDED.id,
+	location_id = EXCLUDED.location_id,
+	source = EXCLUDED.source,
+	command_id = EXCLUDED.command_id,
+	created_at = EXCLUDED.created_at;
+
+-- name: RaybotPositionHistoryInsert :exec
+INSERT INTO raybot_position_history (
+	id,
+	raybot_id,
+	location_id,
+	source,
+	command_id,
+	created_at
+)
+VALUES (
+	@id,
+	@raybot_id,
+	@location_id,
+	@source,
+	@command_id,
+	@created_at
+);
@@ -46,3 +46,12 @@ SET
 	updated_at = NOW()
 WHERE id = @id
 RETURNING *;
+
+-- name: StepExecutionListByWorkflowExecutionIDs :many
+SELECT * FROM step_executions
+WHERE workflow_execution_id = ANY(@workflow_execution_ids::UUID[])
+ORDER BY workflow_execution_id, created_at;
+
+-- name: StepExecutionDeleteByWorkflowExecutionIDs :execrows
+DELETE FROM step_executions
+WHERE workflow_execution_id = ANY(@workflow_execution_ids::UUID[]);
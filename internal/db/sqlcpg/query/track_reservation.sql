@@ -0,0 +1,45 @@
+-- name: TrackReservationLock :exec
+LOCK TABLE track_reservations IN EXCLUSIVE MODE;
+
+-- name: TrackReservationListActive :many
+SELECT * FROM track_reservations
+WHERE command_id IN (
+	SELECT id FROM raybot_commands
+	WHERE status NOT IN ('SUCCEEDED', 'FAILED')
+)
+ORDER BY created_at, command_id;
+
+-- name: TrackReservationInsert :exec
+INSERT INTO track_reservations (
+	command_id,
+	raybot_id,
+	to_location_id,
+	status,
+	location_ids,
+	segment_ids,
+	created_at,
+	updated_at
+)
+VALUES (
+	@command_id,
+	@raybot_id,
+	@to_location_id,
+	@status,
+	@location_ids,
+	@segment_ids,
+	@created_at,
+	@updated_at
+);
+
+-- name: TrackReservationUpdate :exec
+UPDATE track_reservations
+SET
+	status = @status,
+	location_ids = @location_ids,
+	segment_ids = @segment_ids,
+	updated_at = NOW()
+WHERE command_id = @command_id;
+
+-- name: TrackReservationDelete :exec
+DELETE FROM track_reservations
+WHERE command_id = @command_id;
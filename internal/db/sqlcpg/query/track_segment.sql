@@ -0,0 +1,42 @@
+-- name: TrackSegmentGetByID :one
+SELECT * FROM track_segments
+WHERE id = @id;
+
+-- name: TrackSegmentListAll :many
+SELECT * FROM track_segments
+ORDER BY created_at, id;
+
+-- name: TrackSegmentInsert :exec
+INSERT INTO track_segments (
+	id,
+	from_location_id,
+	to_location_id,
+	distance,
+	direction,
+	created_at,
+	updated_at
+)
+VALUES (
+	@id,
+	@from_location_id,
+	@to_location_id,
+	@distance,
+	@direction,
+	@created_at,
+	@updated_at
+);
+
+-- name: TrackSegmentUpdate :one
+UPDATE track_segments
+SET
+	from_location_id = CASE WHEN @set_from_location_id::boolean THEN @from_location_id ELSE from_location_id END,
+	to_location_id = CASE WHEN @set_to_location_id::boolean THEN @to_location_id ELSE to_location_id END,
+	distance = CASE WHEN @set_distance::boolean THEN @distance ELSE distance END,
+	direction = CASE WHEN @set_direction::boolean THEN @direction ELSE direction END,
+	updated_at = now()
+WHERE id = @id
+RETURNING *;
+
+-- name: TrackSegmentDelete :execrows
+DELETE FROM track_segments
+WHERE id = @id;
@@ -0,0 +1,111 @@
+-- name: WebhookSubscriptionGetByID :one
+SELECT * FROM webhook_subscriptions
+WHERE id = @id;
+
+-- name: WebhookSubscriptionListActiveByEventType :many
+SELECT * FROM webhook_subscriptions
+WHERE is_active
+	AND (cardinality(event_types) = 0 OR @event_type::TEXT = ANY(event_types))
+ORDER BY created_at;
+
+-- name: WebhookSubscriptionInsert :exec
+INSERT INTO webhook_subscriptions (
+	id,
+	url,
+	secret,
+	event_types,
+	is_active,
+	created_at,
+	updated_at
+)
+VALUES (
+	@id,
+	@url,
+	@secret,
+	@event_types,
+	@is_active,
+	@created_at,
+	@updated_at
+);
+
+-- name: WebhookSubscriptionUpdate :one
+UPDATE webhook_subscriptions
+SET
+	url = CASE WHEN @set_url::boolean THEN @url ELSE url END,
+	secret = CASE WHEN @set_secret::boolean THEN @secret ELSE secret END,
+	event_types = CASE WHEN @set_event_types::boolean THEN @event_types::TEXT[] ELSE event_types END,
+	is_active = CASE WHEN @set_is_active::boolean THEN @is_active ELSE is_active END,
+	updated_at = now()
+WHERE id = @id
+RETURNING *;
+
+-- name: WebhookSubscriptionDelete :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = @id;
+
+-- name: WebhookDeliveryInsert :exec
+INSERT INTO webhook_deliveries (
+	id,
+	subscription_id,
+	event_id,
+	event_type,
+	payload,
+	status,
+	attempts,
+	next_attempt_at,
+	last_attempt_at,
+	last_response_status,
+	last_error,
+	created_at,
+	updated_at
+)
+VALUES (
+	@id,
+	@subscription_id,
+	@event_id,
+	@event_type,
+	@payload,
+	@status,
+	@attempts,
+	@next_attempt_at,
+	@last_attempt_at,
+	@last_response_status,
+	@last_error,
+	@created_at,
+	@updated_at
+)
+ON CONFLICT (subscription_id, event_id) DO NOTHING;
+
+-- name: WebhookDeliveryGetByIDForUpdate :one
+SELECT * FROM webhook_deliveries
+WHERE id = @id AND subscription_id = @subscription_id
+FOR UPDATE;
+
+-- name: WebhookDeliveryClaimDue :many
+-- Claims the pending deliveries due for an attempt by pushing their next
+-- attempt to the end of the lease, so other instances skip them while they
+-- are being sent. Deliveries of inactive subscriptions wait for them to be
+-- activated again.
+UPDATE webhook_deliveries
+SET next_attempt_at = @lease_until
+WHERE id IN (
+	SELECT d.id FROM webhook_deliveries AS d
+	JOIN webhook_subscriptions AS s ON s.id = d.subscription_id
+	WHERE d.status = 'PENDING' AND d.next_attempt_at <= NOW() AND s.is_active
+	ORDER BY d.next_attempt_at
+	LIMIT @limit_count
+	FOR UPDATE OF d SKIP LOCKED
+)
+RETURNING *;
+
+-- name: WebhookDeliveryUpdate :exec
+UPDATE webhook_deliveries
+SET
+	status = @status,
+	attempts = @attempts,
+	next_attempt_at = @next_attempt_at,
+	last_attempt_at = @last_attempt_at,
+	last_response_status = @last_response_status,
+	last_error = @last_error,
+	updated_at = @updated_at
+WHERE id = @id;
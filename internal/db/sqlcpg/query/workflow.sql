@@ -2,6 +2,11 @@
 SELECT * FROM workflows
 WHERE id = @id;
 
+-- name: WorkflowGetByIDForUpdate :one
+SELECT * FROM workflows
+WHERE id = @id
+FOR UPDATE;
+
 -- name: WorkflowInsert :exec
 INSERT INTO workflows (
 	id,
@@ -10,6 +15,8 @@ INSERT INTO workflows (
 	is_draft,
 	is_valid,
 	data,
+	is_template,
+	template_parameters,
 	created_at,
 	updated_at
 )
@@ -20,6 +27,8 @@ VALUES (
 	@is_draft,
 	@is_valid,
 	@data,
+	@is_template,
+	@template_parameters,
 	@created_at,
 	@updated_at
 );
@@ -32,6 +41,9 @@ SET
 	is_draft = CASE WHEN @set_is_draft::boolean THEN @is_draft ELSE is_draft END,
 	is_valid = CASE WHEN @set_is_valid::boolean THEN @is_valid ELSE is_valid END,
 	data = CASE WHEN @set_data::boolean THEN @data ELSE data END,
+	published_version = CASE WHEN @set_published_version::boolean THEN @published_version ELSE published_version END,
+	is_template = CASE WHEN @set_is_template::boolean THEN @is_template ELSE is_template END,
+	template_parameters = CASE WHEN @set_template_parameters::boolean THEN @template_parameters ELSE template_parameters END,
 	updated_at = NOW()
 WHERE id = @id
 RETURNING *;
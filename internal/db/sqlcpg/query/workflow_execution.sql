@@ -13,7 +13,11 @@ INSERT INTO workflow_executions (
 	error,
 	created_at,
 	started_at,
-	completed_at
+	completed_at,
+	workflow_version_id,
+	is_simulated,
+	trigger_type,
+	raybot_id
 )
 VALUES (
 	@id,
@@ -25,7 +29,11 @@ VALUES (
 	@error,
 	@created_at,
 	@started_at,
-	@completed_at
+	@completed_at,
+	@workflow_version_id,
+	@is_simulated,
+	@trigger_type,
+	@raybot_id
 );
 
 -- name: WorkflowExecutionUpdate :one
@@ -40,3 +48,14 @@ SET
 	updated_at = NOW()
 WHERE id = @id
 RETURNING *;
+
+-- name: WorkflowExecutionListExpired :many
+SELECT * FROM workflow_executions
+WHERE status = @status AND COALESCE(completed_at, updated_at) < @before
+ORDER BY created_at
+LIMIT @batch_size
+FOR UPDATE SKIP LOCKED;
+
+-- name: WorkflowExecutionDeleteByIDs :execrows
+DELETE FROM workflow_executions
+WHERE id = ANY(@ids::UUID[]);
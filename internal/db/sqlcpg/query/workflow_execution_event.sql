@@ -0,0 +1,34 @@
+-- name: WorkflowExecutionEventListAfterID :many
+SELECT * FROM workflow_execution_events
+WHERE workflow_execution_id = @workflow_execution_id AND id > @after_id
+ORDER BY id;
+
+-- name: WorkflowExecutionEventInsert :one
+INSERT INTO workflow_execution_events (
+	workflow_execution_id,
+	step_execution_id,
+	type,
+	status,
+	outputs,
+	error,
+	created_at
+)
+VALUES (
+	@workflow_execution_id,
+	@step_execution_id,
+	@type,
+	@status,
+	@outputs,
+	@error,
+	@created_at
+)
+RETURNING id;
+
+-- name: WorkflowExecutionEventListByWorkflowExecutionIDs :many
+SELECT * FROM workflow_execution_events
+WHERE workflow_execution_id = ANY(@workflow_execution_ids::UUID[])
+ORDER BY workflow_execution_id, id;
+
+-- name: WorkflowExecutionEventDeleteByWorkflowExecutionIDs :execrows
+DELETE FROM workflow_execution_events
+WHERE workflow_execution_id = ANY(@workflow_execution_ids::UUID[]);
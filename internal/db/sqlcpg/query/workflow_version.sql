@@ -0,0 +1,23 @@
+-- name: WorkflowVersionGetByVersion :one
+SELECT * FROM workflow_versions
+WHERE workflow_id = @workflow_id AND version = @version;
+
+-- name: WorkflowVersionGetMaxVersion :one
+SELECT COALESCE(MAX(version), 0)::integer FROM workflow_versions
+WHERE workflow_id = @workflow_id;
+
+-- name: WorkflowVersionInsert :exec
+INSERT INTO workflow_versions (
+	id,
+	workflow_id,
+	version,
+	data,
+	created_at
+)
+VALUES (
+	@id,
+	@workflow_id,
+	@version,
+	@data,
+	@created_at
+);
@@ -11,6 +11,19 @@ import (
 	"time"
 )
 
+const raybotCommandDeleteByIDs = `-- name: RaybotCommandDeleteByIDs :execrows
+DELETE FROM raybot_commands
+WHERE id = ANY($1::UUID[])
+`
+
+func (q *Queries) RaybotCommandDeleteByIDs(ctx context.Context, db DBTX, ids []string) (int64, error) {
+	result, err := db.Exec(ctx, raybotCommandDeleteByIDs, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const raybotCommandDeleteByRaybotID = `-- name: RaybotCommandDeleteByRaybotID :exec
 DELETE FROM raybot_commands
 WHERE raybot_id = $1
@@ -130,6 +143,51 @@ func (q *Queries) RaybotCommandInsert(ctx context.Context, db DBTX, arg RaybotCo
 	return err
 }
 
+const raybotCommandListExpired = `-- name: RaybotCommandListExpired :many
+SELECT id, raybot_id, type, status, inputs, outputs, error, created_at, updated_at, completed_at FROM raybot_commands
+WHERE status = $1 AND COALESCE(completed_at, updated_at) < $2
+ORDER BY created_at
+LIMIT $3
+FOR UPDATE SKIP LOCKED
+`
+
+type RaybotCommandListExpiredParams struct {
+	Status    string    `json:"status"`
+	Before    time.Time `json:"before"`
+	BatchSize int32     `json:"batch_size"`
+}
+
+func (q *Queries) RaybotCommandListExpired(ctx context.Context, db DBTX, arg RaybotCommandListExpiredParams) ([]RaybotCommand, error) {
+	rows, err := db.Query(ctx, raybotCommandListExpired, arg.Status, arg.Before, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RaybotCommand{}
+	for rows.Next() {
+		var i RaybotCommand
+		if err := rows.Scan(
+			&i.ID,
+			&i.RaybotID,
+			&i.Type,
+			&i.Status,
+			&i.Inputs,
+			&i.Outputs,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const raybotCommandMarkFailed = `-- name: RaybotCommandMarkFailed :exec
 UPDATE raybot_commands
 SET
@@ -137,7 +195,7 @@ SET
     error = $1,
     updated_at = NOW()
 WHERE raybot_id = $2
-    AND (status = 'PENDING' OR status = 'IN_PROGRESS')
+    AND status IN ('QUEUED', 'PENDING', 'IN_PROGRESS')
 `
 
 type RaybotCommandMarkFailedParams struct {
@@ -25,6 +25,19 @@ type StepExecutionBatchInsertParams struct {
 	CompletedAt         *time.Time      `json:"completed_at"`
 }
 
+const stepExecutionDeleteByWorkflowExecutionIDs = `-- name: StepExecutionDeleteByWorkflowExecutionIDs :execrows
+DELETE FROM step_executions
+WHERE workflow_execution_id = ANY($1::UUID[])
+`
+
+func (q *Queries) StepExecutionDeleteByWorkflowExecutionIDs(ctx context.Context, db DBTX, workflowExecutionIds []string) (int64, error) {
+	result, err := db.Exec(ctx, stepExecutionDeleteByWorkflowExecutionIDs, workflowExecutionIds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const stepExecutionGet = `-- name: StepExecutionGet :one
 SELECT id, workflow_execution_id, status, node, inputs, outputs, error, created_at, updated_at, started_at, completed_at FROM step_executions
 WHERE id = $1
@@ -86,6 +99,44 @@ func (q *Queries) StepExecutionListByWorkflowExecutionID(ctx context.Context, db
 	return items, nil
 }
 
+const stepExecutionListByWorkflowExecutionIDs = `-- name: StepExecutionListByWorkflowExecutionIDs :many
+SELECT id, workflow_execution_id, status, node, inputs, outputs, error, created_at, updated_at, started_at, completed_at FROM step_executions
+WHERE workflow_execution_id = ANY($1::UUID[])
+ORDER BY workflow_execution_id, created_at
+`
+
+func (q *Queries) StepExecutionListByWorkflowExecutionIDs(ctx context.Context, db DBTX, workflowExecutionIds []string) ([]StepExecution, error) {
+	rows, err := db.Query(ctx, stepExecutionListByWorkflowExecutionIDs, workflowExecutionIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []StepExecution{}
+	for rows.Next() {
+		var i StepExecution
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkflowExecutionID,
+			&i.Status,
+			&i.Node,
+			&i.Inputs,
+			&i.Outputs,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const stepExecutionUpdate = `-- name: StepExecutionUpdate :one
 UPDATE step_executions
 SET
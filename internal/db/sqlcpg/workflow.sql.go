@@ -36,7 +36,7 @@ func (q *Queries) WorkflowDelete(ctx context.Context, db DBTX, id string) error
 }
 
 const workflowGetByID = `-- name: WorkflowGetByID :one
-SELECT id, name, description, is_draft, is_valid, data, created_at, updated_at FROM workflows
+SELECT id, name, description, is_draft, is_valid, data, created_at, updated_at, published_version, is_template, template_parameters FROM workflows
 WHERE id = $1
 `
 
@@ -52,6 +52,34 @@ func (q *Queries) WorkflowGetByID(ctx context.Context, db DBTX, id string) (Work
 		&i.Data,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PublishedVersion,
+		&i.IsTemplate,
+		&i.TemplateParameters,
+	)
+	return i, err
+}
+
+const workflowGetByIDForUpdate = `-- name: WorkflowGetByIDForUpdate :one
+SELECT id, name, description, is_draft, is_valid, data, created_at, updated_at, published_version, is_template, template_parameters FROM workflows
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) WorkflowGetByIDForUpdate(ctx context.Context, db DBTX, id string) (Workflow, error) {
+	row := db.QueryRow(ctx, workflowGetByIDForUpdate, id)
+	var i Workflow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.IsDraft,
+		&i.IsValid,
+		&i.Data,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PublishedVersion,
+		&i.IsTemplate,
+		&i.TemplateParameters,
 	)
 	return i, err
 }
@@ -64,6 +92,8 @@ INSERT INTO workflows (
 	is_draft,
 	is_valid,
 	data,
+	is_template,
+	template_parameters,
 	created_at,
 	updated_at
 )
@@ -75,19 +105,23 @@ VALUES (
 	$5,
 	$6,
 	$7,
-	$8
+	$8,
+	$9,
+	$10
 )
 `
 
 type WorkflowInsertParams struct {
-	ID          string          `json:"id"`
-	Name        string          `json:"name"`
-	Description *string         `json:"description"`
-	IsDraft     bool            `json:"is_draft"`
-	IsValid     bool            `json:"is_valid"`
-	Data        json.RawMessage `json:"data"`
-	CreatedAt   time.Time       `json:"created_at"`
-	UpdatedAt   time.Time       `json:"updated_at"`
+	ID                 string          `json:"id"`
+	Name               string          `json:"name"`
+	Description        *string         `json:"description"`
+	IsDraft            bool            `json:"is_draft"`
+	IsValid            bool            `json:"is_valid"`
+	Data               json.RawMessage `json:"data"`
+	IsTemplate         bool            `json:"is_template"`
+	TemplateParameters json.RawMessage `json:"template_parameters"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
 }
 
 func (q *Queries) WorkflowInsert(ctx context.Context, db DBTX, arg WorkflowInsertParams) error {
@@ -98,6 +132,8 @@ func (q *Queries) WorkflowInsert(ctx context.Context, db DBTX, arg WorkflowInser
 		arg.IsDraft,
 		arg.IsValid,
 		arg.Data,
+		arg.IsTemplate,
+		arg.TemplateParameters,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -112,23 +148,32 @@ SET
 	is_draft = CASE WHEN $5::boolean THEN $6 ELSE is_draft END,
 	is_valid = CASE WHEN $7::boolean THEN $8 ELSE is_valid END,
 	data = CASE WHEN $9::boolean THEN $10 ELSE data END,
+	published_version = CASE WHEN $11::boolean THEN $12 ELSE published_version END,
+	is_template = CASE WHEN $13::boolean THEN $14 ELSE is_template END,
+	template_parameters = CASE WHEN $15::boolean THEN $16 ELSE template_parameters END,
 	updated_at = NOW()
-WHERE id = $11
-RETURNING id, name, description, is_draft, is_valid, data, created_at, updated_at
+WHERE id = $17
+RETURNING id, name, description, is_draft, is_valid, data, created_at, updated_at, published_version, is_template, template_parameters
 `
 
 type WorkflowUpdateParams struct {
-	SetName        bool            `json:"set_name"`
-	Name           string          `json:"name"`
-	SetDescription bool            `json:"set_description"`
-	Description    *string         `json:"description"`
-	SetIsDraft     bool            `json:"set_is_draft"`
-	IsDraft        bool            `json:"is_draft"`
-	SetIsValid     bool            `json:"set_is_valid"`
-	IsValid        bool            `json:"is_valid"`
-	SetData        bool            `json:"set_data"`
-	Data           json.RawMessage `json:"data"`
-	ID             string          `json:"id"`
+	SetName               bool            `json:"set_name"`
+	Name                  string          `json:"name"`
+	SetDescription        bool            `json:"set_description"`
+	Description           *string         `json:"description"`
+	SetIsDraft            bool            `json:"set_is_draft"`
+	IsDraft               bool            `json:"is_draft"`
+	SetIsValid            bool            `json:"set_is_valid"`
+	IsValid               bool            `json:"is_valid"`
+	SetData               bool            `json:"set_data"`
+	Data                  json.RawMessage `json:"data"`
+	SetPublishedVersion   bool            `json:"set_published_version"`
+	PublishedVersion      *int32          `json:"published_version"`
+	SetIsTemplate         bool            `json:"set_is_template"`
+	IsTemplate            bool            `json:"is_template"`
+	SetTemplateParameters bool            `json:"set_template_parameters"`
+	TemplateParameters    json.RawMessage `json:"template_parameters"`
+	ID                    string          `json:"id"`
 }
 
 func (q *Queries) WorkflowUpdate(ctx context.Context, db DBTX, arg WorkflowUpdateParams) (Workflow, error) {
@@ -143,6 +188,12 @@ func (q *Queries) WorkflowUpdate(ctx context.Context, db DBTX, arg WorkflowUpdat
 		arg.IsValid,
 		arg.SetData,
 		arg.Data,
+		arg.SetPublishedVersion,
+		arg.PublishedVersion,
+		arg.SetIsTemplate,
+		arg.IsTemplate,
+		arg.SetTemplateParameters,
+		arg.TemplateParameters,
 		arg.ID,
 	)
 	var i Workflow
@@ -155,6 +206,9 @@ func (q *Queries) WorkflowUpdate(ctx context.Context, db DBTX, arg WorkflowUpdat
 		&i.Data,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PublishedVersion,
+		&i.IsTemplate,
+		&i.TemplateParameters,
 	)
 	return i, err
 }
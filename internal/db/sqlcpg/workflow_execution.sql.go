@@ -11,8 +11,21 @@ import (
 	"time"
 )
 
+const workflowExecutionDeleteByIDs = `-- name: WorkflowExecutionDeleteByIDs :execrows
+DELETE FROM workflow_executions
+WHERE id = ANY($1::UUID[])
+`
+
+func (q *Queries) WorkflowExecutionDeleteByIDs(ctx context.Context, db DBTX, ids []string) (int64, error) {
+	result, err := db.Exec(ctx, workflowExecutionDeleteByIDs, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const workflowExecutionGetByID = `-- name: WorkflowExecutionGetByID :one
-SELECT id, workflow_id, status, data, inputs, outputs, error, created_at, updated_at, started_at, completed_at FROM workflow_executions
+SELECT id, workflow_id, status, data, inputs, outputs, error, created_at, updated_at, started_at, completed_at, workflow_version_id, is_simulated, trigger_type, raybot_id FROM workflow_executions
 WHERE id = $1
 `
 
@@ -31,6 +44,10 @@ func (q *Queries) WorkflowExecutionGetByID(ctx context.Context, db DBTX, id stri
 		&i.UpdatedAt,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.WorkflowVersionID,
+		&i.IsSimulated,
+		&i.TriggerType,
+		&i.RaybotID,
 	)
 	return i, err
 }
@@ -46,7 +63,11 @@ INSERT INTO workflow_executions (
 	error,
 	created_at,
 	started_at,
-	completed_at
+	completed_at,
+	workflow_version_id,
+	is_simulated,
+	trigger_type,
+	raybot_id
 )
 VALUES (
 	$1,
@@ -58,21 +79,29 @@ VALUES (
 	$7,
 	$8,
 	$9,
-	$10
+	$10,
+	$11,
+	$12,
+	$13,
+	$14
 )
 `
 
 type WorkflowExecutionInsertParams struct {
-	ID          string          `json:"id"`
-	WorkflowID  string          `json:"workflow_id"`
-	Status      string          `json:"status"`
-	Data        json.RawMessage `json:"data"`
-	Inputs      json.RawMessage `json:"inputs"`
-	Outputs     json.RawMessage `json:"outputs"`
-	Error       *string         `json:"error"`
-	CreatedAt   time.Time       `json:"created_at"`
-	StartedAt   *time.Time      `json:"started_at"`
-	CompletedAt *time.Time      `json:"completed_at"`
+	ID                string          `json:"id"`
+	WorkflowID        string          `json:"workflow_id"`
+	Status            string          `json:"status"`
+	Data              json.RawMessage `json:"data"`
+	Inputs            json.RawMessage `json:"inputs"`
+	Outputs           json.RawMessage `json:"outputs"`
+	Error             *string         `json:"error"`
+	CreatedAt         time.Time       `json:"created_at"`
+	StartedAt         *time.Time      `json:"started_at"`
+	CompletedAt       *time.Time      `json:"completed_at"`
+	WorkflowVersionID *string         `json:"workflow_version_id"`
+	IsSimulated       bool            `json:"is_simulated"`
+	TriggerType       string          `json:"trigger_type"`
+	RaybotID          *string         `json:"raybot_id"`
 }
 
 func (q *Queries) WorkflowExecutionInsert(ctx context.Context, db DBTX, arg WorkflowExecutionInsertParams) error {
@@ -87,10 +116,64 @@ func (q *Queries) WorkflowExecutionInsert(ctx context.Context, db DBTX, arg Work
 		arg.CreatedAt,
 		arg.StartedAt,
 		arg.CompletedAt,
+		arg.WorkflowVersionID,
+		arg.IsSimulated,
+		arg.TriggerType,
+		arg.RaybotID,
 	)
 	return err
 }
 
+const workflowExecutionListExpired = `-- name: WorkflowExecutionListExpired :many
+SELECT id, workflow_id, status, data, inputs, outputs, error, created_at, updated_at, started_at, completed_at, workflow_version_id, is_simulated, trigger_type, raybot_id FROM workflow_executions
+WHERE status = $1 AND COALESCE(completed_at, updated_at) < $2
+ORDER BY created_at
+LIMIT $3
+FOR UPDATE SKIP LOCKED
+`
+
+type WorkflowExecutionListExpiredParams struct {
+	Status    string    `json:"status"`
+	Before    time.Time `json:"before"`
+	BatchSize int32     `json:"batch_size"`
+}
+
+func (q *Queries) WorkflowExecutionListExpired(ctx context.Context, db DBTX, arg WorkflowExecutionListExpiredParams) ([]WorkflowExecution, error) {
+	rows, err := db.Query(ctx, workflowExecutionListExpired, arg.Status, arg.Before, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WorkflowExecution{}
+	for rows.Next() {
+		var i WorkflowExecution
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkflowID,
+			&i.Status,
+			&i.Data,
+			&i.Inputs,
+			&i.Outputs,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.WorkflowVersionID,
+			&i.IsSimulated,
+			&i.TriggerType,
+			&i.RaybotID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const workflowExecutionUpdate = `-- name: WorkflowExecutionUpdate :one
 UPDATE workflow_executions
 SET
@@ -102,7 +185,7 @@ SET
 	completed_at = CASE WHEN $11::boolean THEN $12 ELSE completed_at END,
 	updated_at = NOW()
 WHERE id = $13
-RETURNING id, workflow_id, status, data, inputs, outputs, error, created_at, updated_at, started_at, completed_at
+RETURNING id, workflow_id, status, data, inputs, outputs, error, created_at, updated_at, started_at, completed_at, workflow_version_id, is_simulated, trigger_type, raybot_id
 `
 
 type WorkflowExecutionUpdateParams struct {
@@ -150,6 +233,10 @@ func (q *Queries) WorkflowExecutionUpdate(ctx context.Context, db DBTX, arg Work
 		&i.UpdatedAt,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.WorkflowVersionID,
+		&i.IsSimulated,
+		&i.TriggerType,
+		&i.RaybotID,
 	)
 	return i, err
 }
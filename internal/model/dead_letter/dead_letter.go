@@ -0,0 +1,52 @@
+package deadletter
+
+import (
+	"time"
+)
+
+// Message is a message whose handler still failed after its retries. It is
+// kept for inspection until it is replayed or purged.
+//
+// ID is the UUID of the message on the dead-letter topic, MessageID its UUID
+// on its original Topic. Reason is the error of the last attempt of Handler.
+type Message struct {
+	ID             string
+	MessageID      string
+	Topic          string
+	Handler        string
+	Subscriber     string
+	Reason         string
+	Payload        []byte
+	Metadata       map[string]string
+	ReplayCount    int32
+	LastReplayedAt *time.Time
+	CreatedAt      time.Time
+}
+
+func NewMessage(
+	id string,
+	messageID string,
+	topic string,
+	handler string,
+	subscriber string,
+	reason string,
+	payload []byte,
+	metadata map[string]string,
+) Message {
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	return Message{
+		ID:             id,
+		MessageID:      messageID,
+		Topic:          topic,
+		Handler:        handler,
+		Subscriber:     subscriber,
+		Reason:         reason,
+		Payload:        payload,
+		Metadata:       metadata,
+		ReplayCount:    0,
+		LastReplayedAt: nil,
+		CreatedAt:      time.Now(),
+	}
+}
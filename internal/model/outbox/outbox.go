@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"time"
+)
+
+// Message is a message written to the outbox in the transaction of the state
+// change it describes. The relay publishes it once the transaction commits.
+type Message struct {
+	ID          int64
+	MessageID   string
+	Topic       string
+	Payload     []byte
+	Metadata    map[string]string
+	CreatedAt   time.Time
+	PublishedAt *time.Time
+}
+
+func NewMessage(topic string, messageID string, payload []byte, metadata map[string]string) Message {
+	return Message{
+		MessageID:   messageID,
+		Topic:       topic,
+		Payload:     payload,
+		Metadata:    metadata,
+		CreatedAt:   time.Now(),
+		PublishedAt: nil,
+	}
+}
+
+// Stats describes the messages waiting to be published.
+type Stats struct {
+	// PendingCount is the number of messages not yet published.
+	PendingCount int64
+	// Lag is the age of the oldest message not yet published, 0 if there is none.
+	Lag time.Duration
+}
@@ -0,0 +1,182 @@
+package qrlocation
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+// CSVHeader is the header of the CSV documents QR locations are imported
+// from and exported to. The metadata column holds a JSON object.
+var CSVHeader = []string{"name", "qr_code", "metadata"}
+
+// ImportRow is a QR location of an imported document. Row is its position in
+// the document, from 1, the CSV header excluded.
+type ImportRow struct {
+	Row      int
+	Name     string         `validate:"required,alphanumspace,min=1,max=100"`
+	QRCode   string         `validate:"required,alphanumspace,min=1,max=100"`
+	Metadata map[string]any `validate:"required"`
+}
+
+// ReadCSV reads the rows of a CSV document. The header names the columns, in
+// any order. The name and qr_code columns are required, an empty or missing
+// metadata is an empty object.
+func ReadCSV(r io.Reader) ([]ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, xerror.ValidationFailed(err, "CSV document is empty")
+		}
+		return nil, xerror.ValidationFailed(err, fmt.Sprintf("Invalid CSV header: %s", err))
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if !slices.Contains(CSVHeader, name) {
+			return nil, xerror.ValidationFailed(nil, fmt.Sprintf("Unknown CSV column %s, columns are %v", name, CSVHeader))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, xerror.ValidationFailed(nil, fmt.Sprintf("CSV column %s is declared more than once", name))
+		}
+		columns[name] = i
+	}
+	for _, name := range CSVHeader[:2] {
+		if _, ok := columns[name]; !ok {
+			return nil, xerror.ValidationFailed(nil, fmt.Sprintf("CSV column %s is required", name))
+		}
+	}
+
+	rows := []ImportRow{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, xerror.ValidationFailed(err, fmt.Sprintf("Invalid CSV row %d: %s", len(rows)+1, err))
+		}
+
+		row := ImportRow{
+			Row:      len(rows) + 1,
+			Name:     record[columns["name"]],
+			QRCode:   record[columns["qr_code"]],
+			Metadata: map[string]any{},
+		}
+		if i, ok := columns["metadata"]; ok && record[i] != "" {
+			if err := json.Unmarshal([]byte(record[i]), &row.Metadata); err != nil || row.Metadata == nil {
+				return nil, xerror.ValidationFailed(err, fmt.Sprintf("Metadata of CSV row %d must be a JSON object", row.Row))
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// WriteCSV writes the QR locations as a CSV document, which ReadCSV reads
+// back.
+func WriteCSV(w io.Writer, locations []QRLocation) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+
+	for _, l := range locations {
+		metadata, err := json.Marshal(l.Metadata)
+		if err != nil {
+			return fmt.Errorf("marshal metadata of %s: %w", l.QRCode, err)
+		}
+		if err := writer.Write([]string{l.Name, l.QRCode, string(metadata)}); err != nil {
+			return fmt.Errorf("write row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ImportItem is a QR location an import creates, updates or leaves
+// unchanged, with the row it comes from.
+type ImportItem struct {
+	Row      int
+	Location QRLocation
+}
+
+// ImportConflict is a row which can not be imported.
+type ImportConflict struct {
+	Row    int
+	QRCode string
+	Reason string
+}
+
+// ImportPlan is what importing rows does to the QR locations.
+type ImportPlan struct {
+	Creates   []ImportItem
+	Updates   []ImportItem
+	Unchanged []ImportItem
+	Conflicts []ImportConflict
+}
+
+// PlanImport upserts the rows into the existing QR locations by QR code. A
+// row whose QR code exists updates the name and the metadata of the location
+// when they differ. The rows sharing a QR code conflict, none of them is
+// imported.
+func PlanImport(rows []ImportRow, existing []QRLocation) ImportPlan {
+	plan := ImportPlan{
+		Creates:   []ImportItem{},
+		Updates:   []ImportItem{},
+		Unchanged: []ImportItem{},
+		Conflicts: []ImportConflict{},
+	}
+
+	rowsByQRCode := make(map[string][]int, len(rows))
+	for _, r := range rows {
+		rowsByQRCode[r.QRCode] = append(rowsByQRCode[r.QRCode], r.Row)
+	}
+	byQRCode := make(map[string]QRLocation, len(existing))
+	for _, l := range existing {
+		byQRCode[l.QRCode] = l
+	}
+
+	for _, r := range rows {
+		if others := rowsByQRCode[r.QRCode]; len(others) > 1 {
+			plan.Conflicts = append(plan.Conflicts, ImportConflict{
+				Row:    r.Row,
+				QRCode: r.QRCode,
+				Reason: fmt.Sprintf("QR code %s is used by rows %v", r.QRCode, others),
+			})
+			continue
+		}
+
+		l, ok := byQRCode[r.QRCode]
+		switch {
+		case !ok:
+			plan.Creates = append(plan.Creates, ImportItem{
+				Row:      r.Row,
+				Location: NewQRLocation(r.Name, r.QRCode, r.Metadata),
+			})
+		case l.Name == r.Name && sameMetadata(l.Metadata, r.Metadata):
+			plan.Unchanged = append(plan.Unchanged, ImportItem{Row: r.Row, Location: l})
+		default:
+			l.Name = r.Name
+			l.Metadata = r.Metadata
+			plan.Updates = append(plan.Updates, ImportItem{Row: r.Row, Location: l})
+		}
+	}
+
+	return plan
+}
+
+// sameMetadata reports whether both metadata hold the same values. Both are
+// decoded from JSON, so they hold the same types.
+func sameMetadata(a, b map[string]any) bool {
+	return len(a) == 0 && len(b) == 0 || reflect.DeepEqual(a, b)
+}
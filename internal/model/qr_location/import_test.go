@@ -0,0 +1,116 @@
+package qrlocation_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		csv       string
+		want      []qrlocation.ImportRow
+		expectErr bool
+	}{
+		{
+			name: "all columns",
+			csv:  "name,qr_code,metadata\nDock A,QR001,\"{\"\"zone\"\":1}\"\n",
+			want: []qrlocation.ImportRow{
+				{Row: 1, Name: "Dock A", QRCode: "QR001", Metadata: map[string]any{"zone": float64(1)}},
+			},
+		},
+		{
+			name: "columns in any order without metadata",
+			csv:  "qr_code,name\nQR001,Dock A\nQR002,Dock B\n",
+			want: []qrlocation.ImportRow{
+				{Row: 1, Name: "Dock A", QRCode: "QR001", Metadata: map[string]any{}},
+				{Row: 2, Name: "Dock B", QRCode: "QR002", Metadata: map[string]any{}},
+			},
+		},
+		{
+			name: "header only",
+			csv:  "name,qr_code,metadata\n",
+			want: []qrlocation.ImportRow{},
+		},
+		{name: "empty", csv: "", expectErr: true},
+		{name: "missing qr_code column", csv: "name\nDock A\n", expectErr: true},
+		{name: "unknown column", csv: "name,qr_code,zone\nDock A,QR001,1\n", expectErr: true},
+		{name: "duplicated column", csv: "name,qr_code,name\nDock A,QR001,Dock\n", expectErr: true},
+		{name: "metadata not an object", csv: "name,qr_code,metadata\nDock A,QR001,[1]\n", expectErr: true},
+		{name: "missing cell", csv: "name,qr_code\nDock A\n", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := qrlocation.ReadCSV(strings.NewReader(tt.csv))
+			if tt.expectErr {
+				assert.True(t, xerror.IsStatus(err, xerror.StatusValidationFailed), err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rows)
+		})
+	}
+}
+
+func TestWriteCSVReadsBack(t *testing.T) {
+	locations := []qrlocation.QRLocation{
+		qrlocation.NewQRLocation("Dock A", "QR001", map[string]any{"zone": "north, east"}),
+		qrlocation.NewQRLocation("Dock B", "QR002", map[string]any{}),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, qrlocation.WriteCSV(&buf, locations))
+
+	rows, err := qrlocation.ReadCSV(&buf)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	for i, l := range locations {
+		assert.Equal(t, l.Name, rows[i].Name)
+		assert.Equal(t, l.QRCode, rows[i].QRCode)
+		assert.Equal(t, l.Metadata, rows[i].Metadata)
+	}
+}
+
+func TestPlanImport(t *testing.T) {
+	dock := qrlocation.NewQRLocation("Dock A", "QR001", map[string]any{"zone": float64(1)})
+	shelf := qrlocation.NewQRLocation("Shelf", "QR002", map[string]any{})
+	existing := []qrlocation.QRLocation{dock, shelf}
+
+	rows := []qrlocation.ImportRow{
+		{Row: 1, Name: "Dock A", QRCode: "QR001", Metadata: map[string]any{"zone": float64(1)}},
+		{Row: 2, Name: "Shelf 2", QRCode: "QR002", Metadata: map[string]any{}},
+		{Row: 3, Name: "Gate", QRCode: "QR003", Metadata: map[string]any{}},
+		{Row: 4, Name: "Gate A", QRCode: "QR004", Metadata: map[string]any{}},
+		{Row: 5, Name: "Gate B", QRCode: "QR004", Metadata: map[string]any{}},
+	}
+
+	plan := qrlocation.PlanImport(rows, existing)
+
+	require.Len(t, plan.Unchanged, 1)
+	assert.Equal(t, 1, plan.Unchanged[0].Row)
+	assert.Equal(t, dock, plan.Unchanged[0].Location)
+
+	require.Len(t, plan.Updates, 1)
+	assert.Equal(t, 2, plan.Updates[0].Row)
+	assert.Equal(t, shelf.ID, plan.Updates[0].Location.ID)
+	assert.Equal(t, "Shelf 2", plan.Updates[0].Location.Name)
+
+	require.Len(t, plan.Creates, 1)
+	assert.Equal(t, 3, plan.Creates[0].Row)
+	assert.Equal(t, "QR003", plan.Creates[0].Location.QRCode)
+	assert.NotEmpty(t, plan.Creates[0].Location.ID)
+
+	require.Len(t, plan.Conflicts, 2)
+	assert.Equal(t, 4, plan.Conflicts[0].Row)
+	assert.Equal(t, 5, plan.Conflicts[1].Row)
+	assert.Equal(t, "QR code QR004 is used by rows [4 5]", plan.Conflicts[0].Reason)
+}
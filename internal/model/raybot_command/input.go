@@ -2,7 +2,13 @@ package raybotcommand
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
 )
 
 type Inputs struct {
@@ -123,3 +129,95 @@ type CheckQRCodeInput struct {
 type SpeakInput struct {
 	Text string `json:"text"`
 }
+
+// MaxBoxDistance is the farthest, in millimeters, LIFT_BOX and DROP_BOX move
+// the box. A distance of 0 lets the raybot use its default.
+const MaxBoxDistance int32 = 5000
+
+// Validate checks the inputs of a command of type t are well formed. It does
+// not check the QR locations and QR codes they reference exist. The errors
+// name the invalid field.
+func (i Inputs) Validate(t Type) error {
+	switch t {
+	case TypeMoveToLocation:
+		// The direction is decoded as a string, so an invalid one is told
+		// apart from malformed inputs.
+		var input struct {
+			Location  string `json:"location"`
+			Direction string `json:"direction"`
+		}
+		if err := json.Unmarshal(i.union, &input); err != nil {
+			return invalidInputs(err, t)
+		}
+		if err := uuid.Validate(input.Location); err != nil {
+			return xerror.ValidationFailed(err, "inputs.location must be a valid UUID")
+		}
+		var direction MoveDirection
+		if err := direction.UnmarshalText([]byte(input.Direction)); err != nil {
+			return xerror.ValidationFailed(err, fmt.Sprintf(
+				"inputs.direction must be %s or %s", MoveDirectionForward, MoveDirectionBackward))
+		}
+	case TypeLiftBox:
+		input, err := i.AsLiftBoxInput()
+		if err != nil {
+			return invalidInputs(err, t)
+		}
+		return validateBoxDistance(input.Distance)
+	case TypeDropBox:
+		input, err := i.AsDropBoxInput()
+		if err != nil {
+			return invalidInputs(err, t)
+		}
+		return validateBoxDistance(input.Distance)
+	case TypeCheckQRCode:
+		input, err := i.AsCheckQRCodeInput()
+		if err != nil {
+			return invalidInputs(err, t)
+		}
+		if input.QRCode == "" {
+			return xerror.ValidationFailed(nil, "inputs.qr_code is required")
+		}
+	case TypeSpeak:
+		input, err := i.AsSpeakInput()
+		if err != nil {
+			return invalidInputs(err, t)
+		}
+		if input.Text == "" {
+			return xerror.ValidationFailed(nil, "inputs.text is required")
+		}
+	}
+
+	return nil
+}
+
+func invalidInputs(err error, t Type) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return xerror.ValidationFailed(err, fmt.Sprintf("inputs.%s must be a %s", typeErr.Field, jsonTypeName(typeErr.Type)))
+	}
+	return xerror.ValidationFailed(err, fmt.Sprintf("inputs must be a JSON object of %s inputs", t))
+}
+
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+func validateBoxDistance(distance int32) error {
+	if distance < 0 || distance > MaxBoxDistance {
+		return xerror.ValidationFailed(nil, fmt.Sprintf("inputs.distance must be between 0 and %d", MaxBoxDistance))
+	}
+	return nil
+}
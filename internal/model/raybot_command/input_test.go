@@ -0,0 +1,115 @@
+package raybotcommand_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+func TestInputsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     raybotcommand.Type
+		inputs  string
+		wantMsg string
+	}{
+		{
+			name:   "move to location",
+			typ:    raybotcommand.TypeMoveToLocation,
+			inputs: `{"location":"123e4567-e89b-12d3-a456-426614174000","direction":"FORWARD"}`,
+		},
+		{
+			name:    "move to location without location",
+			typ:     raybotcommand.TypeMoveToLocation,
+			inputs:  `{"direction":"FORWARD"}`,
+			wantMsg: "inputs.location must be a valid UUID",
+		},
+		{
+			name:    "move to location with invalid direction",
+			typ:     raybotcommand.TypeMoveToLocation,
+			inputs:  `{"location":"123e4567-e89b-12d3-a456-426614174000","direction":"LEFT"}`,
+			wantMsg: "inputs.direction must be FORWARD or BACKWARD",
+		},
+		{
+			name:    "move to location with location of the wrong type",
+			typ:     raybotcommand.TypeMoveToLocation,
+			inputs:  `{"location":1,"direction":"FORWARD"}`,
+			wantMsg: "inputs.location must be a string",
+		},
+		{
+			name:    "move to location with malformed inputs",
+			typ:     raybotcommand.TypeMoveToLocation,
+			inputs:  `[]`,
+			wantMsg: "inputs must be a JSON object of MOVE_TO_LOCATION inputs",
+		},
+		{
+			name:   "lift box without distance",
+			typ:    raybotcommand.TypeLiftBox,
+			inputs: `{}`,
+		},
+		{
+			name:   "lift box at max distance",
+			typ:    raybotcommand.TypeLiftBox,
+			inputs: `{"distance":5000}`,
+		},
+		{
+			name:    "lift box too far",
+			typ:     raybotcommand.TypeLiftBox,
+			inputs:  `{"distance":5001}`,
+			wantMsg: "inputs.distance must be between 0 and 5000",
+		},
+		{
+			name:    "drop box with negative distance",
+			typ:     raybotcommand.TypeDropBox,
+			inputs:  `{"distance":-1}`,
+			wantMsg: "inputs.distance must be between 0 and 5000",
+		},
+		{
+			name:    "drop box with distance of the wrong type",
+			typ:     raybotcommand.TypeDropBox,
+			inputs:  `{"distance":"far"}`,
+			wantMsg: "inputs.distance must be a number",
+		},
+		{
+			name:   "check qr",
+			typ:    raybotcommand.TypeCheckQRCode,
+			inputs: `{"qr_code":"QR001"}`,
+		},
+		{
+			name:    "check qr without qr code",
+			typ:     raybotcommand.TypeCheckQRCode,
+			inputs:  `{}`,
+			wantMsg: "inputs.qr_code is required",
+		},
+		{
+			name:    "speak without text",
+			typ:     raybotcommand.TypeSpeak,
+			inputs:  `{"text":""}`,
+			wantMsg: "inputs.text is required",
+		},
+		{
+			name:   "command without inputs",
+			typ:    raybotcommand.TypeStop,
+			inputs: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := raybotcommand.NewInputs([]byte(tt.inputs)).Validate(tt.typ)
+			if tt.wantMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.True(t, xerror.IsStatus(err, xerror.StatusValidationFailed), err)
+			var xErr xerror.XError
+			if assert.ErrorAs(t, err, &xErr) {
+				assert.Equal(t, tt.wantMsg, xErr.Msg())
+			}
+		})
+	}
+}
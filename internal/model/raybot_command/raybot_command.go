@@ -63,6 +63,9 @@ func (s *Status) UnmarshalText(text []byte) error {
 }
 
 const (
+	// RaybotCommandStatusQueued is a MOVE_TO_LOCATION command waiting for
+	// its route to be reserved, it is not dispatched to the raybot yet.
+	RaybotCommandStatusQueued     Status = "QUEUED"
 	RaybotCommandStatusPending    Status = "PENDING"
 	RaybotCommandStatusInProgress Status = "IN_PROGRESS"
 	RaybotCommandStatusSucceeded  Status = "SUCCEEDED"
@@ -70,12 +73,33 @@ const (
 )
 
 var RaybotCommandStatusMap = map[Status]struct{}{
+	RaybotCommandStatusQueued:     {},
 	RaybotCommandStatusPending:    {},
 	RaybotCommandStatusInProgress: {},
 	RaybotCommandStatusSucceeded:  {},
 	RaybotCommandStatusFailed:     {},
 }
 
+// IsFinished reports whether the status is final: the command succeeded or
+// failed.
+func (s Status) IsFinished() bool {
+	return s == RaybotCommandStatusSucceeded || s == RaybotCommandStatusFailed
+}
+
+// CanUpdateTo reports whether a command may be updated from status s to
+// next. QUEUED is managed by the traffic control, which grants the
+// reservation of a queued command, so a queued command may only fail and no
+// command goes back to QUEUED.
+func (s Status) CanUpdateTo(next Status) bool {
+	if s == next {
+		return true
+	}
+	if s == RaybotCommandStatusQueued {
+		return next == RaybotCommandStatusFailed
+	}
+	return next != RaybotCommandStatusQueued
+}
+
 type RaybotCommand struct {
 	ID          string
 	RaybotID    string
@@ -0,0 +1,61 @@
+package raybotcommand_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+)
+
+func TestStatusCanUpdateTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from raybotcommand.Status
+		to   raybotcommand.Status
+		want bool
+	}{
+		{
+			name: "pending to in progress",
+			from: raybotcommand.RaybotCommandStatusPending,
+			to:   raybotcommand.RaybotCommandStatusInProgress,
+			want: true,
+		},
+		{
+			name: "queued to failed",
+			from: raybotcommand.RaybotCommandStatusQueued,
+			to:   raybotcommand.RaybotCommandStatusFailed,
+			want: true,
+		},
+		{
+			name: "queued to queued",
+			from: raybotcommand.RaybotCommandStatusQueued,
+			to:   raybotcommand.RaybotCommandStatusQueued,
+			want: true,
+		},
+		{
+			name: "queued to pending",
+			from: raybotcommand.RaybotCommandStatusQueued,
+			to:   raybotcommand.RaybotCommandStatusPending,
+			want: false,
+		},
+		{
+			name: "queued to succeeded",
+			from: raybotcommand.RaybotCommandStatusQueued,
+			to:   raybotcommand.RaybotCommandStatusSucceeded,
+			want: false,
+		},
+		{
+			name: "pending to queued",
+			from: raybotcommand.RaybotCommandStatusPending,
+			to:   raybotcommand.RaybotCommandStatusQueued,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.from.CanUpdateTo(tt.to))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package raybotposition
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+)
+
+// Source is what located the raybot.
+type Source string
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *Source) UnmarshalText(text []byte) error {
+	source := Source(text)
+	if _, ok := SourceMap[source]; !ok {
+		return fmt.Errorf("invalid RaybotPositionSource: %s", text)
+	}
+	*s = source
+	return nil
+}
+
+const (
+	// SourceReport is a QR code reported by the raybot.
+	SourceReport Source = "REPORT"
+	// SourceCheckQR is the QR code of a succeeded CHECK_QR command.
+	SourceCheckQR Source = "CHECK_QR"
+	// SourceScanLocation is the last QR code of a succeeded SCAN_LOCATION
+	// command.
+	SourceScanLocation Source = "SCAN_LOCATION"
+	// SourceMoveToLocation is the destination of a succeeded
+	// MOVE_TO_LOCATION command.
+	SourceMoveToLocation Source = "MOVE_TO_LOCATION"
+)
+
+var SourceMap = map[Source]struct{}{
+	SourceReport:         {},
+	SourceCheckQR:        {},
+	SourceScanLocation:   {},
+	SourceMoveToLocation: {},
+}
+
+// Position is a QR location a raybot was seen at. The last one is where the
+// raybot stands, the previous ones are its history.
+type Position struct {
+	ID         string
+	RaybotID   string
+	LocationID string
+	Source     Source
+	// CommandID is the command which located the raybot, nil when the
+	// raybot reported its position.
+	CommandID *string
+	CreatedAt time.Time
+}
+
+func NewPosition(raybotID, locationID string, source Source, commandID *string) Position {
+	return Position{
+		ID:         uuid.NewString(),
+		RaybotID:   raybotID,
+		LocationID: locationID,
+		Source:     source,
+		CommandID:  commandID,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// CheckIn is a raybot telling the QR location it stands at, by the QR code
+// of the location or by its ID.
+type CheckIn struct {
+	RaybotID   string
+	QRCode     string
+	LocationID string
+	Source     Source
+	CommandID  *string
+}
+
+// CheckInFromCommand returns the check-in of a succeeded command locating
+// its raybot, false if the command does not locate it.
+func CheckInFromCommand(rbc raybotcommand.RaybotCommand) (CheckIn, bool) {
+	if rbc.Status != raybotcommand.RaybotCommandStatusSucceeded {
+		return CheckIn{}, false
+	}
+
+	checkIn := CheckIn{RaybotID: rbc.RaybotID, CommandID: &rbc.ID}
+	switch rbc.Type {
+	case raybotcommand.TypeCheckQRCode:
+		input, err := rbc.Inputs.AsCheckQRCodeInput()
+		if err != nil || input.QRCode == "" {
+			return CheckIn{}, false
+		}
+		checkIn.QRCode = input.QRCode
+		checkIn.Source = SourceCheckQR
+	case raybotcommand.TypeScanLocation:
+		outputs, err := rbc.Outputs.AsScanLocationOutputs()
+		if err != nil || len(outputs.Locations) == 0 {
+			return CheckIn{}, false
+		}
+		checkIn.QRCode = outputs.Locations[len(outputs.Locations)-1]
+		checkIn.Source = SourceScanLocation
+	case raybotcommand.TypeMoveToLocation:
+		input, err := rbc.Inputs.AsMoveToLocationInput()
+		if err != nil || input.Location == "" {
+			return CheckIn{}, false
+		}
+		checkIn.LocationID = input.Location
+		checkIn.Source = SourceMoveToLocation
+	default:
+		return CheckIn{}, false
+	}
+
+	return checkIn, true
+}
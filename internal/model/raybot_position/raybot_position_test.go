@@ -0,0 +1,94 @@
+package raybotposition_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	raybotposition "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_position"
+)
+
+func TestCheckInFromCommand(t *testing.T) {
+	commandID := "command-id"
+
+	tests := []struct {
+		name        string
+		commandType raybotcommand.Type
+		status      raybotcommand.Status
+		inputs      string
+		outputs     string
+		want        raybotposition.CheckIn
+		wantOK      bool
+	}{
+		{
+			name:        "check qr",
+			commandType: raybotcommand.TypeCheckQRCode,
+			status:      raybotcommand.RaybotCommandStatusSucceeded,
+			inputs:      `{"qr_code":"QR-1"}`,
+			want:        raybotposition.CheckIn{QRCode: "QR-1", Source: raybotposition.SourceCheckQR},
+			wantOK:      true,
+		},
+		{
+			name:        "scan location keeps the last code",
+			commandType: raybotcommand.TypeScanLocation,
+			status:      raybotcommand.RaybotCommandStatusSucceeded,
+			outputs:     `{"locations":["QR-1","QR-2"]}`,
+			want:        raybotposition.CheckIn{QRCode: "QR-2", Source: raybotposition.SourceScanLocation},
+			wantOK:      true,
+		},
+		{
+			name:        "scan without location",
+			commandType: raybotcommand.TypeScanLocation,
+			status:      raybotcommand.RaybotCommandStatusSucceeded,
+			outputs:     `{"locations":[]}`,
+		},
+		{
+			name:        "move to location",
+			commandType: raybotcommand.TypeMoveToLocation,
+			status:      raybotcommand.RaybotCommandStatusSucceeded,
+			inputs:      `{"location":"location-id","direction":"FORWARD"}`,
+			want:        raybotposition.CheckIn{LocationID: "location-id", Source: raybotposition.SourceMoveToLocation},
+			wantOK:      true,
+		},
+		{
+			name:        "failed command",
+			commandType: raybotcommand.TypeCheckQRCode,
+			status:      raybotcommand.RaybotCommandStatusFailed,
+			inputs:      `{"qr_code":"QR-1"}`,
+		},
+		{
+			name:        "command not locating the raybot",
+			commandType: raybotcommand.TypeOpenBox,
+			status:      raybotcommand.RaybotCommandStatusSucceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.inputs == "" {
+				tt.inputs = "{}"
+			}
+			if tt.outputs == "" {
+				tt.outputs = "{}"
+			}
+			rbc := raybotcommand.RaybotCommand{
+				ID:       commandID,
+				RaybotID: "raybot-id",
+				Type:     tt.commandType,
+				Status:   tt.status,
+				Inputs:   raybotcommand.NewInputs([]byte(tt.inputs)),
+				Outputs:  raybotcommand.NewOutputs([]byte(tt.outputs)),
+			}
+
+			got, ok := raybotposition.CheckInFromCommand(rbc)
+			assert.Equal(t, tt.wantOK, ok)
+			if !tt.wantOK {
+				return
+			}
+			tt.want.RaybotID = "raybot-id"
+			tt.want.CommandID = &commandID
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
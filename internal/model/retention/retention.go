@@ -0,0 +1,171 @@
+// Package retention describes the history records purged once past their
+// retention, and the archive records they are kept as.
+package retention
+
+import (
+	"time"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
+	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+)
+
+// The tables of the purged records.
+const (
+	TableWorkflowExecutions      = "workflow_executions"
+	TableStepExecutions          = "step_executions"
+	TableWorkflowExecutionEvents = "workflow_execution_events"
+	TableRaybotCommands          = "raybot_commands"
+)
+
+// Purged is the number of purged rows by table.
+type Purged map[string]int64
+
+// WorkflowExecutionRecord is the archive record of a workflow execution,
+// with its steps and events.
+type WorkflowExecutionRecord struct {
+	ID                string                `json:"id"`
+	WorkflowID        string                `json:"workflow_id"`
+	WorkflowVersionID *string               `json:"workflow_version_id"`
+	IsSimulated       bool                  `json:"is_simulated"`
+	TriggerType       node.TriggerType      `json:"trigger_type"`
+	RaybotID          *string               `json:"raybot_id"`
+	Status            string                `json:"status"`
+	Data              workflow.Data         `json:"data"`
+	Inputs            map[string]any        `json:"inputs"`
+	Outputs           map[string]any        `json:"outputs"`
+	Error             *string               `json:"error"`
+	CreatedAt         time.Time             `json:"created_at"`
+	UpdatedAt         time.Time             `json:"updated_at"`
+	StartedAt         *time.Time            `json:"started_at"`
+	CompletedAt       *time.Time            `json:"completed_at"`
+	Steps             []StepExecutionRecord `json:"steps"`
+	Events            []EventRecord         `json:"events"`
+}
+
+// StepExecutionRecord is the archive record of a step execution.
+type StepExecutionRecord struct {
+	ID          string         `json:"id"`
+	Status      string         `json:"status"`
+	Node        node.Node      `json:"node"`
+	Inputs      map[string]any `json:"inputs"`
+	Outputs     map[string]any `json:"outputs"`
+	Error       *string        `json:"error"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	StartedAt   *time.Time     `json:"started_at"`
+	CompletedAt *time.Time     `json:"completed_at"`
+}
+
+// EventRecord is the archive record of a workflow execution event.
+type EventRecord struct {
+	ID              int64          `json:"id"`
+	StepExecutionID *string        `json:"step_execution_id"`
+	Type            string         `json:"type"`
+	Status          string         `json:"status"`
+	Outputs         map[string]any `json:"outputs"`
+	Error           *string        `json:"error"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
+// RaybotCommandRecord is the archive record of a raybot command.
+type RaybotCommandRecord struct {
+	ID          string                `json:"id"`
+	RaybotID    string                `json:"raybot_id"`
+	Type        string                `json:"type"`
+	Status      string                `json:"status"`
+	Inputs      raybotcommand.Inputs  `json:"inputs"`
+	Outputs     raybotcommand.Outputs `json:"outputs"`
+	Error       *string               `json:"error"`
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+	CompletedAt *time.Time            `json:"completed_at"`
+}
+
+// NewWorkflowExecutionRecords returns the records of the executions, each
+// with its steps among steps and its events among events.
+func NewWorkflowExecutionRecords(
+	executions []workflowexecution.WorkflowExecution,
+	steps []stepexecution.StepExecution,
+	events []workflowexecution.Event,
+) []WorkflowExecutionRecord {
+	stepsByExecution := make(map[string][]StepExecutionRecord, len(executions))
+	for _, s := range steps {
+		stepsByExecution[s.WorkflowExecutionID] = append(stepsByExecution[s.WorkflowExecutionID], StepExecutionRecord{
+			ID:          s.ID,
+			Status:      string(s.Status),
+			Node:        s.Node,
+			Inputs:      s.Inputs,
+			Outputs:     s.Outputs,
+			Error:       s.Error,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   s.UpdatedAt,
+			StartedAt:   s.StartedAt,
+			CompletedAt: s.CompletedAt,
+		})
+	}
+
+	eventsByExecution := make(map[string][]EventRecord, len(executions))
+	for _, ev := range events {
+		eventsByExecution[ev.WorkflowExecutionID] = append(eventsByExecution[ev.WorkflowExecutionID], EventRecord{
+			ID:              ev.ID,
+			StepExecutionID: ev.StepExecutionID,
+			Type:            string(ev.Type),
+			Status:          ev.Status,
+			Outputs:         ev.Outputs,
+			Error:           ev.Error,
+			CreatedAt:       ev.CreatedAt,
+		})
+	}
+
+	records := make([]WorkflowExecutionRecord, len(executions))
+	for i, e := range executions {
+		executionSteps := stepsByExecution[e.ID]
+		if executionSteps == nil {
+			executionSteps = []StepExecutionRecord{}
+		}
+		executionEvents := eventsByExecution[e.ID]
+		if executionEvents == nil {
+			executionEvents = []EventRecord{}
+		}
+		records[i] = WorkflowExecutionRecord{
+			ID:                e.ID,
+			WorkflowID:        e.WorkflowID,
+			WorkflowVersionID: e.WorkflowVersionID,
+			IsSimulated:       e.IsSimulated,
+			TriggerType:       e.TriggerType,
+			RaybotID:          e.RaybotID,
+			Status:            string(e.Status),
+			Data:              e.Data,
+			Inputs:            e.Inputs,
+			Outputs:           e.Outputs,
+			Error:             e.Error,
+			CreatedAt:         e.CreatedAt,
+			UpdatedAt:         e.UpdatedAt,
+			StartedAt:         e.StartedAt,
+			CompletedAt:       e.CompletedAt,
+			Steps:             executionSteps,
+			Events:            executionEvents,
+		}
+	}
+
+	return records
+}
+
+// NewRaybotCommandRecord returns the record of the command.
+func NewRaybotCommandRecord(rbc raybotcommand.RaybotCommand) RaybotCommandRecord {
+	return RaybotCommandRecord{
+		ID:          rbc.ID,
+		RaybotID:    rbc.RaybotID,
+		Type:        string(rbc.Type),
+		Status:      string(rbc.Status),
+		Inputs:      rbc.Inputs,
+		Outputs:     rbc.Outputs,
+		Error:       rbc.Error,
+		CreatedAt:   rbc.CreatedAt,
+		UpdatedAt:   rbc.UpdatedAt,
+		CompletedAt: rbc.CompletedAt,
+	}
+}
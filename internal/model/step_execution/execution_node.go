@@ -1,8 +1,11 @@
 package stepexecution
 
 import (
+	"encoding/json"
+	"fmt"
 	"sync"
 
+	dynamicvalue "github.com/tuanvumaihuynh/roboflow/internal/model/workflow/dynamic_value"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/edge"
 )
 
@@ -11,12 +14,31 @@ type ExecutionNode struct {
 	Step     StepExecution
 	Parents  []*ExecutionNode
 	Children []*ExecutionNode
-	Outputs  map[string]any
+
+	// Outputs are set once the node is executed, while the nodes running
+	// concurrently may read them, see SetOutputs and Output.
+	Outputs   map[string]any
+	OutputsMu sync.RWMutex
 
 	IsExecuted   bool
 	IsExecutedMu sync.Mutex
 }
 
+// SetOutputs sets the outputs of the executed node.
+func (n *ExecutionNode) SetOutputs(outputs map[string]any) {
+	n.OutputsMu.Lock()
+	defer n.OutputsMu.Unlock()
+	n.Outputs = outputs
+}
+
+// Output returns the output of the node with the given key.
+func (n *ExecutionNode) Output(key string) (any, bool) {
+	n.OutputsMu.RLock()
+	defer n.OutputsMu.RUnlock()
+	output, ok := n.Outputs[key]
+	return output, ok
+}
+
 // ExecutionGraph is a map of node ID to execution node
 type ExecutionGraph map[string]*ExecutionNode
 
@@ -45,3 +67,37 @@ func BuildExecutionGraph(edges []edge.Edge, steps []StepExecution) ExecutionGrap
 
 	return nodes
 }
+
+// ResolveDynamicValue returns the static value of v, or the output of the
+// referenced node of the graph. The referenced node must have been executed.
+func ResolveDynamicValue[T any](g ExecutionGraph, v dynamicvalue.DynamicValue[T]) (T, error) {
+	var zero T
+	if v.Type == dynamicvalue.SourceTypeStatic {
+		return v.GetStaticValue()
+	}
+
+	ref, err := v.GetNodeReference()
+	if err != nil {
+		return zero, err
+	}
+	n, ok := g[ref.NodeID]
+	if !ok {
+		return zero, fmt.Errorf("referenced node %s not found", ref.NodeID)
+	}
+	output, ok := n.Output(ref.Key)
+	if !ok {
+		return zero, fmt.Errorf("output %s of node %s not found", ref.Key, ref.NodeID)
+	}
+
+	// Outputs are decoded from JSON, round trip them to get a T.
+	raw, err := json.Marshal(output)
+	if err != nil {
+		return zero, fmt.Errorf("marshal output %s of node %s: %w", ref.Key, ref.NodeID, err)
+	}
+	var ret T
+	if err := json.Unmarshal(raw, &ret); err != nil {
+		return zero, fmt.Errorf("output %s of node %s has an invalid type: %w", ref.Key, ref.NodeID, err)
+	}
+
+	return ret, nil
+}
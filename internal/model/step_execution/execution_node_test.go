@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	dynamicvalue "github.com/tuanvumaihuynh/roboflow/internal/model/workflow/dynamic_value"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/edge"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
 )
@@ -50,3 +52,78 @@ func TestBuildExecutionGraph(t *testing.T) {
 	assert.Equal(t, "2", node3.Parents[0].Step.Node.ID)
 	assert.Empty(t, node3.Children)
 }
+
+func TestResolveDynamicValue(t *testing.T) {
+	graph := ExecutionGraph{
+		"1": {Outputs: map[string]any{"location": "QR_A1", "distance": float64(10)}},
+	}
+
+	tests := []struct {
+		name       string
+		value      dynamicvalue.DynamicValue[string]
+		expected   string
+		expectErr  bool
+		errMessage string
+	}{
+		{
+			name:     "Static value",
+			value:    *dynamicvalue.NewStaticValue("QR_B2"),
+			expected: "QR_B2",
+		},
+		{
+			name:     "Reference to an output",
+			value:    *dynamicvalue.NewReferenceValue[string]("1", "location"),
+			expected: "QR_A1",
+		},
+		{
+			name:       "Reference to an unknown node",
+			value:      *dynamicvalue.NewReferenceValue[string]("2", "location"),
+			expectErr:  true,
+			errMessage: "referenced node 2 not found",
+		},
+		{
+			name:       "Reference to an unknown output",
+			value:      *dynamicvalue.NewReferenceValue[string]("1", "qr_code"),
+			expectErr:  true,
+			errMessage: "output qr_code of node 1 not found",
+		},
+		{
+			name:       "Reference to an output of another type",
+			value:      *dynamicvalue.NewReferenceValue[string]("1", "distance"),
+			expectErr:  true,
+			errMessage: "has an invalid type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ResolveDynamicValue(graph, tt.value)
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestResolveDynamicValueWhileExecuting(t *testing.T) {
+	graph := ExecutionGraph{"1": {}}
+	value := *dynamicvalue.NewReferenceValue[string]("1", "location")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		graph["1"].SetOutputs(map[string]any{"location": "QR_A1"})
+	}()
+	// The node may not be executed yet, but reading must not race with
+	// the write of its outputs.
+	_, _ = ResolveDynamicValue(graph, value)
+	<-done
+
+	result, err := ResolveDynamicValue(graph, value)
+	require.NoError(t, err)
+	assert.Equal(t, "QR_A1", result)
+}
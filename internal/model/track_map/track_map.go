@@ -0,0 +1,290 @@
+package trackmap
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+// Segment is a piece of rail a raybot travels from one QR location to
+// another by moving in Direction. The way back is another Segment.
+type Segment struct {
+	ID             string
+	FromLocationID string
+	ToLocationID   string
+	// Distance is the length of the segment in millimeters.
+	Distance  int32
+	Direction raybotcommand.MoveDirection
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewSegment(fromLocationID, toLocationID string, distance int32, direction raybotcommand.MoveDirection) Segment {
+	now := time.Now()
+	return Segment{
+		ID:             uuid.NewString(),
+		FromLocationID: fromLocationID,
+		ToLocationID:   toLocationID,
+		Distance:       distance,
+		Direction:      direction,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Map is the graph of the track, the QR locations are its nodes and the
+// segments its directed edges.
+type Map struct {
+	Locations []qrlocation.QRLocation
+	Segments  []Segment
+}
+
+// HasLocation reports whether the location is on the map.
+func (m Map) HasLocation(id string) bool {
+	return slices.ContainsFunc(m.Locations, func(loc qrlocation.QRLocation) bool {
+		return loc.ID == id
+	})
+}
+
+// Components returns the IDs of the locations grouped by the parts of the
+// map a raybot can travel within: every location of a group can be reached
+// from every other one of the same group, but not from another group. The
+// largest groups come first, the IDs keep the order of Locations.
+func (m Map) Components() [][]string {
+	index := make(map[string]int, len(m.Locations))
+	for i, loc := range m.Locations {
+		index[loc.ID] = i
+	}
+
+	next := make([][]int, len(m.Locations))
+	prev := make([][]int, len(m.Locations))
+	for _, seg := range m.Segments {
+		from, okFrom := index[seg.FromLocationID]
+		to, okTo := index[seg.ToLocationID]
+		if !okFrom || !okTo {
+			continue
+		}
+		next[from] = append(next[from], to)
+		prev[to] = append(prev[to], from)
+	}
+
+	// Kosaraju: order the locations by the end of their visit, then collect
+	// the locations reaching each one of them in the reverse order.
+	visited := make([]bool, len(m.Locations))
+	order := make([]int, 0, len(m.Locations))
+	var visit func(int)
+	visit = func(i int) {
+		visited[i] = true
+		for _, j := range next[i] {
+			if !visited[j] {
+				visit(j)
+			}
+		}
+		order = append(order, i)
+	}
+	for i := range m.Locations {
+		if !visited[i] {
+			visit(i)
+		}
+	}
+
+	component := make([]int, len(m.Locations))
+	for i := range component {
+		component[i] = -1
+	}
+	var assign func(int, int)
+	assign = func(i, c int) {
+		component[i] = c
+		for _, j := range prev[i] {
+			if component[j] == -1 {
+				assign(j, c)
+			}
+		}
+	}
+	count := 0
+	for _, i := range slices.Backward(order) {
+		if component[i] == -1 {
+			assign(i, count)
+			count++
+		}
+	}
+
+	// The groups are in the order of their first location, the stable sort
+	// keeps it between groups of the same size.
+	groupOf := make(map[int]int, count)
+	components := make([][]string, 0, count)
+	for i, loc := range m.Locations {
+		g, ok := groupOf[component[i]]
+		if !ok {
+			g = len(components)
+			groupOf[component[i]] = g
+			components = append(components, nil)
+		}
+		components[g] = append(components[g], loc.ID)
+	}
+	slices.SortStableFunc(components, func(a, b []string) int {
+		return cmp.Compare(len(b), len(a))
+	})
+
+	return components
+}
+
+// Connected reports whether a raybot can travel from every location to every
+// other one.
+func (m Map) Connected() bool {
+	return len(m.Components()) <= 1
+}
+
+// ErrRouteNotFound is returned when no route leads to the destination.
+var ErrRouteNotFound = xerror.NotFound(nil, "route.notFound", "no route to the location")
+
+// Route is the shortest way from a location to another.
+type Route struct {
+	FromLocationID string
+	ToLocationID   string
+	// Distance is the length of the route in millimeters.
+	Distance int64
+	// Segments are the segments of the route in travel order, empty when
+	// the route starts at its destination.
+	Segments []Segment
+}
+
+// Move is a part of a route traveled in a single direction, it is run by a
+// MOVE_TO_LOCATION command.
+type Move struct {
+	ToLocationID string
+	Direction    raybotcommand.MoveDirection
+	// Distance is the length of the move in millimeters.
+	Distance int64
+}
+
+// Direction returns the direction a raybot at the start of the route moves
+// in first, empty when the route starts at its destination.
+func (r Route) Direction() raybotcommand.MoveDirection {
+	if len(r.Segments) == 0 {
+		return ""
+	}
+	return r.Segments[0].Direction
+}
+
+// Moves returns the route split at every change of direction.
+func (r Route) Moves() []Move {
+	moves := []Move{}
+	for _, seg := range r.Segments {
+		if n := len(moves); n > 0 && moves[n-1].Direction == seg.Direction {
+			moves[n-1].ToLocationID = seg.ToLocationID
+			moves[n-1].Distance += int64(seg.Distance)
+			continue
+		}
+		moves = append(moves, Move{
+			ToLocationID: seg.ToLocationID,
+			Direction:    seg.Direction,
+			Distance:     int64(seg.Distance),
+		})
+	}
+	return moves
+}
+
+// ShortestRoute returns the shortest route from a location to another. Of
+// routes of the same distance, the one with the fewest segments is chosen.
+func (m Map) ShortestRoute(fromLocationID, toLocationID string) (Route, error) {
+	known := make(map[string]struct{}, len(m.Locations))
+	for _, loc := range m.Locations {
+		known[loc.ID] = struct{}{}
+	}
+	for _, id := range []string{fromLocationID, toLocationID} {
+		if _, ok := known[id]; !ok {
+			return Route{}, xerror.ValidationFailed(nil, fmt.Sprintf("Location %s is not on the track map", id))
+		}
+	}
+
+	next := make(map[string][]Segment, len(m.Locations))
+	for _, seg := range m.Segments {
+		next[seg.FromLocationID] = append(next[seg.FromLocationID], seg)
+	}
+
+	// Dijkstra, prev holds the segment reaching each visited location.
+	dist := map[string]routeCost{fromLocationID: {}}
+	prev := make(map[string]Segment)
+	done := make(map[string]bool)
+	queue := &routeQueue{{locationID: fromLocationID}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(routeQueueItem)
+		if done[item.locationID] {
+			continue
+		}
+		done[item.locationID] = true
+		if item.locationID == toLocationID {
+			break
+		}
+
+		for _, seg := range next[item.locationID] {
+			cost := routeCost{
+				distance: item.cost.distance + int64(seg.Distance),
+				segments: item.cost.segments + 1,
+			}
+			if current, ok := dist[seg.ToLocationID]; ok && !cost.less(current) {
+				continue
+			}
+			dist[seg.ToLocationID] = cost
+			prev[seg.ToLocationID] = seg
+			heap.Push(queue, routeQueueItem{locationID: seg.ToLocationID, cost: cost})
+		}
+	}
+
+	if !done[toLocationID] {
+		return Route{}, ErrRouteNotFound
+	}
+
+	segments := []Segment{}
+	for id := toLocationID; id != fromLocationID; id = prev[id].FromLocationID {
+		segments = append(segments, prev[id])
+	}
+	slices.Reverse(segments)
+
+	return Route{
+		FromLocationID: fromLocationID,
+		ToLocationID:   toLocationID,
+		Distance:       dist[toLocationID].distance,
+		Segments:       segments,
+	}, nil
+}
+
+type routeCost struct {
+	distance int64
+	segments int
+}
+
+func (c routeCost) less(o routeCost) bool {
+	if c.distance != o.distance {
+		return c.distance < o.distance
+	}
+	return c.segments < o.segments
+}
+
+type routeQueueItem struct {
+	locationID string
+	cost       routeCost
+}
+
+// routeQueue is a min-heap of the locations to visit by cost.
+type routeQueue []routeQueueItem
+
+func (q routeQueue) Len() int           { return len(q) }
+func (q routeQueue) Less(i, j int) bool { return q[i].cost.less(q[j].cost) }
+func (q routeQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *routeQueue) Push(x any)        { *q = append(*q, x.(routeQueueItem)) }
+func (q *routeQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,188 @@
+package trackmap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+)
+
+func newMap(locationIDs []string, segments [][2]string) trackmap.Map {
+	m := trackmap.Map{}
+	for _, id := range locationIDs {
+		m.Locations = append(m.Locations, qrlocation.QRLocation{ID: id})
+	}
+	for _, s := range segments {
+		m.Segments = append(m.Segments, trackmap.NewSegment(s[0], s[1], 1000, raybotcommand.MoveDirectionForward))
+	}
+	return m
+}
+
+func TestMapComponents(t *testing.T) {
+	tests := []struct {
+		name        string
+		locationIDs []string
+		segments    [][2]string
+		want        [][]string
+	}{
+		{
+			name: "empty",
+			want: [][]string{},
+		},
+		{
+			name:        "single location",
+			locationIDs: []string{"a"},
+			want:        [][]string{{"a"}},
+		},
+		{
+			name:        "two way rail",
+			locationIDs: []string{"a", "b", "c"},
+			segments:    [][2]string{{"a", "b"}, {"b", "a"}, {"b", "c"}, {"c", "b"}},
+			want:        [][]string{{"a", "b", "c"}},
+		},
+		{
+			name:        "loop",
+			locationIDs: []string{"a", "b", "c"},
+			segments:    [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}},
+			want:        [][]string{{"a", "b", "c"}},
+		},
+		{
+			name:        "one way rail",
+			locationIDs: []string{"a", "b"},
+			segments:    [][2]string{{"a", "b"}},
+			want:        [][]string{{"a"}, {"b"}},
+		},
+		{
+			name:        "isolated location",
+			locationIDs: []string{"a", "b", "c"},
+			segments:    [][2]string{{"b", "c"}, {"c", "b"}},
+			want:        [][]string{{"b", "c"}, {"a"}},
+		},
+		{
+			name:        "unknown location",
+			locationIDs: []string{"a"},
+			segments:    [][2]string{{"a", "z"}, {"z", "a"}},
+			want:        [][]string{{"a"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newMap(tc.locationIDs, tc.segments)
+			assert.Equal(t, tc.want, m.Components())
+			assert.Equal(t, len(tc.want) <= 1, m.Connected())
+		})
+	}
+}
+
+func TestMapShortestRoute(t *testing.T) {
+	forward := raybotcommand.MoveDirectionForward
+	backward := raybotcommand.MoveDirectionBackward
+
+	// a - b - c on a two way rail with a one way shortcut from a to c, d is
+	// not connected.
+	m := trackmap.Map{
+		Locations: []qrlocation.QRLocation{{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "d"}},
+		Segments: []trackmap.Segment{
+			{ID: "ab", FromLocationID: "a", ToLocationID: "b", Distance: 1000, Direction: forward},
+			{ID: "ba", FromLocationID: "b", ToLocationID: "a", Distance: 1000, Direction: backward},
+			{ID: "bc", FromLocationID: "b", ToLocationID: "c", Distance: 1000, Direction: forward},
+			{ID: "cb", FromLocationID: "c", ToLocationID: "b", Distance: 1000, Direction: backward},
+			{ID: "ac", FromLocationID: "a", ToLocationID: "c", Distance: 1500, Direction: backward},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		from, to     string
+		wantSegments []string
+		wantDistance int64
+		wantMoves    []trackmap.Move
+		wantErr      bool
+	}{
+		{
+			name:         "same location",
+			from:         "a",
+			to:           "a",
+			wantSegments: []string{},
+			wantMoves:    []trackmap.Move{},
+		},
+		{
+			name:         "single segment",
+			from:         "a",
+			to:           "b",
+			wantSegments: []string{"ab"},
+			wantDistance: 1000,
+			wantMoves:    []trackmap.Move{{ToLocationID: "b", Direction: forward, Distance: 1000}},
+		},
+		{
+			name:         "shortcut",
+			from:         "a",
+			to:           "c",
+			wantSegments: []string{"ac"},
+			wantDistance: 1500,
+			wantMoves:    []trackmap.Move{{ToLocationID: "c", Direction: backward, Distance: 1500}},
+		},
+		{
+			name:         "several segments",
+			from:         "c",
+			to:           "a",
+			wantSegments: []string{"cb", "ba"},
+			wantDistance: 2000,
+			wantMoves:    []trackmap.Move{{ToLocationID: "a", Direction: backward, Distance: 2000}},
+		},
+		{
+			name:    "unreachable location",
+			from:    "a",
+			to:      "d",
+			wantErr: true,
+		},
+		{
+			name:    "unknown location",
+			from:    "a",
+			to:      "z",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route, err := m.ShortestRoute(tc.from, tc.to)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			segments := []string{}
+			for _, seg := range route.Segments {
+				segments = append(segments, seg.ID)
+			}
+			assert.Equal(t, tc.wantSegments, segments)
+			assert.Equal(t, tc.wantDistance, route.Distance)
+			assert.Equal(t, tc.wantMoves, route.Moves())
+		})
+	}
+}
+
+func TestRouteMoves(t *testing.T) {
+	route := trackmap.Route{
+		FromLocationID: "a",
+		ToLocationID:   "d",
+		Segments: []trackmap.Segment{
+			{FromLocationID: "a", ToLocationID: "b", Distance: 1000, Direction: raybotcommand.MoveDirectionForward},
+			{FromLocationID: "b", ToLocationID: "c", Distance: 500, Direction: raybotcommand.MoveDirectionForward},
+			{FromLocationID: "c", ToLocationID: "d", Distance: 200, Direction: raybotcommand.MoveDirectionBackward},
+		},
+	}
+
+	assert.Equal(t, raybotcommand.MoveDirectionForward, route.Direction())
+	assert.Equal(t, []trackmap.Move{
+		{ToLocationID: "c", Direction: raybotcommand.MoveDirectionForward, Distance: 1500},
+		{ToLocationID: "d", Direction: raybotcommand.MoveDirectionBackward, Distance: 200},
+	}, route.Moves())
+}
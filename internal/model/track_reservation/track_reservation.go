@@ -0,0 +1,301 @@
+package trackreservation
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+type Status string
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *Status) UnmarshalText(text []byte) error {
+	status := Status(text)
+	if _, ok := StatusMap[status]; !ok {
+		return fmt.Errorf("invalid TrackReservationStatus: %s", text)
+	}
+	*s = status
+	return nil
+}
+
+const (
+	// StatusQueued is a reservation waiting for the locations of its route
+	// to be released by the other raybots.
+	StatusQueued Status = "QUEUED"
+	// StatusGranted is a reservation holding the locations of its route, its
+	// command is dispatched to the raybot.
+	StatusGranted Status = "GRANTED"
+)
+
+var StatusMap = map[Status]struct{}{
+	StatusQueued:  {},
+	StatusGranted: {},
+}
+
+// Reservation holds the route of a MOVE_TO_LOCATION command, so no other
+// raybot travels it until the command is finished.
+type Reservation struct {
+	CommandID    string
+	RaybotID     string
+	ToLocationID string
+	Status       Status
+	// LocationIDs are the locations of the route in travel order, from the
+	// location of the raybot to ToLocationID. Empty while queued, the route
+	// is planned when the reservation is granted.
+	LocationIDs []string
+	// SegmentIDs are the segments of the route in travel order.
+	SegmentIDs []string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewReservation(commandID, raybotID, toLocationID string) Reservation {
+	now := time.Now()
+	return Reservation{
+		CommandID:    commandID,
+		RaybotID:     raybotID,
+		ToLocationID: toLocationID,
+		Status:       StatusQueued,
+		LocationIDs:  []string{},
+		SegmentIDs:   []string{},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+// Conflicts reports whether the routes of both reservations share a
+// location. Two raybots on the same rail always share the locations at its
+// ends, whatever direction they travel it in.
+func (r Reservation) Conflicts(o Reservation) bool {
+	for _, id := range r.LocationIDs {
+		if slices.Contains(o.LocationIDs, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// Advance releases the part of the route behind a raybot which reached
+// locationID. It reports whether the route changed, it does not when the
+// location is not on the route.
+func (r *Reservation) Advance(locationID string) bool {
+	i := slices.Index(r.LocationIDs, locationID)
+	if i <= 0 {
+		return false
+	}
+
+	r.LocationIDs = r.LocationIDs[i:]
+	r.SegmentIDs = r.SegmentIDs[min(i, len(r.SegmentIDs)):]
+	return true
+}
+
+// Traffic is the state the traffic controller schedules the reservations
+// from.
+type Traffic struct {
+	Map trackmap.Map
+	// Reservations are the reservations of the unfinished commands, in
+	// creation order.
+	Reservations []Reservation
+	// RaybotLocations are the locations the raybots stand at by raybot ID.
+	// A raybot whose location is unknown is missing.
+	RaybotLocations map[string]string
+}
+
+// Rejection is a queued reservation whose command can never be dispatched.
+type Rejection struct {
+	Reservation Reservation
+	Err         error
+}
+
+// Plan is the outcome of Traffic.Schedule.
+type Plan struct {
+	// Granted are the queued reservations to grant, with their route.
+	Granted []Reservation
+	// Rejected are the queued reservations whose command must fail.
+	Rejected []Rejection
+}
+
+// Schedule grants the queued reservations whose route is free, in creation
+// order. The moves of a raybot run one after the other, only its oldest
+// reservation can be granted. A route is free when no other raybot stands on
+// it and it shares no location with a granted reservation.
+//
+// Schedule also rejects the reservations which would wait forever: the ones
+// without a route to their destination, and the ones of raybots waiting for
+// each other in a cycle. Of a cycle, the newest reservation is rejected.
+func (t Traffic) Schedule() Plan {
+	plan := Plan{}
+	granted := []Reservation{}
+	for _, r := range t.Reservations {
+		if r.Status == StatusGranted {
+			granted = append(granted, r)
+		}
+	}
+
+	seen := make(map[string]bool, len(t.Reservations))
+	waiting := []Reservation{}
+	for _, r := range t.Reservations {
+		if seen[r.RaybotID] {
+			continue
+		}
+		seen[r.RaybotID] = true
+		if r.Status == StatusGranted {
+			continue
+		}
+
+		r, err := t.planRoute(r)
+		if err != nil {
+			plan.Rejected = append(plan.Rejected, Rejection{Reservation: r, Err: err})
+			continue
+		}
+		if len(t.blockers(r, granted)) > 0 {
+			waiting = append(waiting, r)
+			continue
+		}
+
+		r.Status = StatusGranted
+		granted = append(granted, r)
+		plan.Granted = append(plan.Granted, r)
+	}
+
+	// The raybots of the reservations granted after a waiting one was
+	// checked block it too.
+	waitsFor := make(map[string][]string, len(waiting))
+	for _, r := range waiting {
+		waitsFor[r.RaybotID] = t.blockers(r, granted)
+	}
+	plan.Rejected = append(plan.Rejected, rejectDeadlocks(waiting, waitsFor)...)
+
+	return plan
+}
+
+// planRoute returns r with the shortest route from the location of its
+// raybot. The route of a raybot whose location is unknown, or of a map
+// without segments, is only made of the destination and the known location.
+func (t Traffic) planRoute(r Reservation) (Reservation, error) {
+	if !t.Map.HasLocation(r.ToLocationID) {
+		return r, xerror.ValidationFailed(nil, fmt.Sprintf("Location %s is not on the track map", r.ToLocationID))
+	}
+
+	fromLocationID, ok := t.RaybotLocations[r.RaybotID]
+	switch {
+	case !ok:
+		r.LocationIDs = []string{r.ToLocationID}
+		r.SegmentIDs = []string{}
+		return r, nil
+	case len(t.Map.Segments) == 0:
+		r.LocationIDs = slices.Compact([]string{fromLocationID, r.ToLocationID})
+		r.SegmentIDs = []string{}
+		return r, nil
+	}
+
+	route, err := t.Map.ShortestRoute(fromLocationID, r.ToLocationID)
+	if err != nil {
+		return r, err
+	}
+
+	r.LocationIDs = []string{route.FromLocationID}
+	r.SegmentIDs = make([]string, 0, len(route.Segments))
+	for _, seg := range route.Segments {
+		r.LocationIDs = append(r.LocationIDs, seg.ToLocationID)
+		r.SegmentIDs = append(r.SegmentIDs, seg.ID)
+	}
+	return r, nil
+}
+
+// blockers returns the IDs of the other raybots standing on the route of r
+// or holding a granted reservation conflicting with it.
+func (t Traffic) blockers(r Reservation, granted []Reservation) []string {
+	ids := []string{}
+	for raybotID, locationID := range t.RaybotLocations {
+		if raybotID != r.RaybotID && slices.Contains(r.LocationIDs, locationID) {
+			ids = append(ids, raybotID)
+		}
+	}
+	for _, g := range granted {
+		if g.RaybotID != r.RaybotID && g.Conflicts(r) {
+			ids = append(ids, g.RaybotID)
+		}
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// rejectDeadlocks rejects the newest reservation of every cycle of raybots
+// waiting for each other, until none is left. waitsFor holds the raybots
+// each waiting raybot waits for.
+func rejectDeadlocks(waiting []Reservation, waitsFor map[string][]string) []Rejection {
+	rejected := []Rejection{}
+	byRaybot := make(map[string]Reservation, len(waiting))
+	for _, r := range waiting {
+		byRaybot[r.RaybotID] = r
+	}
+
+	for {
+		cycle := findCycle(waiting, waitsFor)
+		if cycle == nil {
+			return rejected
+		}
+
+		newest := slices.MaxFunc(cycle, func(a, b string) int {
+			ra, rb := byRaybot[a], byRaybot[b]
+			return cmp.Or(ra.CreatedAt.Compare(rb.CreatedAt), cmp.Compare(ra.CommandID, rb.CommandID))
+		})
+		r := byRaybot[newest]
+		others := slices.DeleteFunc(slices.Clone(cycle), func(id string) bool { return id == newest })
+		slices.Sort(others)
+		rejected = append(rejected, Rejection{
+			Reservation: r,
+			Err: xerror.Conflict(nil, "trackReservation.deadlock", fmt.Sprintf(
+				"Moving raybot %s to location %s would deadlock with raybots %s",
+				r.RaybotID, r.ToLocationID, strings.Join(others, ", "),
+			)),
+		})
+		delete(waitsFor, newest)
+	}
+}
+
+// findCycle returns the raybots of a cycle of waitsFor, nil if there is
+// none. The raybots are visited in the order of waiting.
+func findCycle(waiting []Reservation, waitsFor map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(waitsFor))
+	path := []string{}
+
+	var visit func(string) []string
+	visit = func(id string) []string {
+		state[id] = visiting
+		path = append(path, id)
+		for _, next := range waitsFor[id] {
+			switch state[next] {
+			case visiting:
+				return slices.Clone(path[slices.Index(path, next):])
+			case unvisited:
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[id] = visited
+		return nil
+	}
+
+	for _, r := range waiting {
+		if _, ok := waitsFor[r.RaybotID]; ok && state[r.RaybotID] == unvisited {
+			if cycle := visit(r.RaybotID); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package trackreservation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+	trackreservation "github.com/tuanvumaihuynh/roboflow/internal/model/track_reservation"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+// newLine returns a two way rail through the locations in order.
+func newLine(locationIDs ...string) trackmap.Map {
+	m := trackmap.Map{}
+	for i, id := range locationIDs {
+		m.Locations = append(m.Locations, qrlocation.QRLocation{ID: id})
+		if i > 0 {
+			prev := locationIDs[i-1]
+			m.Segments = append(m.Segments,
+				trackmap.Segment{ID: prev + id, FromLocationID: prev, ToLocationID: id, Distance: 1000, Direction: raybotcommand.MoveDirectionForward},
+				trackmap.Segment{ID: id + prev, FromLocationID: id, ToLocationID: prev, Distance: 1000, Direction: raybotcommand.MoveDirectionBackward},
+			)
+		}
+	}
+	return m
+}
+
+var start = time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+func queued(n int, raybotID, toLocationID string) trackreservation.Reservation {
+	r := trackreservation.NewReservation(raybotID+"-"+toLocationID, raybotID, toLocationID)
+	r.CreatedAt = start.Add(time.Duration(n) * time.Second)
+	return r
+}
+
+func granted(n int, raybotID string, locationIDs ...string) trackreservation.Reservation {
+	r := queued(n, raybotID, locationIDs[len(locationIDs)-1])
+	r.Status = trackreservation.StatusGranted
+	r.LocationIDs = locationIDs
+	return r
+}
+
+func TestReservationConflicts(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want bool
+	}{
+		{name: "head-on", a: []string{"a", "b"}, b: []string{"b", "a"}, want: true},
+		{name: "same destination", a: []string{"a", "c"}, b: []string{"b", "c"}, want: true},
+		{name: "apart", a: []string{"a", "b"}, b: []string{"c", "d"}, want: false},
+		{name: "empty", a: []string{}, b: []string{"a"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := trackreservation.Reservation{LocationIDs: tt.a}
+			b := trackreservation.Reservation{LocationIDs: tt.b}
+			assert.Equal(t, tt.want, a.Conflicts(b))
+			assert.Equal(t, tt.want, b.Conflicts(a))
+		})
+	}
+}
+
+func TestReservationAdvance(t *testing.T) {
+	tests := []struct {
+		name         string
+		locationID   string
+		want         bool
+		wantLocation []string
+		wantSegments []string
+	}{
+		{name: "halfway", locationID: "b", want: true, wantLocation: []string{"b", "c"}, wantSegments: []string{"bc"}},
+		{name: "destination", locationID: "c", want: true, wantLocation: []string{"c"}, wantSegments: []string{}},
+		{name: "start", locationID: "a", want: false, wantLocation: []string{"a", "b", "c"}, wantSegments: []string{"ab", "bc"}},
+		{name: "off the route", locationID: "z", want: false, wantLocation: []string{"a", "b", "c"}, wantSegments: []string{"ab", "bc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := granted(1, "r1", "a", "b", "c")
+			r.SegmentIDs = []string{"ab", "bc"}
+
+			assert.Equal(t, tt.want, r.Advance(tt.locationID))
+			assert.Equal(t, tt.wantLocation, r.LocationIDs)
+			assert.Equal(t, tt.wantSegments, r.SegmentIDs)
+		})
+	}
+}
+
+func TestTrafficSchedule(t *testing.T) {
+	type grant struct {
+		CommandID   string
+		LocationIDs []string
+	}
+
+	tests := []struct {
+		name            string
+		m               trackmap.Map
+		reservations    []trackreservation.Reservation
+		raybotLocations map[string]string
+		wantGranted     []grant
+		wantRejected    []string
+		wantStatus      xerror.Status
+	}{
+		{
+			name:            "free route",
+			m:               newLine("a", "b", "c"),
+			reservations:    []trackreservation.Reservation{queued(1, "r1", "c")},
+			raybotLocations: map[string]string{"r1": "a"},
+			wantGranted:     []grant{{CommandID: "r1-c", LocationIDs: []string{"a", "b", "c"}}},
+		},
+		{
+			name: "head-on move waits",
+			m:    newLine("a", "b", "c", "d"),
+			reservations: []trackreservation.Reservation{
+				granted(1, "r1", "a", "b", "c"),
+				queued(2, "r2", "b"),
+			},
+			raybotLocations: map[string]string{"r1": "a", "r2": "d"},
+		},
+		{
+			name: "raybot standing on the route",
+			m:    newLine("a", "b", "c"),
+			reservations: []trackreservation.Reservation{
+				queued(1, "r1", "c"),
+			},
+			raybotLocations: map[string]string{"r1": "a", "r2": "b"},
+		},
+		{
+			name: "moves of a raybot run in order",
+			m:    newLine("a", "b", "c"),
+			reservations: []trackreservation.Reservation{
+				granted(1, "r1", "a", "b"),
+				queued(2, "r1", "c"),
+			},
+			raybotLocations: map[string]string{"r1": "a"},
+		},
+		{
+			name: "older move goes first",
+			m:    newLine("a", "b", "c", "d", "e"),
+			reservations: []trackreservation.Reservation{
+				queued(1, "r1", "b"),
+				queued(2, "r2", "b"),
+				queued(3, "r3", "e"),
+			},
+			raybotLocations: map[string]string{"r1": "a", "r2": "d", "r3": "e"},
+			wantGranted: []grant{
+				{CommandID: "r1-b", LocationIDs: []string{"a", "b"}},
+				{CommandID: "r3-e", LocationIDs: []string{"e"}},
+			},
+		},
+		{
+			name:         "unknown raybot location",
+			m:            newLine("a", "b"),
+			reservations: []trackreservation.Reservation{queued(1, "r1", "b")},
+			wantGranted:  []grant{{CommandID: "r1-b", LocationIDs: []string{"b"}}},
+		},
+		{
+			name: "map without segments",
+			m: trackmap.Map{Locations: []qrlocation.QRLocation{
+				{ID: "a"}, {ID: "b"},
+			}},
+			reservations:    []trackreservation.Reservation{queued(1, "r1", "b")},
+			raybotLocations: map[string]string{"r1": "a"},
+			wantGranted:     []grant{{CommandID: "r1-b", LocationIDs: []string{"a", "b"}}},
+		},
+		{
+			name:            "location not on the map",
+			m:               newLine("a", "b"),
+			reservations:    []trackreservation.Reservation{queued(1, "r1", "z")},
+			raybotLocations: map[string]string{"r1": "a"},
+			wantRejected:    []string{"r1-z"},
+			wantStatus:      xerror.StatusValidationFailed,
+		},
+		{
+			name: "no route",
+			m: func() trackmap.Map {
+				m := newLine("a", "b")
+				m.Locations = append(m.Locations, qrlocation.QRLocation{ID: "c"})
+				return m
+			}(),
+			reservations:    []trackreservation.Reservation{queued(1, "r1", "c")},
+			raybotLocations: map[string]string{"r1": "a"},
+			wantRejected:    []string{"r1-c"},
+			wantStatus:      xerror.StatusNotFound,
+		},
+		{
+			name: "raybots swapping locations deadlock",
+			m:    newLine("a", "b", "c"),
+			reservations: []trackreservation.Reservation{
+				queued(1, "r1", "c"),
+				queued(2, "r2", "a"),
+			},
+			raybotLocations: map[string]string{"r1": "a", "r2": "c"},
+			wantRejected:    []string{"r2-a"},
+			wantStatus:      xerror.StatusConflict,
+		},
+		{
+			name: "nested cycles deadlock",
+			m:    newLine("a", "b", "c", "d", "e"),
+			reservations: []trackreservation.Reservation{
+				queued(3, "r1", "c"),
+				queued(1, "r2", "e"),
+				queued(2, "r3", "a"),
+			},
+			raybotLocations: map[string]string{"r1": "a", "r2": "c", "r3": "e"},
+			wantRejected:    []string{"r1-c", "r3-a"},
+			wantStatus:      xerror.StatusConflict,
+		},
+		{
+			name: "waiting for a moving raybot is no deadlock",
+			m:    newLine("a", "b", "c"),
+			reservations: []trackreservation.Reservation{
+				granted(1, "r1", "a", "b"),
+				queued(2, "r2", "a"),
+			},
+			raybotLocations: map[string]string{"r1": "a", "r2": "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			traffic := trackreservation.Traffic{
+				Map:             tt.m,
+				Reservations:    tt.reservations,
+				RaybotLocations: tt.raybotLocations,
+			}
+
+			plan := traffic.Schedule()
+
+			gotGranted := []grant{}
+			for _, r := range plan.Granted {
+				assert.Equal(t, trackreservation.StatusGranted, r.Status)
+				gotGranted = append(gotGranted, grant{CommandID: r.CommandID, LocationIDs: r.LocationIDs})
+			}
+			if tt.wantGranted == nil {
+				tt.wantGranted = []grant{}
+			}
+			assert.Equal(t, tt.wantGranted, gotGranted)
+
+			gotRejected := []string{}
+			for _, r := range plan.Rejected {
+				require.Error(t, r.Err)
+				assert.True(t, xerror.IsStatus(r.Err, tt.wantStatus), r.Err)
+				gotRejected = append(gotRejected, r.Reservation.CommandID)
+			}
+			if tt.wantRejected == nil {
+				tt.wantRejected = []string{}
+			}
+			assert.Equal(t, tt.wantRejected, gotRejected)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package webhook
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Subscription is an endpoint notified of the domain events.
+//
+// EventTypes filters the events sent to the endpoint, an empty filter
+// subscribes to every event. Secret signs the requests, see
+// pkg/webhookhttp.
+type Subscription struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes []string
+	IsActive   bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewSubscription(url string, secret string, eventTypes []string, isActive bool) Subscription {
+	now := time.Now()
+	return Subscription{
+		ID:         uuid.NewString(),
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		IsActive:   isActive,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// Subscribes reports whether the subscription receives events of eventType.
+func (s Subscription) Subscribes(eventType string) bool {
+	return len(s.EventTypes) == 0 || slices.Contains(s.EventTypes, eventType)
+}
+
+type DeliveryStatus string
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *DeliveryStatus) UnmarshalText(text []byte) error {
+	status := DeliveryStatus(text)
+	if _, ok := DeliveryStatusMap[status]; !ok {
+		return fmt.Errorf("invalid DeliveryStatus: %s", text)
+	}
+	*s = status
+	return nil
+}
+
+const (
+	// DeliveryStatusPending is a delivery waiting for its next attempt.
+	DeliveryStatusPending DeliveryStatus = "PENDING"
+	// DeliveryStatusSucceeded is a delivery acknowledged by the endpoint.
+	DeliveryStatusSucceeded DeliveryStatus = "SUCCEEDED"
+	// DeliveryStatusDead is a delivery that failed every attempt. It is only
+	// sent again when redelivered.
+	DeliveryStatusDead DeliveryStatus = "DEAD"
+)
+
+var DeliveryStatusMap = map[DeliveryStatus]struct{}{
+	DeliveryStatusPending:   {},
+	DeliveryStatusSucceeded: {},
+	DeliveryStatusDead:      {},
+}
+
+// RetryPolicy is the schedule of the attempts of a Delivery. The interval
+// between attempts starts at InitialInterval and doubles after every failed
+// attempt, up to MaxInterval.
+type RetryPolicy struct {
+	MaxAttempts     int32
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+}
+
+// NextInterval returns the interval before the attempt following the given
+// number of failed attempts.
+func (p RetryPolicy) NextInterval(attempts int32) time.Duration {
+	interval := p.InitialInterval
+	for i := int32(1); i < attempts; i++ {
+		interval *= 2
+		if interval >= p.MaxInterval {
+			return p.MaxInterval
+		}
+	}
+	return min(interval, p.MaxInterval)
+}
+
+// Delivery is a domain event sent to a Subscription.
+//
+// Payload is the event envelope, sent as the request body.
+type Delivery struct {
+	ID                 string
+	SubscriptionID     string
+	EventID            string
+	EventType          string
+	Payload            []byte
+	Status             DeliveryStatus
+	Attempts           int32
+	NextAttemptAt      time.Time
+	LastAttemptAt      *time.Time
+	LastResponseStatus *int32
+	LastError          *string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func NewDelivery(subscriptionID string, eventID string, eventType string, payload []byte) Delivery {
+	now := time.Now()
+	return Delivery{
+		ID:                 uuid.NewString(),
+		SubscriptionID:     subscriptionID,
+		EventID:            eventID,
+		EventType:          eventType,
+		Payload:            payload,
+		Status:             DeliveryStatusPending,
+		Attempts:           0,
+		NextAttemptAt:      now,
+		LastAttemptAt:      nil,
+		LastResponseStatus: nil,
+		LastError:          nil,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+}
+
+// RecordSuccess records an attempt acknowledged by the endpoint.
+func (d *Delivery) RecordSuccess(responseStatus int32, at time.Time) {
+	d.Attempts++
+	d.Status = DeliveryStatusSucceeded
+	d.LastAttemptAt = &at
+	d.LastResponseStatus = &responseStatus
+	d.LastError = nil
+	d.UpdatedAt = at
+}
+
+// RecordFailure records a failed attempt and schedules the next one with
+// policy. The delivery is dead once it reached the maximum attempts. A zero
+// responseStatus means the endpoint did not respond.
+func (d *Delivery) RecordFailure(responseStatus int32, errMsg string, at time.Time, policy RetryPolicy) {
+	d.Attempts++
+	d.LastAttemptAt = &at
+	d.LastResponseStatus = nil
+	if responseStatus != 0 {
+		d.LastResponseStatus = &responseStatus
+	}
+	d.LastError = &errMsg
+	d.UpdatedAt = at
+
+	if d.Attempts >= policy.MaxAttempts {
+		d.Status = DeliveryStatusDead
+		return
+	}
+	d.Status = DeliveryStatusPending
+	d.NextAttemptAt = at.Add(policy.NextInterval(d.Attempts))
+}
+
+// Redeliver schedules the delivery to be sent again now, whatever its status.
+// The attempts are reset so a dead delivery gets the full retry schedule.
+func (d *Delivery) Redeliver(at time.Time) {
+	d.Status = DeliveryStatusPending
+	d.Attempts = 0
+	d.NextAttemptAt = at
+	d.UpdatedAt = at
+}
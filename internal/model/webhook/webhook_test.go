@@ -0,0 +1,131 @@
+package webhook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+)
+
+func TestSubscriptionSubscribes(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventTypes []string
+		eventType  string
+		want       bool
+	}{
+		{
+			name:       "empty filter",
+			eventTypes: nil,
+			eventType:  "raybot:created",
+			want:       true,
+		},
+		{
+			name:       "matching filter",
+			eventTypes: []string{"raybot:created", "raybot:updated"},
+			eventType:  "raybot:updated",
+			want:       true,
+		},
+		{
+			name:       "not matching filter",
+			eventTypes: []string{"raybot:created"},
+			eventType:  "workflow:created",
+			want:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sub := webhook.NewSubscription("http://localhost", "secret", tc.eventTypes, true)
+			assert.Equal(t, tc.want, sub.Subscribes(tc.eventType))
+		})
+	}
+}
+
+func TestRetryPolicyNextInterval(t *testing.T) {
+	policy := webhook.RetryPolicy{
+		MaxAttempts:     10,
+		InitialInterval: 10 * time.Second,
+		MaxInterval:     time.Minute,
+	}
+
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 1, want: 10 * time.Second},
+		{attempts: 2, want: 20 * time.Second},
+		{attempts: 3, want: 40 * time.Second},
+		{attempts: 4, want: time.Minute},
+		{attempts: 60, want: time.Minute},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, policy.NextInterval(tc.attempts), "attempts %d", tc.attempts)
+	}
+}
+
+func TestDeliveryAttempts(t *testing.T) {
+	policy := webhook.RetryPolicy{
+		MaxAttempts:     3,
+		InitialInterval: time.Second,
+		MaxInterval:     time.Hour,
+	}
+	now := time.Now()
+
+	t.Run("Retry then dead", func(t *testing.T) {
+		d := webhook.NewDelivery("sub", "event", "raybot:created", []byte("{}"))
+
+		d.RecordFailure(500, "boom", now, policy)
+		assert.Equal(t, webhook.DeliveryStatusPending, d.Status)
+		assert.Equal(t, int32(1), d.Attempts)
+		assert.Equal(t, now.Add(time.Second), d.NextAttemptAt)
+		require.NotNil(t, d.LastResponseStatus)
+		assert.Equal(t, int32(500), *d.LastResponseStatus)
+
+		d.RecordFailure(0, "timeout", now, policy)
+		assert.Equal(t, webhook.DeliveryStatusPending, d.Status)
+		assert.Equal(t, now.Add(2*time.Second), d.NextAttemptAt)
+		assert.Nil(t, d.LastResponseStatus)
+		require.NotNil(t, d.LastError)
+		assert.Equal(t, "timeout", *d.LastError)
+
+		d.RecordFailure(500, "boom", now, policy)
+		assert.Equal(t, webhook.DeliveryStatusDead, d.Status)
+		assert.Equal(t, int32(3), d.Attempts)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		d := webhook.NewDelivery("sub", "event", "raybot:created", []byte("{}"))
+		d.RecordFailure(500, "boom", now, policy)
+
+		d.RecordSuccess(200, now)
+		assert.Equal(t, webhook.DeliveryStatusSucceeded, d.Status)
+		assert.Equal(t, int32(2), d.Attempts)
+		assert.Nil(t, d.LastError)
+	})
+
+	t.Run("Redeliver dead", func(t *testing.T) {
+		d := webhook.NewDelivery("sub", "event", "raybot:created", []byte("{}"))
+		for range policy.MaxAttempts {
+			d.RecordFailure(500, "boom", now, policy)
+		}
+		require.Equal(t, webhook.DeliveryStatusDead, d.Status)
+
+		later := now.Add(time.Hour)
+		d.Redeliver(later)
+		assert.Equal(t, webhook.DeliveryStatusPending, d.Status)
+		assert.Zero(t, d.Attempts)
+		assert.Equal(t, later, d.NextAttemptAt)
+	})
+}
+
+func TestDeliveryStatusUnmarshalText(t *testing.T) {
+	var s webhook.DeliveryStatus
+	require.NoError(t, s.UnmarshalText([]byte("DEAD")))
+	assert.Equal(t, webhook.DeliveryStatusDead, s)
+	assert.Error(t, s.UnmarshalText([]byte("UNKNOWN")))
+}
@@ -0,0 +1,82 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DocumentFormatVersion is the version of the Document format written on export.
+// Documents with a greater version are rejected on import.
+const DocumentFormatVersion = 1
+
+// Document is the portable representation of a workflow, used to move
+// workflows between sites.
+//
+// QR location IDs differ between sites, so the QR locations referenced by
+// Data are listed with their code alongside their ID on the exporting site.
+type Document struct {
+	FormatVersion int                  `json:"formatVersion" validate:"required,min=1"`
+	Name          string               `json:"name" validate:"required,alphanumspace,min=1,max=100"`
+	Description   *string              `json:"description,omitempty" validate:"omitempty,min=1,max=100"`
+	ExportedAt    time.Time            `json:"exportedAt"`
+	Data          Data                 `json:"data" validate:"required"`
+	QRLocations   []DocumentQRLocation `json:"qrLocations" validate:"dive"`
+	// IsTemplate and TemplateParameters are only set for templates.
+	IsTemplate         bool                `json:"isTemplate,omitempty"`
+	TemplateParameters []TemplateParameter `json:"templateParameters,omitempty" validate:"dive"`
+}
+
+// DocumentQRLocation is a QR location referenced by the data of a Document.
+type DocumentQRLocation struct {
+	ID     string `json:"id" validate:"required"`
+	Name   string `json:"name"`
+	QRCode string `json:"qrCode" validate:"required"`
+}
+
+func NewDocument(wf Workflow, qrLocations []DocumentQRLocation) Document {
+	return Document{
+		FormatVersion:      DocumentFormatVersion,
+		Name:               wf.Name,
+		Description:        wf.Description,
+		ExportedAt:         time.Now(),
+		Data:               wf.Data,
+		QRLocations:        qrLocations,
+		IsTemplate:         wf.IsTemplate,
+		TemplateParameters: wf.TemplateParameters,
+	}
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// The document is encoded through its JSON representation so both formats
+// share the same field names.
+func (d Document) MarshalYAML() (any, error) {
+	raw, err := json.Marshal(d)
+	if err != nil {
+		return nil, fmt.Errorf("marshal document: %w", err)
+	}
+
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("unmarshal document: %w", err)
+	}
+
+	return v, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (d *Document) UnmarshalYAML(value *yaml.Node) error {
+	var v any
+	if err := value.Decode(&v); err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal document: %w", err)
+	}
+
+	return json.Unmarshal(raw, d)
+}
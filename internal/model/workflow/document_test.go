@@ -0,0 +1,37 @@
+package workflow_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/pkg/ptr"
+)
+
+func TestDocumentYAML(t *testing.T) {
+	doc := workflow.NewDocument(workflow.Workflow{
+		Name:        "Deliver box",
+		Description: ptr.New("Deliver a box to a location"),
+		Data:        newTestData(t),
+	}, []workflow.DocumentQRLocation{
+		{ID: locationA, Name: "Dock", QRCode: "QR-DOCK"},
+	})
+
+	out, err := yaml.Marshal(doc)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "formatVersion: 1")
+	assert.Contains(t, string(out), "qrCode: QR-DOCK")
+
+	var decoded workflow.Document
+	require.NoError(t, yaml.Unmarshal(out, &decoded))
+
+	expected, err := json.Marshal(doc)
+	require.NoError(t, err)
+	actual, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+}
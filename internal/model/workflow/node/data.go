@@ -51,3 +51,15 @@ func (d *Data) FromControlRaybotInput(c ControlRaybotInput) error {
 	d.union = ret
 	return err
 }
+
+func (d Data) AsControlRaybotData() (ControlRaybotData, error) {
+	var ret ControlRaybotData
+	err := json.Unmarshal(d.union, &ret)
+	return ret, err
+}
+
+func (d *Data) FromControlRaybotData(c ControlRaybotData) error {
+	ret, err := json.Marshal(c)
+	d.union = ret
+	return err
+}
@@ -0,0 +1,175 @@
+package node
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+type InputType string
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (i *InputType) UnmarshalText(text []byte) error {
+	inputType := InputType(text)
+	if _, ok := InputTypeMap[inputType]; !ok {
+		return fmt.Errorf("invalid InputType: %s", text)
+	}
+	*i = inputType
+	return nil
+}
+
+const (
+	InputTypeString     InputType = "STRING"
+	InputTypeNumber     InputType = "NUMBER"
+	InputTypeInteger    InputType = "INTEGER"
+	InputTypeBoolean    InputType = "BOOLEAN"
+	InputTypeEnum       InputType = "ENUM"
+	InputTypeQRLocation InputType = "QR_LOCATION"
+	InputTypeRaybot     InputType = "RAYBOT"
+	InputTypeList       InputType = "LIST"
+)
+
+var InputTypeMap = map[InputType]struct{}{
+	InputTypeString:     {},
+	InputTypeNumber:     {},
+	InputTypeInteger:    {},
+	InputTypeBoolean:    {},
+	InputTypeEnum:       {},
+	InputTypeQRLocation: {},
+	InputTypeRaybot:     {},
+	InputTypeList:       {},
+}
+
+// RuntimeVariable describes a variable the user provides when running a workflow.
+//
+// Min and Max bound the length of a STRING, the value of a NUMBER or INTEGER
+// and the number of items of a LIST. Pattern applies to STRING values and
+// Options lists the allowed values of an ENUM. For a LIST, ItemType is the
+// type of each item, which is checked against Options and Pattern.
+type RuntimeVariable struct {
+	Key          string    `json:"key" validate:"required,alphanumspace,min=1,max=100"`
+	InputType    InputType `json:"input_type" validate:"required,enum"`
+	Required     bool      `json:"required" validate:"required"`
+	DefaultValue any       `json:"default_value"`
+	ItemType     InputType `json:"item_type,omitempty" validate:"required_if=InputType LIST,omitempty,enum,ne=LIST"`
+	Options      []string  `json:"options,omitempty" validate:"required_if=InputType ENUM,omitempty,dive,min=1"`
+	Min          *float64  `json:"min,omitempty"`
+	Max          *float64  `json:"max,omitempty"`
+	Pattern      *string   `json:"pattern,omitempty"`
+}
+
+// Validate checks that the constraints of the runtime variable are consistent
+// and that its default value, if any, satisfies them.
+func (v RuntimeVariable) Validate() error {
+	if v.Min != nil && v.Max != nil && *v.Min > *v.Max {
+		return fmt.Errorf("has a min %v greater than its max %v", *v.Min, *v.Max)
+	}
+	if v.Pattern != nil {
+		if _, err := regexp.Compile(*v.Pattern); err != nil {
+			return fmt.Errorf("has an invalid pattern %q", *v.Pattern)
+		}
+	}
+	if v.DefaultValue != nil {
+		if err := v.ValidateValue(v.DefaultValue); err != nil {
+			return fmt.Errorf("has an invalid default value: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateValue checks that the given value matches the type and the
+// constraints of the runtime variable. The value is expected to be decoded
+// from JSON, so numbers are float64 and lists are []any.
+//
+// QR_LOCATION and RAYBOT values are only checked to be valid UUIDs,
+// the caller is responsible for checking that they exist.
+func (v RuntimeVariable) ValidateValue(value any) error {
+	if v.InputType != InputTypeList {
+		return v.validateScalar(v.InputType, value, true)
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("must be a list")
+	}
+	if err := v.validateBounds(float64(len(items)), "number of items"); err != nil {
+		return err
+	}
+	for i, item := range items {
+		if err := v.validateScalar(v.ItemType, item, false); err != nil {
+			return fmt.Errorf("item %d %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (v RuntimeVariable) validateScalar(inputType InputType, value any, checkBounds bool) error {
+	switch inputType {
+	case InputTypeString:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("must be a string")
+		}
+		if checkBounds {
+			if err := v.validateBounds(float64(utf8.RuneCountInString(s)), "length"); err != nil {
+				return err
+			}
+		}
+		if v.Pattern != nil {
+			re, err := regexp.Compile(*v.Pattern)
+			if err != nil {
+				return fmt.Errorf("has an invalid pattern %q", *v.Pattern)
+			}
+			if !re.MatchString(s) {
+				return fmt.Errorf("must match pattern %q", *v.Pattern)
+			}
+		}
+	case InputTypeNumber, InputTypeInteger:
+		n, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("must be a number")
+		}
+		if inputType == InputTypeInteger && n != math.Trunc(n) {
+			return fmt.Errorf("must be an integer")
+		}
+		if checkBounds {
+			if err := v.validateBounds(n, "value"); err != nil {
+				return err
+			}
+		}
+	case InputTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("must be a boolean")
+		}
+	case InputTypeEnum:
+		s, ok := value.(string)
+		if !ok || !slices.Contains(v.Options, s) {
+			return fmt.Errorf("must be one of %v", v.Options)
+		}
+	case InputTypeQRLocation, InputTypeRaybot:
+		s, ok := value.(string)
+		if !ok || uuid.Validate(s) != nil {
+			return fmt.Errorf("must be a valid %s id", inputType)
+		}
+	default:
+		return fmt.Errorf("has unsupported input type %s", inputType)
+	}
+
+	return nil
+}
+
+func (v RuntimeVariable) validateBounds(n float64, name string) error {
+	if v.Min != nil && n < *v.Min {
+		return fmt.Errorf("%s must be at least %v", name, *v.Min)
+	}
+	if v.Max != nil && n > *v.Max {
+		return fmt.Errorf("%s must be at most %v", name, *v.Max)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package node_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
+	"github.com/tuanvumaihuynh/roboflow/pkg/ptr"
+)
+
+func TestRuntimeVariableValidateValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		variable   node.RuntimeVariable
+		value      any
+		expectErr  bool
+		errMessage string
+	}{
+		{
+			name:     "String within bounds",
+			variable: node.RuntimeVariable{InputType: node.InputTypeString, Min: ptr.New(1.0), Max: ptr.New(5.0)},
+			value:    "abc",
+		},
+		{
+			name:       "String too long",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeString, Max: ptr.New(2.0)},
+			value:      "abc",
+			expectErr:  true,
+			errMessage: "length must be at most 2",
+		},
+		{
+			name:       "String not matching pattern",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeString, Pattern: ptr.New("^[0-9]+$")},
+			value:      "abc",
+			expectErr:  true,
+			errMessage: "must match pattern",
+		},
+		{
+			name:       "Number of wrong type",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeNumber},
+			value:      "1",
+			expectErr:  true,
+			errMessage: "must be a number",
+		},
+		{
+			name:       "Number below min",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeNumber, Min: ptr.New(0.5)},
+			value:      0.1,
+			expectErr:  true,
+			errMessage: "value must be at least 0.5",
+		},
+		{
+			name:     "Integer",
+			variable: node.RuntimeVariable{InputType: node.InputTypeInteger},
+			value:    float64(3),
+		},
+		{
+			name:       "Integer with fraction",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeInteger},
+			value:      3.5,
+			expectErr:  true,
+			errMessage: "must be an integer",
+		},
+		{
+			name:     "Boolean",
+			variable: node.RuntimeVariable{InputType: node.InputTypeBoolean},
+			value:    true,
+		},
+		{
+			name:       "Boolean of wrong type",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeBoolean},
+			value:      "true",
+			expectErr:  true,
+			errMessage: "must be a boolean",
+		},
+		{
+			name:     "Enum option",
+			variable: node.RuntimeVariable{InputType: node.InputTypeEnum, Options: []string{"low", "high"}},
+			value:    "high",
+		},
+		{
+			name:       "Enum unknown option",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeEnum, Options: []string{"low", "high"}},
+			value:      "medium",
+			expectErr:  true,
+			errMessage: "must be one of",
+		},
+		{
+			name:     "QR location id",
+			variable: node.RuntimeVariable{InputType: node.InputTypeQRLocation},
+			value:    "123e4567-e89b-12d3-a456-426614174000",
+		},
+		{
+			name:       "Raybot invalid id",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeRaybot},
+			value:      "raybot-1",
+			expectErr:  true,
+			errMessage: "must be a valid RAYBOT id",
+		},
+		{
+			name:     "List of integers",
+			variable: node.RuntimeVariable{InputType: node.InputTypeList, ItemType: node.InputTypeInteger, Max: ptr.New(3.0)},
+			value:    []any{float64(1), float64(2)},
+		},
+		{
+			name:       "List with too many items",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeList, ItemType: node.InputTypeInteger, Max: ptr.New(1.0)},
+			value:      []any{float64(1), float64(2)},
+			expectErr:  true,
+			errMessage: "number of items must be at most 1",
+		},
+		{
+			name:       "List with invalid item",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeList, ItemType: node.InputTypeBoolean},
+			value:      []any{true, "false"},
+			expectErr:  true,
+			errMessage: "item 1 must be a boolean",
+		},
+		{
+			name:       "List of wrong type",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeList, ItemType: node.InputTypeString},
+			value:      "a,b",
+			expectErr:  true,
+			errMessage: "must be a list",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.variable.ValidateValue(tt.value)
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRuntimeVariableValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		variable   node.RuntimeVariable
+		expectErr  bool
+		errMessage string
+	}{
+		{
+			name: "Consistent constraints",
+			variable: node.RuntimeVariable{
+				InputType:    node.InputTypeString,
+				Min:          ptr.New(1.0),
+				Max:          ptr.New(5.0),
+				Pattern:      ptr.New("^[a-z]+$"),
+				DefaultValue: "abc",
+			},
+		},
+		{
+			name:       "Min greater than max",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeNumber, Min: ptr.New(5.0), Max: ptr.New(1.0)},
+			expectErr:  true,
+			errMessage: "has a min 5 greater than its max 1",
+		},
+		{
+			name:       "Invalid pattern",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeString, Pattern: ptr.New("[a-z")},
+			expectErr:  true,
+			errMessage: "has an invalid pattern",
+		},
+		{
+			name:       "Default value out of bounds",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeInteger, Max: ptr.New(3.0), DefaultValue: float64(4)},
+			expectErr:  true,
+			errMessage: "has an invalid default value: value must be at most 3",
+		},
+		{
+			name:       "Default value of wrong type",
+			variable:   node.RuntimeVariable{InputType: node.InputTypeBoolean, DefaultValue: "true"},
+			expectErr:  true,
+			errMessage: "has an invalid default value: must be a boolean",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.variable.Validate()
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTriggerDataUnmarshalJSON(t *testing.T) {
+	data := []byte(`{"trigger_type":"ON_DEMAND","runtime_variables":[{"key":"speed","input_type":"INTEGER","required":true,"min":1}]}`)
+
+	var triggerData node.TriggerData
+	require.NoError(t, json.Unmarshal(data, &triggerData))
+	assert.Equal(t, node.TriggerTypeOnDemand, triggerData.TriggerType)
+
+	onDemand, err := triggerData.AsOnDemandTriggerData()
+	require.NoError(t, err)
+	require.Len(t, onDemand.RuntimeVariables, 1)
+	assert.Equal(t, node.InputTypeInteger, onDemand.RuntimeVariables[0].InputType)
+	assert.Equal(t, ptr.New(1.0), onDemand.RuntimeVariables[0].Min)
+}
@@ -11,6 +11,13 @@ type TriggerData struct {
 }
 
 func (d *TriggerData) UnmarshalJSON(data []byte) error {
+	var discriminator struct {
+		TriggerType TriggerType `json:"trigger_type"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return err
+	}
+	d.TriggerType = discriminator.TriggerType
 	return json.Unmarshal(data, &d.union)
 }
 
@@ -25,7 +32,14 @@ func (d TriggerData) AsOnDemandTriggerData() (OnDemandTriggerData, error) {
 }
 
 func (d *TriggerData) FromOnDemandTriggerData(o OnDemandTriggerData) error {
-	ret, err := json.Marshal(o)
+	ret, err := json.Marshal(struct {
+		TriggerType TriggerType `json:"trigger_type"`
+		OnDemandTriggerData
+	}{
+		TriggerType:         TriggerTypeOnDemand,
+		OnDemandTriggerData: o,
+	})
+	d.TriggerType = TriggerTypeOnDemand
 	d.union = ret
 	return err
 }
@@ -52,35 +66,6 @@ var TriggerTypeMap = map[TriggerType]struct{}{
 	// TriggerTypeSchedule: {},
 }
 
-type InputType string
-
-// UnmarshalText implements the encoding.TextUnmarshaler interface.
-func (i *InputType) UnmarshalText(text []byte) error {
-	inputType := InputType(text)
-	if _, ok := InputTypeMap[inputType]; !ok {
-		return fmt.Errorf("invalid InputType: %s", text)
-	}
-	*i = inputType
-	return nil
-}
-
-const (
-	InputTypeString InputType = "STRING"
-	InputTypeNumber InputType = "NUMBER"
-)
-
-var InputTypeMap = map[InputType]struct{}{
-	InputTypeString: {},
-	InputTypeNumber: {},
-}
-
-type RuntimeVariable struct {
-	Key          string    `json:"key" validate:"required,alphanumspace,min=1,max=100"`
-	InputType    InputType `json:"input_type" validate:"required,enum"`
-	Required     bool      `json:"required" validate:"required"`
-	DefaultValue any       `json:"default_value"`
-}
-
 type OnDemandTriggerData struct {
 	RuntimeVariables []RuntimeVariable `json:"runtime_variables" validate:"required,dive"`
 }
@@ -0,0 +1,259 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	dynamicvalue "github.com/tuanvumaihuynh/roboflow/internal/model/workflow/dynamic_value"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/edge"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
+)
+
+// CloneWithNewIDs returns a copy of the data where every node and edge has a
+// new ID. Edges and node references in the data of the nodes are rewritten
+// to point to the new node IDs.
+func (d Data) CloneWithNewIDs() (Data, error) {
+	nodeIDs := make(map[string]string, len(d.Nodes))
+	for _, n := range d.Nodes {
+		nodeIDs[n.ID] = uuid.NewString()
+	}
+
+	clone := d
+	clone.Position = append([]float32(nil), d.Position...)
+
+	clone.Nodes = make([]node.Node, len(d.Nodes))
+	for i, n := range d.Nodes {
+		n.ID = nodeIDs[n.ID]
+
+		data, err := remapNodeReferences(n.Data, nodeIDs)
+		if err != nil {
+			return Data{}, fmt.Errorf("remap node references of node %s: %w", n.ID, err)
+		}
+		n.Data = data
+
+		clone.Nodes[i] = n
+	}
+
+	clone.Edges = make([]edge.Edge, len(d.Edges))
+	for i, e := range d.Edges {
+		e.ID = uuid.NewString()
+		if id, ok := nodeIDs[e.Source]; ok {
+			e.Source = id
+		}
+		if id, ok := nodeIDs[e.Target]; ok {
+			e.Target = id
+		}
+		clone.Edges[i] = e
+	}
+
+	return clone, nil
+}
+
+// QRLocationIDs returns the IDs of the QR locations referenced by the data,
+// in order of appearance and without duplicates. Template placeholders are
+// not IDs and are skipped.
+//
+// QR locations are referenced by the static location of MOVE_TO_LOCATION
+// nodes and by the default value of QR_LOCATION runtime variables.
+func (d Data) QRLocationIDs() ([]string, error) {
+	ids := []string{}
+	seen := make(map[string]struct{})
+	_, err := d.mapReferences(func(inputType node.InputType, id string) string {
+		if inputType != node.InputTypeQRLocation || IsPlaceholder(id) {
+			return id
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+		return id
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ReplaceQRLocationIDs returns a copy of the data where the referenced QR
+// location IDs are replaced according to the given mapping. IDs missing from
+// the mapping are kept.
+func (d Data) ReplaceQRLocationIDs(mapping map[string]string) (Data, error) {
+	return d.mapReferences(func(inputType node.InputType, id string) string {
+		if inputType != node.InputTypeQRLocation {
+			return id
+		}
+		if newID, ok := mapping[id]; ok {
+			return newID
+		}
+		return id
+	})
+}
+
+// mapReferences calls fn for each raybot or QR location referenced by the data,
+// with the type of the reference, and replaces the reference with its result.
+// Nodes whose data cannot be decoded, such as incomplete nodes of a draft, are
+// left untouched.
+func (d Data) mapReferences(fn func(inputType node.InputType, value string) string) (Data, error) {
+	ret := d
+	ret.Nodes = make([]node.Node, len(d.Nodes))
+	copy(ret.Nodes, d.Nodes)
+
+	for i, n := range ret.Nodes {
+		var err error
+		switch n.Type {
+		case node.TypeControlRaybot:
+			err = mapControlRaybotReferences(&ret.Nodes[i].Data, fn)
+		case node.TypeTrigger:
+			err = mapTriggerReferences(&ret.Nodes[i].Data, fn)
+		}
+		if err != nil {
+			return Data{}, fmt.Errorf("map references of node %s: %w", n.ID, err)
+		}
+	}
+
+	return ret, nil
+}
+
+func mapControlRaybotReferences(data *node.Data, fn func(inputType node.InputType, value string) string) error {
+	controlRaybotData, err := data.AsControlRaybotData()
+	if err != nil || controlRaybotData.ControlRaybotType != node.ControlRaybotTypeMoveToLocation {
+		return nil
+	}
+
+	input, err := controlRaybotData.Input.AsMoveToLocationInput()
+	if err != nil || input.Location.Type != dynamicvalue.SourceTypeStatic || input.Location.StaticValue == nil {
+		return nil
+	}
+
+	id := fn(node.InputTypeQRLocation, *input.Location.StaticValue)
+	if id == *input.Location.StaticValue {
+		return nil
+	}
+	input.Location.StaticValue = &id
+
+	if err := controlRaybotData.Input.FromMoveToLocationInput(input); err != nil {
+		return fmt.Errorf("encode move to location input: %w", err)
+	}
+	if err := data.FromControlRaybotData(controlRaybotData); err != nil {
+		return fmt.Errorf("encode control raybot data: %w", err)
+	}
+
+	return nil
+}
+
+func mapTriggerReferences(data *node.Data, fn func(inputType node.InputType, value string) string) error {
+	triggerData, err := data.AsTriggerData()
+	if err != nil || triggerData.TriggerType != node.TriggerTypeOnDemand {
+		return nil
+	}
+
+	onDemand, err := triggerData.AsOnDemandTriggerData()
+	if err != nil {
+		return nil
+	}
+
+	changed := false
+	for i, v := range onDemand.RuntimeVariables {
+		switch {
+		case isReferenceType(v.InputType):
+			value, ok := v.DefaultValue.(string)
+			if !ok {
+				continue
+			}
+			if newValue := fn(v.InputType, value); newValue != value {
+				onDemand.RuntimeVariables[i].DefaultValue = newValue
+				changed = true
+			}
+		case v.InputType == node.InputTypeList && isReferenceType(v.ItemType):
+			items, ok := v.DefaultValue.([]any)
+			if !ok {
+				continue
+			}
+			newItems := make([]any, len(items))
+			for j, item := range items {
+				newItems[j] = item
+				value, ok := item.(string)
+				if !ok {
+					continue
+				}
+				if newValue := fn(v.ItemType, value); newValue != value {
+					newItems[j] = newValue
+					changed = true
+				}
+			}
+			onDemand.RuntimeVariables[i].DefaultValue = newItems
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := triggerData.FromOnDemandTriggerData(onDemand); err != nil {
+		return fmt.Errorf("encode on demand trigger data: %w", err)
+	}
+	if err := data.FromTriggerData(triggerData); err != nil {
+		return fmt.Errorf("encode trigger data: %w", err)
+	}
+
+	return nil
+}
+
+func isReferenceType(inputType node.InputType) bool {
+	return inputType == node.InputTypeQRLocation || inputType == node.InputTypeRaybot
+}
+
+// remapNodeReferences rewrites the node ID of every dynamic value reference
+// found in the node data.
+func remapNodeReferences(data node.Data, nodeIDs map[string]string) (node.Data, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return node.Data{}, fmt.Errorf("marshal node data: %w", err)
+	}
+
+	var tree any
+	if err := json.Unmarshal(raw, &tree); err != nil {
+		return node.Data{}, fmt.Errorf("unmarshal node data: %w", err)
+	}
+	if !remapReferences(tree, nodeIDs) {
+		return data, nil
+	}
+
+	raw, err = json.Marshal(tree)
+	if err != nil {
+		return node.Data{}, fmt.Errorf("marshal remapped node data: %w", err)
+	}
+
+	var ret node.Data
+	if err := json.Unmarshal(raw, &ret); err != nil {
+		return node.Data{}, fmt.Errorf("unmarshal remapped node data: %w", err)
+	}
+
+	return ret, nil
+}
+
+func remapReferences(v any, nodeIDs map[string]string) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]any:
+		if ref, ok := v["reference"].(map[string]any); ok {
+			if id, ok := ref["node_id"].(string); ok {
+				if newID, ok := nodeIDs[id]; ok {
+					ref["node_id"] = newID
+					changed = true
+				}
+			}
+		}
+		for _, child := range v {
+			changed = remapReferences(child, nodeIDs) || changed
+		}
+	case []any:
+		for _, child := range v {
+			changed = remapReferences(child, nodeIDs) || changed
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,115 @@
+package workflow_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/edge"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
+)
+
+const (
+	triggerNodeID = "11111111-1111-1111-1111-111111111111"
+	moveNodeID    = "22222222-2222-2222-2222-222222222222"
+	locationA     = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	locationB     = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+)
+
+func newTestData(t *testing.T) workflow.Data {
+	t.Helper()
+
+	var data workflow.Data
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"nodes": [
+			{
+				"id": "`+triggerNodeID+`",
+				"type": "TRIGGER",
+				"data": {
+					"trigger_type": "ON_DEMAND",
+					"runtime_variables": [
+						{"key": "target", "input_type": "QR_LOCATION", "required": true, "default_value": "`+locationA+`"},
+						{"key": "stops", "input_type": "LIST", "item_type": "QR_LOCATION", "required": false, "default_value": ["`+locationA+`", "`+locationB+`"]}
+					]
+				}
+			},
+			{
+				"id": "`+moveNodeID+`",
+				"type": "CONTROL_RAYBOT",
+				"data": {
+					"control_raybot_type": "MOVE_TO_LOCATION",
+					"timeout_sec": 60,
+					"input": {
+						"location": {"type": "STATIC", "static_value": "`+locationB+`"},
+						"direction": {"type": "REFERENCE", "reference": {"node_id": "`+triggerNodeID+`", "key": "direction"}}
+					}
+				}
+			}
+		],
+		"edges": [
+			{"id": "33333333-3333-3333-3333-333333333333", "source": "`+triggerNodeID+`", "target": "`+moveNodeID+`"}
+		]
+	}`), &data))
+
+	return data
+}
+
+func TestDataCloneWithNewIDs(t *testing.T) {
+	data := newTestData(t)
+
+	clone, err := data.CloneWithNewIDs()
+	require.NoError(t, err)
+	require.Len(t, clone.Nodes, 2)
+	require.Len(t, clone.Edges, 1)
+
+	newTriggerID, newMoveID := clone.Nodes[0].ID, clone.Nodes[1].ID
+	assert.NotEqual(t, triggerNodeID, newTriggerID)
+	assert.NotEqual(t, moveNodeID, newMoveID)
+	assert.NotEqual(t, data.Edges[0].ID, clone.Edges[0].ID)
+	assert.Equal(t, edge.Edge{
+		ID:     clone.Edges[0].ID,
+		Source: newTriggerID,
+		Target: newMoveID,
+	}, clone.Edges[0])
+
+	controlRaybotData, err := clone.Nodes[1].Data.AsControlRaybotData()
+	require.NoError(t, err)
+	input, err := controlRaybotData.Input.AsMoveToLocationInput()
+	require.NoError(t, err)
+	require.NotNil(t, input.Direction.Reference)
+	assert.Equal(t, newTriggerID, input.Direction.Reference.NodeID)
+
+	// The original data is left untouched.
+	assert.Equal(t, triggerNodeID, data.Nodes[0].ID)
+	assert.Equal(t, triggerNodeID, data.Edges[0].Source)
+}
+
+func TestDataQRLocationIDs(t *testing.T) {
+	data := newTestData(t)
+
+	ids, err := data.QRLocationIDs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{locationA, locationB}, ids)
+
+	replaced, err := data.ReplaceQRLocationIDs(map[string]string{locationA: "location-a", locationB: "location-b"})
+	require.NoError(t, err)
+
+	ids, err = replaced.QRLocationIDs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"location-a", "location-b"}, ids)
+
+	triggerData, err := replaced.Nodes[0].Data.AsTriggerData()
+	require.NoError(t, err)
+	assert.Equal(t, node.TriggerTypeOnDemand, triggerData.TriggerType)
+	onDemand, err := triggerData.AsOnDemandTriggerData()
+	require.NoError(t, err)
+	assert.Equal(t, []any{"location-a", "location-b"}, onDemand.RuntimeVariables[1].DefaultValue)
+
+	// The original data is left untouched.
+	ids, err = data.QRLocationIDs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{locationA, locationB}, ids)
+}
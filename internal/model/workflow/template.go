@@ -0,0 +1,90 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+// TemplateParameter is a parameter of a template workflow.
+//
+// The data of a template uses the placeholder of a parameter, see Placeholder,
+// where a raybot or QR location ID is expected: the static location of
+// MOVE_TO_LOCATION nodes and the default value of RAYBOT and QR_LOCATION
+// runtime variables. Instantiating the template replaces the placeholders
+// with concrete IDs.
+type TemplateParameter struct {
+	Key         string         `json:"key" validate:"required,alphanumspace,min=1,max=100"`
+	InputType   node.InputType `json:"inputType" validate:"required,oneof=RAYBOT QR_LOCATION"`
+	Description *string        `json:"description,omitempty" validate:"omitempty,min=1,max=255"`
+}
+
+// Placeholder returns the placeholder of the template parameter with the given key.
+func Placeholder(key string) string {
+	return "{{" + key + "}}"
+}
+
+// IsPlaceholder reports whether the value is a template parameter placeholder.
+func IsPlaceholder(value string) bool {
+	_, ok := placeholderKey(value)
+	return ok
+}
+
+func placeholderKey(value string) (string, bool) {
+	if len(value) <= 4 || !strings.HasPrefix(value, "{{") || !strings.HasSuffix(value, "}}") {
+		return "", false
+	}
+	return value[2 : len(value)-2], true
+}
+
+// ValidateTemplate checks that the parameters have unique keys and that every
+// placeholder of the data references a parameter of the matching type.
+func (d Data) ValidateTemplate(params []TemplateParameter) error {
+	paramTypes := make(map[string]node.InputType, len(params))
+	for _, p := range params {
+		if _, ok := paramTypes[p.Key]; ok {
+			return xerror.ValidationFailed(nil, fmt.Sprintf("Template parameter %s is declared more than once", p.Key))
+		}
+		paramTypes[p.Key] = p.InputType
+	}
+
+	var validationErr error
+	_, err := d.mapReferences(func(inputType node.InputType, value string) string {
+		key, ok := placeholderKey(value)
+		if !ok || validationErr != nil {
+			return value
+		}
+
+		paramType, ok := paramTypes[key]
+		switch {
+		case !ok:
+			validationErr = xerror.ValidationFailed(nil, fmt.Sprintf("Placeholder %s references unknown template parameter %s", value, key))
+		case paramType != inputType:
+			validationErr = xerror.ValidationFailed(nil, fmt.Sprintf("Placeholder %s is used as %s but template parameter %s is %s", value, inputType, key, paramType))
+		}
+		return value
+	})
+	if err != nil {
+		return fmt.Errorf("map references: %w", err)
+	}
+
+	return validationErr
+}
+
+// FillPlaceholders returns a copy of the data where the placeholders are
+// replaced by the values of the corresponding template parameters.
+// Placeholders without a value are kept.
+func (d Data) FillPlaceholders(values map[string]string) (Data, error) {
+	return d.mapReferences(func(_ node.InputType, value string) string {
+		key, ok := placeholderKey(value)
+		if !ok {
+			return value
+		}
+		if v, ok := values[key]; ok {
+			return v
+		}
+		return value
+	})
+}
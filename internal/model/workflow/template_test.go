@@ -0,0 +1,122 @@
+package workflow_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
+)
+
+func newTemplateData(t *testing.T) workflow.Data {
+	t.Helper()
+
+	var data workflow.Data
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"nodes": [
+			{
+				"id": "`+triggerNodeID+`",
+				"type": "TRIGGER",
+				"data": {
+					"trigger_type": "ON_DEMAND",
+					"runtime_variables": [
+						{"key": "robot", "input_type": "RAYBOT", "required": true, "default_value": "{{robot}}"}
+					]
+				}
+			},
+			{
+				"id": "`+moveNodeID+`",
+				"type": "CONTROL_RAYBOT",
+				"data": {
+					"control_raybot_type": "MOVE_TO_LOCATION",
+					"timeout_sec": 60,
+					"input": {
+						"location": {"type": "STATIC", "static_value": "{{dock}}"},
+						"direction": {"type": "STATIC", "static_value": "FORWARD"}
+					}
+				}
+			}
+		]
+	}`), &data))
+
+	return data
+}
+
+func TestDataValidateTemplate(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     []workflow.TemplateParameter
+		expectErr  bool
+		errMessage string
+	}{
+		{
+			name: "All placeholders declared",
+			params: []workflow.TemplateParameter{
+				{Key: "robot", InputType: node.InputTypeRaybot},
+				{Key: "dock", InputType: node.InputTypeQRLocation},
+			},
+		},
+		{
+			name: "Unknown parameter",
+			params: []workflow.TemplateParameter{
+				{Key: "robot", InputType: node.InputTypeRaybot},
+			},
+			expectErr:  true,
+			errMessage: "Placeholder {{dock}} references unknown template parameter dock",
+		},
+		{
+			name: "Mismatched type",
+			params: []workflow.TemplateParameter{
+				{Key: "robot", InputType: node.InputTypeQRLocation},
+				{Key: "dock", InputType: node.InputTypeQRLocation},
+			},
+			expectErr:  true,
+			errMessage: "Placeholder {{robot}} is used as RAYBOT but template parameter robot is QR_LOCATION",
+		},
+		{
+			name: "Duplicated parameter",
+			params: []workflow.TemplateParameter{
+				{Key: "dock", InputType: node.InputTypeQRLocation},
+				{Key: "dock", InputType: node.InputTypeQRLocation},
+			},
+			expectErr:  true,
+			errMessage: "Template parameter dock is declared more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newTemplateData(t).ValidateTemplate(tt.params)
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDataFillPlaceholders(t *testing.T) {
+	data := newTemplateData(t)
+
+	ids, err := data.QRLocationIDs()
+	require.NoError(t, err)
+	assert.Empty(t, ids, "placeholders are not QR location ids")
+
+	filled, err := data.FillPlaceholders(map[string]string{"robot": "raybot-1", "dock": locationA})
+	require.NoError(t, err)
+
+	ids, err = filled.QRLocationIDs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{locationA}, ids)
+
+	triggerData, err := filled.Nodes[0].Data.AsTriggerData()
+	require.NoError(t, err)
+	onDemand, err := triggerData.AsOnDemandTriggerData()
+	require.NoError(t, err)
+	assert.Equal(t, "raybot-1", onDemand.RuntimeVariables[0].DefaultValue)
+}
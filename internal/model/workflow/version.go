@@ -0,0 +1,114 @@
+package workflow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/edge"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
+)
+
+// Version is an immutable, numbered revision of the data of a workflow,
+// created each time the workflow is published.
+type Version struct {
+	ID         string
+	WorkflowID string
+	Version    int32
+	Data       Data
+	CreatedAt  time.Time
+}
+
+func NewVersion(workflowID string, version int32, data Data) Version {
+	return Version{
+		ID:         uuid.NewString(),
+		WorkflowID: workflowID,
+		Version:    version,
+		Data:       data,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// DataDiff lists the IDs of the nodes and edges that differ between two Data.
+type DataDiff struct {
+	AddedNodes   []string
+	RemovedNodes []string
+	ChangedNodes []string
+	AddedEdges   []string
+	RemovedEdges []string
+	ChangedEdges []string
+}
+
+// Diff returns the changes needed to go from the base data to the target data.
+// Nodes and edges are matched by ID and compared by their JSON representation.
+func Diff(base, target Data) (DataDiff, error) {
+	var diff DataDiff
+	var err error
+
+	diff.AddedNodes, diff.RemovedNodes, diff.ChangedNodes, err = diffByID(
+		base.Nodes, target.Nodes, func(n node.Node) string { return n.ID })
+	if err != nil {
+		return DataDiff{}, fmt.Errorf("diff nodes: %w", err)
+	}
+
+	diff.AddedEdges, diff.RemovedEdges, diff.ChangedEdges, err = diffByID(
+		base.Edges, target.Edges, func(e edge.Edge) string { return e.ID })
+	if err != nil {
+		return DataDiff{}, fmt.Errorf("diff edges: %w", err)
+	}
+
+	return diff, nil
+}
+
+func diffByID[T any](base, target []T, id func(T) string) (added, removed, changed []string, err error) {
+	added, removed, changed = []string{}, []string{}, []string{}
+
+	baseByID := make(map[string]T, len(base))
+	for _, item := range base {
+		baseByID[id(item)] = item
+	}
+
+	targetIDs := make(map[string]struct{}, len(target))
+	for _, item := range target {
+		itemID := id(item)
+		targetIDs[itemID] = struct{}{}
+
+		baseItem, ok := baseByID[itemID]
+		if !ok {
+			added = append(added, itemID)
+			continue
+		}
+
+		equal, err := jsonEqual(baseItem, item)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !equal {
+			changed = append(changed, itemID)
+		}
+	}
+
+	for _, item := range base {
+		if _, ok := targetIDs[id(item)]; !ok {
+			removed = append(removed, id(item))
+		}
+	}
+
+	return added, removed, changed, nil
+}
+
+func jsonEqual(a, b any) (bool, error) {
+	aj, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	bj, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(aj, bj), nil
+}
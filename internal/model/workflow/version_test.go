@@ -0,0 +1,79 @@
+package workflow_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/edge"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
+)
+
+func TestDiff(t *testing.T) {
+	base := workflow.Data{
+		Nodes: []node.Node{
+			{ID: "node-1", Type: node.TypeTrigger, Label: "Trigger"},
+			{ID: "node-2", Type: node.TypeControlRaybot, Label: "Move"},
+			{ID: "node-3", Type: node.TypeControlRaybot, Label: "Lift"},
+		},
+		Edges: []edge.Edge{
+			{ID: "edge-1", Source: "node-1", Target: "node-2"},
+			{ID: "edge-2", Source: "node-2", Target: "node-3"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		base     workflow.Data
+		target   workflow.Data
+		expected workflow.DataDiff
+	}{
+		{
+			name:   "Same data",
+			base:   base,
+			target: base,
+			expected: workflow.DataDiff{
+				AddedNodes: []string{}, RemovedNodes: []string{}, ChangedNodes: []string{},
+				AddedEdges: []string{}, RemovedEdges: []string{}, ChangedEdges: []string{},
+			},
+		},
+		{
+			name:   "Empty base",
+			base:   workflow.Data{},
+			target: base,
+			expected: workflow.DataDiff{
+				AddedNodes: []string{"node-1", "node-2", "node-3"}, RemovedNodes: []string{}, ChangedNodes: []string{},
+				AddedEdges: []string{"edge-1", "edge-2"}, RemovedEdges: []string{}, ChangedEdges: []string{},
+			},
+		},
+		{
+			name: "Added, removed and changed",
+			base: base,
+			target: workflow.Data{
+				Nodes: []node.Node{
+					{ID: "node-1", Type: node.TypeTrigger, Label: "Trigger"},
+					{ID: "node-2", Type: node.TypeControlRaybot, Label: "Move fast"},
+					{ID: "node-4", Type: node.TypeControlRaybot, Label: "Drop"},
+				},
+				Edges: []edge.Edge{
+					{ID: "edge-1", Source: "node-1", Target: "node-2"},
+					{ID: "edge-3", Source: "node-2", Target: "node-4"},
+				},
+			},
+			expected: workflow.DataDiff{
+				AddedNodes: []string{"node-4"}, RemovedNodes: []string{"node-3"}, ChangedNodes: []string{"node-2"},
+				AddedEdges: []string{"edge-3"}, RemovedEdges: []string{"edge-2"}, ChangedEdges: []string{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := workflow.Diff(tt.base, tt.target)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, diff)
+		})
+	}
+}
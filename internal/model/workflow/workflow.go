@@ -27,7 +27,7 @@ type Data struct {
 
 // Validate validates the workflow data.
 // It checks if the workflow has at least one node, exactly one trigger node
-// and all nodes are connected using DFS.
+// with valid runtime variables and all nodes are connected using DFS.
 func (d Data) Validate() error {
 	if len(d.Nodes) == 0 {
 		return xerror.ValidationFailed(nil, "Workflow must have at least one node")
@@ -50,6 +50,10 @@ func (d Data) Validate() error {
 		return xerror.ValidationFailed(nil, "Workflow must have exactly one trigger node")
 	}
 
+	if err := d.validateRuntimeVariables(); err != nil {
+		return err
+	}
+
 	// Validate edges
 	for _, edge := range d.Edges {
 		if _, exists := nodeMap[edge.Source]; !exists {
@@ -91,27 +95,130 @@ func (d Data) Validate() error {
 	return nil
 }
 
+// validateRuntimeVariables checks the runtime variables of the on demand
+// trigger. A default value holding a template placeholder is not checked,
+// it is replaced when the template is instantiated.
+func (d Data) validateRuntimeVariables() error {
+	for _, n := range d.Nodes {
+		if n.Type != node.TypeTrigger {
+			continue
+		}
+		triggerData, err := n.Data.AsTriggerData()
+		if err != nil || triggerData.TriggerType != node.TriggerTypeOnDemand {
+			return nil
+		}
+		onDemand, err := triggerData.AsOnDemandTriggerData()
+		if err != nil {
+			return nil
+		}
+
+		for _, v := range onDemand.RuntimeVariables {
+			if hasPlaceholder(v.DefaultValue) {
+				v.DefaultValue = nil
+			}
+			if err := v.Validate(); err != nil {
+				return xerror.ValidationFailed(nil, fmt.Sprintf("Runtime variable %s %s", v.Key, err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasPlaceholder reports whether the value, or an item of it, is a template
+// parameter placeholder.
+func hasPlaceholder(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return IsPlaceholder(v)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok && IsPlaceholder(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TriggerType returns the type of the trigger node, ON_DEMAND when the data
+// has no trigger node or its data can not be read.
+func (d Data) TriggerType() node.TriggerType {
+	for _, n := range d.Nodes {
+		if n.Type != node.TypeTrigger {
+			continue
+		}
+		triggerData, err := n.Data.AsTriggerData()
+		if err != nil || triggerData.TriggerType == "" {
+			break
+		}
+		return triggerData.TriggerType
+	}
+	return node.TriggerTypeOnDemand
+}
+
+// RaybotID returns the value the inputs give to the first RAYBOT runtime
+// variable of the trigger node, nil when none of them has a value.
+func (d Data) RaybotID(inputs map[string]any) *string {
+	for _, n := range d.Nodes {
+		if n.Type != node.TypeTrigger {
+			continue
+		}
+		triggerData, err := n.Data.AsTriggerData()
+		if err != nil || triggerData.TriggerType != node.TriggerTypeOnDemand {
+			return nil
+		}
+		onDemand, err := triggerData.AsOnDemandTriggerData()
+		if err != nil {
+			return nil
+		}
+
+		for _, v := range onDemand.RuntimeVariables {
+			if v.InputType != node.InputTypeRaybot {
+				continue
+			}
+			if id, ok := inputs[v.Key].(string); ok && id != "" {
+				return &id
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// Workflow is the editable definition of a workflow.
+//
+// Data is the draft, IsDraft reports whether it has changes that are not
+// published yet and PublishedVersion is the number of the live Version,
+// nil if the workflow has never been published.
+//
+// A template is not run nor published, it is instantiated into new workflows
+// by replacing the placeholders of its TemplateParameters.
 type Workflow struct {
-	ID          string
-	Name        string
-	Description *string
-	IsDraft     bool
-	IsValid     bool
-	Data        Data
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                 string
+	Name               string
+	Description        *string
+	IsDraft            bool
+	IsValid            bool
+	Data               Data
+	PublishedVersion   *int32
+	IsTemplate         bool
+	TemplateParameters []TemplateParameter
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
 
 func NewWorkflow(name string, description *string, isValid bool, data Data) Workflow {
 	now := time.Now()
 	return Workflow{
-		ID:          uuid.NewString(),
-		Name:        name,
-		Description: description,
-		Data:        data,
-		IsDraft:     true,
-		IsValid:     isValid,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                 uuid.NewString(),
+		Name:               name,
+		Description:        description,
+		Data:               data,
+		IsDraft:            true,
+		IsValid:            isValid,
+		TemplateParameters: []TemplateParameter{},
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 }
@@ -0,0 +1,141 @@
+package workflow_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
+)
+
+func TestDataTriggerType(t *testing.T) {
+	var triggerData node.Data
+	require.NoError(t, triggerData.FromTriggerData(node.TriggerData{TriggerType: node.TriggerTypeOnDemand}))
+
+	tests := []struct {
+		name string
+		data workflow.Data
+		want node.TriggerType
+	}{
+		{
+			name: "trigger node",
+			data: workflow.Data{Nodes: []node.Node{
+				{ID: "node-1", Type: node.TypeControlRaybot},
+				{ID: "node-2", Type: node.TypeTrigger, Data: triggerData},
+			}},
+			want: node.TriggerTypeOnDemand,
+		},
+		{
+			name: "no trigger node",
+			data: workflow.Data{Nodes: []node.Node{{ID: "node-1", Type: node.TypeControlRaybot}}},
+			want: node.TriggerTypeOnDemand,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.data.TriggerType())
+		})
+	}
+}
+
+func TestDataRaybotID(t *testing.T) {
+	var triggerData node.TriggerData
+	require.NoError(t, triggerData.FromOnDemandTriggerData(node.OnDemandTriggerData{
+		RuntimeVariables: []node.RuntimeVariable{
+			{Key: "speed", InputType: node.InputTypeNumber},
+			{Key: "robot", InputType: node.InputTypeRaybot},
+			{Key: "spare", InputType: node.InputTypeRaybot},
+		},
+	}))
+	var data node.Data
+	require.NoError(t, data.FromTriggerData(triggerData))
+	wf := workflow.Data{Nodes: []node.Node{{ID: "node-1", Type: node.TypeTrigger, Data: data}}}
+
+	raybotID := "4c6f9a2e-1b3d-4e5f-8a7b-9c0d1e2f3a4b"
+	spareID := "7d8e9f0a-1b2c-4d3e-8f5a-6b7c8d9e0f1a"
+
+	tests := []struct {
+		name   string
+		inputs map[string]any
+		want   *string
+	}{
+		{
+			name:   "first raybot variable",
+			inputs: map[string]any{"speed": 1.5, "robot": raybotID, "spare": spareID},
+			want:   &raybotID,
+		},
+		{
+			name:   "first raybot variable without value",
+			inputs: map[string]any{"spare": spareID},
+			want:   &spareID,
+		},
+		{
+			name:   "no raybot value",
+			inputs: map[string]any{"speed": 1.5},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, wf.RaybotID(tt.inputs))
+		})
+	}
+}
+
+func TestDataValidateRuntimeVariables(t *testing.T) {
+	tests := []struct {
+		name       string
+		variable   string
+		expectErr  bool
+		errMessage string
+	}{
+		{
+			name:     "valid variable",
+			variable: `{"key": "code", "input_type": "STRING", "required": true, "pattern": "^[0-9]+$", "default_value": "42"}`,
+		},
+		{
+			name:     "template placeholder default value",
+			variable: `{"key": "robot", "input_type": "RAYBOT", "required": true, "default_value": "{{robot}}"}`,
+		},
+		{
+			name:       "invalid pattern",
+			variable:   `{"key": "code", "input_type": "STRING", "required": true, "pattern": "[0-9"}`,
+			expectErr:  true,
+			errMessage: "Runtime variable code has an invalid pattern",
+		},
+		{
+			name:       "default value failing its constraints",
+			variable:   `{"key": "speed", "input_type": "INTEGER", "required": false, "min": 1, "default_value": 0}`,
+			expectErr:  true,
+			errMessage: "Runtime variable speed has an invalid default value: value must be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data workflow.Data
+			require.NoError(t, json.Unmarshal([]byte(`{
+				"nodes": [
+					{
+						"id": "trigger",
+						"type": "TRIGGER",
+						"data": {"trigger_type": "ON_DEMAND", "runtime_variables": [`+tt.variable+`]}
+					}
+				]
+			}`), &data))
+
+			err := data.Validate()
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
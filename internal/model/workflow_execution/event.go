@@ -0,0 +1,61 @@
+package workflowexecution
+
+import (
+	"time"
+
+	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
+)
+
+type EventType string
+
+const (
+	EventTypeExecutionStatusChanged EventType = "EXECUTION_STATUS_CHANGED"
+	EventTypeStepStatusChanged      EventType = "STEP_STATUS_CHANGED"
+)
+
+// Event is a change of a WorkflowExecution or of one of its steps.
+//
+// IDs are assigned by the database when the event is stored and increase
+// over time, so a client following an execution can resume after the last
+// event it has seen. StepExecutionID is only set for STEP_STATUS_CHANGED
+// events, whose Status is a step execution status.
+type Event struct {
+	ID                  int64
+	WorkflowExecutionID string
+	StepExecutionID     *string
+	Type                EventType
+	Status              string
+	Outputs             map[string]any
+	Error               *string
+	CreatedAt           time.Time
+}
+
+// NewExecutionEvent creates an Event for a status change of a WorkflowExecution.
+func NewExecutionEvent(wfe WorkflowExecution) Event {
+	return Event{
+		WorkflowExecutionID: wfe.ID,
+		Type:                EventTypeExecutionStatusChanged,
+		Status:              string(wfe.Status),
+		Outputs:             wfe.Outputs,
+		Error:               wfe.Error,
+		CreatedAt:           time.Now(),
+	}
+}
+
+// NewStepEvent creates an Event for a status change of a StepExecution.
+func NewStepEvent(step stepexecution.StepExecution) Event {
+	return Event{
+		WorkflowExecutionID: step.WorkflowExecutionID,
+		StepExecutionID:     &step.ID,
+		Type:                EventTypeStepStatusChanged,
+		Status:              string(step.Status),
+		Outputs:             step.Outputs,
+		Error:               step.Error,
+		CreatedAt:           time.Now(),
+	}
+}
+
+// IsFinal reports whether the event ends the execution, no event follows it.
+func (e Event) IsFinal() bool {
+	return e.Type == EventTypeExecutionStatusChanged && Status(e.Status).IsFinished()
+}
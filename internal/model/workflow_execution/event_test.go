@@ -0,0 +1,50 @@
+package workflowexecution_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
+	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+)
+
+func TestEventIsFinal(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    workflowexecution.Event
+		expected bool
+	}{
+		{
+			name:     "Execution running",
+			event:    workflowexecution.NewExecutionEvent(workflowexecution.WorkflowExecution{Status: workflowexecution.StatusRunning}),
+			expected: false,
+		},
+		{
+			name:     "Execution completed",
+			event:    workflowexecution.NewExecutionEvent(workflowexecution.WorkflowExecution{Status: workflowexecution.StatusCompleted}),
+			expected: true,
+		},
+		{
+			name:     "Execution failed",
+			event:    workflowexecution.NewExecutionEvent(workflowexecution.WorkflowExecution{Status: workflowexecution.StatusFailed}),
+			expected: true,
+		},
+		{
+			name:     "Execution cancelled",
+			event:    workflowexecution.NewExecutionEvent(workflowexecution.WorkflowExecution{Status: workflowexecution.StatusCancelled}),
+			expected: true,
+		},
+		{
+			name:     "Step completed",
+			event:    workflowexecution.NewStepEvent(stepexecution.StepExecution{Status: stepexecution.StatusCompleted}),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.event.IsFinal())
+		})
+	}
+}
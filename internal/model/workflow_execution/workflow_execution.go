@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
 )
 
 type Status string
@@ -37,33 +38,54 @@ var StatusMap = map[Status]struct{}{
 	StatusCancelled: {},
 }
 
+// WorkflowExecution is a run of a Version of a workflow.
+//
+// IsSimulated reports whether the execution is a dry run: its CONTROL_RAYBOT
+// steps run against a simulated raybot instead of being sent to real robots.
+// TriggerType is the type of the trigger node of Data, kept apart to filter
+// the executions by it. RaybotID is the raybot given to the first RAYBOT
+// runtime variable, nil when the execution does not run on a raybot.
 type WorkflowExecution struct {
-	ID          string
-	WorkflowID  string
-	Status      Status
-	Data        workflow.Data
-	Inputs      map[string]any
-	Outputs     map[string]any
-	Error       *string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	StartedAt   *time.Time
-	CompletedAt *time.Time
+	ID                string
+	WorkflowID        string
+	WorkflowVersionID *string
+	IsSimulated       bool
+	TriggerType       node.TriggerType
+	RaybotID          *string
+	Status            Status
+	Data              workflow.Data
+	Inputs            map[string]any
+	Outputs           map[string]any
+	Error             *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	StartedAt         *time.Time
+	CompletedAt       *time.Time
 }
 
-func NewWorkflowExecution(workflowID string, data workflow.Data, inputs map[string]any) WorkflowExecution {
+// IsFinished reports whether the status is final: the execution is completed,
+// failed or cancelled.
+func (s Status) IsFinished() bool {
+	return s == StatusCompleted || s == StatusFailed || s == StatusCancelled
+}
+
+func NewWorkflowExecution(version workflow.Version, inputs map[string]any, isSimulated bool) WorkflowExecution {
 	now := time.Now()
 	return WorkflowExecution{
-		ID:          uuid.NewString(),
-		WorkflowID:  workflowID,
-		Status:      StatusPending,
-		Data:        data,
-		Inputs:      inputs,
-		Outputs:     make(map[string]any),
-		Error:       nil,
-		StartedAt:   nil,
-		CompletedAt: nil,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                uuid.NewString(),
+		WorkflowID:        version.WorkflowID,
+		WorkflowVersionID: &version.ID,
+		IsSimulated:       isSimulated,
+		TriggerType:       version.Data.TriggerType(),
+		RaybotID:          version.Data.RaybotID(inputs),
+		Status:            StatusPending,
+		Data:              version.Data,
+		Inputs:            inputs,
+		Outputs:           make(map[string]any),
+		Error:             nil,
+		StartedAt:         nil,
+		CompletedAt:       nil,
+		CreatedAt:         now,
+		UpdatedAt:         now,
 	}
 }
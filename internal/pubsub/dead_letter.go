@@ -0,0 +1,84 @@
+package pubsub
+
+import (
+	"errors"
+	"maps"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+)
+
+// DeadLetterTopic receives the messages whose handler still fails after the
+// retries, see WithPoisonQueue.
+const DeadLetterTopic = "dead_letter"
+
+// DeadLetterMessageIDKey is the metadata key of the UUID a dead-lettered
+// message had on its original topic.
+const DeadLetterMessageIDKey = "dead_letter_message_id"
+
+// deadLetterMetadataKeys are the metadata keys added to a dead-lettered
+// message, they are not part of the original message.
+var deadLetterMetadataKeys = []string{
+	middleware.ReasonForPoisonedKey,
+	middleware.PoisonedTopicKey,
+	middleware.PoisonedHandlerKey,
+	middleware.PoisonedSubscriberKey,
+	DeadLetterMessageIDKey,
+}
+
+// DeadLetter describes a message published to DeadLetterTopic.
+type DeadLetter struct {
+	// MessageID is the UUID of the message on its original topic.
+	MessageID string
+	// Topic is the original topic of the message.
+	Topic string
+	// Handler is the name of the handler which failed.
+	Handler string
+	// Subscriber is the name of the subscriber of the handler.
+	Subscriber string
+	// Reason is the error of the last attempt of the handler.
+	Reason string
+	// Metadata is the metadata of the original message.
+	Metadata map[string]string
+}
+
+// deadLetterPublisher publishes the poisoned messages under a new UUID. The
+// same message may be poisoned by the handlers of several consumers, and
+// JetStream drops a message with the UUID of one it has recently received.
+type deadLetterPublisher struct {
+	message.Publisher
+}
+
+func (p deadLetterPublisher) Publish(topic string, msgs ...*message.Message) error {
+	deadLetters := make([]*message.Message, len(msgs))
+	for i, msg := range msgs {
+		deadLetter := msg.Copy()
+		deadLetter.UUID = watermill.NewUUID()
+		deadLetter.Metadata.Set(DeadLetterMessageIDKey, msg.UUID)
+		deadLetters[i] = deadLetter
+	}
+	return p.Publisher.Publish(topic, deadLetters...)
+}
+
+// UnmarshalDeadLetter reads the DeadLetter of a message published to
+// DeadLetterTopic.
+func UnmarshalDeadLetter(msg *message.Message) (DeadLetter, error) {
+	deadLetter := DeadLetter{
+		MessageID:  msg.Metadata.Get(DeadLetterMessageIDKey),
+		Topic:      msg.Metadata.Get(middleware.PoisonedTopicKey),
+		Handler:    msg.Metadata.Get(middleware.PoisonedHandlerKey),
+		Subscriber: msg.Metadata.Get(middleware.PoisonedSubscriberKey),
+		Reason:     msg.Metadata.Get(middleware.ReasonForPoisonedKey),
+		Metadata:   maps.Clone(map[string]string(msg.Metadata)),
+	}
+	if deadLetter.MessageID == "" || deadLetter.Topic == "" {
+		return DeadLetter{}, errors.New("missing dead letter metadata")
+	}
+
+	for _, key := range deadLetterMetadataKeys {
+		delete(deadLetter.Metadata, key)
+	}
+
+	return deadLetter, nil
+}
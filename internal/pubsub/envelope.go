@@ -0,0 +1,135 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/google/uuid"
+
+	mymiddleware "github.com/tuanvumaihuynh/roboflow/pkg/middleware"
+)
+
+// Envelope wraps the payload of every domain event.
+//
+// CorrelationID is shared by all events caused by the same request, such as
+// the events of a workflow execution and the request that ran the workflow.
+type Envelope struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	Version       int             `json:"version"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	CorrelationID string          `json:"correlation_id"`
+	Data          json.RawMessage `json:"data"`
+}
+
+type correlationIDCtxKey struct{}
+
+// WithCorrelationID returns a copy of ctx holding the correlation ID of the
+// events published with it.
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDCtxKey{}, correlationID)
+}
+
+// CorrelationID returns the correlation ID held by ctx, falling back to the
+// ID of the HTTP request and then to a new ID.
+func CorrelationID(ctx context.Context) string {
+	if id, ok := ctx.Value(correlationIDCtxKey{}).(string); ok && id != "" {
+		return id
+	}
+	if id := mymiddleware.GetReqID(ctx); id != "" {
+		return id
+	}
+	return uuid.NewString()
+}
+
+// NewMessage wraps the event in an Envelope and returns the message to
+// publish to the event topic. Services do not publish it directly, they write
+// it to the outbox.
+func NewMessage(ctx context.Context, event Event) (*message.Message, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("marshal event data: %w", err)
+	}
+
+	env := Envelope{
+		ID:            uuid.NewString(),
+		Type:          event.EventType(),
+		Version:       event.EventVersion(),
+		OccurredAt:    time.Now().UTC(),
+		CorrelationID: CorrelationID(ctx),
+		Data:          data,
+	}
+	payload, err := json.Marshal(env)
+	if err != nil {
+		return nil, fmt.Errorf("marshal envelope: %w", err)
+	}
+
+	msg := message.NewMessage(env.ID, payload)
+	middleware.SetCorrelationID(env.CorrelationID, msg)
+
+	return msg, nil
+}
+
+// UnmarshalEnvelope decodes the message Envelope without decoding its data,
+// for handlers of any event type.
+func UnmarshalEnvelope(msg *message.Message) (Envelope, error) {
+	var env Envelope
+	if err := json.Unmarshal(msg.Payload, &env); err != nil {
+		return Envelope{}, fmt.Errorf("unmarshal envelope: %w", err)
+	}
+	return env, nil
+}
+
+// ErrNewerEventVersion is returned when decoding a version of an event newer
+// than the one known by this release. The message is nacked, so it may be
+// redelivered to an upgraded instance during a rolling upgrade.
+var ErrNewerEventVersion = errors.New("newer event version")
+
+// Upcaster is implemented by the events whose version is greater than 1, to
+// decode the data of their previous versions.
+type Upcaster interface {
+	// Upcast converts the data of version of the event to the data of the
+	// next version.
+	Upcast(version int, data json.RawMessage) (json.RawMessage, error)
+}
+
+// UnmarshalEvent decodes the data of the message Envelope into event, which
+// must be a pointer, checking that the type matches. The data of a previous
+// version is upcast to the version of event. It returns the Envelope.
+func UnmarshalEvent(msg *message.Message, event Event) (Envelope, error) {
+	env, err := UnmarshalEnvelope(msg)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	if env.Type != event.EventType() {
+		return Envelope{}, fmt.Errorf("unexpected event type %s, want %s", env.Type, event.EventType())
+	}
+	if env.Version > event.EventVersion() {
+		return Envelope{}, fmt.Errorf("%w %d of event %s, want %d", ErrNewerEventVersion, env.Version, env.Type, event.EventVersion())
+	}
+
+	data := env.Data
+	if env.Version < event.EventVersion() {
+		upcaster, ok := event.(Upcaster)
+		if !ok || env.Version < 1 {
+			return Envelope{}, fmt.Errorf("unsupported version %d of event %s, want %d", env.Version, env.Type, event.EventVersion())
+		}
+		for v := env.Version; v < event.EventVersion(); v++ {
+			if data, err = upcaster.Upcast(v, data); err != nil {
+				return Envelope{}, fmt.Errorf("upcast version %d of event %s: %w", v, env.Type, err)
+			}
+		}
+	}
+
+	if err := json.Unmarshal(data, event); err != nil {
+		return Envelope{}, fmt.Errorf("unmarshal event data: %w", err)
+	}
+
+	return env, nil
+}
@@ -1,19 +1,258 @@
 package pubsub
 
-import raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+import (
+	"time"
 
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	raybotposition "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_position"
+	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+)
+
+// Domain event topics, each event type is published to the topic of the
+// same name. The payloads are documented in docs/asyncapi/asyncapi.yml.
 const (
-	RaybotCommandCreatedTopic     = "raybot_command:created"
-	WorkflowExecutionCreatedTopic = "workflow_execution:created"
+	RaybotCreatedTopic = "raybot:created"
+	RaybotUpdatedTopic = "raybot:updated"
+	RaybotOnlineTopic  = "raybot:online"
+	RaybotOfflineTopic = "raybot:offline"
+
+	RaybotPositionChangedTopic = "raybot:position_changed"
+
+	RaybotCommandCreatedTopic       = "raybot_command:created"
+	RaybotCommandStatusChangedTopic = "raybot_command:status_changed"
+
+	WorkflowCreatedTopic   = "workflow:created"
+	WorkflowPublishedTopic = "workflow:published"
+	WorkflowDeletedTopic   = "workflow:deleted"
+
+	WorkflowExecutionCreatedTopic   = "workflow_execution:created"
+	WorkflowExecutionStartedTopic   = "workflow_execution:started"
+	WorkflowExecutionCompletedTopic = "workflow_execution:completed"
+	WorkflowExecutionFailedTopic    = "workflow_execution:failed"
+	WorkflowExecutionCancelledTopic = "workflow_execution:cancelled"
+
+	StepExecutionStartedTopic   = "step_execution:started"
+	StepExecutionCompletedTopic = "step_execution:completed"
+	StepExecutionFailedTopic    = "step_execution:failed"
 )
 
+// WorkflowExecutionEventTopic is broadcast to every instance, see PubSub.
+// It carries the live updates of executions, not a domain event.
+const WorkflowExecutionEventTopic = "workflow_execution:event"
+
+// Event is the payload of a domain event.
+type Event interface {
+	// EventType is the type of the event, which is also its topic.
+	EventType() string
+
+	// EventVersion is the version of the payload, increased on every
+	// breaking change: removing, renaming or changing the type of a field.
+	// Adding a field is not breaking, readers ignore unknown fields. An event
+	// whose version is greater than 1 implements Upcaster.
+	EventVersion() int
+}
+
+// Catalogue lists every domain event.
+var Catalogue = []Event{
+	RaybotCreated{},
+	RaybotUpdated{},
+	RaybotOnline{},
+	RaybotOffline{},
+	RaybotPositionChanged{},
+	RaybotCommandCreated{},
+	RaybotCommandStatusChanged{},
+	WorkflowCreated{},
+	WorkflowPublished{},
+	WorkflowDeleted{},
+	WorkflowExecutionCreated{},
+	WorkflowExecutionStarted{},
+	WorkflowExecutionCompleted{},
+	WorkflowExecutionFailed{},
+	WorkflowExecutionCancelled{},
+	StepExecutionStarted{},
+	StepExecutionCompleted{},
+	StepExecutionFailed{},
+}
+
+type RaybotCreated struct {
+	RaybotID    string `json:"raybot_id"`
+	Name        string `json:"name"`
+	ControlMode string `json:"control_mode"`
+}
+
+func (RaybotCreated) EventType() string { return RaybotCreatedTopic }
+func (RaybotCreated) EventVersion() int { return 1 }
+
+type RaybotUpdated struct {
+	RaybotID    string  `json:"raybot_id"`
+	Name        string  `json:"name"`
+	ControlMode string  `json:"control_mode"`
+	IsOnline    bool    `json:"is_online"`
+	IPAddress   *string `json:"ip_address"`
+}
+
+func (RaybotUpdated) EventType() string { return RaybotUpdatedTopic }
+func (RaybotUpdated) EventVersion() int { return 1 }
+
+type RaybotOnline struct {
+	RaybotID  string  `json:"raybot_id"`
+	IPAddress *string `json:"ip_address"`
+}
+
+func (RaybotOnline) EventType() string { return RaybotOnlineTopic }
+func (RaybotOnline) EventVersion() int { return 1 }
+
+type RaybotOffline struct {
+	RaybotID string `json:"raybot_id"`
+}
+
+func (RaybotOffline) EventType() string { return RaybotOfflineTopic }
+func (RaybotOffline) EventVersion() int { return 1 }
+
+type RaybotPositionChanged struct {
+	RaybotID   string                `json:"raybot_id"`
+	LocationID string                `json:"location_id"`
+	Source     raybotposition.Source `json:"source"`
+	CommandID  *string               `json:"command_id"`
+}
+
+func (RaybotPositionChanged) EventType() string { return RaybotPositionChangedTopic }
+func (RaybotPositionChanged) EventVersion() int { return 1 }
+
 type RaybotCommandCreated struct {
-	RaybotID  string               `json:"raybot_id"`
-	CommandID string               `json:"command_id"`
-	Type      raybotcommand.Type   `json:"type"`
-	Inputs    raybotcommand.Inputs `json:"inputs"`
+	RaybotID  string             `json:"raybot_id"`
+	CommandID string             `json:"command_id"`
+	Type      raybotcommand.Type `json:"type"`
+	// Status is QUEUED while the route of a MOVE_TO_LOCATION command waits
+	// for other raybots, the command is dispatched once it is PENDING.
+	Status raybotcommand.Status `json:"status"`
+	Inputs raybotcommand.Inputs `json:"inputs"`
+}
+
+func (RaybotCommandCreated) EventType() string { return RaybotCommandCreatedTopic }
+func (RaybotCommandCreated) EventVersion() int { return 1 }
+
+type RaybotCommandStatusChanged struct {
+	RaybotID  string                `json:"raybot_id"`
+	CommandID string                `json:"command_id"`
+	Type      raybotcommand.Type    `json:"type"`
+	Status    raybotcommand.Status  `json:"status"`
+	Outputs   raybotcommand.Outputs `json:"outputs"`
+	Error     *string               `json:"error"`
+}
+
+func (RaybotCommandStatusChanged) EventType() string { return RaybotCommandStatusChangedTopic }
+func (RaybotCommandStatusChanged) EventVersion() int { return 1 }
+
+type WorkflowCreated struct {
+	WorkflowID string `json:"workflow_id"`
+	Name       string `json:"name"`
+	IsTemplate bool   `json:"is_template"`
 }
 
+func (WorkflowCreated) EventType() string { return WorkflowCreatedTopic }
+func (WorkflowCreated) EventVersion() int { return 1 }
+
+type WorkflowPublished struct {
+	WorkflowID        string `json:"workflow_id"`
+	WorkflowVersionID string `json:"workflow_version_id"`
+	Version           int32  `json:"version"`
+}
+
+func (WorkflowPublished) EventType() string { return WorkflowPublishedTopic }
+func (WorkflowPublished) EventVersion() int { return 1 }
+
+type WorkflowDeleted struct {
+	WorkflowID string `json:"workflow_id"`
+}
+
+func (WorkflowDeleted) EventType() string { return WorkflowDeletedTopic }
+func (WorkflowDeleted) EventVersion() int { return 1 }
+
 type WorkflowExecutionCreated struct {
 	WorkflowExecutionID string `json:"workflow_execution_id"`
 }
+
+func (WorkflowExecutionCreated) EventType() string { return WorkflowExecutionCreatedTopic }
+func (WorkflowExecutionCreated) EventVersion() int { return 1 }
+
+type WorkflowExecutionStarted struct {
+	WorkflowExecutionID string `json:"workflow_execution_id"`
+	WorkflowID          string `json:"workflow_id"`
+	IsSimulated         bool   `json:"is_simulated"`
+}
+
+func (WorkflowExecutionStarted) EventType() string { return WorkflowExecutionStartedTopic }
+func (WorkflowExecutionStarted) EventVersion() int { return 1 }
+
+type WorkflowExecutionCompleted struct {
+	WorkflowExecutionID string         `json:"workflow_execution_id"`
+	WorkflowID          string         `json:"workflow_id"`
+	IsSimulated         bool           `json:"is_simulated"`
+	Outputs             map[string]any `json:"outputs"`
+}
+
+func (WorkflowExecutionCompleted) EventType() string { return WorkflowExecutionCompletedTopic }
+func (WorkflowExecutionCompleted) EventVersion() int { return 1 }
+
+type WorkflowExecutionFailed struct {
+	WorkflowExecutionID string  `json:"workflow_execution_id"`
+	WorkflowID          string  `json:"workflow_id"`
+	IsSimulated         bool    `json:"is_simulated"`
+	Error               *string `json:"error"`
+}
+
+func (WorkflowExecutionFailed) EventType() string { return WorkflowExecutionFailedTopic }
+func (WorkflowExecutionFailed) EventVersion() int { return 1 }
+
+type WorkflowExecutionCancelled struct {
+	WorkflowExecutionID string `json:"workflow_execution_id"`
+	WorkflowID          string `json:"workflow_id"`
+	IsSimulated         bool   `json:"is_simulated"`
+}
+
+func (WorkflowExecutionCancelled) EventType() string { return WorkflowExecutionCancelledTopic }
+func (WorkflowExecutionCancelled) EventVersion() int { return 1 }
+
+type StepExecutionStarted struct {
+	StepExecutionID     string `json:"step_execution_id"`
+	WorkflowExecutionID string `json:"workflow_execution_id"`
+	NodeID              string `json:"node_id"`
+	NodeType            string `json:"node_type"`
+}
+
+func (StepExecutionStarted) EventType() string { return StepExecutionStartedTopic }
+func (StepExecutionStarted) EventVersion() int { return 1 }
+
+type StepExecutionCompleted struct {
+	StepExecutionID     string         `json:"step_execution_id"`
+	WorkflowExecutionID string         `json:"workflow_execution_id"`
+	NodeID              string         `json:"node_id"`
+	NodeType            string         `json:"node_type"`
+	Outputs             map[string]any `json:"outputs"`
+}
+
+func (StepExecutionCompleted) EventType() string { return StepExecutionCompletedTopic }
+func (StepExecutionCompleted) EventVersion() int { return 1 }
+
+type StepExecutionFailed struct {
+	StepExecutionID     string  `json:"step_execution_id"`
+	WorkflowExecutionID string  `json:"workflow_execution_id"`
+	NodeID              string  `json:"node_id"`
+	NodeType            string  `json:"node_type"`
+	Error               *string `json:"error"`
+}
+
+func (StepExecutionFailed) EventType() string { return StepExecutionFailedTopic }
+func (StepExecutionFailed) EventVersion() int { return 1 }
+
+type WorkflowExecutionEvent struct {
+	ID                  int64                       `json:"id"`
+	WorkflowExecutionID string                      `json:"workflow_execution_id"`
+	StepExecutionID     *string                     `json:"step_execution_id"`
+	Type                workflowexecution.EventType `json:"type"`
+	Status              string                      `json:"status"`
+	Outputs             map[string]any              `json:"outputs"`
+	Error               *string                     `json:"error"`
+	CreatedAt           time.Time                   `json:"created_at"`
+}
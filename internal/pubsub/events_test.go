@@ -0,0 +1,258 @@
+package pubsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/pkg/ptr"
+)
+
+type asyncAPISchema struct {
+	Required   []string            `yaml:"required"`
+	Properties map[string]struct{} `yaml:"properties"`
+}
+
+type asyncAPIDoc struct {
+	Channels   map[string]any `yaml:"channels"`
+	Components struct {
+		Messages map[string]struct {
+			Name string `yaml:"name"`
+		} `yaml:"messages"`
+		Schemas map[string]asyncAPISchema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+func loadAsyncAPIDoc(t *testing.T) asyncAPIDoc {
+	t.Helper()
+
+	data, err := os.ReadFile("../../docs/asyncapi/asyncapi.yml")
+	require.NoError(t, err)
+
+	var doc asyncAPIDoc
+	require.NoError(t, yaml.Unmarshal(data, &doc))
+	return doc
+}
+
+// jsonKeys returns the sorted keys of v marshaled as a JSON object.
+func jsonKeys(t *testing.T, v any) []string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	var m map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &m))
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func schemaKeys(schema asyncAPISchema) []string {
+	keys := make([]string, 0, len(schema.Properties))
+	for k := range schema.Properties {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func TestCatalogueIsDocumented(t *testing.T) {
+	doc := loadAsyncAPIDoc(t)
+
+	names := make(map[string]string, len(doc.Components.Messages))
+	for name, msg := range doc.Components.Messages {
+		names[msg.Name] = name
+	}
+
+	seen := make(map[string]struct{}, len(pubsub.Catalogue))
+	for _, event := range pubsub.Catalogue {
+		t.Run(event.EventType(), func(t *testing.T) {
+			_, dup := seen[event.EventType()]
+			assert.False(t, dup, "event type is not unique")
+			seen[event.EventType()] = struct{}{}
+
+			assert.Contains(t, doc.Channels, event.EventType())
+			assert.Equal(t, 1, event.EventVersion())
+
+			name, ok := names[event.EventType()]
+			require.True(t, ok, "message is not documented")
+			schema, ok := doc.Components.Schemas[name]
+			require.True(t, ok, "schema is not documented")
+
+			keys := jsonKeys(t, event)
+			assert.Equal(t, keys, schemaKeys(schema))
+			required := slices.Clone(schema.Required)
+			slices.Sort(required)
+			assert.Equal(t, keys, required)
+		})
+	}
+
+	assert.Len(t, doc.Channels, len(pubsub.Catalogue))
+}
+
+func TestEnvelopeIsDocumented(t *testing.T) {
+	doc := loadAsyncAPIDoc(t)
+
+	schema, ok := doc.Components.Schemas["Envelope"]
+	require.True(t, ok)
+	assert.Equal(t, jsonKeys(t, pubsub.Envelope{}), schemaKeys(schema))
+}
+
+func TestNewMessage(t *testing.T) {
+	ctx := pubsub.WithCorrelationID(context.Background(), "correlation-id")
+	event := pubsub.WorkflowExecutionFailed{
+		WorkflowExecutionID: "execution-id",
+		WorkflowID:          "workflow-id",
+		IsSimulated:         true,
+		Error:               ptr.New("boom"),
+	}
+
+	msg, err := pubsub.NewMessage(ctx, event)
+	require.NoError(t, err)
+	assert.Equal(t, "correlation-id", middleware.MessageCorrelationID(msg))
+
+	var decoded pubsub.WorkflowExecutionFailed
+	env, err := pubsub.UnmarshalEvent(msg, &decoded)
+	require.NoError(t, err)
+	assert.Equal(t, msg.UUID, env.ID)
+	assert.Equal(t, pubsub.WorkflowExecutionFailedTopic, env.Type)
+	assert.Equal(t, 1, env.Version)
+	assert.Equal(t, "correlation-id", env.CorrelationID)
+	assert.False(t, env.OccurredAt.IsZero())
+	assert.Equal(t, event, decoded)
+}
+
+func TestUnmarshalEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			payload: `{"type":"workflow:deleted","version":1,"data":{"workflow_id":"id"}}`,
+		},
+		{
+			name:    "unexpected type",
+			payload: `{"type":"workflow:created","version":1,"data":{"workflow_id":"id"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "newer version",
+			payload: `{"type":"workflow:deleted","version":2,"data":{"workflow_id":"id"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported version",
+			payload: `{"type":"workflow:deleted","version":0,"data":{"workflow_id":"id"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid payload",
+			payload: `{"type":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := pubsub.NewMessage(context.Background(), pubsub.WorkflowDeleted{})
+			require.NoError(t, err)
+			msg.Payload = []byte(tc.payload)
+
+			var event pubsub.WorkflowDeleted
+			_, err = pubsub.UnmarshalEvent(msg, &event)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "id", event.WorkflowID)
+		})
+	}
+}
+
+// renamedEvent is at version 3: version 2 renamed old_name to name and
+// version 3 renamed name to label.
+type renamedEvent struct {
+	Label string `json:"label"`
+}
+
+func (renamedEvent) EventType() string { return "test:renamed" }
+func (renamedEvent) EventVersion() int { return 3 }
+
+func (renamedEvent) Upcast(version int, data json.RawMessage) (json.RawMessage, error) {
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	switch version {
+	case 1:
+		m["name"] = m["old_name"]
+		delete(m, "old_name")
+	case 2:
+		m["label"] = m["name"]
+		delete(m, "name")
+	}
+	return json.Marshal(m)
+}
+
+func TestUnmarshalEventUpcast(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    renamedEvent
+		wantErr error
+	}{
+		{
+			name:    "current version",
+			payload: `{"type":"test:renamed","version":3,"data":{"label":"a"}}`,
+			want:    renamedEvent{Label: "a"},
+		},
+		{
+			name:    "previous version",
+			payload: `{"type":"test:renamed","version":2,"data":{"name":"a"}}`,
+			want:    renamedEvent{Label: "a"},
+		},
+		{
+			name:    "first version",
+			payload: `{"type":"test:renamed","version":1,"data":{"old_name":"a"}}`,
+			want:    renamedEvent{Label: "a"},
+		},
+		{
+			name:    "newer version",
+			payload: `{"type":"test:renamed","version":4,"data":{"title":"a"}}`,
+			wantErr: pubsub.ErrNewerEventVersion,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := pubsub.NewMessage(context.Background(), renamedEvent{})
+			require.NoError(t, err)
+			msg.Payload = []byte(tc.payload)
+
+			var event renamedEvent
+			_, err = pubsub.UnmarshalEvent(msg, &event)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, event)
+		})
+	}
+}
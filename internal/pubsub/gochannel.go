@@ -0,0 +1,26 @@
+package pubsub
+
+import (
+	"log/slog"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+)
+
+// NewGoChannelPubSub creates publishers and subscribers passing the messages
+// in memory, every subscriber of a topic receives all its messages. Messages
+// are lost when no subscriber is running or when the process stops, it is
+// meant for tests and single-node installs running the API and the worker in
+// the same process.
+func NewGoChannelPubSub(log *slog.Logger) *PubSub {
+	goChannel := gochannel.NewGoChannel(gochannel.Config{}, watermill.NewSlogLogger(log))
+
+	return &PubSub{
+		Publisher:           goChannel,
+		Subscriber:          goChannel,
+		WebhookSubscriber:   goChannel,
+		BroadcastPublisher:  goChannel,
+		BroadcastSubscriber: goChannel,
+		closers:             []func() error{closeWith("gochannel", goChannel)},
+	}
+}
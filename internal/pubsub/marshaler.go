@@ -0,0 +1,103 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill-nats/v2/pkg/nats"
+	"github.com/ThreeDotsLabs/watermill/message"
+	nc "github.com/nats-io/nats.go"
+)
+
+// MessageFormat is the wire format of the messages published to NATS.
+type MessageFormat string
+
+const (
+	// MessageFormatGob encodes the whole message with encoding/gob, only
+	// watermill consumers can read it. It is kept for rolling upgrades from
+	// the releases publishing it.
+	MessageFormatGob MessageFormat = "gob"
+
+	// MessageFormatJSON sends the payload, a JSON Envelope, as the message
+	// data and the metadata as NATS headers.
+	MessageFormatJSON MessageFormat = "json"
+
+	// MessageFormatCloudEvents is MessageFormatJSON with the CloudEvents
+	// attributes as ce- headers, the binary content mode of the CloudEvents
+	// NATS binding.
+	MessageFormatCloudEvents MessageFormat = "cloudevents"
+)
+
+const (
+	cloudEventsSpecVersion  = "1.0"
+	cloudEventsSource       = "roboflow"
+	cloudEventsHeaderPrefix = "ce-"
+	contentTypeHeader       = "Content-Type"
+)
+
+// NewMarshaler returns the marshaler of the messages published in format.
+func NewMarshaler(format MessageFormat) (nats.Marshaler, error) {
+	switch format {
+	case MessageFormatGob:
+		return nats.GobMarshaler{}, nil
+	case MessageFormatJSON:
+		return &nats.NATSMarshaler{}, nil
+	case MessageFormatCloudEvents:
+		return cloudEventsMarshaler{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported message format: %s", format)
+	}
+}
+
+// Unmarshaler reads the messages of every MessageFormat, so instances
+// publishing different formats can coexist during a rolling upgrade.
+type Unmarshaler struct{}
+
+var _ nats.Unmarshaler = Unmarshaler{}
+
+func (Unmarshaler) Unmarshal(natsMsg *nc.Msg) (*message.Message, error) {
+	// Gob messages carry the UUID in their data, the other formats in a header
+	if natsMsg.Header.Get(nats.WatermillUUIDHdr) == "" {
+		return nats.GobMarshaler{}.Unmarshal(natsMsg)
+	}
+
+	msg, err := (&nats.NATSMarshaler{}).Unmarshal(natsMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range msg.Metadata {
+		if k == contentTypeHeader || strings.HasPrefix(k, cloudEventsHeaderPrefix) {
+			delete(msg.Metadata, k)
+		}
+	}
+
+	return msg, nil
+}
+
+type cloudEventsMarshaler struct{}
+
+func (cloudEventsMarshaler) Marshal(topic string, msg *message.Message) (*nc.Msg, error) {
+	natsMsg, err := (&nats.NATSMarshaler{}).Marshal(topic, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	natsMsg.Header.Set(cloudEventsHeaderPrefix+"specversion", cloudEventsSpecVersion)
+	natsMsg.Header.Set(cloudEventsHeaderPrefix+"id", msg.UUID)
+	natsMsg.Header.Set(cloudEventsHeaderPrefix+"source", cloudEventsSource)
+	natsMsg.Header.Set(cloudEventsHeaderPrefix+"type", topic)
+	natsMsg.Header.Set(contentTypeHeader, "application/json")
+
+	// Only domain events are wrapped in an Envelope
+	var env struct {
+		OccurredAt time.Time `json:"occurred_at"`
+	}
+	if err := json.Unmarshal(msg.Payload, &env); err == nil && !env.OccurredAt.IsZero() {
+		natsMsg.Header.Set(cloudEventsHeaderPrefix+"time", env.OccurredAt.Format(time.RFC3339Nano))
+	}
+
+	return natsMsg, nil
+}
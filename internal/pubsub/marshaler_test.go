@@ -0,0 +1,89 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill-nats/v2/pkg/nats"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+)
+
+func TestMarshalerRoundTrip(t *testing.T) {
+	ctx := pubsub.WithCorrelationID(context.Background(), "correlation-id")
+	msg, err := pubsub.NewMessage(ctx, pubsub.WorkflowDeleted{WorkflowID: "workflow-id"})
+	require.NoError(t, err)
+
+	formats := []pubsub.MessageFormat{
+		pubsub.MessageFormatGob,
+		pubsub.MessageFormatJSON,
+		pubsub.MessageFormatCloudEvents,
+	}
+
+	for _, format := range formats {
+		t.Run(string(format), func(t *testing.T) {
+			marshaler, err := pubsub.NewMarshaler(format)
+			require.NoError(t, err)
+
+			natsMsg, err := marshaler.Marshal(pubsub.WorkflowDeletedTopic, msg)
+			require.NoError(t, err)
+
+			decoded, err := pubsub.Unmarshaler{}.Unmarshal(natsMsg)
+			require.NoError(t, err)
+			assert.Equal(t, msg.UUID, decoded.UUID)
+			assert.Equal(t, msg.Payload, decoded.Payload)
+			assert.Equal(t, msg.Metadata, decoded.Metadata)
+			assert.Equal(t, "correlation-id", middleware.MessageCorrelationID(decoded))
+
+			var event pubsub.WorkflowDeleted
+			_, err = pubsub.UnmarshalEvent(decoded, &event)
+			require.NoError(t, err)
+			assert.Equal(t, "workflow-id", event.WorkflowID)
+		})
+	}
+}
+
+func TestMarshalerJSONData(t *testing.T) {
+	msg, err := pubsub.NewMessage(context.Background(), pubsub.WorkflowDeleted{WorkflowID: "workflow-id"})
+	require.NoError(t, err)
+
+	for _, format := range []pubsub.MessageFormat{pubsub.MessageFormatJSON, pubsub.MessageFormatCloudEvents} {
+		t.Run(string(format), func(t *testing.T) {
+			marshaler, err := pubsub.NewMarshaler(format)
+			require.NoError(t, err)
+
+			natsMsg, err := marshaler.Marshal(pubsub.WorkflowDeletedTopic, msg)
+			require.NoError(t, err)
+
+			// Consumers read the envelope from the data without watermill
+			assert.JSONEq(t, string(msg.Payload), string(natsMsg.Data))
+			assert.Equal(t, msg.UUID, natsMsg.Header.Get(nats.WatermillUUIDHdr))
+		})
+	}
+}
+
+func TestMarshalerCloudEventsHeaders(t *testing.T) {
+	msg, err := pubsub.NewMessage(context.Background(), pubsub.WorkflowDeleted{WorkflowID: "workflow-id"})
+	require.NoError(t, err)
+
+	marshaler, err := pubsub.NewMarshaler(pubsub.MessageFormatCloudEvents)
+	require.NoError(t, err)
+
+	natsMsg, err := marshaler.Marshal(pubsub.WorkflowDeletedTopic, msg)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.0", natsMsg.Header.Get("ce-specversion"))
+	assert.Equal(t, msg.UUID, natsMsg.Header.Get("ce-id"))
+	assert.Equal(t, "roboflow", natsMsg.Header.Get("ce-source"))
+	assert.Equal(t, pubsub.WorkflowDeletedTopic, natsMsg.Header.Get("ce-type"))
+	assert.NotEmpty(t, natsMsg.Header.Get("ce-time"))
+	assert.Equal(t, "application/json", natsMsg.Header.Get("Content-Type"))
+}
+
+func TestNewMarshalerUnsupportedFormat(t *testing.T) {
+	_, err := pubsub.NewMarshaler("xml")
+	assert.Error(t, err)
+}
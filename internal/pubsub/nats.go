@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill"
@@ -14,10 +17,18 @@ import (
 	"github.com/tuanvumaihuynh/roboflow/pkg/config"
 )
 
-// NewNatsPubSub creates a new nats publisher and subscriber
-func NewNatsPubSub(conf config.NatsConfig, log *slog.Logger) (*nats.Publisher, *nats.Subscriber, error) {
+// NewNatsPubSub creates the NATS publishers and subscribers, connected to
+// an embedded server or to the server at the configured URL.
+//
+// Publisher and Subscriber use JetStream, WebhookSubscriber is a second
+// durable consumer. The broadcast ones use core NATS.
+func NewNatsPubSub(conf config.NatsConfig, embedded bool, log *slog.Logger) (*PubSub, error) {
+	marshaler, err := NewMarshaler(MessageFormat(conf.MessageFormat))
+	if err != nil {
+		return nil, err
+	}
+
 	var server *ns.Server
-	var err error
 	url := nc.DefaultURL
 
 	clientOpts := []nc.Option{
@@ -25,11 +36,11 @@ func NewNatsPubSub(conf config.NatsConfig, log *slog.Logger) (*nats.Publisher, *
 		nc.ReconnectWait(1 * time.Second),
 	}
 
-	// If the URL is not provided, we will create a new embedded server.
-	if conf.URL == nil {
-		server, err = newEmbeddedNatsServer()
+	var closers []func() error
+	if embedded {
+		server, err = newEmbeddedNatsServer(conf.Embedded)
 		if err != nil {
-			return nil, nil, fmt.Errorf("create nats server: %w", err)
+			return nil, fmt.Errorf("create nats server: %w", err)
 		}
 
 		if conf.EnableLog {
@@ -37,16 +48,31 @@ func NewNatsPubSub(conf config.NatsConfig, log *slog.Logger) (*nats.Publisher, *
 		}
 
 		clientOpts = append(clientOpts, nc.InProcessServer(server))
+		if conf.Embedded.User != nil && conf.Embedded.Password != nil {
+			clientOpts = append(clientOpts, nc.UserInfo(*conf.Embedded.User, *conf.Embedded.Password))
+		}
 
-		log.Info("Starting NATS server in embedded mode")
+		log.Info("Starting NATS server in embedded mode", slog.String("server_name", conf.Embedded.ServerName))
 		go server.Start()
+		closers = append(closers, func() error {
+			server.Shutdown()
+			return nil
+		})
 		if !server.ReadyForConnections(5 * time.Second) {
-			return nil, nil, errors.New("nats server not ready for connections")
+			return nil, errors.New("nats server not ready for connections")
 		}
+		log.Info("NATS server ready", slog.String("store_dir", server.StoreDir()))
 	} else {
+		if conf.URL == nil {
+			return nil, errors.New("missing nats url")
+		}
 		url = *conf.URL
 	}
 
+	if err := provisionStreams(url, clientOpts, conf.Stream, embedded, log); err != nil {
+		return nil, fmt.Errorf("provision nats streams: %w", err)
+	}
+
 	wLog := watermill.NewSlogLogger(log)
 	subscribeOptions := []nc.SubOpt{
 		nc.DeliverAll(),
@@ -67,32 +93,118 @@ func NewNatsPubSub(conf config.NatsConfig, log *slog.Logger) (*nats.Publisher, *
 		CloseTimeout:   30 * time.Second,
 		AckWaitTimeout: 30 * time.Second,
 		NatsOptions:    clientOpts,
-		Unmarshaler:    nats.GobMarshaler{},
+		Unmarshaler:    Unmarshaler{},
 		JetStream:      jetstreamConf,
 	}, wLog)
 	if err != nil {
-		return nil, nil, fmt.Errorf("create nats subscriber: %w", err)
+		return nil, fmt.Errorf("create nats subscriber: %w", err)
+	}
+
+	// The durable name identifies the consumer, a different one gives the
+	// webhooks their own copy of every message.
+	webhookJetstreamConf := jetstreamConf
+	webhookJetstreamConf.DurablePrefix = "roboflow_webhook"
+	webhookSubscriber, err := nats.NewSubscriber(nats.SubscriberConfig{
+		URL:            url,
+		CloseTimeout:   30 * time.Second,
+		AckWaitTimeout: 30 * time.Second,
+		NatsOptions:    clientOpts,
+		Unmarshaler:    Unmarshaler{},
+		JetStream:      webhookJetstreamConf,
+	}, wLog)
+	if err != nil {
+		return nil, fmt.Errorf("create nats webhook subscriber: %w", err)
 	}
 
 	publisher, err := nats.NewPublisher(nats.PublisherConfig{
 		URL:         url,
 		NatsOptions: clientOpts,
-		Marshaler:   nats.GobMarshaler{},
+		Marshaler:   marshaler,
 		JetStream:   jetstreamConf,
 	}, wLog)
 	if err != nil {
-		return nil, nil, fmt.Errorf("create nats publisher: %w", err)
+		return nil, fmt.Errorf("create nats publisher: %w", err)
+	}
+
+	broadcastConf := nats.JetStreamConfig{Disabled: true}
+	broadcastSubscriber, err := nats.NewSubscriber(nats.SubscriberConfig{
+		URL:            url,
+		CloseTimeout:   30 * time.Second,
+		AckWaitTimeout: 30 * time.Second,
+		NatsOptions:    clientOpts,
+		Unmarshaler:    Unmarshaler{},
+		JetStream:      broadcastConf,
+	}, wLog)
+	if err != nil {
+		return nil, fmt.Errorf("create nats broadcast subscriber: %w", err)
+	}
+
+	broadcastPublisher, err := nats.NewPublisher(nats.PublisherConfig{
+		URL:         url,
+		NatsOptions: clientOpts,
+		Marshaler:   marshaler,
+		JetStream:   broadcastConf,
+	}, wLog)
+	if err != nil {
+		return nil, fmt.Errorf("create nats broadcast publisher: %w", err)
 	}
 
-	return publisher, subscriber, nil
+	closers = append([]func() error{
+		closeWith("publisher", publisher),
+		closeWith("subscriber", subscriber),
+		closeWith("webhook subscriber", webhookSubscriber),
+		closeWith("broadcast publisher", broadcastPublisher),
+		closeWith("broadcast subscriber", broadcastSubscriber),
+	}, closers...)
+
+	return &PubSub{
+		Publisher:           publisher,
+		Subscriber:          subscriber,
+		WebhookSubscriber:   webhookSubscriber,
+		BroadcastPublisher:  broadcastPublisher,
+		BroadcastSubscriber: broadcastSubscriber,
+		closers:             closers,
+	}, nil
 }
 
-func newEmbeddedNatsServer() (*ns.Server, error) {
+func newEmbeddedNatsServer(conf config.NatsEmbeddedConfig) (*ns.Server, error) {
 	opts := &ns.Options{
-		ServerName:      "roboflow-nats",
+		ServerName:      conf.ServerName,
 		DontListen:      true,
 		JetStream:       true,
 		JetStreamDomain: "roboflow-nats-embedded",
+		StoreDir:        conf.StoreDir,
+	}
+
+	if conf.ListenAddr != nil {
+		host, port, err := splitHostPort(*conf.ListenAddr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid listen address: %w", err)
+		}
+		opts.DontListen = false
+		opts.Host = host
+		opts.Port = port
+	}
+	if conf.User != nil && conf.Password != nil {
+		opts.Username = *conf.User
+		opts.Password = *conf.Password
+	}
+
+	if conf.Cluster.Name != "" {
+		host, port, err := splitHostPort(conf.Cluster.ListenAddr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cluster listen address: %w", err)
+		}
+		opts.Cluster = ns.ClusterOpts{
+			Name: conf.Cluster.Name,
+			Host: host,
+			Port: port,
+		}
+		if conf.Cluster.User != nil && conf.Cluster.Password != nil {
+			opts.Cluster.Username = *conf.Cluster.User
+			opts.Cluster.Password = *conf.Cluster.Password
+		}
+		opts.Routes = ns.RoutesFromStr(strings.Join(conf.Cluster.Routes, ","))
 	}
 
 	server, err := ns.NewServer(opts)
@@ -102,3 +214,112 @@ func newEmbeddedNatsServer() (*ns.Server, error) {
 
 	return server, nil
 }
+
+// splitHostPort splits an address of the form host:port.
+func splitHostPort(addr string) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid port %q", portStr)
+	}
+	return host, port, nil
+}
+
+// streamTopics are the topics published with JetStream.
+func streamTopics() []string {
+	topics := make([]string, 0, len(Catalogue)+1)
+	for _, event := range Catalogue {
+		topics = append(topics, event.EventType())
+	}
+	return append(topics, DeadLetterTopic)
+}
+
+// provisionStreamsTimeout bounds the wait for JetStream, the servers of a
+// cluster starting together first elect a leader.
+const provisionStreamsTimeout = 30 * time.Second
+
+// provisionStreams creates the stream of every topic with the limits of
+// conf. The limits of an existing stream are only updated when update is
+// set, the streams of an external server may be managed by its operators.
+// The publishers and subscribers only create the missing streams, without
+// limits.
+func provisionStreams(
+	url string,
+	clientOpts []nc.Option,
+	conf config.NatsStreamConfig,
+	update bool,
+	log *slog.Logger,
+) error {
+	conn, err := nc.Connect(url, clientOpts...)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close()
+
+	js, err := conn.JetStream()
+	if err != nil {
+		return fmt.Errorf("create jetstream context: %w", err)
+	}
+
+	deadline := time.Now().Add(provisionStreamsTimeout)
+	for _, topic := range streamTopics() {
+		for {
+			err := provisionStream(js, topic, conf, update)
+			if err == nil {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("provision stream %s: %w", topic, err)
+			}
+			log.Warn("error provisioning nats stream, retrying",
+				slog.String("stream", topic),
+				slog.Any("error", err),
+			)
+			time.Sleep(time.Second)
+		}
+	}
+
+	return nil
+}
+
+func provisionStream(js nc.JetStreamManager, topic string, conf config.NatsStreamConfig, update bool) error {
+	info, err := js.StreamInfo(topic)
+	if err != nil && !errors.Is(err, nc.ErrStreamNotFound) {
+		return err
+	}
+
+	if info == nil {
+		_, err = js.AddStream(&nc.StreamConfig{
+			Name:     topic,
+			Subjects: nats.DefaultSubjectCalculator("", topic).All(),
+			MaxAge:   conf.MaxAge,
+			MaxMsgs:  streamLimit(conf.MaxMsgs),
+			MaxBytes: streamLimit(conf.MaxBytes),
+			Replicas: conf.Replicas,
+		})
+		return err
+	}
+	if !update {
+		return nil
+	}
+
+	streamConf := info.Config
+	streamConf.MaxAge = conf.MaxAge
+	streamConf.MaxMsgs = streamLimit(conf.MaxMsgs)
+	streamConf.MaxBytes = streamLimit(conf.MaxBytes)
+	streamConf.Replicas = conf.Replicas
+	_, err = js.UpdateStream(&streamConf)
+	return err
+}
+
+// streamLimit returns the JetStream value of a limit, which is -1 when
+// there is none.
+func streamLimit(limit int64) int64 {
+	if limit <= 0 {
+		return -1
+	}
+	return limit
+}
@@ -0,0 +1,118 @@
+package pubsub_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	nc "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+func TestNewNatsPubSubEmbeddedStore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	conf := config.NatsConfig{
+		MessageFormat: "json",
+		Stream: config.NatsStreamConfig{
+			MaxMsgs:  2,
+			Replicas: 1,
+		},
+		Embedded: config.NatsEmbeddedConfig{
+			ServerName: "roboflow-nats-test",
+			StoreDir:   t.TempDir(),
+		},
+	}
+
+	ps, err := pubsub.NewNatsPubSub(conf, true, log)
+	require.NoError(t, err)
+
+	var published []string
+	for range 3 {
+		msg := message.NewMessage(watermill.NewUUID(), []byte("{}"))
+		require.NoError(t, ps.Publisher.Publish(pubsub.WorkflowDeletedTopic, msg))
+		published = append(published, msg.UUID)
+	}
+	require.NoError(t, ps.Close())
+
+	// The messages are kept in the store directory across restarts, the
+	// oldest one is removed by the stream limit.
+	ps, err = pubsub.NewNatsPubSub(conf, true, log)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, ps.Close())
+	}()
+
+	messages, err := ps.Subscriber.Subscribe(ctx, pubsub.WorkflowDeletedTopic)
+	require.NoError(t, err)
+
+	var received []string
+	for range 2 {
+		select {
+		case msg := <-messages:
+			msg.Ack()
+			received = append(received, msg.UUID)
+		case <-ctx.Done():
+			t.Fatal("message not received")
+		}
+	}
+	assert.Equal(t, published[1:], received)
+}
+
+func TestNewNatsPubSubKeepsExternalStreams(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	// The embedded server stands for an external server, its streams are
+	// created with its own limits.
+	server, err := pubsub.NewNatsPubSub(config.NatsConfig{
+		MessageFormat: "json",
+		Stream:        config.NatsStreamConfig{MaxMsgs: 2, Replicas: 1},
+		Embedded: config.NatsEmbeddedConfig{
+			ServerName: "roboflow-nats-test",
+			StoreDir:   t.TempDir(),
+			ListenAddr: &addr,
+		},
+	}, true, log)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, server.Close())
+	}()
+
+	url := fmt.Sprintf("nats://%s", addr)
+	ps, err := pubsub.NewNatsPubSub(config.NatsConfig{
+		URL:           &url,
+		MessageFormat: "json",
+		Stream:        config.NatsStreamConfig{MaxMsgs: 5, Replicas: 1},
+	}, false, log)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, ps.Close())
+	}()
+
+	conn, err := nc.Connect(url)
+	require.NoError(t, err)
+	defer conn.Close()
+	js, err := conn.JetStream()
+	require.NoError(t, err)
+
+	info, err := js.StreamInfo(pubsub.WorkflowDeletedTopic)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), info.Config.MaxMsgs)
+}
@@ -0,0 +1,247 @@
+package pubsub
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wsql "github.com/ThreeDotsLabs/watermill-sql/v3/pkg/sql"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+// NewPostgresPubSub creates publishers and subscribers storing the messages
+// in Postgres through the connections of pgPool, so no broker is needed. Each
+// topic is stored in a watermill_<topic> table, the offsets of the consumers
+// in a watermill_offsets_<topic> table. The tables are created on first use.
+//
+// The broadcast messages are stored too, every instance reads them from the
+// time it starts with its own consumer, and passes them to its subscribers.
+//
+// Every PostgresCleanupInterval, the messages acked by all the consumer groups
+// of their topic are deleted. The topics nobody subscribes to are not cleaned
+// up, and the consumer group of an instance that did not shut down cleanly
+// holds back the cleanup of the broadcast topic until its offsets row is
+// deleted. With the cleanup disabled, the tables grow without bound, the
+// broadcast topic by every step update.
+func NewPostgresPubSub(conf config.PubSubConfig, pgPool *pgxpool.Pool, log *slog.Logger) (*PubSub, error) {
+	if pgPool == nil {
+		return nil, fmt.Errorf("the postgres driver requires a postgres pool")
+	}
+
+	wLog := watermill.NewSlogLogger(log)
+	db := stdlib.OpenDBFromPool(pgPool)
+
+	// Payloads are not always JSON, a dead-lettered one may not be valid.
+	schema := wsql.DefaultPostgreSQLSchema{
+		GeneratePayloadType: func(string) string { return "BYTEA" },
+	}
+	offsets := wsql.DefaultPostgreSQLOffsetsAdapter{}
+
+	publisher, err := wsql.NewPublisher(db, wsql.PublisherConfig{
+		SchemaAdapter:        schema,
+		AutoInitializeSchema: true,
+	}, wLog)
+	if err != nil {
+		return nil, fmt.Errorf("create postgres publisher: %w", err)
+	}
+
+	newSubscriber := func(consumerGroup string, offsetsAdapter wsql.OffsetsAdapter) (*wsql.Subscriber, error) {
+		return wsql.NewSubscriber(db, wsql.SubscriberConfig{
+			ConsumerGroup:    consumerGroup,
+			PollInterval:     conf.PostgresPollInterval,
+			SchemaAdapter:    schema,
+			OffsetsAdapter:   offsetsAdapter,
+			InitializeSchema: true,
+		}, wLog)
+	}
+
+	// A consumer group receives every message once, like the durable
+	// consumers of the nats driver.
+	subscriber, err := newSubscriber("roboflow", offsets)
+	if err != nil {
+		return nil, fmt.Errorf("create postgres subscriber: %w", err)
+	}
+	webhookSubscriber, err := newSubscriber("roboflow_webhook", offsets)
+	if err != nil {
+		return nil, fmt.Errorf("create postgres webhook subscriber: %w", err)
+	}
+
+	// The instance reads the broadcast messages once with its own consumer
+	// group, the fan out passes them to every subscriber of the instance.
+	broadcastConsumerGroup := "roboflow_broadcast_" + watermill.NewShortUUID()
+	broadcastOffsets := latestOffsetsAdapter{DefaultPostgreSQLOffsetsAdapter: offsets, schema: schema}
+	broadcastSQLSubscriber, err := newSubscriber(broadcastConsumerGroup, broadcastOffsets)
+	if err != nil {
+		return nil, fmt.Errorf("create postgres broadcast subscriber: %w", err)
+	}
+	broadcastSubscriber, err := gochannel.NewFanOut(broadcastSQLSubscriber, wLog)
+	if err != nil {
+		return nil, fmt.Errorf("create broadcast fan out: %w", err)
+	}
+	broadcastSubscriber.AddSubscription(WorkflowExecutionEventTopic)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		if err := broadcastSubscriber.Run(ctx); err != nil {
+			log.Error("error running broadcast fan out", slog.Any("error", err))
+		}
+	}()
+	<-broadcastSubscriber.Running()
+
+	cleanupDone := make(chan struct{})
+	go func() {
+		defer close(cleanupDone)
+		if conf.PostgresCleanupInterval <= 0 {
+			return
+		}
+
+		ticker := time.NewTicker(conf.PostgresCleanupInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				deleted, err := deleteAckedMessages(ctx, db, schema, offsets)
+				if err != nil {
+					log.Error("error deleting acked messages", slog.Any("error", err))
+					continue
+				}
+				if deleted > 0 {
+					log.Debug("deleted acked messages", slog.Int64("count", deleted))
+				}
+			}
+		}
+	}()
+
+	closers := []func() error{
+		closeWith("publisher", publisher),
+		closeWith("subscriber", subscriber),
+		closeWith("webhook subscriber", webhookSubscriber),
+		func() error {
+			cancel()
+			<-cleanupDone
+			return nil
+		},
+		closeWith("broadcast subscriber", broadcastSubscriber),
+		closeWith("broadcast sql subscriber", broadcastSQLSubscriber),
+		// The consumer group of the instance is not used again
+		func() error {
+			q := broadcastOffsets.DeleteConsumerGroupQuery(WorkflowExecutionEventTopic, broadcastConsumerGroup)
+			if _, err := db.Exec(q.Query, q.Args...); err != nil {
+				return fmt.Errorf("delete broadcast consumer group: %w", err)
+			}
+			return nil
+		},
+		closeWith("database", db),
+	}
+
+	return &PubSub{
+		Publisher:           publisher,
+		Subscriber:          subscriber,
+		WebhookSubscriber:   webhookSubscriber,
+		BroadcastPublisher:  publisher,
+		BroadcastSubscriber: broadcastSubscriber,
+		closers:             closers,
+	}, nil
+}
+
+// deleteAckedMessages deletes, in every topic, the messages acked by all the
+// consumer groups of the topic and returns the number of deleted messages.
+// A consumer group has acked the messages up to its last processed
+// transaction and offset, all the transactions before are committed.
+func deleteAckedMessages(
+	ctx context.Context,
+	db *sql.DB,
+	schema wsql.DefaultPostgreSQLSchema,
+	offsets wsql.DefaultPostgreSQLOffsetsAdapter,
+) (int64, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT substr(table_name, length('watermill_offsets_') + 1)
+		FROM information_schema.tables
+		WHERE table_schema = current_schema() AND table_name LIKE 'watermill\_offsets\_%'
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("list topics: %w", err)
+	}
+	defer rows.Close()
+
+	var topics []string
+	for rows.Next() {
+		var topic string
+		if err := rows.Scan(&topic); err != nil {
+			return 0, fmt.Errorf("scan topic: %w", err)
+		}
+		topics = append(topics, topic)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("list topics: %w", err)
+	}
+
+	var deleted int64
+	for _, topic := range topics {
+		res, err := db.ExecContext(ctx, `
+			DELETE FROM `+schema.MessagesTable(topic)+` AS m
+			USING (
+				SELECT last_processed_transaction_id, offset_acked
+				FROM `+offsets.MessagesOffsetsTable(topic)+`
+				ORDER BY last_processed_transaction_id, offset_acked
+				LIMIT 1
+			) AS acked
+			WHERE (m.transaction_id, m."offset") <= (acked.last_processed_transaction_id, acked.offset_acked)
+		`)
+		if err != nil {
+			return deleted, fmt.Errorf("delete acked messages of topic %s: %w", topic, err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return deleted, fmt.Errorf("rows affected: %w", err)
+		}
+		deleted += n
+	}
+
+	return deleted, nil
+}
+
+// latestOffsetsAdapter starts a new consumer group after the last message of
+// the topic, instead of the first one.
+type latestOffsetsAdapter struct {
+	wsql.DefaultPostgreSQLOffsetsAdapter
+	schema wsql.DefaultPostgreSQLSchema
+}
+
+func (a latestOffsetsAdapter) BeforeSubscribingQueries(topic string, consumerGroup string) []wsql.Query {
+	return []wsql.Query{
+		{
+			Query: `
+				INSERT INTO ` + a.MessagesOffsetsTable(topic) + ` (consumer_group, offset_acked, last_processed_transaction_id)
+				SELECT $1, COALESCE(last."offset", 0), COALESCE(last.transaction_id, '0')
+				FROM (SELECT 1) AS one
+				LEFT JOIN (
+					SELECT "offset", transaction_id FROM ` + a.schema.MessagesTable(topic) + `
+					ORDER BY transaction_id DESC, "offset" DESC
+					LIMIT 1
+				) AS last ON TRUE
+				ON CONFLICT DO NOTHING
+			`,
+			Args: []any{consumerGroup},
+		},
+	}
+}
+
+// DeleteConsumerGroupQuery returns the query deleting the offset of a
+// consumer group.
+func (a latestOffsetsAdapter) DeleteConsumerGroupQuery(topic string, consumerGroup string) wsql.Query {
+	return wsql.Query{
+		Query: `DELETE FROM ` + a.MessagesOffsetsTable(topic) + ` WHERE consumer_group = $1`,
+		Args:  []any{consumerGroup},
+	}
+}
@@ -0,0 +1,135 @@
+package pubsub
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+// Driver is the backend of the publishers and subscribers.
+type Driver string
+
+const (
+	// DriverNatsEmbedded runs a NATS server with JetStream in the process.
+	DriverNatsEmbedded Driver = "nats-embedded"
+	// DriverNats connects to the NATS server at NATS_URL.
+	DriverNats Driver = "nats"
+	// DriverPostgres stores the messages in Postgres tables, see
+	// NewPostgresPubSub.
+	DriverPostgres Driver = "postgres"
+	// DriverGoChannel passes the messages in memory, see NewGoChannelPubSub.
+	DriverGoChannel Driver = "gochannel"
+)
+
+// InProcess reports whether the driver only connects the publishers and
+// subscribers of a single process, so the API and the worker must run in
+// the same process.
+func (d Driver) InProcess() bool {
+	return d == DriverNatsEmbedded || d == DriverGoChannel
+}
+
+// DriverFromConfig returns the driver of conf, picking nats when NATS_URL is
+// set and nats-embedded otherwise if none is set.
+func DriverFromConfig(conf config.PubSubConfig, natsConf config.NatsConfig) (Driver, error) {
+	driver := Driver(conf.Driver)
+	switch driver {
+	case "":
+		if natsConf.URL != nil {
+			return DriverNats, nil
+		}
+		return DriverNatsEmbedded, nil
+	case DriverNats:
+		if natsConf.URL == nil {
+			return "", errors.New("the nats driver requires NATS_URL")
+		}
+		return driver, nil
+	case DriverNatsEmbedded, DriverPostgres, DriverGoChannel:
+		return driver, nil
+	default:
+		return "", fmt.Errorf("unsupported pubsub driver: %s", conf.Driver)
+	}
+}
+
+// PubSub holds the publishers and subscribers of a driver.
+//
+// Publisher and Subscriber persist the messages and each one is handled by a
+// single subscriber of the topic. WebhookSubscriber is a second consumer of
+// the same topics, so the webhooks receive every message independently of the
+// other handlers. BroadcastPublisher and BroadcastSubscriber do not persist
+// the messages and every subscriber receives all of them, so live updates
+// reach every instance.
+type PubSub struct {
+	Driver Driver
+
+	Publisher           message.Publisher
+	Subscriber          message.Subscriber
+	WebhookSubscriber   message.Subscriber
+	BroadcastPublisher  message.Publisher
+	BroadcastSubscriber message.Subscriber
+
+	// closers are called in order by Close.
+	closers []func() error
+}
+
+// Close closes all publishers and subscribers.
+func (p PubSub) Close() error {
+	var errs []error
+	for _, c := range p.closers {
+		if err := c(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// New creates the publishers and subscribers of the driver of conf. pgPool
+// is only used by the postgres driver.
+func New(
+	conf config.PubSubConfig,
+	natsConf config.NatsConfig,
+	pgPool *pgxpool.Pool,
+	log *slog.Logger,
+) (*PubSub, error) {
+	driver, err := DriverFromConfig(conf, natsConf)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Creating pubsub", slog.String("driver", string(driver)))
+
+	var ps *PubSub
+	switch driver {
+	case DriverNatsEmbedded:
+		ps, err = NewNatsPubSub(natsConf, true, log)
+	case DriverNats:
+		ps, err = NewNatsPubSub(natsConf, false, log)
+	case DriverPostgres:
+		ps, err = NewPostgresPubSub(conf, pgPool, log)
+	case DriverGoChannel:
+		ps = NewGoChannelPubSub(log)
+	default:
+		return nil, fmt.Errorf("unsupported pubsub driver: %s", driver)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ps.Driver = driver
+
+	return ps, nil
+}
+
+// closeWith returns a function closing c, its error names c.
+func closeWith(name string, c io.Closer) func() error {
+	return func() error {
+		if err := c.Close(); err != nil {
+			return fmt.Errorf("close %s: %w", name, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,109 @@
+package pubsub_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+func TestDriverFromConfig(t *testing.T) {
+	natsURL := "nats://localhost:4222"
+
+	tests := []struct {
+		name     string
+		conf     config.PubSubConfig
+		natsConf config.NatsConfig
+		want     pubsub.Driver
+		wantErr  bool
+	}{
+		{
+			name: "default without nats url",
+			want: pubsub.DriverNatsEmbedded,
+		},
+		{
+			name:     "default with nats url",
+			natsConf: config.NatsConfig{URL: &natsURL},
+			want:     pubsub.DriverNats,
+		},
+		{
+			name:     "nats",
+			conf:     config.PubSubConfig{Driver: "nats"},
+			natsConf: config.NatsConfig{URL: &natsURL},
+			want:     pubsub.DriverNats,
+		},
+		{
+			name:    "nats without url",
+			conf:    config.PubSubConfig{Driver: "nats"},
+			wantErr: true,
+		},
+		{
+			name:     "postgres",
+			conf:     config.PubSubConfig{Driver: "postgres"},
+			natsConf: config.NatsConfig{URL: &natsURL},
+			want:     pubsub.DriverPostgres,
+		},
+		{
+			name: "gochannel",
+			conf: config.PubSubConfig{Driver: "gochannel"},
+			want: pubsub.DriverGoChannel,
+		},
+		{
+			name:    "unsupported",
+			conf:    config.PubSubConfig{Driver: "kafka"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver, err := pubsub.DriverFromConfig(tt.conf, tt.natsConf)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, driver)
+		})
+	}
+}
+
+func TestNewGoChannel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ps, err := pubsub.New(config.PubSubConfig{Driver: "gochannel"}, config.NatsConfig{}, nil, log)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, ps.Close())
+	}()
+
+	messages, err := ps.Subscriber.Subscribe(ctx, pubsub.WorkflowDeletedTopic)
+	require.NoError(t, err)
+	webhookMessages, err := ps.WebhookSubscriber.Subscribe(ctx, pubsub.WorkflowDeletedTopic)
+	require.NoError(t, err)
+
+	msg := message.NewMessage(watermill.NewUUID(), []byte("{}"))
+	require.NoError(t, ps.Publisher.Publish(pubsub.WorkflowDeletedTopic, msg))
+
+	// The handlers and the webhooks both receive the message
+	for _, ch := range []<-chan *message.Message{messages, webhookMessages} {
+		select {
+		case received := <-ch:
+			received.Ack()
+			assert.Equal(t, msg.UUID, received.UUID)
+		case <-ctx.Done():
+			t.Fatal("message not received")
+		}
+	}
+}
@@ -10,8 +10,32 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
 )
 
+type routerOptions struct {
+	deadLetterPublisher message.Publisher
+}
+
+// RouterOption configures the router created by NewRouter.
+type RouterOption func(*routerOptions)
+
+// WithPoisonQueue moves the messages whose handler still fails after the
+// retries to DeadLetterTopic, published with publisher. Without it they are
+// dropped.
+//
+// The handlers of DeadLetterTopic must not use it, a message they fail to
+// handle would be dead-lettered again and again.
+func WithPoisonQueue(publisher message.Publisher) RouterOption {
+	return func(o *routerOptions) {
+		o.deadLetterPublisher = publisher
+	}
+}
+
 // NewRouter creates a new pubsub router
-func NewRouter(log *slog.Logger) (*message.Router, error) {
+func NewRouter(log *slog.Logger, opts ...RouterOption) (*message.Router, error) {
+	var options routerOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	wLog := watermill.NewSlogLogger(log)
 
 	router, err := message.NewRouter(message.RouterConfig{
@@ -21,15 +45,24 @@ func NewRouter(log *slog.Logger) (*message.Router, error) {
 		return nil, fmt.Errorf("create router: %w", err)
 	}
 
-	// Add middlewares
+	// Add middlewares, the first one wraps the others. The poison queue sees
+	// the error once all retries failed, a panic is turned into an error
+	// first so it is retried and dead-lettered too.
+	router.AddMiddleware(middleware.CorrelationID)
+	if options.deadLetterPublisher != nil {
+		poisonQueue, err := middleware.PoisonQueue(deadLetterPublisher{options.deadLetterPublisher}, DeadLetterTopic)
+		if err != nil {
+			return nil, fmt.Errorf("create poison queue: %w", err)
+		}
+		router.AddMiddleware(poisonQueue)
+	}
 	router.AddMiddleware(
-		middleware.Recoverer,
-		middleware.CorrelationID,
 		middleware.Retry{
 			MaxRetries:      3,
 			InitialInterval: time.Second,
 			Logger:          wLog,
 		}.Middleware,
+		middleware.Recoverer,
 	)
 
 	return router, nil
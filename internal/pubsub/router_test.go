@@ -0,0 +1,128 @@
+package pubsub_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+)
+
+func TestRouterPoisonQueue(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	goChannel := gochannel.NewGoChannel(gochannel.Config{}, watermill.NopLogger{})
+	defer goChannel.Close()
+
+	deadLetters, err := goChannel.Subscribe(ctx, pubsub.DeadLetterTopic)
+	require.NoError(t, err)
+
+	router, err := pubsub.NewRouter(log, pubsub.WithPoisonQueue(goChannel))
+	require.NoError(t, err)
+
+	attempts := 0
+	router.AddNoPublisherHandler("failing_handler", pubsub.WorkflowDeletedTopic, goChannel, func(*message.Message) error {
+		attempts++
+		return errors.New("handler failed")
+	})
+
+	go func() {
+		_ = router.Run(ctx)
+	}()
+	defer router.Close()
+	<-router.Running()
+
+	msg, err := pubsub.NewMessage(ctx, pubsub.WorkflowDeleted{WorkflowID: "workflow-id"})
+	require.NoError(t, err)
+	require.NoError(t, goChannel.Publish(pubsub.WorkflowDeletedTopic, msg))
+
+	select {
+	case deadLetterMsg := <-deadLetters:
+		deadLetterMsg.Ack()
+		assert.Equal(t, 4, attempts)
+		assert.NotEqual(t, msg.UUID, deadLetterMsg.UUID)
+		assert.Equal(t, msg.Payload, deadLetterMsg.Payload)
+
+		deadLetter, err := pubsub.UnmarshalDeadLetter(deadLetterMsg)
+		require.NoError(t, err)
+		assert.Equal(t, pubsub.DeadLetter{
+			MessageID:  msg.UUID,
+			Topic:      pubsub.WorkflowDeletedTopic,
+			Handler:    "failing_handler",
+			Subscriber: "gochannel.GoChannel",
+			Reason:     "handler failed",
+			Metadata:   msg.Metadata,
+		}, deadLetter)
+	case <-ctx.Done():
+		t.Fatal("message not dead-lettered")
+	}
+}
+
+func TestUnmarshalDeadLetter(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata message.Metadata
+		want     pubsub.DeadLetter
+		wantErr  bool
+	}{
+		{
+			name: "dead letter",
+			metadata: message.Metadata{
+				"correlation_id":              "correlation-id",
+				"reason_poisoned":             "handler failed",
+				"topic_poisoned":              pubsub.WorkflowDeletedTopic,
+				"handler_poisoned":            "handler",
+				"subscriber_poisoned":         "subscriber",
+				pubsub.DeadLetterMessageIDKey: "message-id",
+			},
+			want: pubsub.DeadLetter{
+				MessageID:  "message-id",
+				Topic:      pubsub.WorkflowDeletedTopic,
+				Handler:    "handler",
+				Subscriber: "subscriber",
+				Reason:     "handler failed",
+				Metadata:   map[string]string{"correlation_id": "correlation-id"},
+			},
+		},
+		{
+			name: "missing message id",
+			metadata: message.Metadata{
+				"topic_poisoned": pubsub.WorkflowDeletedTopic,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing topic",
+			metadata: message.Metadata{
+				pubsub.DeadLetterMessageIDKey: "message-id",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := message.NewMessage(watermill.NewUUID(), []byte("{}"))
+			msg.Metadata = tt.metadata
+
+			deadLetter, err := pubsub.UnmarshalDeadLetter(msg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, deadLetter)
+		})
+	}
+}
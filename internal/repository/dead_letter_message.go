@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	deadletter "github.com/tuanvumaihuynh/roboflow/internal/model/dead_letter"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+// DeadLetterMessageFilter selects dead-letter Messages, a nil field matches
+// every Message.
+type DeadLetterMessageFilter struct {
+	Topic         *string
+	Handler       *string
+	Replayed      *bool
+	CreatedBefore *time.Time
+}
+
+type DeadLetterMessageRepository interface {
+	// GetDeadLetterMessage gets a Message by its ID.
+	GetDeadLetterMessage(ctx context.Context, db sqldb.SQLDB, id string) (deadletter.Message, error)
+
+	// ListDeadLetterMessages lists the Messages matching filter.
+	ListDeadLetterMessages(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		pagingParams paging.Params,
+		sorts []sort.Sort,
+		filter DeadLetterMessageFilter,
+	) (paging.List[deadletter.Message], error)
+
+	// CreateDeadLetterMessage creates a new Message. It does nothing if a
+	// Message with the same ID exists.
+	CreateDeadLetterMessage(ctx context.Context, db sqldb.SQLDB, msg deadletter.Message) error
+
+	// MarkDeadLetterMessageReplayed increments the replay count of a Message.
+	MarkDeadLetterMessageReplayed(ctx context.Context, db sqldb.SQLDB, id string, replayedAt time.Time) (deadletter.Message, error)
+
+	// DeleteDeadLetterMessage deletes a Message.
+	DeleteDeadLetterMessage(ctx context.Context, db sqldb.SQLDB, id string) error
+
+	// PurgeDeadLetterMessages deletes the Messages matching filter and
+	// returns the number of deleted Messages.
+	PurgeDeadLetterMessages(ctx context.Context, db sqldb.SQLDB, filter DeadLetterMessageFilter) (int64, error)
+}
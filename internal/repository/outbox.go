@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/outbox"
+)
+
+type OutboxRepository interface {
+	// CreateOutboxMessage creates a new outbox Message.
+	CreateOutboxMessage(ctx context.Context, db sqldb.SQLDB, msg outbox.Message) error
+
+	// ListPendingOutboxMessagesForUpdate lists, oldest first, at most limit
+	// Messages not yet published and locks them until the transaction ends.
+	// Messages locked by another transaction are skipped.
+	ListPendingOutboxMessagesForUpdate(ctx context.Context, db sqldb.SQLDB, limit int32) ([]outbox.Message, error)
+
+	// MarkOutboxMessagesPublished marks Messages as published.
+	MarkOutboxMessagesPublished(ctx context.Context, db sqldb.SQLDB, ids []int64, publishedAt time.Time) error
+
+	// DeletePublishedOutboxMessages deletes the Messages published before a
+	// time and returns the number of deleted Messages.
+	DeletePublishedOutboxMessages(ctx context.Context, db sqldb.SQLDB, publishedBefore time.Time) (int64, error)
+
+	// GetOutboxStats gets the Stats of the Messages not yet published.
+	GetOutboxStats(ctx context.Context, db sqldb.SQLDB) (outbox.Stats, error)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
@@ -23,12 +24,33 @@ type QRLocationRepository interface {
 	// GetQRLocation gets a QRLocation by its ID.
 	GetQRLocation(ctx context.Context, db sqldb.SQLDB, id string) (qrlocation.QRLocation, error)
 
-	// ListQRLocations lists all QRLocations.
-	ListQRLocations(ctx context.Context, db sqldb.SQLDB, pagingParams paging.Params, sorts []sort.Sort) (paging.List[qrlocation.QRLocation], error)
+	// GetQRLocationByQRCode gets a QRLocation by its QR code.
+	GetQRLocationByQRCode(ctx context.Context, db sqldb.SQLDB, qrCode string) (qrlocation.QRLocation, error)
+
+	// ListQRLocations lists all QRLocations matching the filters and whose
+	// name matches search.
+	ListQRLocations(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		pagingParams paging.Params,
+		sorts []sort.Sort,
+		filters []filter.Filter,
+		search string,
+	) (paging.List[qrlocation.QRLocation], error)
+
+	// ListAllQRLocations lists every QRLocation, ordered by creation.
+	ListAllQRLocations(ctx context.Context, db sqldb.SQLDB) ([]qrlocation.QRLocation, error)
+
+	// ListQRLocationsByIDs lists the QRLocations with the given IDs, in no
+	// particular order. The IDs which do not exist are skipped.
+	ListQRLocationsByIDs(ctx context.Context, db sqldb.SQLDB, ids []string) ([]qrlocation.QRLocation, error)
 
 	// CreateQRLocation creates a new QRLocation.
 	CreateQRLocation(ctx context.Context, db sqldb.SQLDB, qrLocation qrlocation.QRLocation) error
 
+	// BatchCreateQRLocations creates multiple QRLocations.
+	BatchCreateQRLocations(ctx context.Context, db sqldb.SQLDB, qrLocations []qrlocation.QRLocation) error
+
 	// UpdateQRLocation updates a QRLocation.
 	UpdateQRLocation(ctx context.Context, db sqldb.SQLDB, params UpdateQRLocationParams) (qrlocation.QRLocation, error)
 
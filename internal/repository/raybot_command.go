@@ -6,6 +6,7 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
@@ -31,8 +32,16 @@ type RaybotCommandRepository interface {
 	// GetRaybotCommand gets a RaybotCommand by its ID.
 	GetRaybotCommand(ctx context.Context, db sqldb.SQLDB, id string) (raybotcommand.RaybotCommand, error)
 
-	// ListRaybotCommandsByRaybotID lists all RaybotCommands by Raybot ID.
-	ListRaybotCommandsByRaybotID(ctx context.Context, db sqldb.SQLDB, raybotID string, pagingParams paging.Params, sorts []sort.Sort) (paging.List[raybotcommand.RaybotCommand], error)
+	// ListRaybotCommandsByRaybotID lists all RaybotCommands by Raybot ID
+	// matching the filters.
+	ListRaybotCommandsByRaybotID(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		raybotID string,
+		pagingParams paging.Params,
+		sorts []sort.Sort,
+		filters []filter.Filter,
+	) (paging.List[raybotcommand.RaybotCommand], error)
 
 	// CreateRaybotCommand creates a new RaybotCommand.
 	CreateRaybotCommand(ctx context.Context, db sqldb.SQLDB, raybotCommand raybotcommand.RaybotCommand) error
@@ -43,6 +52,22 @@ type RaybotCommandRepository interface {
 	// DeleteRaybotCommandsByRaybotID deletes a RaybotCommand.
 	DeleteRaybotCommandsByRaybotID(ctx context.Context, db sqldb.SQLDB, raybotID string) error
 
+	// ListExpiredRaybotCommands lists at most limit RaybotCommands of a
+	// Status completed before a time, or last updated before it when they
+	// have no completion time, oldest first. They stay locked until the end of
+	// the transaction, the ones locked by another transaction are skipped.
+	ListExpiredRaybotCommands(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		status raybotcommand.Status,
+		before time.Time,
+		limit int32,
+	) ([]raybotcommand.RaybotCommand, error)
+
+	// DeleteRaybotCommandsByIDs deletes the RaybotCommands by their IDs and
+	// returns the number of deleted RaybotCommands.
+	DeleteRaybotCommandsByIDs(ctx context.Context, db sqldb.SQLDB, ids []string) (int64, error)
+
 	// MarkRaybotCommandFailed marks multiple RaybotCommands as failed.
 	// Filter by Raybot ID.
 	MarkRaybotCommandFailed(ctx context.Context, db sqldb.SQLDB, params MarkRaybotCommandFailedParams) error
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	raybotposition "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_position"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type RaybotPositionRepository interface {
+	// GetRaybotPosition gets the current Position of a Raybot.
+	GetRaybotPosition(ctx context.Context, db sqldb.SQLDB, raybotID string) (raybotposition.Position, error)
+
+	// ListRaybotPositionHistory lists the Positions a Raybot was seen at.
+	ListRaybotPositionHistory(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		raybotID string,
+		pagingParams paging.Params,
+		sorts []sort.Sort,
+	) (paging.List[raybotposition.Position], error)
+
+	// ListRaybotPositionsByLocationID lists the current Positions of the
+	// Raybots standing at a QRLocation.
+	ListRaybotPositionsByLocationID(ctx context.Context, db sqldb.SQLDB, locationID string) ([]raybotposition.Position, error)
+
+	// ListAllRaybotPositions lists the current Position of every Raybot.
+	ListAllRaybotPositions(ctx context.Context, db sqldb.SQLDB) ([]raybotposition.Position, error)
+
+	// SetRaybotPosition sets the current Position of a Raybot and appends it
+	// to its history.
+	SetRaybotPosition(ctx context.Context, db sqldb.SQLDB, position raybotposition.Position) error
+}
@@ -0,0 +1,261 @@
+package repoimpl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	deadletter "github.com/tuanvumaihuynh/roboflow/internal/model/dead_letter"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var (
+	_ repository.DeadLetterMessageRepository = (*deadLetterMessageRepository)(nil)
+
+	ErrDeadLetterMessageNotFound = xerror.NotFound(nil, "deadLetterMessage.notFound", "dead letter message not found")
+)
+
+type deadLetterMessageRepository struct {
+	queries sqlcpg.Queries
+}
+
+func newDeadLetterMessageRepository(queries sqlcpg.Queries) *deadLetterMessageRepository {
+	return &deadLetterMessageRepository{queries: queries}
+}
+
+func (r deadLetterMessageRepository) GetDeadLetterMessage(ctx context.Context, db sqldb.SQLDB, id string) (deadletter.Message, error) {
+	row, err := r.queries.DeadLetterMessageGetByID(ctx, db, id)
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return deadletter.Message{}, ErrDeadLetterMessageNotFound
+		}
+		return deadletter.Message{}, fmt.Errorf("queries get dead letter message by id: %w", err)
+	}
+
+	msg, err := deadLetterMessageRowToModel(row)
+	if err != nil {
+		return deadletter.Message{}, fmt.Errorf("dead letter message row to model: %w", err)
+	}
+
+	return msg, nil
+}
+
+func (r deadLetterMessageRepository) ListDeadLetterMessages(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+	filter repository.DeadLetterMessageFilter,
+) (paging.List[deadletter.Message], error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	where := deadLetterMessageFilterToWhere(filter)
+	query := psql.Select(
+		"id",
+		"message_id",
+		"topic",
+		"handler",
+		"subscriber",
+		"reason",
+		"payload",
+		"metadata",
+		"replay_count",
+		"last_replayed_at",
+		"created_at",
+	).
+		From("dead_letter_messages").
+		Where(where).
+		Limit(uint64(pagingParams.Limit())).
+		Offset(uint64(pagingParams.Offset()))
+	countQuery := psql.Select("COUNT(*)").
+		From("dead_letter_messages").
+		Where(where)
+
+	for _, s := range sorts {
+		query = s.Attach(query)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return paging.List[deadletter.Message]{}, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return paging.List[deadletter.Message]{}, fmt.Errorf("queries list dead letter messages: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]deadletter.Message, 0, pagingParams.Limit())
+	for rows.Next() {
+		var row sqlcpg.DeadLetterMessage
+		if err := rows.Scan(
+			&row.ID,
+			&row.MessageID,
+			&row.Topic,
+			&row.Handler,
+			&row.Subscriber,
+			&row.Reason,
+			&row.Payload,
+			&row.Metadata,
+			&row.ReplayCount,
+			&row.LastReplayedAt,
+			&row.CreatedAt,
+		); err != nil {
+			return paging.List[deadletter.Message]{}, fmt.Errorf("scan dead letter message: %w", err)
+		}
+
+		msg, err := deadLetterMessageRowToModel(row)
+		if err != nil {
+			return paging.List[deadletter.Message]{}, fmt.Errorf("dead letter message row to model: %w", err)
+		}
+		items = append(items, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return paging.List[deadletter.Message]{}, fmt.Errorf("rows error: %w", err)
+	}
+
+	countSQL, countArgs, err := countQuery.ToSql()
+	if err != nil {
+		return paging.List[deadletter.Message]{}, fmt.Errorf("build count query: %w", err)
+	}
+
+	var count int64
+	if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
+		return paging.List[deadletter.Message]{}, fmt.Errorf("queries count dead letter messages: %w", err)
+	}
+
+	return paging.NewList(items, count), nil
+}
+
+func (r deadLetterMessageRepository) CreateDeadLetterMessage(ctx context.Context, db sqldb.SQLDB, msg deadletter.Message) error {
+	metadata, err := json.Marshal(msg.Metadata)
+	if err != nil {
+		return fmt.Errorf("marshal metadata: %w", err)
+	}
+
+	if err := r.queries.DeadLetterMessageInsert(ctx, db, sqlcpg.DeadLetterMessageInsertParams{
+		ID:             msg.ID,
+		MessageID:      msg.MessageID,
+		Topic:          msg.Topic,
+		Handler:        msg.Handler,
+		Subscriber:     msg.Subscriber,
+		Reason:         msg.Reason,
+		Payload:        msg.Payload,
+		Metadata:       metadata,
+		ReplayCount:    msg.ReplayCount,
+		LastReplayedAt: msg.LastReplayedAt,
+		CreatedAt:      msg.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("queries insert dead letter message: %w", err)
+	}
+
+	return nil
+}
+
+func (r deadLetterMessageRepository) MarkDeadLetterMessageReplayed(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	id string,
+	replayedAt time.Time,
+) (deadletter.Message, error) {
+	row, err := r.queries.DeadLetterMessageMarkReplayed(ctx, db, sqlcpg.DeadLetterMessageMarkReplayedParams{
+		ReplayedAt: &replayedAt,
+		ID:         id,
+	})
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return deadletter.Message{}, ErrDeadLetterMessageNotFound
+		}
+		return deadletter.Message{}, fmt.Errorf("queries mark dead letter message replayed: %w", err)
+	}
+
+	msg, err := deadLetterMessageRowToModel(row)
+	if err != nil {
+		return deadletter.Message{}, fmt.Errorf("dead letter message row to model: %w", err)
+	}
+
+	return msg, nil
+}
+
+func (r deadLetterMessageRepository) DeleteDeadLetterMessage(ctx context.Context, db sqldb.SQLDB, id string) error {
+	n, err := r.queries.DeadLetterMessageDelete(ctx, db, id)
+	if err != nil {
+		return fmt.Errorf("queries delete dead letter message: %w", err)
+	}
+	if n == 0 {
+		return ErrDeadLetterMessageNotFound
+	}
+
+	return nil
+}
+
+func (r deadLetterMessageRepository) PurgeDeadLetterMessages(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	filter repository.DeadLetterMessageFilter,
+) (int64, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	sql, args, err := psql.Delete("dead_letter_messages").
+		Where(deadLetterMessageFilterToWhere(filter)).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
+	}
+
+	tag, err := db.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("queries purge dead letter messages: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func deadLetterMessageFilterToWhere(filter repository.DeadLetterMessageFilter) sq.And {
+	where := sq.And{}
+	if filter.Topic != nil {
+		where = append(where, sq.Eq{"topic": *filter.Topic})
+	}
+	if filter.Handler != nil {
+		where = append(where, sq.Eq{"handler": *filter.Handler})
+	}
+	if filter.Replayed != nil {
+		if *filter.Replayed {
+			where = append(where, sq.Gt{"replay_count": 0})
+		} else {
+			where = append(where, sq.Eq{"replay_count": 0})
+		}
+	}
+	if filter.CreatedBefore != nil {
+		where = append(where, sq.Lt{"created_at": *filter.CreatedBefore})
+	}
+	return where
+}
+
+func deadLetterMessageRowToModel(row sqlcpg.DeadLetterMessage) (deadletter.Message, error) {
+	var metadata map[string]string
+	if err := json.Unmarshal(row.Metadata, &metadata); err != nil {
+		return deadletter.Message{}, fmt.Errorf("unmarshal metadata: %w", err)
+	}
+
+	return deadletter.Message{
+		ID:             row.ID,
+		MessageID:      row.MessageID,
+		Topic:          row.Topic,
+		Handler:        row.Handler,
+		Subscriber:     row.Subscriber,
+		Reason:         row.Reason,
+		Payload:        row.Payload,
+		Metadata:       metadata,
+		ReplayCount:    row.ReplayCount,
+		LastReplayedAt: row.LastReplayedAt,
+		CreatedAt:      row.CreatedAt,
+	}, nil
+}
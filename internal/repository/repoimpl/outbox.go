@@ -0,0 +1,111 @@
+package repoimpl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/outbox"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+)
+
+var _ repository.OutboxRepository = (*outboxRepository)(nil)
+
+type outboxRepository struct {
+	queries sqlcpg.Queries
+}
+
+func newOutboxRepository(queries sqlcpg.Queries) *outboxRepository {
+	return &outboxRepository{queries: queries}
+}
+
+func (r outboxRepository) CreateOutboxMessage(ctx context.Context, db sqldb.SQLDB, msg outbox.Message) error {
+	metadata, err := json.Marshal(msg.Metadata)
+	if err != nil {
+		return fmt.Errorf("marshal metadata: %w", err)
+	}
+
+	err = r.queries.OutboxMessageInsert(ctx, db, sqlcpg.OutboxMessageInsertParams{
+		MessageID: msg.MessageID,
+		Topic:     msg.Topic,
+		Payload:   msg.Payload,
+		Metadata:  metadata,
+		CreatedAt: msg.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("queries insert outbox message: %w", err)
+	}
+
+	return nil
+}
+
+func (r outboxRepository) ListPendingOutboxMessagesForUpdate(ctx context.Context, db sqldb.SQLDB, limit int32) ([]outbox.Message, error) {
+	rows, err := r.queries.OutboxMessageListPendingForUpdate(ctx, db, limit)
+	if err != nil {
+		return nil, fmt.Errorf("queries list pending outbox messages: %w", err)
+	}
+
+	msgs := make([]outbox.Message, 0, len(rows))
+	for _, row := range rows {
+		msg, err := outboxMessageRowToModel(row)
+		if err != nil {
+			return nil, fmt.Errorf("outbox message row to model: %w", err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+func (r outboxRepository) MarkOutboxMessagesPublished(ctx context.Context, db sqldb.SQLDB, ids []int64, publishedAt time.Time) error {
+	err := r.queries.OutboxMessageMarkPublished(ctx, db, sqlcpg.OutboxMessageMarkPublishedParams{
+		PublishedAt: &publishedAt,
+		Ids:         ids,
+	})
+	if err != nil {
+		return fmt.Errorf("queries mark outbox messages published: %w", err)
+	}
+
+	return nil
+}
+
+func (r outboxRepository) DeletePublishedOutboxMessages(ctx context.Context, db sqldb.SQLDB, publishedBefore time.Time) (int64, error) {
+	count, err := r.queries.OutboxMessageDeletePublishedBefore(ctx, db, &publishedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("queries delete published outbox messages: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r outboxRepository) GetOutboxStats(ctx context.Context, db sqldb.SQLDB) (outbox.Stats, error) {
+	row, err := r.queries.OutboxMessagePendingStats(ctx, db)
+	if err != nil {
+		return outbox.Stats{}, fmt.Errorf("queries get outbox message pending stats: %w", err)
+	}
+
+	return outbox.Stats{
+		PendingCount: row.PendingCount,
+		Lag:          time.Duration(row.LagSeconds * float64(time.Second)),
+	}, nil
+}
+
+func outboxMessageRowToModel(row sqlcpg.OutboxMessage) (outbox.Message, error) {
+	var metadata map[string]string
+	if err := json.Unmarshal(row.Metadata, &metadata); err != nil {
+		return outbox.Message{}, fmt.Errorf("unmarshal metadata: %w", err)
+	}
+
+	return outbox.Message{
+		ID:          row.ID,
+		MessageID:   row.MessageID,
+		Topic:       row.Topic,
+		Payload:     row.Payload,
+		Metadata:    metadata,
+		CreatedAt:   row.CreatedAt,
+		PublishedAt: row.PublishedAt,
+	}, nil
+}
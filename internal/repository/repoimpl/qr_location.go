@@ -11,6 +11,7 @@ import (
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
@@ -45,13 +46,41 @@ func (r qrLocationRepository) GetQRLocation(ctx context.Context, db sqldb.SQLDB,
 	return qrLocationRowToModel(row)
 }
 
-func (r qrLocationRepository) ListQRLocations(ctx context.Context, db sqldb.SQLDB, pagingParams paging.Params, sorts []sort.Sort) (paging.List[qrlocation.QRLocation], error) {
+func (r qrLocationRepository) GetQRLocationByQRCode(ctx context.Context, db sqldb.SQLDB, qrCode string) (qrlocation.QRLocation, error) {
+	row, err := r.queries.QRLocationGetByQRCode(ctx, db, qrCode)
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return qrlocation.QRLocation{}, ErrQRLocationNotFound
+		}
+		return qrlocation.QRLocation{}, fmt.Errorf("queries get qr location by qr code: %w", err)
+	}
+
+	return qrLocationRowToModel(row)
+}
+
+func (r qrLocationRepository) ListQRLocations(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+	filters []filter.Filter,
+	search string,
+) (paging.List[qrlocation.QRLocation], error) {
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	query := psql.Select("*").
 		From("qr_locations").
 		Limit(uint64(pagingParams.Limit())).
 		Offset(uint64(pagingParams.Offset()))
+	countQuery := psql.Select("COUNT(*)").From("qr_locations")
 
+	for _, f := range filters {
+		query = f.Attach(query)
+		countQuery = f.Attach(countQuery)
+	}
+	if cond, ok := filter.Search("name", search); ok {
+		query = query.Where(cond)
+		countQuery = countQuery.Where(cond)
+	}
 	for _, s := range sorts {
 		query = s.Attach(query)
 	}
@@ -92,8 +121,6 @@ func (r qrLocationRepository) ListQRLocations(ctx context.Context, db sqldb.SQLD
 		return paging.List[qrlocation.QRLocation]{}, fmt.Errorf("rows error: %w", err)
 	}
 
-	countQuery := psql.Select("COUNT(*)").From("qr_locations")
-
 	countSQL, countArgs, err := countQuery.ToSql()
 	if err != nil {
 		return paging.List[qrlocation.QRLocation]{}, fmt.Errorf("build count query: %w", err)
@@ -107,6 +134,40 @@ func (r qrLocationRepository) ListQRLocations(ctx context.Context, db sqldb.SQLD
 	return paging.NewList(items, count), nil
 }
 
+func (r qrLocationRepository) ListAllQRLocations(ctx context.Context, db sqldb.SQLDB) ([]qrlocation.QRLocation, error) {
+	rows, err := r.queries.QRLocationListAll(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("queries list all qr locations: %w", err)
+	}
+
+	qrLocations := make([]qrlocation.QRLocation, len(rows))
+	for i, row := range rows {
+		qrLocations[i], err = qrLocationRowToModel(row)
+		if err != nil {
+			return nil, fmt.Errorf("convert qr location row to model: %w", err)
+		}
+	}
+
+	return qrLocations, nil
+}
+
+func (r qrLocationRepository) ListQRLocationsByIDs(ctx context.Context, db sqldb.SQLDB, ids []string) ([]qrlocation.QRLocation, error) {
+	rows, err := r.queries.QRLocationListByIDs(ctx, db, ids)
+	if err != nil {
+		return nil, fmt.Errorf("queries list qr locations by ids: %w", err)
+	}
+
+	qrLocations := make([]qrlocation.QRLocation, len(rows))
+	for i, row := range rows {
+		qrLocations[i], err = qrLocationRowToModel(row)
+		if err != nil {
+			return nil, fmt.Errorf("convert qr location row to model: %w", err)
+		}
+	}
+
+	return qrLocations, nil
+}
+
 func (r qrLocationRepository) CreateQRLocation(ctx context.Context, db sqldb.SQLDB, qrLocation qrlocation.QRLocation) error {
 	metadata, err := json.Marshal(qrLocation.Metadata)
 	if err != nil {
@@ -132,6 +193,38 @@ func (r qrLocationRepository) CreateQRLocation(ctx context.Context, db sqldb.SQL
 	return nil
 }
 
+func (r qrLocationRepository) BatchCreateQRLocations(ctx context.Context, db sqldb.SQLDB, qrLocations []qrlocation.QRLocation) error {
+	arg := make([]sqlcpg.QRLocationBatchInsertParams, 0, len(qrLocations))
+	for _, qrLocation := range qrLocations {
+		metadata, err := json.Marshal(qrLocation.Metadata)
+		if err != nil {
+			return fmt.Errorf("marshal metadata: %w", err)
+		}
+
+		arg = append(arg, sqlcpg.QRLocationBatchInsertParams{
+			ID:        qrLocation.ID,
+			Name:      qrLocation.Name,
+			QrCode:    qrLocation.QRCode,
+			Metadata:  metadata,
+			CreatedAt: qrLocation.CreatedAt,
+			UpdatedAt: qrLocation.UpdatedAt,
+		})
+	}
+
+	count, err := r.queries.QRLocationBatchInsert(ctx, db, arg)
+	if err != nil {
+		if sqldb.IsUniqueViolationError(err, qrLocationQRCodeConstraint) {
+			return ErrQRCodeAlreadyExists
+		}
+		return fmt.Errorf("queries batch create qr locations: %w", err)
+	}
+	if count != int64(len(qrLocations)) {
+		return fmt.Errorf("batch create qr locations: created %d of %d", count, len(qrLocations))
+	}
+
+	return nil
+}
+
 func (r qrLocationRepository) UpdateQRLocation(ctx context.Context, db sqldb.SQLDB, params repository.UpdateQRLocationParams) (qrlocation.QRLocation, error) {
 	metadata, err := json.Marshal(params.Metadata)
 	if err != nil {
@@ -3,6 +3,7 @@ package repoimpl
 import (
 	"context"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
@@ -38,16 +40,34 @@ func (r raybotCommandRepository) GetRaybotCommand(ctx context.Context, db sqldb.
 	return raybotCommandRowToModel(row), nil
 }
 
-func (r raybotCommandRepository) ListRaybotCommandsByRaybotID(ctx context.Context, db sqldb.SQLDB, raybotID string, pagingParams paging.Params, sorts []sort.Sort) (paging.List[raybotcommand.RaybotCommand], error) {
+func (r raybotCommandRepository) ListRaybotCommandsByRaybotID(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	raybotID string,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+	filters []filter.Filter,
+) (paging.List[raybotcommand.RaybotCommand], error) {
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	query := psql.Select("*").
 		From("raybot_commands").
 		Limit(uint64(pagingParams.Limit())).
 		Offset(uint64(pagingParams.Offset())).
 		Where(sq.Eq{"raybot_id": raybotID})
+	countQuery := psql.Select("COUNT(*)").From("raybot_commands").Where(sq.Eq{"raybot_id": raybotID})
 
-	for _, s := range sorts {
-		query = s.Attach(query)
+	for _, f := range filters {
+		query = f.Attach(query)
+		countQuery = f.Attach(countQuery)
+	}
+
+	if len(sorts) == 0 {
+		sorts = []sort.Sort{{Col: "created_at", Order: sort.OrderDESC}}
+	}
+	keyset := paging.NewKeyset(sorts, "id", "completed_at")
+	query, err := keyset.Attach(query, pagingParams.Cursor)
+	if err != nil {
+		return paging.List[raybotcommand.RaybotCommand]{}, err
 	}
 
 	sql, args, err := query.ToSql()
@@ -72,9 +92,9 @@ func (r raybotCommandRepository) ListRaybotCommandsByRaybotID(ctx context.Contex
 			&i.Inputs,
 			&i.Outputs,
 			&i.Error,
-			&i.CompletedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CompletedAt,
 		); err != nil {
 			return paging.List[raybotcommand.RaybotCommand]{}, fmt.Errorf("scan raybot command: %w", err)
 		}
@@ -85,19 +105,40 @@ func (r raybotCommandRepository) ListRaybotCommandsByRaybotID(ctx context.Contex
 		return paging.List[raybotcommand.RaybotCommand]{}, fmt.Errorf("rows error: %w", err)
 	}
 
-	countQuery := psql.Select("COUNT(*)").From("raybot_commands").Where(sq.Eq{"raybot_id": raybotID})
+	ret := paging.NewUncountedList(items)
+	if !pagingParams.SkipCount {
+		countSQL, countArgs, err := countQuery.ToSql()
+		if err != nil {
+			return paging.List[raybotcommand.RaybotCommand]{}, fmt.Errorf("build count query: %w", err)
+		}
 
-	countSQL, countArgs, err := countQuery.ToSql()
-	if err != nil {
-		return paging.List[raybotcommand.RaybotCommand]{}, fmt.Errorf("build count query: %w", err)
+		var count int64
+		if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
+			return paging.List[raybotcommand.RaybotCommand]{}, fmt.Errorf("queries count raybot commands: %w", err)
+		}
+		ret = paging.NewList(items, count)
 	}
 
-	var count int64
-	if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
-		return paging.List[raybotcommand.RaybotCommand]{}, fmt.Errorf("queries count raybot commands: %w", err)
+	if len(items) > 0 && len(items) == int(pagingParams.Limit()) {
+		ret.NextCursor, err = keyset.Cursor(raybotCommandSortKey(items[len(items)-1]))
+		if err != nil {
+			return paging.List[raybotcommand.RaybotCommand]{}, fmt.Errorf("make cursor: %w", err)
+		}
 	}
 
-	return paging.NewList(items, count), nil
+	return ret, nil
+}
+
+// raybotCommandSortKey returns the values of the sortable columns of rbc.
+func raybotCommandSortKey(rbc raybotcommand.RaybotCommand) map[string]any {
+	return map[string]any{
+		"id":           rbc.ID,
+		"type":         rbc.Type,
+		"status":       rbc.Status,
+		"completed_at": rbc.CompletedAt,
+		"created_at":   rbc.CreatedAt,
+		"updated_at":   rbc.UpdatedAt,
+	}
 }
 
 func (r raybotCommandRepository) CreateRaybotCommand(ctx context.Context, db sqldb.SQLDB, raybotCommand raybotcommand.RaybotCommand) error {
@@ -142,6 +183,39 @@ func (r raybotCommandRepository) UpdateRaybotCommand(ctx context.Context, db sql
 	return raybotCommandRowToModel(row), nil
 }
 
+func (r raybotCommandRepository) ListExpiredRaybotCommands(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	status raybotcommand.Status,
+	before time.Time,
+	limit int32,
+) ([]raybotcommand.RaybotCommand, error) {
+	rows, err := r.queries.RaybotCommandListExpired(ctx, db, sqlcpg.RaybotCommandListExpiredParams{
+		Status:    string(status),
+		Before:    before,
+		BatchSize: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("queries list expired raybot commands: %w", err)
+	}
+
+	items := make([]raybotcommand.RaybotCommand, len(rows))
+	for i, row := range rows {
+		items[i] = raybotCommandRowToModel(row)
+	}
+
+	return items, nil
+}
+
+func (r raybotCommandRepository) DeleteRaybotCommandsByIDs(ctx context.Context, db sqldb.SQLDB, ids []string) (int64, error) {
+	count, err := r.queries.RaybotCommandDeleteByIDs(ctx, db, ids)
+	if err != nil {
+		return 0, fmt.Errorf("queries delete raybot commands by ids: %w", err)
+	}
+
+	return count, nil
+}
+
 func (r raybotCommandRepository) DeleteRaybotCommandsByRaybotID(ctx context.Context, db sqldb.SQLDB, raybotID string) error {
 	err := r.queries.RaybotCommandDeleteByRaybotID(ctx, db, raybotID)
 	if err != nil {
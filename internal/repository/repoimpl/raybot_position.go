@@ -0,0 +1,176 @@
+package repoimpl
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	raybotposition "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_position"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var (
+	_ repository.RaybotPositionRepository = (*raybotPositionRepository)(nil)
+
+	ErrRaybotPositionNotFound = xerror.NotFound(nil, "raybotPosition.notFound", "raybot position is unknown")
+)
+
+type raybotPositionRepository struct {
+	queries sqlcpg.Queries
+}
+
+func newRaybotPositionRepository(queries sqlcpg.Queries) *raybotPositionRepository {
+	return &raybotPositionRepository{queries: queries}
+}
+
+func (r raybotPositionRepository) GetRaybotPosition(ctx context.Context, db sqldb.SQLDB, raybotID string) (raybotposition.Position, error) {
+	row, err := r.queries.RaybotPositionGetByRaybotID(ctx, db, raybotID)
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return raybotposition.Position{}, ErrRaybotPositionNotFound
+		}
+		return raybotposition.Position{}, fmt.Errorf("queries get raybot position by raybot id: %w", err)
+	}
+
+	return raybotPositionRowToModel(row), nil
+}
+
+func (r raybotPositionRepository) ListRaybotPositionHistory(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	raybotID string,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+) (paging.List[raybotposition.Position], error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	query := psql.Select("id", "raybot_id", "location_id", "source", "command_id", "created_at").
+		From("raybot_position_history").
+		Where(sq.Eq{"raybot_id": raybotID}).
+		Limit(uint64(pagingParams.Limit())).
+		Offset(uint64(pagingParams.Offset()))
+
+	for _, s := range sorts {
+		query = s.Attach(query)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return paging.List[raybotposition.Position]{}, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return paging.List[raybotposition.Position]{}, fmt.Errorf("queries list raybot position history: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]raybotposition.Position, 0, pagingParams.Limit())
+	for rows.Next() {
+		var row sqlcpg.RaybotPosition
+		if err := rows.Scan(
+			&row.ID,
+			&row.RaybotID,
+			&row.LocationID,
+			&row.Source,
+			&row.CommandID,
+			&row.CreatedAt,
+		); err != nil {
+			return paging.List[raybotposition.Position]{}, fmt.Errorf("scan raybot position: %w", err)
+		}
+
+		items = append(items, raybotPositionRowToModel(row))
+	}
+	if err := rows.Err(); err != nil {
+		return paging.List[raybotposition.Position]{}, fmt.Errorf("rows error: %w", err)
+	}
+
+	countSQL, countArgs, err := psql.Select("COUNT(*)").
+		From("raybot_position_history").
+		Where(sq.Eq{"raybot_id": raybotID}).
+		ToSql()
+	if err != nil {
+		return paging.List[raybotposition.Position]{}, fmt.Errorf("build count query: %w", err)
+	}
+
+	var count int64
+	if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
+		return paging.List[raybotposition.Position]{}, fmt.Errorf("queries count raybot position history: %w", err)
+	}
+
+	return paging.NewList(items, count), nil
+}
+
+func (r raybotPositionRepository) ListRaybotPositionsByLocationID(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	locationID string,
+) ([]raybotposition.Position, error) {
+	rows, err := r.queries.RaybotPositionListByLocationID(ctx, db, locationID)
+	if err != nil {
+		return nil, fmt.Errorf("queries list raybot positions by location id: %w", err)
+	}
+
+	positions := make([]raybotposition.Position, len(rows))
+	for i, row := range rows {
+		positions[i] = raybotPositionRowToModel(row)
+	}
+
+	return positions, nil
+}
+
+func (r raybotPositionRepository) ListAllRaybotPositions(ctx context.Context, db sqldb.SQLDB) ([]raybotposition.Position, error) {
+	rows, err := r.queries.RaybotPositionListAll(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("queries list all raybot positions: %w", err)
+	}
+
+	positions := make([]raybotposition.Position, len(rows))
+	for i, row := range rows {
+		positions[i] = raybotPositionRowToModel(row)
+	}
+
+	return positions, nil
+}
+
+func (r raybotPositionRepository) SetRaybotPosition(ctx context.Context, db sqldb.SQLDB, position raybotposition.Position) error {
+	if err := r.queries.RaybotPositionUpsert(ctx, db, sqlcpg.RaybotPositionUpsertParams{
+		ID:         position.ID,
+		RaybotID:   position.RaybotID,
+		LocationID: position.LocationID,
+		Source:     string(position.Source),
+		CommandID:  position.CommandID,
+		CreatedAt:  position.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("queries upsert raybot position: %w", err)
+	}
+
+	if err := r.queries.RaybotPositionHistoryInsert(ctx, db, sqlcpg.RaybotPositionHistoryInsertParams{
+		ID:         position.ID,
+		RaybotID:   position.RaybotID,
+		LocationID: position.LocationID,
+		Source:     string(position.Source),
+		CommandID:  position.CommandID,
+		CreatedAt:  position.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("queries insert raybot position history: %w", err)
+	}
+
+	return nil
+}
+
+func raybotPositionRowToModel(row sqlcpg.RaybotPosition) raybotposition.Position {
+	return raybotposition.Position{
+		ID:         row.ID,
+		RaybotID:   row.RaybotID,
+		LocationID: row.LocationID,
+		Source:     raybotposition.Source(row.Source),
+		CommandID:  row.CommandID,
+		CreatedAt:  row.CreatedAt,
+	}
+}
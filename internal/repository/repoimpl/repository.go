@@ -8,23 +8,39 @@ import (
 var _ repository.Repository = (*repoimpl)(nil)
 
 type repoimpl struct {
-	qrLocationRepository        *qrLocationRepository
-	raybotRepository            *raybotRepository
-	raybotCommandRepository     *raybotCommandRepository
-	workflowRepository          *workflowRepository
-	workflowExecutionRepository *workflowExecutionRepository
-	stepExecutionRepository     *stepExecutionRepository
+	qrLocationRepository          *qrLocationRepository
+	trackSegmentRepository        *trackSegmentRepository
+	trackReservationRepository    *trackReservationRepository
+	raybotRepository              *raybotRepository
+	raybotCommandRepository       *raybotCommandRepository
+	raybotPositionRepository      *raybotPositionRepository
+	workflowRepository            *workflowRepository
+	workflowVersionRepository     *workflowVersionRepository
+	workflowExecutionRepository   *workflowExecutionRepository
+	stepExecutionRepository       *stepExecutionRepository
+	outboxRepository              *outboxRepository
+	webhookSubscriptionRepository *webhookSubscriptionRepository
+	webhookDeliveryRepository     *webhookDeliveryRepository
+	deadLetterMessageRepository   *deadLetterMessageRepository
 }
 
 //nolint:revive
 func NewRepository(queries sqlcpg.Queries) *repoimpl {
 	return &repoimpl{
-		qrLocationRepository:        newQRLocationRepository(queries),
-		raybotRepository:            newRaybotRepository(queries),
-		raybotCommandRepository:     newRaybotCommandRepository(queries),
-		workflowRepository:          newWorkflowRepository(queries),
-		workflowExecutionRepository: newWorkflowExecutionRepository(queries),
-		stepExecutionRepository:     newStepExecutionRepository(queries),
+		qrLocationRepository:          newQRLocationRepository(queries),
+		trackSegmentRepository:        newTrackSegmentRepository(queries),
+		trackReservationRepository:    newTrackReservationRepository(queries),
+		raybotRepository:              newRaybotRepository(queries),
+		raybotCommandRepository:       newRaybotCommandRepository(queries),
+		raybotPositionRepository:      newRaybotPositionRepository(queries),
+		workflowRepository:            newWorkflowRepository(queries),
+		workflowVersionRepository:     newWorkflowVersionRepository(queries),
+		workflowExecutionRepository:   newWorkflowExecutionRepository(queries),
+		stepExecutionRepository:       newStepExecutionRepository(queries),
+		outboxRepository:              newOutboxRepository(queries),
+		webhookSubscriptionRepository: newWebhookSubscriptionRepository(queries),
+		webhookDeliveryRepository:     newWebhookDeliveryRepository(queries),
+		deadLetterMessageRepository:   newDeadLetterMessageRepository(queries),
 	}
 }
 
@@ -32,6 +48,14 @@ func (r repoimpl) QRLocation() repository.QRLocationRepository {
 	return r.qrLocationRepository
 }
 
+func (r repoimpl) TrackSegment() repository.TrackSegmentRepository {
+	return r.trackSegmentRepository
+}
+
+func (r repoimpl) TrackReservation() repository.TrackReservationRepository {
+	return r.trackReservationRepository
+}
+
 func (r repoimpl) Raybot() repository.RaybotRepository {
 	return r.raybotRepository
 }
@@ -40,10 +64,18 @@ func (r repoimpl) RaybotCommand() repository.RaybotCommandRepository {
 	return r.raybotCommandRepository
 }
 
+func (r repoimpl) RaybotPosition() repository.RaybotPositionRepository {
+	return r.raybotPositionRepository
+}
+
 func (r repoimpl) Workflow() repository.WorkflowRepository {
 	return r.workflowRepository
 }
 
+func (r repoimpl) WorkflowVersion() repository.WorkflowVersionRepository {
+	return r.workflowVersionRepository
+}
+
 func (r repoimpl) WorkflowExecution() repository.WorkflowExecutionRepository {
 	return r.workflowExecutionRepository
 }
@@ -51,3 +83,19 @@ func (r repoimpl) WorkflowExecution() repository.WorkflowExecutionRepository {
 func (r repoimpl) StepExecution() repository.StepExecutionRepository {
 	return r.stepExecutionRepository
 }
+
+func (r repoimpl) Outbox() repository.OutboxRepository {
+	return r.outboxRepository
+}
+
+func (r repoimpl) WebhookSubscription() repository.WebhookSubscriptionRepository {
+	return r.webhookSubscriptionRepository
+}
+
+func (r repoimpl) WebhookDelivery() repository.WebhookDeliveryRepository {
+	return r.webhookDeliveryRepository
+}
+
+func (r repoimpl) DeadLetterMessage() repository.DeadLetterMessageRepository {
+	return r.deadLetterMessageRepository
+}
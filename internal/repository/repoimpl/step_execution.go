@@ -12,6 +12,8 @@ import (
 	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
 
 var (
@@ -35,55 +37,145 @@ func (r stepExecutionRepository) GetStepExecution(ctx context.Context, db sqldb.
 	return stepExecutionRowToModel(row)
 }
 
-func (r stepExecutionRepository) ListStepsByWorkflowExecutionID(ctx context.Context, db sqldb.SQLDB, workflowExecutionID string) ([]stepexecution.StepExecution, error) {
+func (r stepExecutionRepository) ListStepsByWorkflowExecutionID(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	workflowExecutionID string,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+) (paging.List[stepexecution.StepExecution], error) {
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-	query := psql.Select("*").
+	query := psql.Select(
+		"id",
+		"workflow_execution_id",
+		"status",
+		"node",
+		"inputs",
+		"outputs",
+		"error",
+		"created_at",
+		"updated_at",
+		"started_at",
+		"completed_at",
+	).
 		From("step_executions").
+		Limit(uint64(pagingParams.Limit())).
+		Offset(uint64(pagingParams.Offset())).
 		Where(sq.Eq{"workflow_execution_id": workflowExecutionID})
+	countQuery := psql.Select("COUNT(*)").From("step_executions").Where(sq.Eq{"workflow_execution_id": workflowExecutionID})
+
+	if len(sorts) == 0 {
+		sorts = []sort.Sort{{Col: "created_at", Order: sort.OrderDESC}}
+	}
+	keyset := paging.NewKeyset(sorts, "id", "started_at", "completed_at")
+	query, err := keyset.Attach(query, pagingParams.Cursor)
+	if err != nil {
+		return paging.List[stepexecution.StepExecution]{}, err
+	}
 
 	sql, args, err := query.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("build query: %w", err)
+		return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("build query: %w", err)
 	}
 
 	rows, err := db.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, fmt.Errorf("queries list steps by workflow execution id: %w", err)
+		return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("queries list steps by workflow execution id: %w", err)
 	}
 	defer rows.Close()
 
-	items := make([]stepexecution.StepExecution, 0)
+	items := make([]stepexecution.StepExecution, 0, pagingParams.Limit())
 	for rows.Next() {
 		var i sqlcpg.StepExecution
 		if err := rows.Scan(
 			&i.ID,
 			&i.WorkflowExecutionID,
 			&i.Status,
+			&i.Node,
 			&i.Inputs,
 			&i.Outputs,
 			&i.Error,
-			&i.StartedAt,
-			&i.CompletedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
 		); err != nil {
-			return nil, fmt.Errorf("scan step execution: %w", err)
+			return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("scan step execution: %w", err)
 		}
 
 		item, err := stepExecutionRowToModel(i)
 		if err != nil {
-			return nil, fmt.Errorf("convert step execution row to model: %w", err)
+			return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("convert step execution row to model: %w", err)
 		}
 
 		items = append(items, item)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows error: %w", err)
+		return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("rows error: %w", err)
+	}
+
+	ret := paging.NewUncountedList(items)
+	if !pagingParams.SkipCount {
+		countSQL, countArgs, err := countQuery.ToSql()
+		if err != nil {
+			return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("build count query: %w", err)
+		}
+
+		var count int64
+		if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
+			return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("queries count step executions: %w", err)
+		}
+		ret = paging.NewList(items, count)
+	}
+
+	if len(items) > 0 && len(items) == int(pagingParams.Limit()) {
+		ret.NextCursor, err = keyset.Cursor(stepExecutionSortKey(items[len(items)-1]))
+		if err != nil {
+			return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("make cursor: %w", err)
+		}
+	}
+
+	return ret, nil
+}
+
+// stepExecutionSortKey returns the values of the sortable columns of step.
+func stepExecutionSortKey(step stepexecution.StepExecution) map[string]any {
+	return map[string]any{
+		"id":           step.ID,
+		"status":       step.Status,
+		"started_at":   step.StartedAt,
+		"completed_at": step.CompletedAt,
+		"created_at":   step.CreatedAt,
+		"updated_at":   step.UpdatedAt,
+	}
+}
+
+func (r stepExecutionRepository) ListStepsByWorkflowExecutionIDs(ctx context.Context, db sqldb.SQLDB, workflowExecutionIDs []string) ([]stepexecution.StepExecution, error) {
+	rows, err := r.queries.StepExecutionListByWorkflowExecutionIDs(ctx, db, workflowExecutionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("queries list steps by workflow execution ids: %w", err)
+	}
+
+	items := make([]stepexecution.StepExecution, len(rows))
+	for i, row := range rows {
+		items[i], err = stepExecutionRowToModel(row)
+		if err != nil {
+			return nil, fmt.Errorf("convert step execution row to model: %w", err)
+		}
 	}
 
 	return items, nil
 }
 
+func (r stepExecutionRepository) DeleteStepsByWorkflowExecutionIDs(ctx context.Context, db sqldb.SQLDB, workflowExecutionIDs []string) (int64, error) {
+	count, err := r.queries.StepExecutionDeleteByWorkflowExecutionIDs(ctx, db, workflowExecutionIDs)
+	if err != nil {
+		return 0, fmt.Errorf("queries delete steps by workflow execution ids: %w", err)
+	}
+
+	return count, nil
+}
+
 func (r stepExecutionRepository) BatchCreateStepExecutions(ctx context.Context, db sqldb.SQLDB, steps []stepexecution.StepExecution) error {
 	arg := make([]sqlcpg.StepExecutionBatchInsertParams, 0, len(steps))
 	for _, step := range steps {
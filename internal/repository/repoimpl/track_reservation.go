@@ -0,0 +1,105 @@
+package repoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	trackreservation "github.com/tuanvumaihuynh/roboflow/internal/model/track_reservation"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+)
+
+var _ repository.TrackReservationRepository = (*trackReservationRepository)(nil)
+
+type trackReservationRepository struct {
+	queries sqlcpg.Queries
+}
+
+func newTrackReservationRepository(queries sqlcpg.Queries) *trackReservationRepository {
+	return &trackReservationRepository{queries: queries}
+}
+
+func (r trackReservationRepository) LockTrackReservations(ctx context.Context, db sqldb.SQLDB) error {
+	if err := r.queries.TrackReservationLock(ctx, db); err != nil {
+		return fmt.Errorf("queries lock track reservations: %w", err)
+	}
+
+	return nil
+}
+
+func (r trackReservationRepository) ListActiveTrackReservations(
+	ctx context.Context,
+	db sqldb.SQLDB,
+) ([]trackreservation.Reservation, error) {
+	rows, err := r.queries.TrackReservationListActive(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("queries list active track reservations: %w", err)
+	}
+
+	reservations := make([]trackreservation.Reservation, len(rows))
+	for i, row := range rows {
+		reservations[i] = trackReservationRowToModel(row)
+	}
+
+	return reservations, nil
+}
+
+func (r trackReservationRepository) CreateTrackReservation(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	reservation trackreservation.Reservation,
+) error {
+	if err := r.queries.TrackReservationInsert(ctx, db, sqlcpg.TrackReservationInsertParams{
+		CommandID:    reservation.CommandID,
+		RaybotID:     reservation.RaybotID,
+		ToLocationID: reservation.ToLocationID,
+		Status:       string(reservation.Status),
+		LocationIds:  reservation.LocationIDs,
+		SegmentIds:   reservation.SegmentIDs,
+		CreatedAt:    reservation.CreatedAt,
+		UpdatedAt:    reservation.UpdatedAt,
+	}); err != nil {
+		return fmt.Errorf("queries insert track reservation: %w", err)
+	}
+
+	return nil
+}
+
+func (r trackReservationRepository) UpdateTrackReservation(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	reservation trackreservation.Reservation,
+) error {
+	if err := r.queries.TrackReservationUpdate(ctx, db, sqlcpg.TrackReservationUpdateParams{
+		Status:      string(reservation.Status),
+		LocationIds: reservation.LocationIDs,
+		SegmentIds:  reservation.SegmentIDs,
+		CommandID:   reservation.CommandID,
+	}); err != nil {
+		return fmt.Errorf("queries update track reservation: %w", err)
+	}
+
+	return nil
+}
+
+func (r trackReservationRepository) DeleteTrackReservation(ctx context.Context, db sqldb.SQLDB, commandID string) error {
+	if err := r.queries.TrackReservationDelete(ctx, db, commandID); err != nil {
+		return fmt.Errorf("queries delete track reservation: %w", err)
+	}
+
+	return nil
+}
+
+func trackReservationRowToModel(row sqlcpg.TrackReservation) trackreservation.Reservation {
+	return trackreservation.Reservation{
+		CommandID:    row.CommandID,
+		RaybotID:     row.RaybotID,
+		ToLocationID: row.ToLocationID,
+		Status:       trackreservation.Status(row.Status),
+		LocationIDs:  row.LocationIds,
+		SegmentIDs:   row.SegmentIds,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
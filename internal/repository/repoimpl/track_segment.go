@@ -0,0 +1,193 @@
+package repoimpl
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var (
+	_ repository.TrackSegmentRepository = (*trackSegmentRepository)(nil)
+
+	trackSegmentFromToConstraint = "track_segments_from_to_key"
+
+	ErrTrackSegmentNotFound      = xerror.NotFound(nil, "trackSegment.notFound", "track segment not found")
+	ErrTrackSegmentAlreadyExists = xerror.Conflict(nil, "trackSegment.alreadyExists", "track segment between these locations already exists")
+)
+
+type trackSegmentRepository struct {
+	queries sqlcpg.Queries
+}
+
+func newTrackSegmentRepository(queries sqlcpg.Queries) *trackSegmentRepository {
+	return &trackSegmentRepository{queries: queries}
+}
+
+func (r trackSegmentRepository) GetTrackSegment(ctx context.Context, db sqldb.SQLDB, id string) (trackmap.Segment, error) {
+	row, err := r.queries.TrackSegmentGetByID(ctx, db, id)
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return trackmap.Segment{}, ErrTrackSegmentNotFound
+		}
+		return trackmap.Segment{}, fmt.Errorf("queries get track segment by id: %w", err)
+	}
+
+	return trackSegmentRowToModel(row), nil
+}
+
+func (r trackSegmentRepository) ListTrackSegments(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+) (paging.List[trackmap.Segment], error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	query := psql.Select("id", "from_location_id", "to_location_id", "distance", "direction", "created_at", "updated_at").
+		From("track_segments").
+		Limit(uint64(pagingParams.Limit())).
+		Offset(uint64(pagingParams.Offset()))
+
+	for _, s := range sorts {
+		query = s.Attach(query)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return paging.List[trackmap.Segment]{}, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return paging.List[trackmap.Segment]{}, fmt.Errorf("queries list track segments: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]trackmap.Segment, 0, pagingParams.Limit())
+	for rows.Next() {
+		var row sqlcpg.TrackSegment
+		if err := rows.Scan(
+			&row.ID,
+			&row.FromLocationID,
+			&row.ToLocationID,
+			&row.Distance,
+			&row.Direction,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+		); err != nil {
+			return paging.List[trackmap.Segment]{}, fmt.Errorf("scan track segment: %w", err)
+		}
+
+		items = append(items, trackSegmentRowToModel(row))
+	}
+	if err := rows.Err(); err != nil {
+		return paging.List[trackmap.Segment]{}, fmt.Errorf("rows error: %w", err)
+	}
+
+	countSQL, countArgs, err := psql.Select("COUNT(*)").From("track_segments").ToSql()
+	if err != nil {
+		return paging.List[trackmap.Segment]{}, fmt.Errorf("build count query: %w", err)
+	}
+
+	var count int64
+	if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
+		return paging.List[trackmap.Segment]{}, fmt.Errorf("queries count track segments: %w", err)
+	}
+
+	return paging.NewList(items, count), nil
+}
+
+func (r trackSegmentRepository) ListAllTrackSegments(ctx context.Context, db sqldb.SQLDB) ([]trackmap.Segment, error) {
+	rows, err := r.queries.TrackSegmentListAll(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("queries list all track segments: %w", err)
+	}
+
+	segments := make([]trackmap.Segment, len(rows))
+	for i, row := range rows {
+		segments[i] = trackSegmentRowToModel(row)
+	}
+
+	return segments, nil
+}
+
+func (r trackSegmentRepository) CreateTrackSegment(ctx context.Context, db sqldb.SQLDB, segment trackmap.Segment) error {
+	if err := r.queries.TrackSegmentInsert(ctx, db, sqlcpg.TrackSegmentInsertParams{
+		ID:             segment.ID,
+		FromLocationID: segment.FromLocationID,
+		ToLocationID:   segment.ToLocationID,
+		Distance:       segment.Distance,
+		Direction:      string(segment.Direction),
+		CreatedAt:      segment.CreatedAt,
+		UpdatedAt:      segment.UpdatedAt,
+	}); err != nil {
+		if sqldb.IsUniqueViolationError(err, trackSegmentFromToConstraint) {
+			return ErrTrackSegmentAlreadyExists
+		}
+		return fmt.Errorf("queries insert track segment: %w", err)
+	}
+
+	return nil
+}
+
+func (r trackSegmentRepository) UpdateTrackSegment(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	params repository.UpdateTrackSegmentParams,
+) (trackmap.Segment, error) {
+	row, err := r.queries.TrackSegmentUpdate(ctx, db, sqlcpg.TrackSegmentUpdateParams{
+		ID:                params.ID,
+		FromLocationID:    params.FromLocationID,
+		SetFromLocationID: params.SetFromLocationID,
+		ToLocationID:      params.ToLocationID,
+		SetToLocationID:   params.SetToLocationID,
+		Distance:          params.Distance,
+		SetDistance:       params.SetDistance,
+		Direction:         string(params.Direction),
+		SetDirection:      params.SetDirection,
+	})
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return trackmap.Segment{}, ErrTrackSegmentNotFound
+		}
+		if sqldb.IsUniqueViolationError(err, trackSegmentFromToConstraint) {
+			return trackmap.Segment{}, ErrTrackSegmentAlreadyExists
+		}
+		return trackmap.Segment{}, fmt.Errorf("queries update track segment: %w", err)
+	}
+
+	return trackSegmentRowToModel(row), nil
+}
+
+func (r trackSegmentRepository) DeleteTrackSegment(ctx context.Context, db sqldb.SQLDB, id string) error {
+	n, err := r.queries.TrackSegmentDelete(ctx, db, id)
+	if err != nil {
+		return fmt.Errorf("queries delete track segment: %w", err)
+	}
+	if n == 0 {
+		return ErrTrackSegmentNotFound
+	}
+
+	return nil
+}
+
+func trackSegmentRowToModel(row sqlcpg.TrackSegment) trackmap.Segment {
+	return trackmap.Segment{
+		ID:             row.ID,
+		FromLocationID: row.FromLocationID,
+		ToLocationID:   row.ToLocationID,
+		Distance:       row.Distance,
+		Direction:      raybotcommand.MoveDirection(row.Direction),
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
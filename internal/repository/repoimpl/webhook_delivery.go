@@ -0,0 +1,221 @@
+package repoimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var (
+	_ repository.WebhookDeliveryRepository = (*webhookDeliveryRepository)(nil)
+
+	ErrWebhookDeliveryNotFound = xerror.NotFound(nil, "webhookDelivery.notFound", "webhook delivery not found")
+)
+
+type webhookDeliveryRepository struct {
+	queries sqlcpg.Queries
+}
+
+func newWebhookDeliveryRepository(queries sqlcpg.Queries) *webhookDeliveryRepository {
+	return &webhookDeliveryRepository{queries: queries}
+}
+
+func (r webhookDeliveryRepository) GetWebhookDeliveryForUpdate(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	subscriptionID string,
+	id string,
+) (webhook.Delivery, error) {
+	row, err := r.queries.WebhookDeliveryGetByIDForUpdate(ctx, db, sqlcpg.WebhookDeliveryGetByIDForUpdateParams{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+	})
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return webhook.Delivery{}, ErrWebhookDeliveryNotFound
+		}
+		return webhook.Delivery{}, fmt.Errorf("queries get webhook delivery by id for update: %w", err)
+	}
+
+	return webhookDeliveryRowToModel(row), nil
+}
+
+func (r webhookDeliveryRepository) ListWebhookDeliveriesBySubscriptionID(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	subscriptionID string,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+	status *webhook.DeliveryStatus,
+) (paging.List[webhook.Delivery], error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	query := psql.Select(
+		"id",
+		"subscription_id",
+		"event_id",
+		"event_type",
+		"payload",
+		"status",
+		"attempts",
+		"next_attempt_at",
+		"last_attempt_at",
+		"last_response_status",
+		"last_error",
+		"created_at",
+		"updated_at",
+	).
+		From("webhook_deliveries").
+		Where(sq.Eq{"subscription_id": subscriptionID}).
+		Limit(uint64(pagingParams.Limit())).
+		Offset(uint64(pagingParams.Offset()))
+	countQuery := psql.Select("COUNT(*)").
+		From("webhook_deliveries").
+		Where(sq.Eq{"subscription_id": subscriptionID})
+
+	if status != nil {
+		query = query.Where(sq.Eq{"status": string(*status)})
+		countQuery = countQuery.Where(sq.Eq{"status": string(*status)})
+	}
+	for _, s := range sorts {
+		query = s.Attach(query)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return paging.List[webhook.Delivery]{}, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return paging.List[webhook.Delivery]{}, fmt.Errorf("queries list webhook deliveries by subscription id: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]webhook.Delivery, 0, pagingParams.Limit())
+	for rows.Next() {
+		var row sqlcpg.WebhookDelivery
+		if err := rows.Scan(
+			&row.ID,
+			&row.SubscriptionID,
+			&row.EventID,
+			&row.EventType,
+			&row.Payload,
+			&row.Status,
+			&row.Attempts,
+			&row.NextAttemptAt,
+			&row.LastAttemptAt,
+			&row.LastResponseStatus,
+			&row.LastError,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+		); err != nil {
+			return paging.List[webhook.Delivery]{}, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+
+		items = append(items, webhookDeliveryRowToModel(row))
+	}
+	if err := rows.Err(); err != nil {
+		return paging.List[webhook.Delivery]{}, fmt.Errorf("rows error: %w", err)
+	}
+
+	countSQL, countArgs, err := countQuery.ToSql()
+	if err != nil {
+		return paging.List[webhook.Delivery]{}, fmt.Errorf("build count query: %w", err)
+	}
+
+	var count int64
+	if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
+		return paging.List[webhook.Delivery]{}, fmt.Errorf("queries count webhook deliveries: %w", err)
+	}
+
+	return paging.NewList(items, count), nil
+}
+
+func (r webhookDeliveryRepository) CreateWebhookDelivery(ctx context.Context, db sqldb.SQLDB, delivery webhook.Delivery) error {
+	if err := r.queries.WebhookDeliveryInsert(ctx, db, sqlcpg.WebhookDeliveryInsertParams{
+		ID:                 delivery.ID,
+		SubscriptionID:     delivery.SubscriptionID,
+		EventID:            delivery.EventID,
+		EventType:          delivery.EventType,
+		Payload:            delivery.Payload,
+		Status:             string(delivery.Status),
+		Attempts:           delivery.Attempts,
+		NextAttemptAt:      delivery.NextAttemptAt,
+		LastAttemptAt:      delivery.LastAttemptAt,
+		LastResponseStatus: delivery.LastResponseStatus,
+		LastError:          delivery.LastError,
+		CreatedAt:          delivery.CreatedAt,
+		UpdatedAt:          delivery.UpdatedAt,
+	}); err != nil {
+		return fmt.Errorf("queries insert webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r webhookDeliveryRepository) ClaimDueWebhookDeliveries(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	limit int32,
+	leaseUntil time.Time,
+) ([]webhook.Delivery, error) {
+	rows, err := r.queries.WebhookDeliveryClaimDue(ctx, db, sqlcpg.WebhookDeliveryClaimDueParams{
+		LeaseUntil: leaseUntil,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("queries claim due webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]webhook.Delivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = webhookDeliveryRowToModel(row)
+	}
+
+	return deliveries, nil
+}
+
+func (r webhookDeliveryRepository) UpdateWebhookDelivery(ctx context.Context, db sqldb.SQLDB, delivery webhook.Delivery) error {
+	if err := r.queries.WebhookDeliveryUpdate(ctx, db, sqlcpg.WebhookDeliveryUpdateParams{
+		ID:                 delivery.ID,
+		Status:             string(delivery.Status),
+		Attempts:           delivery.Attempts,
+		NextAttemptAt:      delivery.NextAttemptAt,
+		LastAttemptAt:      delivery.LastAttemptAt,
+		LastResponseStatus: delivery.LastResponseStatus,
+		LastError:          delivery.LastError,
+		UpdatedAt:          delivery.UpdatedAt,
+	}); err != nil {
+		return fmt.Errorf("queries update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func webhookDeliveryRowToModel(row sqlcpg.WebhookDelivery) webhook.Delivery {
+	return webhook.Delivery{
+		ID:                 row.ID,
+		SubscriptionID:     row.SubscriptionID,
+		EventID:            row.EventID,
+		EventType:          row.EventType,
+		Payload:            row.Payload,
+		Status:             webhook.DeliveryStatus(row.Status),
+		Attempts:           row.Attempts,
+		NextAttemptAt:      row.NextAttemptAt,
+		LastAttemptAt:      row.LastAttemptAt,
+		LastResponseStatus: row.LastResponseStatus,
+		LastError:          row.LastError,
+		CreatedAt:          row.CreatedAt,
+		UpdatedAt:          row.UpdatedAt,
+	}
+}
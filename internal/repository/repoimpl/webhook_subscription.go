@@ -0,0 +1,196 @@
+package repoimpl
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var (
+	_ repository.WebhookSubscriptionRepository = (*webhookSubscriptionRepository)(nil)
+
+	ErrWebhookSubscriptionNotFound = xerror.NotFound(nil, "webhookSubscription.notFound", "webhook subscription not found")
+)
+
+type webhookSubscriptionRepository struct {
+	queries sqlcpg.Queries
+}
+
+func newWebhookSubscriptionRepository(queries sqlcpg.Queries) *webhookSubscriptionRepository {
+	return &webhookSubscriptionRepository{queries: queries}
+}
+
+func (r webhookSubscriptionRepository) GetWebhookSubscription(ctx context.Context, db sqldb.SQLDB, id string) (webhook.Subscription, error) {
+	row, err := r.queries.WebhookSubscriptionGetByID(ctx, db, id)
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return webhook.Subscription{}, ErrWebhookSubscriptionNotFound
+		}
+		return webhook.Subscription{}, fmt.Errorf("queries get webhook subscription by id: %w", err)
+	}
+
+	return webhookSubscriptionRowToModel(row), nil
+}
+
+func (r webhookSubscriptionRepository) ListWebhookSubscriptions(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+) (paging.List[webhook.Subscription], error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	query := psql.Select("id", "url", "secret", "event_types", "is_active", "created_at", "updated_at").
+		From("webhook_subscriptions").
+		Limit(uint64(pagingParams.Limit())).
+		Offset(uint64(pagingParams.Offset()))
+
+	for _, s := range sorts {
+		query = s.Attach(query)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return paging.List[webhook.Subscription]{}, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return paging.List[webhook.Subscription]{}, fmt.Errorf("queries list webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]webhook.Subscription, 0, pagingParams.Limit())
+	for rows.Next() {
+		var row sqlcpg.WebhookSubscription
+		if err := rows.Scan(
+			&row.ID,
+			&row.Url,
+			&row.Secret,
+			&row.EventTypes,
+			&row.IsActive,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+		); err != nil {
+			return paging.List[webhook.Subscription]{}, fmt.Errorf("scan webhook subscription: %w", err)
+		}
+
+		items = append(items, webhookSubscriptionRowToModel(row))
+	}
+	if err := rows.Err(); err != nil {
+		return paging.List[webhook.Subscription]{}, fmt.Errorf("rows error: %w", err)
+	}
+
+	countSQL, countArgs, err := psql.Select("COUNT(*)").From("webhook_subscriptions").ToSql()
+	if err != nil {
+		return paging.List[webhook.Subscription]{}, fmt.Errorf("build count query: %w", err)
+	}
+
+	var count int64
+	if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
+		return paging.List[webhook.Subscription]{}, fmt.Errorf("queries count webhook subscriptions: %w", err)
+	}
+
+	return paging.NewList(items, count), nil
+}
+
+func (r webhookSubscriptionRepository) ListActiveWebhookSubscriptionsByEventType(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	eventType string,
+) ([]webhook.Subscription, error) {
+	rows, err := r.queries.WebhookSubscriptionListActiveByEventType(ctx, db, eventType)
+	if err != nil {
+		return nil, fmt.Errorf("queries list active webhook subscriptions by event type: %w", err)
+	}
+
+	subscriptions := make([]webhook.Subscription, len(rows))
+	for i, row := range rows {
+		subscriptions[i] = webhookSubscriptionRowToModel(row)
+	}
+
+	return subscriptions, nil
+}
+
+func (r webhookSubscriptionRepository) CreateWebhookSubscription(ctx context.Context, db sqldb.SQLDB, subscription webhook.Subscription) error {
+	if err := r.queries.WebhookSubscriptionInsert(ctx, db, sqlcpg.WebhookSubscriptionInsertParams{
+		ID:         subscription.ID,
+		Url:        subscription.URL,
+		Secret:     subscription.Secret,
+		EventTypes: eventTypesToRow(subscription.EventTypes),
+		IsActive:   subscription.IsActive,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}); err != nil {
+		return fmt.Errorf("queries insert webhook subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (r webhookSubscriptionRepository) UpdateWebhookSubscription(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	params repository.UpdateWebhookSubscriptionParams,
+) (webhook.Subscription, error) {
+	row, err := r.queries.WebhookSubscriptionUpdate(ctx, db, sqlcpg.WebhookSubscriptionUpdateParams{
+		ID:            params.ID,
+		Url:           params.URL,
+		SetUrl:        params.SetURL,
+		Secret:        params.Secret,
+		SetSecret:     params.SetSecret,
+		EventTypes:    eventTypesToRow(params.EventTypes),
+		SetEventTypes: params.SetEventTypes,
+		IsActive:      params.IsActive,
+		SetIsActive:   params.SetIsActive,
+	})
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return webhook.Subscription{}, ErrWebhookSubscriptionNotFound
+		}
+		return webhook.Subscription{}, fmt.Errorf("queries update webhook subscription: %w", err)
+	}
+
+	return webhookSubscriptionRowToModel(row), nil
+}
+
+func (r webhookSubscriptionRepository) DeleteWebhookSubscription(ctx context.Context, db sqldb.SQLDB, id string) error {
+	n, err := r.queries.WebhookSubscriptionDelete(ctx, db, id)
+	if err != nil {
+		return fmt.Errorf("queries delete webhook subscription: %w", err)
+	}
+	if n == 0 {
+		return ErrWebhookSubscriptionNotFound
+	}
+
+	return nil
+}
+
+// eventTypesToRow returns an empty slice for a nil filter, the column is not
+// nullable.
+func eventTypesToRow(eventTypes []string) []string {
+	if eventTypes == nil {
+		return []string{}
+	}
+	return eventTypes
+}
+
+func webhookSubscriptionRowToModel(row sqlcpg.WebhookSubscription) webhook.Subscription {
+	return webhook.Subscription{
+		ID:         row.ID,
+		URL:        row.Url,
+		Secret:     row.Secret,
+		EventTypes: row.EventTypes,
+		IsActive:   row.IsActive,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	workflow "github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
@@ -47,13 +48,51 @@ func (r workflowRepository) GetWorkflow(ctx context.Context, db sqldb.SQLDB, id
 	return m, nil
 }
 
-func (r workflowRepository) ListWorkflows(ctx context.Context, db sqldb.SQLDB, pagingParams paging.Params, sorts []sort.Sort) (paging.List[workflow.Workflow], error) {
+func (r workflowRepository) GetWorkflowForUpdate(ctx context.Context, db sqldb.SQLDB, id string) (workflow.Workflow, error) {
+	row, err := r.queries.WorkflowGetByIDForUpdate(ctx, db, id)
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return workflow.Workflow{}, ErrWorkflowNotFound
+		}
+		return workflow.Workflow{}, fmt.Errorf("queries get workflow by id for update: %w", err)
+	}
+
+	m, err := workflowRowToModel(row)
+	if err != nil {
+		return workflow.Workflow{}, fmt.Errorf("workflow row to model: %w", err)
+	}
+
+	return m, nil
+}
+
+func (r workflowRepository) ListWorkflows(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+	filters []filter.Filter,
+	search string,
+	isTemplate *bool,
+) (paging.List[workflow.Workflow], error) {
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	query := psql.Select("*").
 		From("workflows").
 		Limit(uint64(pagingParams.Limit())).
 		Offset(uint64(pagingParams.Offset()))
+	countQuery := psql.Select("COUNT(*)").From("workflows")
 
+	if isTemplate != nil {
+		query = query.Where("is_template = ?", *isTemplate)
+		countQuery = countQuery.Where("is_template = ?", *isTemplate)
+	}
+	for _, f := range filters {
+		query = f.Attach(query)
+		countQuery = f.Attach(countQuery)
+	}
+	if cond, ok := filter.Search("name", search); ok {
+		query = query.Where(cond)
+		countQuery = countQuery.Where(cond)
+	}
 	for _, s := range sorts {
 		query = s.Attach(query)
 	}
@@ -81,6 +120,9 @@ func (r workflowRepository) ListWorkflows(ctx context.Context, db sqldb.SQLDB, p
 			&i.Data,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PublishedVersion,
+			&i.IsTemplate,
+			&i.TemplateParameters,
 		); err != nil {
 			return paging.List[workflow.Workflow]{}, fmt.Errorf("scan workflow: %w", err)
 		}
@@ -97,8 +139,6 @@ func (r workflowRepository) ListWorkflows(ctx context.Context, db sqldb.SQLDB, p
 		return paging.List[workflow.Workflow]{}, fmt.Errorf("rows error: %w", err)
 	}
 
-	countQuery := psql.Select("COUNT(*)").From("workflows")
-
 	countSQL, countArgs, err := countQuery.ToSql()
 	if err != nil {
 		return paging.List[workflow.Workflow]{}, fmt.Errorf("build count query: %w", err)
@@ -118,15 +158,22 @@ func (r workflowRepository) CreateWorkflow(ctx context.Context, db sqldb.SQLDB,
 		return fmt.Errorf("marshal workflow data: %w", err)
 	}
 
+	templateParameters, err := marshalTemplateParameters(workflow.TemplateParameters)
+	if err != nil {
+		return fmt.Errorf("marshal workflow template parameters: %w", err)
+	}
+
 	err = r.queries.WorkflowInsert(ctx, db, sqlcpg.WorkflowInsertParams{
-		ID:          workflow.ID,
-		Name:        workflow.Name,
-		Description: workflow.Description,
-		IsDraft:     workflow.IsDraft,
-		IsValid:     workflow.IsValid,
-		Data:        data,
-		CreatedAt:   workflow.CreatedAt,
-		UpdatedAt:   workflow.UpdatedAt,
+		ID:                 workflow.ID,
+		Name:               workflow.Name,
+		Description:        workflow.Description,
+		IsDraft:            workflow.IsDraft,
+		IsValid:            workflow.IsValid,
+		Data:               data,
+		IsTemplate:         workflow.IsTemplate,
+		TemplateParameters: templateParameters,
+		CreatedAt:          workflow.CreatedAt,
+		UpdatedAt:          workflow.UpdatedAt,
 	})
 	if err != nil {
 		return fmt.Errorf("queries create workflow: %w", err)
@@ -141,18 +188,29 @@ func (r workflowRepository) UpdateWorkflow(ctx context.Context, db sqldb.SQLDB,
 		return workflow.Workflow{}, fmt.Errorf("marshal workflow data: %w", err)
 	}
 
+	templateParameters, err := marshalTemplateParameters(params.TemplateParameters)
+	if err != nil {
+		return workflow.Workflow{}, fmt.Errorf("marshal workflow template parameters: %w", err)
+	}
+
 	row, err := r.queries.WorkflowUpdate(ctx, db, sqlcpg.WorkflowUpdateParams{
-		ID:             params.ID,
-		Name:           params.Name,
-		SetName:        params.SetName,
-		Description:    params.Description,
-		SetDescription: params.SetDescription,
-		IsDraft:        params.IsDraft,
-		SetIsDraft:     params.SetIsDraft,
-		IsValid:        params.IsValid,
-		SetIsValid:     params.SetIsValid,
-		Data:           data,
-		SetData:        params.SetData,
+		ID:                    params.ID,
+		Name:                  params.Name,
+		SetName:               params.SetName,
+		Description:           params.Description,
+		SetDescription:        params.SetDescription,
+		IsDraft:               params.IsDraft,
+		SetIsDraft:            params.SetIsDraft,
+		IsValid:               params.IsValid,
+		SetIsValid:            params.SetIsValid,
+		Data:                  data,
+		SetData:               params.SetData,
+		PublishedVersion:      params.PublishedVersion,
+		SetPublishedVersion:   params.SetPublishedVersion,
+		IsTemplate:            params.IsTemplate,
+		SetIsTemplate:         params.SetIsTemplate,
+		TemplateParameters:    templateParameters,
+		SetTemplateParameters: params.SetTemplateParameters,
 	})
 	if err != nil {
 		if sqldb.IsNoRowsError(err) {
@@ -187,14 +245,31 @@ func workflowRowToModel(row sqlcpg.Workflow) (workflow.Workflow, error) {
 		return workflow.Workflow{}, fmt.Errorf("unmarshal workflow data: %w", err)
 	}
 
+	templateParameters := []workflow.TemplateParameter{}
+	if err := json.Unmarshal(row.TemplateParameters, &templateParameters); err != nil {
+		return workflow.Workflow{}, fmt.Errorf("unmarshal workflow template parameters: %w", err)
+	}
+
 	return workflow.Workflow{
-		ID:          row.ID,
-		Name:        row.Name,
-		Description: row.Description,
-		IsDraft:     row.IsDraft,
-		IsValid:     row.IsValid,
-		Data:        data,
-		CreatedAt:   row.CreatedAt,
-		UpdatedAt:   row.UpdatedAt,
+		ID:                 row.ID,
+		Name:               row.Name,
+		Description:        row.Description,
+		IsDraft:            row.IsDraft,
+		IsValid:            row.IsValid,
+		Data:               data,
+		PublishedVersion:   row.PublishedVersion,
+		IsTemplate:         row.IsTemplate,
+		TemplateParameters: templateParameters,
+		CreatedAt:          row.CreatedAt,
+		UpdatedAt:          row.UpdatedAt,
 	}, nil
 }
+
+// marshalTemplateParameters marshals the template parameters, a nil slice is
+// stored as an empty array.
+func marshalTemplateParameters(params []workflow.TemplateParameter) ([]byte, error) {
+	if params == nil {
+		params = []workflow.TemplateParameter{}
+	}
+	return json.Marshal(params)
+}
@@ -4,14 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
 	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
@@ -54,16 +57,98 @@ func (r workflowExecutionRepository) ListWorkflowExecutionsByWorkflowID(
 	pagingParams paging.Params,
 	sorts []sort.Sort,
 	workflowID string,
+	isSimulated *bool,
 ) (paging.List[workflowexecution.WorkflowExecution], error) {
+	where := sq.And{sq.Eq{"workflow_id": workflowID}}
+	if isSimulated != nil {
+		where = append(where, sq.Eq{"is_simulated": *isSimulated})
+	}
+
+	return r.listWorkflowExecutions(ctx, db, pagingParams, sorts, func(b sq.SelectBuilder) sq.SelectBuilder {
+		return b.Where(where)
+	})
+}
+
+func (r workflowExecutionRepository) ListWorkflowExecutions(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+	filters []filter.Filter,
+) (paging.List[workflowexecution.WorkflowExecution], error) {
+	return r.listWorkflowExecutions(ctx, db, pagingParams, sorts, func(b sq.SelectBuilder) sq.SelectBuilder {
+		for _, f := range filters {
+			b = f.Attach(b)
+		}
+		return b
+	})
+}
+
+func (r workflowExecutionRepository) CountWorkflowExecutionsByStatus(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	filters []filter.Filter,
+) (map[workflowexecution.Status]int64, error) {
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-	query := psql.Select("*").
+	query := psql.Select("status", "COUNT(*)").
+		From("workflow_executions").
+		GroupBy("status")
+	for _, f := range filters {
+		query = f.Attach(query)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("queries count workflow executions by status: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[workflowexecution.Status]int64, len(workflowexecution.StatusMap))
+	for status := range workflowexecution.StatusMap {
+		counts[status] = 0
+	}
+	for rows.Next() {
+		var status string
+		var count int64
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("scan workflow execution count: %w", err)
+		}
+		counts[workflowexecution.Status(status)] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// listWorkflowExecutions lists the workflow executions selected by where, a
+// page at a time.
+func (r workflowExecutionRepository) listWorkflowExecutions(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+	where func(sq.SelectBuilder) sq.SelectBuilder,
+) (paging.List[workflowexecution.WorkflowExecution], error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	query := where(psql.Select("*").
 		From("workflow_executions").
 		Limit(uint64(pagingParams.Limit())).
-		Offset(uint64(pagingParams.Offset())).
-		Where(sq.Eq{"workflow_id": workflowID})
+		Offset(uint64(pagingParams.Offset())))
 
-	for _, s := range sorts {
-		query = s.Attach(query)
+	if len(sorts) == 0 {
+		sorts = []sort.Sort{{Col: "created_at", Order: sort.OrderDESC}}
+	}
+	keyset := paging.NewKeyset(sorts, "id", "started_at", "completed_at")
+	query, err := keyset.Attach(query, pagingParams.Cursor)
+	if err != nil {
+		return paging.List[workflowexecution.WorkflowExecution]{}, err
 	}
 
 	sql, args, err := query.ToSql()
@@ -89,8 +174,13 @@ func (r workflowExecutionRepository) ListWorkflowExecutionsByWorkflowID(
 			&i.Outputs,
 			&i.Error,
 			&i.CreatedAt,
+			&i.UpdatedAt,
 			&i.StartedAt,
 			&i.CompletedAt,
+			&i.WorkflowVersionID,
+			&i.IsSimulated,
+			&i.TriggerType,
+			&i.RaybotID,
 		); err != nil {
 			return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("scan workflow execution: %w", err)
 		}
@@ -106,21 +196,43 @@ func (r workflowExecutionRepository) ListWorkflowExecutionsByWorkflowID(
 		return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("rows error: %w", err)
 	}
 
-	countQuery := psql.Select("COUNT(*)").
-		From("workflow_executions").
-		Where(sq.Eq{"workflow_id": workflowID})
+	ret := paging.NewUncountedList(items)
+	if !pagingParams.SkipCount {
+		countQuery := where(psql.Select("COUNT(*)").From("workflow_executions"))
 
-	countSQL, countArgs, err := countQuery.ToSql()
-	if err != nil {
-		return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("build count query: %w", err)
+		countSQL, countArgs, err := countQuery.ToSql()
+		if err != nil {
+			return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("build count query: %w", err)
+		}
+
+		var count int64
+		if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
+			return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("queries count workflow executions: %w", err)
+		}
+		ret = paging.NewList(items, count)
 	}
 
-	var count int64
-	if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
-		return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("queries count workflow executions: %w", err)
+	if len(items) > 0 && len(items) == int(pagingParams.Limit()) {
+		ret.NextCursor, err = keyset.Cursor(workflowExecutionSortKey(items[len(items)-1]))
+		if err != nil {
+			return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("make cursor: %w", err)
+		}
 	}
 
-	return paging.NewList(items, count), nil
+	return ret, nil
+}
+
+// workflowExecutionSortKey returns the values of the sortable columns of we.
+func workflowExecutionSortKey(we workflowexecution.WorkflowExecution) map[string]any {
+	return map[string]any{
+		"id":           we.ID,
+		"status":       we.Status,
+		"started_at":   we.StartedAt,
+		"completed_at": we.CompletedAt,
+		"created_at":   we.CreatedAt,
+		"updated_at":   we.UpdatedAt,
+		"trigger_type": we.TriggerType,
+	}
 }
 
 func (r workflowExecutionRepository) CreateWorkflowExecution(ctx context.Context, db sqldb.SQLDB, workflowExecution workflowexecution.WorkflowExecution) error {
@@ -140,16 +252,20 @@ func (r workflowExecutionRepository) CreateWorkflowExecution(ctx context.Context
 	}
 
 	err = r.queries.WorkflowExecutionInsert(ctx, db, sqlcpg.WorkflowExecutionInsertParams{
-		ID:          workflowExecution.ID,
-		WorkflowID:  workflowExecution.WorkflowID,
-		Status:      string(workflowExecution.Status),
-		Data:        data,
-		Inputs:      inputs,
-		Outputs:     outputs,
-		Error:       workflowExecution.Error,
-		CreatedAt:   workflowExecution.CreatedAt,
-		StartedAt:   workflowExecution.StartedAt,
-		CompletedAt: workflowExecution.CompletedAt,
+		ID:                workflowExecution.ID,
+		WorkflowID:        workflowExecution.WorkflowID,
+		Status:            string(workflowExecution.Status),
+		Data:              data,
+		Inputs:            inputs,
+		Outputs:           outputs,
+		Error:             workflowExecution.Error,
+		CreatedAt:         workflowExecution.CreatedAt,
+		StartedAt:         workflowExecution.StartedAt,
+		CompletedAt:       workflowExecution.CompletedAt,
+		WorkflowVersionID: workflowExecution.WorkflowVersionID,
+		IsSimulated:       workflowExecution.IsSimulated,
+		TriggerType:       string(workflowExecution.TriggerType),
+		RaybotID:          workflowExecution.RaybotID,
 	})
 	if err != nil {
 		return fmt.Errorf("queries create workflow execution: %w", err)
@@ -199,6 +315,124 @@ func (r workflowExecutionRepository) UpdateWorkflowExecution(ctx context.Context
 	return ret, nil
 }
 
+func (r workflowExecutionRepository) ListExpiredWorkflowExecutions(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	status workflowexecution.Status,
+	before time.Time,
+	limit int32,
+) ([]workflowexecution.WorkflowExecution, error) {
+	rows, err := r.queries.WorkflowExecutionListExpired(ctx, db, sqlcpg.WorkflowExecutionListExpiredParams{
+		Status:    string(status),
+		Before:    before,
+		BatchSize: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("queries list expired workflow executions: %w", err)
+	}
+
+	items := make([]workflowexecution.WorkflowExecution, len(rows))
+	for i, row := range rows {
+		items[i], err = workflowExecutionRowToModel(row)
+		if err != nil {
+			return nil, fmt.Errorf("convert workflow execution row to model: %w", err)
+		}
+	}
+
+	return items, nil
+}
+
+func (r workflowExecutionRepository) DeleteWorkflowExecutionsByIDs(ctx context.Context, db sqldb.SQLDB, ids []string) (int64, error) {
+	count, err := r.queries.WorkflowExecutionDeleteByIDs(ctx, db, ids)
+	if err != nil {
+		return 0, fmt.Errorf("queries delete workflow executions by ids: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r workflowExecutionRepository) CreateWorkflowExecutionEvent(ctx context.Context, db sqldb.SQLDB, event workflowexecution.Event) (workflowexecution.Event, error) {
+	var outputs []byte
+	if event.Outputs != nil {
+		var err error
+		outputs, err = json.Marshal(event.Outputs)
+		if err != nil {
+			return workflowexecution.Event{}, fmt.Errorf("marshal outputs: %w", err)
+		}
+	}
+
+	id, err := r.queries.WorkflowExecutionEventInsert(ctx, db, sqlcpg.WorkflowExecutionEventInsertParams{
+		WorkflowExecutionID: event.WorkflowExecutionID,
+		StepExecutionID:     event.StepExecutionID,
+		Type:                string(event.Type),
+		Status:              event.Status,
+		Outputs:             outputs,
+		Error:               event.Error,
+		CreatedAt:           event.CreatedAt,
+	})
+	if err != nil {
+		return workflowexecution.Event{}, fmt.Errorf("queries create workflow execution event: %w", err)
+	}
+
+	event.ID = id
+	return event, nil
+}
+
+func (r workflowExecutionRepository) ListWorkflowExecutionEvents(ctx context.Context, db sqldb.SQLDB, workflowExecutionID string, afterID int64) ([]workflowexecution.Event, error) {
+	rows, err := r.queries.WorkflowExecutionEventListAfterID(ctx, db, sqlcpg.WorkflowExecutionEventListAfterIDParams{
+		WorkflowExecutionID: workflowExecutionID,
+		AfterID:             afterID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("queries list workflow execution events: %w", err)
+	}
+
+	return workflowExecutionEventRowsToModel(rows)
+}
+
+func (r workflowExecutionRepository) ListEventsByWorkflowExecutionIDs(ctx context.Context, db sqldb.SQLDB, workflowExecutionIDs []string) ([]workflowexecution.Event, error) {
+	rows, err := r.queries.WorkflowExecutionEventListByWorkflowExecutionIDs(ctx, db, workflowExecutionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("queries list events by workflow execution ids: %w", err)
+	}
+
+	return workflowExecutionEventRowsToModel(rows)
+}
+
+func (r workflowExecutionRepository) DeleteEventsByWorkflowExecutionIDs(ctx context.Context, db sqldb.SQLDB, workflowExecutionIDs []string) (int64, error) {
+	count, err := r.queries.WorkflowExecutionEventDeleteByWorkflowExecutionIDs(ctx, db, workflowExecutionIDs)
+	if err != nil {
+		return 0, fmt.Errorf("queries delete events by workflow execution ids: %w", err)
+	}
+
+	return count, nil
+}
+
+func workflowExecutionEventRowsToModel(rows []sqlcpg.WorkflowExecutionEvent) ([]workflowexecution.Event, error) {
+	events := make([]workflowexecution.Event, 0, len(rows))
+	for _, row := range rows {
+		var outputs map[string]any
+		if len(row.Outputs) > 0 {
+			if err := json.Unmarshal(row.Outputs, &outputs); err != nil {
+				return nil, fmt.Errorf("unmarshal outputs: %w", err)
+			}
+		}
+
+		events = append(events, workflowexecution.Event{
+			ID:                  row.ID,
+			WorkflowExecutionID: row.WorkflowExecutionID,
+			StepExecutionID:     row.StepExecutionID,
+			Type:                workflowexecution.EventType(row.Type),
+			Status:              row.Status,
+			Outputs:             outputs,
+			Error:               row.Error,
+			CreatedAt:           row.CreatedAt,
+		})
+	}
+
+	return events, nil
+}
+
 func workflowExecutionRowToModel(row sqlcpg.WorkflowExecution) (workflowexecution.WorkflowExecution, error) {
 	data := workflow.Data{}
 	if err := json.Unmarshal(row.Data, &data); err != nil {
@@ -216,14 +450,20 @@ func workflowExecutionRowToModel(row sqlcpg.WorkflowExecution) (workflowexecutio
 	}
 
 	return workflowexecution.WorkflowExecution{
-		ID:          row.ID,
-		WorkflowID:  row.WorkflowID,
-		Status:      workflowexecution.Status(row.Status),
-		Data:        data,
-		Inputs:      inputs,
-		Outputs:     outputs,
-		Error:       row.Error,
-		StartedAt:   row.StartedAt,
-		CompletedAt: row.CompletedAt,
+		ID:                row.ID,
+		WorkflowID:        row.WorkflowID,
+		WorkflowVersionID: row.WorkflowVersionID,
+		IsSimulated:       row.IsSimulated,
+		TriggerType:       node.TriggerType(row.TriggerType),
+		RaybotID:          row.RaybotID,
+		Status:            workflowexecution.Status(row.Status),
+		Data:              data,
+		Inputs:            inputs,
+		Outputs:           outputs,
+		Error:             row.Error,
+		CreatedAt:         row.CreatedAt,
+		UpdatedAt:         row.UpdatedAt,
+		StartedAt:         row.StartedAt,
+		CompletedAt:       row.CompletedAt,
 	}, nil
 }
@@ -0,0 +1,171 @@
+package repoimpl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqlcpg"
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var (
+	_ repository.WorkflowVersionRepository = (*workflowVersionRepository)(nil)
+
+	workflowVersionConstraint = "workflow_versions_workflow_id_version_key"
+
+	ErrWorkflowVersionNotFound      = xerror.NotFound(nil, "workflowVersion.notFound", "workflow version not found")
+	ErrWorkflowVersionAlreadyExists = xerror.Conflict(nil, "workflowVersion.alreadyExists", "workflow version already exists")
+)
+
+type workflowVersionRepository struct {
+	queries sqlcpg.Queries
+}
+
+func newWorkflowVersionRepository(queries sqlcpg.Queries) *workflowVersionRepository {
+	return &workflowVersionRepository{queries: queries}
+}
+
+func (r workflowVersionRepository) GetWorkflowVersion(ctx context.Context, db sqldb.SQLDB, workflowID string, version int32) (workflow.Version, error) {
+	row, err := r.queries.WorkflowVersionGetByVersion(ctx, db, sqlcpg.WorkflowVersionGetByVersionParams{
+		WorkflowID: workflowID,
+		Version:    version,
+	})
+	if err != nil {
+		if sqldb.IsNoRowsError(err) {
+			return workflow.Version{}, ErrWorkflowVersionNotFound
+		}
+		return workflow.Version{}, fmt.Errorf("queries get workflow version by version: %w", err)
+	}
+
+	m, err := workflowVersionRowToModel(row)
+	if err != nil {
+		return workflow.Version{}, fmt.Errorf("workflow version row to model: %w", err)
+	}
+
+	return m, nil
+}
+
+func (r workflowVersionRepository) GetLatestVersionNumber(ctx context.Context, db sqldb.SQLDB, workflowID string) (int32, error) {
+	version, err := r.queries.WorkflowVersionGetMaxVersion(ctx, db, workflowID)
+	if err != nil {
+		return 0, fmt.Errorf("queries get max workflow version: %w", err)
+	}
+
+	return version, nil
+}
+
+func (r workflowVersionRepository) ListWorkflowVersions(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	pagingParams paging.Params,
+	sorts []sort.Sort,
+	workflowID string,
+) (paging.List[workflow.Version], error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	query := psql.Select("*").
+		From("workflow_versions").
+		Limit(uint64(pagingParams.Limit())).
+		Offset(uint64(pagingParams.Offset())).
+		Where(sq.Eq{"workflow_id": workflowID})
+
+	for _, s := range sorts {
+		query = s.Attach(query)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return paging.List[workflow.Version]{}, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return paging.List[workflow.Version]{}, fmt.Errorf("queries list workflow versions: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]workflow.Version, 0, pagingParams.Limit())
+	for rows.Next() {
+		var i sqlcpg.WorkflowVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkflowID,
+			&i.Version,
+			&i.Data,
+			&i.CreatedAt,
+		); err != nil {
+			return paging.List[workflow.Version]{}, fmt.Errorf("scan workflow version: %w", err)
+		}
+
+		item, err := workflowVersionRowToModel(i)
+		if err != nil {
+			return paging.List[workflow.Version]{}, fmt.Errorf("workflow version row to model: %w", err)
+		}
+
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return paging.List[workflow.Version]{}, fmt.Errorf("rows error: %w", err)
+	}
+
+	countQuery := psql.Select("COUNT(*)").
+		From("workflow_versions").
+		Where(sq.Eq{"workflow_id": workflowID})
+
+	countSQL, countArgs, err := countQuery.ToSql()
+	if err != nil {
+		return paging.List[workflow.Version]{}, fmt.Errorf("build count query: %w", err)
+	}
+
+	var count int64
+	if err := db.QueryRow(ctx, countSQL, countArgs...).Scan(&count); err != nil {
+		return paging.List[workflow.Version]{}, fmt.Errorf("queries count workflow versions: %w", err)
+	}
+
+	return paging.NewList(items, count), nil
+}
+
+func (r workflowVersionRepository) CreateWorkflowVersion(ctx context.Context, db sqldb.SQLDB, version workflow.Version) error {
+	data, err := json.Marshal(version.Data)
+	if err != nil {
+		return fmt.Errorf("marshal workflow version data: %w", err)
+	}
+
+	err = r.queries.WorkflowVersionInsert(ctx, db, sqlcpg.WorkflowVersionInsertParams{
+		ID:         version.ID,
+		WorkflowID: version.WorkflowID,
+		Version:    version.Version,
+		Data:       data,
+		CreatedAt:  version.CreatedAt,
+	})
+	if err != nil {
+		if sqldb.IsUniqueViolationError(err, workflowVersionConstraint) {
+			return ErrWorkflowVersionAlreadyExists
+		}
+		return fmt.Errorf("queries create workflow version: %w", err)
+	}
+
+	return nil
+}
+
+func workflowVersionRowToModel(row sqlcpg.WorkflowVersion) (workflow.Version, error) {
+	var data workflow.Data
+	if err := json.Unmarshal(row.Data, &data); err != nil {
+		return workflow.Version{}, fmt.Errorf("unmarshal workflow version data: %w", err)
+	}
+
+	return workflow.Version{
+		ID:         row.ID,
+		WorkflowID: row.WorkflowID,
+		Version:    row.Version,
+		Data:       data,
+		CreatedAt:  row.CreatedAt,
+	}, nil
+}
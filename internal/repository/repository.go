@@ -2,9 +2,17 @@ package repository
 
 type Repository interface {
 	QRLocation() QRLocationRepository
+	TrackSegment() TrackSegmentRepository
+	TrackReservation() TrackReservationRepository
 	Raybot() RaybotRepository
 	RaybotCommand() RaybotCommandRepository
+	RaybotPosition() RaybotPositionRepository
 	Workflow() WorkflowRepository
+	WorkflowVersion() WorkflowVersionRepository
 	WorkflowExecution() WorkflowExecutionRepository
 	StepExecution() StepExecutionRepository
+	Outbox() OutboxRepository
+	WebhookSubscription() WebhookSubscriptionRepository
+	WebhookDelivery() WebhookDeliveryRepository
+	DeadLetterMessage() DeadLetterMessageRepository
 }
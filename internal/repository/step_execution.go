@@ -6,6 +6,8 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
 
 type UpdateStepExecutionParams struct {
@@ -27,8 +29,23 @@ type StepExecutionRepository interface {
 	// GetStepExecution gets a StepExecution by ID.
 	GetStepExecution(ctx context.Context, db sqldb.SQLDB, id string) (stepexecution.StepExecution, error)
 
-	// ListStepsByWorkflowExecutionID lists all Steps by WorkflowExecution ID.
-	ListStepsByWorkflowExecutionID(ctx context.Context, db sqldb.SQLDB, workflowExecutionID string) ([]stepexecution.StepExecution, error)
+	// ListStepsByWorkflowExecutionID lists the Steps of a WorkflowExecution a
+	// page at a time.
+	ListStepsByWorkflowExecutionID(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		workflowExecutionID string,
+		pagingParams paging.Params,
+		sorts []sort.Sort,
+	) (paging.List[stepexecution.StepExecution], error)
+
+	// ListStepsByWorkflowExecutionIDs lists all Steps of multiple
+	// WorkflowExecutions.
+	ListStepsByWorkflowExecutionIDs(ctx context.Context, db sqldb.SQLDB, workflowExecutionIDs []string) ([]stepexecution.StepExecution, error)
+
+	// DeleteStepsByWorkflowExecutionIDs deletes all Steps of multiple
+	// WorkflowExecutions and returns the number of deleted Steps.
+	DeleteStepsByWorkflowExecutionIDs(ctx context.Context, db sqldb.SQLDB, workflowExecutionIDs []string) (int64, error)
 
 	// BatchCreateStepExecutions creates multiple Steps.
 	BatchCreateStepExecutions(ctx context.Context, db sqldb.SQLDB, steps []stepexecution.StepExecution) error
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	trackreservation "github.com/tuanvumaihuynh/roboflow/internal/model/track_reservation"
+)
+
+type TrackReservationRepository interface {
+	// LockTrackReservations locks the Reservations until the end of the
+	// transaction, so a single transaction schedules them at a time.
+	LockTrackReservations(ctx context.Context, db sqldb.SQLDB) error
+
+	// ListActiveTrackReservations lists the Reservations of the unfinished
+	// commands, ordered by creation.
+	ListActiveTrackReservations(ctx context.Context, db sqldb.SQLDB) ([]trackreservation.Reservation, error)
+
+	// CreateTrackReservation creates a new Reservation.
+	CreateTrackReservation(ctx context.Context, db sqldb.SQLDB, reservation trackreservation.Reservation) error
+
+	// UpdateTrackReservation updates the status and the route of a
+	// Reservation.
+	UpdateTrackReservation(ctx context.Context, db sqldb.SQLDB, reservation trackreservation.Reservation) error
+
+	// DeleteTrackReservation deletes the Reservation of a command, if any.
+	DeleteTrackReservation(ctx context.Context, db sqldb.SQLDB, commandID string) error
+}
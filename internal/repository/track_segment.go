@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type UpdateTrackSegmentParams struct {
+	ID                string
+	FromLocationID    string
+	SetFromLocationID bool
+	ToLocationID      string
+	SetToLocationID   bool
+	Distance          int32
+	SetDistance       bool
+	Direction         raybotcommand.MoveDirection
+	SetDirection      bool
+}
+
+type TrackSegmentRepository interface {
+	// GetTrackSegment gets a Segment by its ID.
+	GetTrackSegment(ctx context.Context, db sqldb.SQLDB, id string) (trackmap.Segment, error)
+
+	// ListTrackSegments lists the Segments.
+	ListTrackSegments(ctx context.Context, db sqldb.SQLDB, pagingParams paging.Params, sorts []sort.Sort) (paging.List[trackmap.Segment], error)
+
+	// ListAllTrackSegments lists every Segment, ordered by creation.
+	ListAllTrackSegments(ctx context.Context, db sqldb.SQLDB) ([]trackmap.Segment, error)
+
+	// CreateTrackSegment creates a new Segment.
+	CreateTrackSegment(ctx context.Context, db sqldb.SQLDB, segment trackmap.Segment) error
+
+	// UpdateTrackSegment updates a Segment.
+	UpdateTrackSegment(ctx context.Context, db sqldb.SQLDB, params UpdateTrackSegmentParams) (trackmap.Segment, error)
+
+	// DeleteTrackSegment deletes a Segment.
+	DeleteTrackSegment(ctx context.Context, db sqldb.SQLDB, id string) error
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type UpdateWebhookSubscriptionParams struct {
+	ID            string
+	URL           string
+	SetURL        bool
+	Secret        string
+	SetSecret     bool
+	EventTypes    []string
+	SetEventTypes bool
+	IsActive      bool
+	SetIsActive   bool
+}
+
+type WebhookSubscriptionRepository interface {
+	// GetWebhookSubscription gets a Subscription by its ID.
+	GetWebhookSubscription(ctx context.Context, db sqldb.SQLDB, id string) (webhook.Subscription, error)
+
+	// ListWebhookSubscriptions lists all Subscriptions.
+	ListWebhookSubscriptions(ctx context.Context, db sqldb.SQLDB, pagingParams paging.Params, sorts []sort.Sort) (paging.List[webhook.Subscription], error)
+
+	// ListActiveWebhookSubscriptionsByEventType lists the active
+	// Subscriptions receiving events of eventType.
+	ListActiveWebhookSubscriptionsByEventType(ctx context.Context, db sqldb.SQLDB, eventType string) ([]webhook.Subscription, error)
+
+	// CreateWebhookSubscription creates a new Subscription.
+	CreateWebhookSubscription(ctx context.Context, db sqldb.SQLDB, subscription webhook.Subscription) error
+
+	// UpdateWebhookSubscription updates a Subscription.
+	UpdateWebhookSubscription(ctx context.Context, db sqldb.SQLDB, params UpdateWebhookSubscriptionParams) (webhook.Subscription, error)
+
+	// DeleteWebhookSubscription deletes a Subscription and all its Deliveries.
+	DeleteWebhookSubscription(ctx context.Context, db sqldb.SQLDB, id string) error
+}
+
+type WebhookDeliveryRepository interface {
+	// GetWebhookDeliveryForUpdate gets a Delivery of a Subscription by its ID
+	// and locks it until the transaction ends.
+	GetWebhookDeliveryForUpdate(ctx context.Context, db sqldb.SQLDB, subscriptionID string, id string) (webhook.Delivery, error)
+
+	// ListWebhookDeliveriesBySubscriptionID lists the Deliveries of a
+	// Subscription, optionally filtered by status.
+	ListWebhookDeliveriesBySubscriptionID(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		subscriptionID string,
+		pagingParams paging.Params,
+		sorts []sort.Sort,
+		status *webhook.DeliveryStatus,
+	) (paging.List[webhook.Delivery], error)
+
+	// CreateWebhookDelivery creates a new Delivery. It does nothing if the
+	// Subscription already has a Delivery of the same event.
+	CreateWebhookDelivery(ctx context.Context, db sqldb.SQLDB, delivery webhook.Delivery) error
+
+	// ClaimDueWebhookDeliveries claims at most limit pending Deliveries of
+	// active Subscriptions due for an attempt. They are not claimed again
+	// before leaseUntil.
+	ClaimDueWebhookDeliveries(ctx context.Context, db sqldb.SQLDB, limit int32, leaseUntil time.Time) ([]webhook.Delivery, error)
+
+	// UpdateWebhookDelivery saves the status and attempts of a Delivery.
+	UpdateWebhookDelivery(ctx context.Context, db sqldb.SQLDB, delivery webhook.Delivery) error
+}
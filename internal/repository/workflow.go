@@ -5,30 +5,49 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
 
 type UpdateWorkflowParams struct {
-	ID             string
-	Name           string
-	SetName        bool
-	Description    *string
-	SetDescription bool
-	IsDraft        bool
-	SetIsDraft     bool
-	IsValid        bool
-	SetIsValid     bool
-	Data           workflow.Data
-	SetData        bool
+	ID                    string
+	Name                  string
+	SetName               bool
+	Description           *string
+	SetDescription        bool
+	IsDraft               bool
+	SetIsDraft            bool
+	IsValid               bool
+	SetIsValid            bool
+	Data                  workflow.Data
+	SetData               bool
+	PublishedVersion      *int32
+	SetPublishedVersion   bool
+	IsTemplate            bool
+	SetIsTemplate         bool
+	TemplateParameters    []workflow.TemplateParameter
+	SetTemplateParameters bool
 }
 
 type WorkflowRepository interface {
 	// GetWorkflow gets a Workflow by its ID.
 	GetWorkflow(ctx context.Context, db sqldb.SQLDB, id string) (workflow.Workflow, error)
 
-	// ListWorkflows lists all Workflows.
-	ListWorkflows(ctx context.Context, db sqldb.SQLDB, pagingParams paging.Params, sorts []sort.Sort) (paging.List[workflow.Workflow], error)
+	// GetWorkflowForUpdate gets a Workflow by its ID and locks it until the end of the transaction.
+	GetWorkflowForUpdate(ctx context.Context, db sqldb.SQLDB, id string) (workflow.Workflow, error)
+
+	// ListWorkflows lists all Workflows matching the filters and whose name
+	// matches search, optionally filtered by whether they are templates.
+	ListWorkflows(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		pagingParams paging.Params,
+		sorts []sort.Sort,
+		filters []filter.Filter,
+		search string,
+		isTemplate *bool,
+	) (paging.List[workflow.Workflow], error)
 
 	// CreateWorkflow creates a new Workflow.
 	CreateWorkflow(ctx context.Context, db sqldb.SQLDB, workflow workflow.Workflow) error
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
@@ -37,11 +38,59 @@ type WorkflowExecutionRepository interface {
 		pagingParams paging.Params,
 		sorts []sort.Sort,
 		workflowID string,
+		isSimulated *bool,
 	) (paging.List[workflowexecution.WorkflowExecution], error)
 
+	// ListWorkflowExecutions lists the WorkflowExecutions of all Workflows.
+	ListWorkflowExecutions(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		pagingParams paging.Params,
+		sorts []sort.Sort,
+		filters []filter.Filter,
+	) (paging.List[workflowexecution.WorkflowExecution], error)
+
+	// CountWorkflowExecutionsByStatus counts the WorkflowExecutions of all
+	// Workflows matching the filters, by Status. Every Status has a count.
+	CountWorkflowExecutionsByStatus(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		filters []filter.Filter,
+	) (map[workflowexecution.Status]int64, error)
+
 	// CreateWorkflowExecution creates a new WorkflowExecution.
 	CreateWorkflowExecution(ctx context.Context, db sqldb.SQLDB, workflowExecution workflowexecution.WorkflowExecution) error
 
 	// UpdateWorkflowExecution updates a WorkflowExecution.
 	UpdateWorkflowExecution(ctx context.Context, db sqldb.SQLDB, params UpdateWorkflowExecutionParams) (workflowexecution.WorkflowExecution, error)
+
+	// ListExpiredWorkflowExecutions lists at most limit WorkflowExecutions of
+	// a Status completed before a time, or last updated before it when they
+	// have no completion time, oldest first. They stay locked until the end of
+	// the transaction, the ones locked by another transaction are skipped.
+	ListExpiredWorkflowExecutions(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		status workflowexecution.Status,
+		before time.Time,
+		limit int32,
+	) ([]workflowexecution.WorkflowExecution, error)
+
+	// DeleteWorkflowExecutionsByIDs deletes the WorkflowExecutions by their
+	// IDs and returns the number of deleted WorkflowExecutions.
+	DeleteWorkflowExecutionsByIDs(ctx context.Context, db sqldb.SQLDB, ids []string) (int64, error)
+
+	// CreateWorkflowExecutionEvent creates a new Event and returns it with its ID set.
+	CreateWorkflowExecutionEvent(ctx context.Context, db sqldb.SQLDB, event workflowexecution.Event) (workflowexecution.Event, error)
+
+	// ListWorkflowExecutionEvents lists the Events of a WorkflowExecution with an ID greater than afterID, in order.
+	ListWorkflowExecutionEvents(ctx context.Context, db sqldb.SQLDB, workflowExecutionID string, afterID int64) ([]workflowexecution.Event, error)
+
+	// ListEventsByWorkflowExecutionIDs lists all Events of multiple
+	// WorkflowExecutions.
+	ListEventsByWorkflowExecutionIDs(ctx context.Context, db sqldb.SQLDB, workflowExecutionIDs []string) ([]workflowexecution.Event, error)
+
+	// DeleteEventsByWorkflowExecutionIDs deletes all Events of multiple
+	// WorkflowExecutions and returns the number of deleted Events.
+	DeleteEventsByWorkflowExecutionIDs(ctx context.Context, db sqldb.SQLDB, workflowExecutionIDs []string) (int64, error)
 }
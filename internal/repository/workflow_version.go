@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type WorkflowVersionRepository interface {
+	// GetWorkflowVersion gets a Version of a Workflow by its number.
+	GetWorkflowVersion(ctx context.Context, db sqldb.SQLDB, workflowID string, version int32) (workflow.Version, error)
+
+	// GetLatestVersionNumber gets the number of the latest Version of a Workflow, 0 if there is none.
+	GetLatestVersionNumber(ctx context.Context, db sqldb.SQLDB, workflowID string) (int32, error)
+
+	// ListWorkflowVersions lists all Versions of a Workflow.
+	ListWorkflowVersions(
+		ctx context.Context,
+		db sqldb.SQLDB,
+		pagingParams paging.Params,
+		sorts []sort.Sort,
+		workflowID string,
+	) (paging.List[workflow.Version], error)
+
+	// CreateWorkflowVersion creates a new Version.
+	CreateWorkflowVersion(ctx context.Context, db sqldb.SQLDB, version workflow.Version) error
+}
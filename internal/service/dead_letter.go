@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	deadletter "github.com/tuanvumaihuynh/roboflow/internal/model/dead_letter"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type GetDeadLetterMessageParams struct {
+	ID string `validate:"required,uuid"`
+}
+
+type ListDeadLetterMessagesParams struct {
+	PagingParams paging.Params `validate:"required"`
+	Sorts        []sort.Sort   `validate:"sort=topic handler replay_count last_replayed_at created_at"`
+	Topic        *string
+	Handler      *string
+	Replayed     *bool
+}
+
+type CreateDeadLetterMessageParams struct {
+	ID         string `validate:"required,uuid"`
+	MessageID  string `validate:"required"`
+	Topic      string `validate:"required"`
+	Handler    string
+	Subscriber string
+	Reason     string
+	Payload    []byte
+	Metadata   map[string]string
+}
+
+type ReplayDeadLetterMessageParams struct {
+	ID string `validate:"required,uuid"`
+}
+
+type DeleteDeadLetterMessageParams struct {
+	ID string `validate:"required,uuid"`
+}
+
+type PurgeDeadLetterMessagesParams struct {
+	Topic         *string
+	Handler       *string
+	Replayed      *bool
+	CreatedBefore *time.Time
+}
+
+type DeadLetterService interface {
+	// GetDeadLetterMessage gets a dead-letter Message by its ID.
+	GetDeadLetterMessage(ctx context.Context, params GetDeadLetterMessageParams) (deadletter.Message, error)
+
+	// ListDeadLetterMessages lists the dead-letter Messages, optionally
+	// filtered by topic, handler and whether they were replayed.
+	ListDeadLetterMessages(ctx context.Context, params ListDeadLetterMessagesParams) (paging.List[deadletter.Message], error)
+
+	// CreateDeadLetterMessage stores a message moved to the dead-letter
+	// topic. Storing the same message twice creates no new Message.
+	CreateDeadLetterMessage(ctx context.Context, params CreateDeadLetterMessageParams) error
+
+	// ReplayDeadLetterMessage publishes a dead-letter Message again to its
+	// original topic, under a new UUID. Every consumer of the topic receives
+	// it, not only the handler which failed. The Message is kept, with its
+	// replay count incremented.
+	ReplayDeadLetterMessage(ctx context.Context, params ReplayDeadLetterMessageParams) (deadletter.Message, error)
+
+	// DeleteDeadLetterMessage deletes a dead-letter Message.
+	DeleteDeadLetterMessage(ctx context.Context, params DeleteDeadLetterMessageParams) error
+
+	// PurgeDeadLetterMessages deletes the dead-letter Messages matching the
+	// filters, every Message without filters, and returns the number of
+	// deleted Messages.
+	PurgeDeadLetterMessages(ctx context.Context, params PurgeDeadLetterMessagesParams) (int64, error)
+}
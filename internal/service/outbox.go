@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/outbox"
+)
+
+type RelayOutboxMessagesParams struct {
+	BatchSize int32 `validate:"required,min=1,max=1000"`
+}
+
+type CleanupOutboxMessagesParams struct {
+	PublishedBefore time.Time `validate:"required"`
+}
+
+type OutboxService interface {
+	// RelayOutboxMessages publishes a batch of outbox Messages, oldest first,
+	// and returns the number of published Messages. A Message is marked as
+	// published only after the publisher acknowledged it, so it may be
+	// published more than once.
+	RelayOutboxMessages(ctx context.Context, params RelayOutboxMessagesParams) (int, error)
+
+	// CleanupOutboxMessages deletes the outbox Messages published before a
+	// time and returns the number of deleted Messages.
+	CleanupOutboxMessages(ctx context.Context, params CleanupOutboxMessagesParams) (int64, error)
+
+	// GetOutboxStats gets the Stats of the outbox Messages not yet published.
+	GetOutboxStats(ctx context.Context) (outbox.Stats, error)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/qrlabel"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
 
@@ -13,8 +15,10 @@ type GetQRLocationParams struct {
 }
 
 type ListQRLocationsParams struct {
-	PagingParams paging.Params `validate:"required"`
-	Sorts        []sort.Sort   `validate:"sort=name qr_code created_at updated_at"`
+	PagingParams paging.Params   `validate:"required"`
+	Sorts        []sort.Sort     `validate:"sort=name qr_code created_at updated_at"`
+	Filters      []filter.Filter `validate:"filter=name qr_code created_at:time updated_at:time"`
+	Search       string          `validate:"max=100"`
 }
 
 type CreateQRLocationParams struct {
@@ -37,6 +41,26 @@ type DeleteQRLocationParams struct {
 	ID string `validate:"required,uuid"`
 }
 
+type ImportQRLocationsParams struct {
+	Rows   []qrlocation.ImportRow `validate:"min=1,max=10000"`
+	DryRun bool
+}
+
+type RenderQRLocationLabelParams struct {
+	ID     string         `validate:"required,uuid"`
+	Format qrlabel.Format `validate:"required,enum"`
+	// Size is the width of the label, in pixels.
+	Size  int           `validate:"min=64,max=2048"`
+	Level qrlabel.Level `validate:"required,enum"`
+}
+
+type RenderQRLocationLabelsPDFParams struct {
+	IDs []string `validate:"min=1,max=500,dive,uuid"`
+	// Size is the width of a label, in millimetres.
+	Size  float64       `validate:"min=20,max=190"`
+	Level qrlabel.Level `validate:"required,enum"`
+}
+
 type QRLocationService interface {
 	// GetQRLocation gets a QRLocation by its ID.
 	GetQRLocation(ctx context.Context, params GetQRLocationParams) (qrlocation.QRLocation, error)
@@ -52,4 +76,20 @@ type QRLocationService interface {
 
 	// DeleteQRLocation deletes a QRLocation.
 	DeleteQRLocation(ctx context.Context, params DeleteQRLocationParams) error
+
+	// ImportQRLocations upserts QRLocations by their QR code and returns what
+	// the import does. Nothing is imported when a row conflicts or when it is a
+	// dry run.
+	ImportQRLocations(ctx context.Context, params ImportQRLocationsParams) (qrlocation.ImportPlan, error)
+
+	// RenderQRLocationLabel renders the QR code of a QRLocation as a label
+	// captioned with its name.
+	RenderQRLocationLabel(ctx context.Context, params RenderQRLocationLabelParams) ([]byte, error)
+
+	// RenderQRLocationLabelsPDF renders the labels of QRLocations on printable
+	// PDF sheets, in the order of the IDs.
+	RenderQRLocationLabelsPDF(ctx context.Context, params RenderQRLocationLabelsPDFParams) ([]byte, error)
+
+	// ExportQRLocations lists every QRLocation, ordered by creation.
+	ExportQRLocations(ctx context.Context) ([]qrlocation.QRLocation, error)
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
@@ -14,9 +15,10 @@ type GetRaybotCommandParams struct {
 }
 
 type ListRaybotCommandsByRaybotIDParams struct {
-	RaybotID     string        `validate:"required,uuid"`
-	PagingParams paging.Params `validate:"required"`
-	Sorts        []sort.Sort   `validate:"sort=type status completed_at created_at updated_at"`
+	RaybotID     string          `validate:"required,uuid"`
+	PagingParams paging.Params   `validate:"required"`
+	Sorts        []sort.Sort     `validate:"sort=type status completed_at created_at updated_at"`
+	Filters      []filter.Filter `validate:"filter=type status completed_at:time created_at:time updated_at:time"`
 }
 
 type CreateRaybotCommandParams struct {
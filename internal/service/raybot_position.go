@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	raybotposition "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_position"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type GetRaybotPositionParams struct {
+	RaybotID string `validate:"required,uuid"`
+}
+
+type ListRaybotPositionHistoryParams struct {
+	RaybotID     string        `validate:"required,uuid"`
+	PagingParams paging.Params `validate:"required"`
+	Sorts        []sort.Sort   `validate:"sort=source created_at"`
+}
+
+type ReportRaybotPositionParams struct {
+	RaybotID string `validate:"required,uuid"`
+	QRCode   string `validate:"required,alphanumspace,min=1,max=100"`
+}
+
+type ListQRLocationOccupantsParams struct {
+	QRLocationID string `validate:"required,uuid"`
+}
+
+type RaybotPositionService interface {
+	// GetRaybotPosition gets the QR location a raybot stands at.
+	GetRaybotPosition(ctx context.Context, params GetRaybotPositionParams) (raybotposition.Position, error)
+
+	// ListRaybotPositionHistory lists the QR locations a raybot was seen at.
+	ListRaybotPositionHistory(ctx context.Context, params ListRaybotPositionHistoryParams) (paging.List[raybotposition.Position], error)
+
+	// ReportRaybotPosition sets the position of a raybot to the QR location
+	// of the QR code it reads.
+	ReportRaybotPosition(ctx context.Context, params ReportRaybotPositionParams) (raybotposition.Position, error)
+
+	// ListQRLocationOccupants lists the positions of the raybots standing at
+	// a QR location.
+	ListQRLocationOccupants(ctx context.Context, params ListQRLocationOccupantsParams) ([]raybotposition.Position, error)
+}
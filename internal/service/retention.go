@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/retention"
+	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+)
+
+type PurgeWorkflowExecutionsParams struct {
+	Status    workflowexecution.Status `validate:"required,enum"`
+	Before    time.Time                `validate:"required"`
+	BatchSize int32                    `validate:"required,min=1,max=10000"`
+}
+
+type PurgeRaybotCommandsParams struct {
+	Status    raybotcommand.Status `validate:"required,enum"`
+	Before    time.Time            `validate:"required"`
+	BatchSize int32                `validate:"required,min=1,max=10000"`
+}
+
+type RetentionService interface {
+	// PurgeWorkflowExecutions deletes a batch of WorkflowExecutions of a
+	// finished Status completed before a time, with their Steps and Events,
+	// archiving them with their Steps first when archival is enabled. It
+	// returns the number of purged rows by table, the WorkflowExecutions are
+	// fewer than BatchSize once none is left.
+	PurgeWorkflowExecutions(ctx context.Context, params PurgeWorkflowExecutionsParams) (retention.Purged, error)
+
+	// PurgeRaybotCommands deletes a batch of RaybotCommands of a finished
+	// Status completed before a time, archiving them first when archival is
+	// enabled. It returns the number of purged rows by table, the
+	// RaybotCommands are fewer than BatchSize once none is left.
+	PurgeRaybotCommands(ctx context.Context, params PurgeRaybotCommandsParams) (retention.Purged, error)
+}
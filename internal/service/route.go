@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+)
+
+type PlanRouteParams struct {
+	// FromLocationID is where the route starts, the position of the raybot
+	// RaybotID when empty.
+	FromLocationID string `validate:"required_without=RaybotID,excluded_with=RaybotID,omitempty,uuid"`
+	RaybotID       string `validate:"omitempty,uuid"`
+	ToLocationID   string `validate:"required,uuid"`
+}
+
+type RouteService interface {
+	// PlanRoute returns the shortest Route between two QR locations of the
+	// track map, or from the position of a raybot.
+	PlanRoute(ctx context.Context, params PlanRouteParams) (trackmap.Route, error)
+}
@@ -2,9 +2,17 @@ package service
 
 type Service interface {
 	QRLocation() QRLocationService
+	TrackMap() TrackMapService
+	Route() RouteService
 	Raybot() RaybotService
 	RaybotCommand() RaybotCommandService
+	RaybotPosition() RaybotPositionService
 	Workflow() WorkflowService
+	WorkflowVersion() WorkflowVersionService
 	WorkflowExecution() WorkflowExecutionService
 	StepExecution() StepExecutionService
+	Outbox() OutboxService
+	Webhook() WebhookService
+	DeadLetter() DeadLetterService
+	Retention() RetentionService
 }
@@ -0,0 +1,165 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	deadletter "github.com/tuanvumaihuynh/roboflow/internal/model/dead_letter"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/outbox"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+)
+
+var _ service.DeadLetterService = (*deadLetterService)(nil)
+
+type deadLetterService struct {
+	deadLetterMessageRepo repository.DeadLetterMessageRepository
+	outboxRepo            repository.OutboxRepository
+	sqlDBProvider         sqldb.Provider
+	validator             validator.Validator
+}
+
+func newDeadLetterService(
+	deadLetterMessageRepo repository.DeadLetterMessageRepository,
+	outboxRepo repository.OutboxRepository,
+	sqlDBProvider sqldb.Provider,
+	validator validator.Validator,
+) *deadLetterService {
+	return &deadLetterService{
+		deadLetterMessageRepo: deadLetterMessageRepo,
+		outboxRepo:            outboxRepo,
+		sqlDBProvider:         sqlDBProvider,
+		validator:             validator,
+	}
+}
+
+func (s deadLetterService) GetDeadLetterMessage(
+	ctx context.Context,
+	params service.GetDeadLetterMessageParams,
+) (deadletter.Message, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return deadletter.Message{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	msg, err := s.deadLetterMessageRepo.GetDeadLetterMessage(ctx, s.sqlDBProvider.DB(), params.ID)
+	if err != nil {
+		return deadletter.Message{}, fmt.Errorf("repo get dead letter message: %w", err)
+	}
+
+	return msg, nil
+}
+
+func (s deadLetterService) ListDeadLetterMessages(
+	ctx context.Context,
+	params service.ListDeadLetterMessagesParams,
+) (paging.List[deadletter.Message], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[deadletter.Message]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	msgs, err := s.deadLetterMessageRepo.ListDeadLetterMessages(
+		ctx,
+		s.sqlDBProvider.DB(),
+		params.PagingParams,
+		params.Sorts,
+		repository.DeadLetterMessageFilter{
+			Topic:    params.Topic,
+			Handler:  params.Handler,
+			Replayed: params.Replayed,
+		},
+	)
+	if err != nil {
+		return paging.List[deadletter.Message]{}, fmt.Errorf("repo list dead letter messages: %w", err)
+	}
+
+	return msgs, nil
+}
+
+func (s deadLetterService) CreateDeadLetterMessage(ctx context.Context, params service.CreateDeadLetterMessageParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	msg := deadletter.NewMessage(
+		params.ID,
+		params.MessageID,
+		params.Topic,
+		params.Handler,
+		params.Subscriber,
+		params.Reason,
+		params.Payload,
+		params.Metadata,
+	)
+	if err := s.deadLetterMessageRepo.CreateDeadLetterMessage(ctx, s.sqlDBProvider.DB(), msg); err != nil {
+		return fmt.Errorf("repo create dead letter message: %w", err)
+	}
+
+	return nil
+}
+
+func (s deadLetterService) ReplayDeadLetterMessage(
+	ctx context.Context,
+	params service.ReplayDeadLetterMessageParams,
+) (deadletter.Message, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return deadletter.Message{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	var msg deadletter.Message
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		var err error
+		msg, err = s.deadLetterMessageRepo.MarkDeadLetterMessageReplayed(ctx, db, params.ID, time.Now())
+		if err != nil {
+			return fmt.Errorf("repo mark dead letter message replayed: %w", err)
+		}
+
+		// A new UUID, the broker drops a message with the UUID of one it has
+		// recently received.
+		replay := outbox.NewMessage(msg.Topic, watermill.NewUUID(), msg.Payload, msg.Metadata)
+		if err := s.outboxRepo.CreateOutboxMessage(ctx, db, replay); err != nil {
+			return fmt.Errorf("repo create outbox message: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return deadletter.Message{}, fmt.Errorf("with tx: %w", err)
+	}
+
+	return msg, nil
+}
+
+func (s deadLetterService) DeleteDeadLetterMessage(ctx context.Context, params service.DeleteDeadLetterMessageParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.deadLetterMessageRepo.DeleteDeadLetterMessage(ctx, s.sqlDBProvider.DB(), params.ID); err != nil {
+		return fmt.Errorf("repo delete dead letter message: %w", err)
+	}
+
+	return nil
+}
+
+func (s deadLetterService) PurgeDeadLetterMessages(ctx context.Context, params service.PurgeDeadLetterMessagesParams) (int64, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return 0, fmt.Errorf("validate params: %w", err)
+	}
+
+	count, err := s.deadLetterMessageRepo.PurgeDeadLetterMessages(ctx, s.sqlDBProvider.DB(), repository.DeadLetterMessageFilter{
+		Topic:         params.Topic,
+		Handler:       params.Handler,
+		Replayed:      params.Replayed,
+		CreatedBefore: params.CreatedBefore,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("repo purge dead letter messages: %w", err)
+	}
+
+	return count, nil
+}
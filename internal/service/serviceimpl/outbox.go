@@ -0,0 +1,124 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/outbox"
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+)
+
+var _ service.OutboxService = (*outboxService)(nil)
+
+type outboxService struct {
+	outboxRepo    repository.OutboxRepository
+	sqlDBProvider sqldb.Provider
+	publisher     message.Publisher
+	validator     validator.Validator
+}
+
+func newOutboxService(
+	outboxRepo repository.OutboxRepository,
+	sqlDBProvider sqldb.Provider,
+	publisher message.Publisher,
+	validator validator.Validator,
+) *outboxService {
+	return &outboxService{
+		outboxRepo:    outboxRepo,
+		sqlDBProvider: sqlDBProvider,
+		publisher:     publisher,
+		validator:     validator,
+	}
+}
+
+func (s outboxService) RelayOutboxMessages(ctx context.Context, params service.RelayOutboxMessagesParams) (int, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return 0, fmt.Errorf("validate params: %w", err)
+	}
+
+	// Messages are published in order, a failure stops the batch but the
+	// messages published before it are still marked as published.
+	var published []int64
+	var publishErr error
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		msgs, err := s.outboxRepo.ListPendingOutboxMessagesForUpdate(ctx, db, params.BatchSize)
+		if err != nil {
+			return fmt.Errorf("repo list pending outbox messages: %w", err)
+		}
+
+		for _, m := range msgs {
+			msg := message.NewMessage(m.MessageID, m.Payload)
+			msg.Metadata = m.Metadata
+			if err := s.publisher.Publish(m.Topic, msg); err != nil {
+				publishErr = fmt.Errorf("publisher publish message %s: %w", m.MessageID, err)
+				break
+			}
+			published = append(published, m.ID)
+		}
+
+		if len(published) == 0 {
+			return nil
+		}
+
+		if err := s.outboxRepo.MarkOutboxMessagesPublished(ctx, db, published, time.Now()); err != nil {
+			return fmt.Errorf("repo mark outbox messages published: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return 0, errors.Join(publishErr, fmt.Errorf("with tx: %w", err))
+	}
+
+	if publishErr != nil {
+		return len(published), publishErr
+	}
+
+	return len(published), nil
+}
+
+func (s outboxService) CleanupOutboxMessages(ctx context.Context, params service.CleanupOutboxMessagesParams) (int64, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return 0, fmt.Errorf("validate params: %w", err)
+	}
+
+	count, err := s.outboxRepo.DeletePublishedOutboxMessages(ctx, s.sqlDBProvider.DB(), params.PublishedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("repo delete published outbox messages: %w", err)
+	}
+
+	return count, nil
+}
+
+func (s outboxService) GetOutboxStats(ctx context.Context) (outbox.Stats, error) {
+	stats, err := s.outboxRepo.GetOutboxStats(ctx, s.sqlDBProvider.DB())
+	if err != nil {
+		return outbox.Stats{}, fmt.Errorf("repo get outbox stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// writeEvents writes the events to the outbox in the transaction of db, the
+// relay publishes them once the transaction commits.
+func writeEvents(ctx context.Context, db sqldb.SQLDB, outboxRepo repository.OutboxRepository, events ...pubsub.Event) error {
+	for _, event := range events {
+		msg, err := pubsub.NewMessage(ctx, event)
+		if err != nil {
+			return fmt.Errorf("new message: %w", err)
+		}
+
+		if err := outboxRepo.CreateOutboxMessage(ctx, db, outbox.NewMessage(event.EventType(), msg.UUID, msg.Payload, msg.Metadata)); err != nil {
+			return fmt.Errorf("repo create outbox message: %w", err)
+		}
+	}
+
+	return nil
+}
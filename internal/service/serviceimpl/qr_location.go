@@ -1,15 +1,23 @@
 package serviceimpl
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+
+	govalidator "github.com/go-playground/validator/v10"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/qrlabel"
 	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
 )
 
 var _ service.QRLocationService = (*qrLocationService)(nil)
@@ -50,7 +58,14 @@ func (s qrLocationService) ListQRLocations(ctx context.Context, params service.L
 		return paging.List[qrlocation.QRLocation]{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	qrLocations, err := s.qrLocationRepo.ListQRLocations(ctx, s.sqlDBProvider.DB(), params.PagingParams, params.Sorts)
+	qrLocations, err := s.qrLocationRepo.ListQRLocations(
+		ctx,
+		s.sqlDBProvider.DB(),
+		params.PagingParams,
+		params.Sorts,
+		params.Filters,
+		params.Search,
+	)
 	if err != nil {
 		return paging.List[qrlocation.QRLocation]{}, fmt.Errorf("repo list qr locations: %w", err)
 	}
@@ -111,3 +126,184 @@ func (s qrLocationService) DeleteQRLocation(ctx context.Context, params service.
 
 	return nil
 }
+
+func (s qrLocationService) ImportQRLocations(
+	ctx context.Context,
+	params service.ImportQRLocationsParams,
+) (qrlocation.ImportPlan, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return qrlocation.ImportPlan{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	rows := make([]qrlocation.ImportRow, 0, len(params.Rows))
+	invalid := []qrlocation.ImportConflict{}
+	for _, row := range params.Rows {
+		reason, err := s.validateImportRow(row)
+		if err != nil {
+			return qrlocation.ImportPlan{}, err
+		}
+		if reason != "" {
+			invalid = append(invalid, qrlocation.ImportConflict{Row: row.Row, QRCode: row.QRCode, Reason: reason})
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	var plan qrlocation.ImportPlan
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		existing, err := s.qrLocationRepo.ListAllQRLocations(ctx, db)
+		if err != nil {
+			return fmt.Errorf("repo list all qr locations: %w", err)
+		}
+
+		plan = qrlocation.PlanImport(rows, existing)
+		plan.Conflicts = append(plan.Conflicts, invalid...)
+		slices.SortFunc(plan.Conflicts, func(a, b qrlocation.ImportConflict) int {
+			return cmp.Compare(a.Row, b.Row)
+		})
+
+		if params.DryRun {
+			return nil
+		}
+		if len(plan.Conflicts) > 0 {
+			conflictRows := make([]string, len(plan.Conflicts))
+			for i, c := range plan.Conflicts {
+				conflictRows[i] = fmt.Sprint(c.Row)
+			}
+			return xerror.Conflict(nil, "qrLocation.importConflict", fmt.Sprintf(
+				"Rows %s conflict, nothing was imported. Run a dry run to see why",
+				strings.Join(conflictRows, ", "),
+			))
+		}
+
+		if len(plan.Creates) > 0 {
+			creates := make([]qrlocation.QRLocation, len(plan.Creates))
+			for i, item := range plan.Creates {
+				creates[i] = item.Location
+			}
+			if err := s.qrLocationRepo.BatchCreateQRLocations(ctx, db, creates); err != nil {
+				return fmt.Errorf("repo batch create qr locations: %w", err)
+			}
+		}
+
+		for i, item := range plan.Updates {
+			updated, err := s.qrLocationRepo.UpdateQRLocation(ctx, db, repository.UpdateQRLocationParams{
+				ID:          item.Location.ID,
+				Name:        item.Location.Name,
+				SetName:     true,
+				Metadata:    item.Location.Metadata,
+				SetMetadata: true,
+			})
+			if err != nil {
+				return fmt.Errorf("repo update qr location: %w", err)
+			}
+			plan.Updates[i].Location = updated
+		}
+
+		return nil
+	}); err != nil {
+		return qrlocation.ImportPlan{}, fmt.Errorf("with tx: %w", err)
+	}
+
+	return plan, nil
+}
+
+// importRowFields names the fields of an import row in the conflicts.
+var importRowFields = map[string]string{
+	"Name":     "Name",
+	"QRCode":   "QR code",
+	"Metadata": "Metadata",
+}
+
+// validateImportRow returns why the row is invalid, or an empty reason.
+func (s qrLocationService) validateImportRow(row qrlocation.ImportRow) (string, error) {
+	err := s.validator.Validate(row)
+	if err == nil {
+		return "", nil
+	}
+
+	var validationErrs govalidator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return "", fmt.Errorf("validate row %d: %w", row.Row, err)
+	}
+
+	reasons := make([]string, len(validationErrs))
+	for i, fe := range validationErrs {
+		reasons[i] = fmt.Sprintf("%s %s", importRowFields[fe.Field()], validator.ValidationErrorMessage(fe))
+	}
+	return strings.Join(reasons, ", "), nil
+}
+
+func (s qrLocationService) RenderQRLocationLabel(
+	ctx context.Context,
+	params service.RenderQRLocationLabelParams,
+) ([]byte, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+
+	qrLocation, err := s.qrLocationRepo.GetQRLocation(ctx, s.sqlDBProvider.DB(), params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("repo get qr location: %w", err)
+	}
+
+	label, err := qrlabel.Render(qrLocationLabel(qrLocation), params.Format, params.Size, params.Level)
+	if err != nil {
+		if errors.Is(err, qrlabel.ErrInvalidSize) {
+			return nil, xerror.ValidationFailed(err, fmt.Sprintf("Size %d is too small for QR code %s", params.Size, qrLocation.QRCode))
+		}
+		return nil, fmt.Errorf("render label: %w", err)
+	}
+
+	return label, nil
+}
+
+func (s qrLocationService) RenderQRLocationLabelsPDF(
+	ctx context.Context,
+	params service.RenderQRLocationLabelsPDFParams,
+) ([]byte, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+
+	qrLocations, err := s.qrLocationRepo.ListQRLocationsByIDs(ctx, s.sqlDBProvider.DB(), params.IDs)
+	if err != nil {
+		return nil, fmt.Errorf("repo list qr locations by ids: %w", err)
+	}
+
+	byID := make(map[string]qrlocation.QRLocation, len(qrLocations))
+	for _, l := range qrLocations {
+		byID[l.ID] = l
+	}
+
+	labels := make([]qrlabel.Label, len(params.IDs))
+	for i, id := range params.IDs {
+		// The IDs are returned in the canonical, lower case, form of UUIDs.
+		l, ok := byID[strings.ToLower(id)]
+		if !ok {
+			return nil, xerror.NotFound(nil, "qrLocation.notFound", fmt.Sprintf("QR location %s not found", id))
+		}
+		labels[i] = qrLocationLabel(l)
+	}
+
+	pdf, err := qrlabel.PDF(labels, params.Size, params.Level)
+	if err != nil {
+		return nil, fmt.Errorf("render labels pdf: %w", err)
+	}
+
+	return pdf, nil
+}
+
+// qrLocationLabel is the label stuck on the rail at a QR location.
+func qrLocationLabel(l qrlocation.QRLocation) qrlabel.Label {
+	return qrlabel.Label{Content: l.QRCode, Caption: l.Name}
+}
+
+func (s qrLocationService) ExportQRLocations(ctx context.Context) ([]qrlocation.QRLocation, error) {
+	qrLocations, err := s.qrLocationRepo.ListAllQRLocations(ctx, s.sqlDBProvider.DB())
+	if err != nil {
+		return nil, fmt.Errorf("repo list all qr locations: %w", err)
+	}
+
+	return qrLocations, nil
+}
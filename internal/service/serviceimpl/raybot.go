@@ -6,6 +6,7 @@ import (
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/raybot"
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
@@ -17,17 +18,20 @@ var _ service.RaybotService = (*raybotService)(nil)
 type raybotService struct {
 	raybotRepo    repository.RaybotRepository
 	sqlDBProvider sqldb.Provider
+	outboxRepo    repository.OutboxRepository
 	validator     validator.Validator
 }
 
 func newRaybotService(
 	raybotRepo repository.RaybotRepository,
 	sqlDBProvider sqldb.Provider,
+	outboxRepo repository.OutboxRepository,
 	validator validator.Validator,
 ) *raybotService {
 	return &raybotService{
 		raybotRepo:    raybotRepo,
 		sqlDBProvider: sqlDBProvider,
+		outboxRepo:    outboxRepo,
 		validator:     validator,
 	}
 }
@@ -72,9 +76,18 @@ func (s raybotService) CreateRaybot(ctx context.Context, params service.CreateRa
 
 	rb := raybot.NewRaybot(params.Name)
 
-	err := s.raybotRepo.CreateRaybot(ctx, s.sqlDBProvider.DB(), rb)
-	if err != nil {
-		return raybot.Raybot{}, fmt.Errorf("repo create raybot: %w", err)
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		if err := s.raybotRepo.CreateRaybot(ctx, db, rb); err != nil {
+			return fmt.Errorf("repo create raybot: %w", err)
+		}
+
+		return writeEvents(ctx, db, s.outboxRepo, pubsub.RaybotCreated{
+			RaybotID:    rb.ID,
+			Name:        rb.Name,
+			ControlMode: string(rb.ControlMode),
+		})
+	}); err != nil {
+		return raybot.Raybot{}, fmt.Errorf("with tx: %w", err)
 	}
 
 	return rb, nil
@@ -85,21 +98,54 @@ func (s raybotService) UpdateRaybot(ctx context.Context, params service.UpdateRa
 		return raybot.Raybot{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	rb, err := s.raybotRepo.UpdateRaybot(ctx, s.sqlDBProvider.DB(), repository.UpdateRaybotParams{
-		ID:                 params.ID,
-		Name:               params.Name,
-		SetName:            params.SetName,
-		ControlMode:        params.ControlMode,
-		SetControlMode:     params.SetControlMode,
-		IsOnline:           params.IsOnline,
-		SetIsOnline:        params.SetIsOnline,
-		IPAddress:          params.IPAddress,
-		SetIPAddress:       params.SetIPAddress,
-		LastConnectedAt:    params.LastConnectedAt,
-		SetLastConnectedAt: params.SetLastConnectedAt,
-	})
-	if err != nil {
-		return raybot.Raybot{}, fmt.Errorf("repo update raybot: %w", err)
+	var rb raybot.Raybot
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		// The previous state tells whether the raybot went online or offline
+		var wasOnline bool
+		if params.SetIsOnline {
+			current, err := s.raybotRepo.GetRaybot(ctx, db, params.ID)
+			if err != nil {
+				return fmt.Errorf("repo get raybot: %w", err)
+			}
+			wasOnline = current.IsOnline
+		}
+
+		var err error
+		rb, err = s.raybotRepo.UpdateRaybot(ctx, db, repository.UpdateRaybotParams{
+			ID:                 params.ID,
+			Name:               params.Name,
+			SetName:            params.SetName,
+			ControlMode:        params.ControlMode,
+			SetControlMode:     params.SetControlMode,
+			IsOnline:           params.IsOnline,
+			SetIsOnline:        params.SetIsOnline,
+			IPAddress:          params.IPAddress,
+			SetIPAddress:       params.SetIPAddress,
+			LastConnectedAt:    params.LastConnectedAt,
+			SetLastConnectedAt: params.SetLastConnectedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("repo update raybot: %w", err)
+		}
+
+		events := []pubsub.Event{pubsub.RaybotUpdated{
+			RaybotID:    rb.ID,
+			Name:        rb.Name,
+			ControlMode: string(rb.ControlMode),
+			IsOnline:    rb.IsOnline,
+			IPAddress:   rb.IPAddress,
+		}}
+		if params.SetIsOnline && rb.IsOnline != wasOnline {
+			if rb.IsOnline {
+				events = append(events, pubsub.RaybotOnline{RaybotID: rb.ID, IPAddress: rb.IPAddress})
+			} else {
+				events = append(events, pubsub.RaybotOffline{RaybotID: rb.ID})
+			}
+		}
+
+		return writeEvents(ctx, db, s.outboxRepo, events...)
+	}); err != nil {
+		return raybot.Raybot{}, fmt.Errorf("with tx: %w", err)
 	}
 
 	return rb, nil
@@ -2,40 +2,47 @@ package serviceimpl
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
-	"github.com/ThreeDotsLabs/watermill/message"
-	"github.com/google/uuid"
-
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
 	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	raybotposition "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_position"
 	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
 )
 
 var _ service.RaybotCommandService = (*raybotCommandService)(nil)
 
 type raybotCommandService struct {
 	raybotCommandRepo repository.RaybotCommandRepository
+	qrLocationRepo    repository.QRLocationRepository
+	trafficController *trafficController
+	positionTracker   *positionTracker
 	sqlDBProvider     sqldb.Provider
-	publisher         message.Publisher
+	outboxRepo        repository.OutboxRepository
 	validator         validator.Validator
 }
 
 func newRaybotCommandService(
 	raybotCommandRepo repository.RaybotCommandRepository,
+	qrLocationRepo repository.QRLocationRepository,
+	trafficController *trafficController,
+	positionTracker *positionTracker,
 	sqlDBProvider sqldb.Provider,
-	publisher message.Publisher,
+	outboxRepo repository.OutboxRepository,
 	validator validator.Validator,
 ) *raybotCommandService {
 	return &raybotCommandService{
 		raybotCommandRepo: raybotCommandRepo,
+		qrLocationRepo:    qrLocationRepo,
+		trafficController: trafficController,
+		positionTracker:   positionTracker,
 		sqlDBProvider:     sqlDBProvider,
-		publisher:         publisher,
+		outboxRepo:        outboxRepo,
 		validator:         validator,
 	}
 }
@@ -58,7 +65,14 @@ func (s raybotCommandService) ListRaybotCommandsByRaybotID(ctx context.Context,
 		return paging.List[raybotcommand.RaybotCommand]{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	rbcs, err := s.raybotCommandRepo.ListRaybotCommandsByRaybotID(ctx, s.sqlDBProvider.DB(), params.RaybotID, params.PagingParams, params.Sorts)
+	rbcs, err := s.raybotCommandRepo.ListRaybotCommandsByRaybotID(
+		ctx,
+		s.sqlDBProvider.DB(),
+		params.RaybotID,
+		params.PagingParams,
+		params.Sorts,
+		params.Filters,
+	)
 	if err != nil {
 		return paging.List[raybotcommand.RaybotCommand]{}, fmt.Errorf("repo list raybot commands by raybot ID: %w", err)
 	}
@@ -72,29 +86,74 @@ func (s raybotCommandService) CreateRaybotCommand(ctx context.Context, params se
 	}
 
 	rbc := raybotcommand.NewRaybotCommand(params.RaybotID, params.Type, params.Inputs)
-	err := s.raybotCommandRepo.CreateRaybotCommand(ctx, s.sqlDBProvider.DB(), rbc)
-	if err != nil {
-		return raybotcommand.RaybotCommand{}, fmt.Errorf("repo create raybot command: %w", err)
+	if rbc.Type == raybotcommand.TypeMoveToLocation {
+		rbc.Status = raybotcommand.RaybotCommandStatusQueued
 	}
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		if err := s.validateInputs(ctx, db, rbc); err != nil {
+			return err
+		}
+
+		if err := s.raybotCommandRepo.CreateRaybotCommand(ctx, db, rbc); err != nil {
+			return fmt.Errorf("repo create raybot command: %w", err)
+		}
+
+		if rbc.Type == raybotcommand.TypeMoveToLocation {
+			status, err := s.trafficController.reserve(ctx, db, rbc)
+			if err != nil {
+				return fmt.Errorf("reserve route: %w", err)
+			}
+			rbc.Status = status
+		}
 
-	// Publish event
-	ev := pubsub.RaybotCommandCreated{
-		RaybotID:  params.RaybotID,
-		CommandID: rbc.ID,
-		Type:      rbc.Type,
-		Inputs:    rbc.Inputs,
+		return writeEvents(ctx, db, s.outboxRepo, pubsub.RaybotCommandCreated{
+			RaybotID:  params.RaybotID,
+			CommandID: rbc.ID,
+			Type:      rbc.Type,
+			Status:    rbc.Status,
+			Inputs:    rbc.Inputs,
+		})
+	}); err != nil {
+		return raybotcommand.RaybotCommand{}, fmt.Errorf("with tx: %w", err)
 	}
-	payload, err := json.Marshal(ev)
-	if err != nil {
-		return raybotcommand.RaybotCommand{}, fmt.Errorf("marshal event: %w", err)
+
+	return rbc, nil
+}
+
+// validateInputs checks the inputs of rbc are well formed, and the QR
+// location or the QR code they reference exists, so the raybot is not sent
+// a command it cannot run.
+func (s raybotCommandService) validateInputs(ctx context.Context, db sqldb.SQLDB, rbc raybotcommand.RaybotCommand) error {
+	if err := rbc.Inputs.Validate(rbc.Type); err != nil {
+		return err
 	}
 
-	msg := message.NewMessage(uuid.NewString(), payload)
-	if err := s.publisher.Publish(pubsub.RaybotCommandCreatedTopic, msg); err != nil {
-		return raybotcommand.RaybotCommand{}, fmt.Errorf("publisher publish event: %w", err)
+	switch rbc.Type {
+	case raybotcommand.TypeMoveToLocation:
+		input, err := rbc.Inputs.AsMoveToLocationInput()
+		if err != nil {
+			return fmt.Errorf("as move to location input: %w", err)
+		}
+		if _, err := s.qrLocationRepo.GetQRLocation(ctx, db, input.Location); err != nil {
+			if xerror.IsStatus(err, xerror.StatusNotFound) {
+				return xerror.ValidationFailed(err, fmt.Sprintf("inputs.location references unknown QR location %s", input.Location))
+			}
+			return fmt.Errorf("repo get qr location: %w", err)
+		}
+	case raybotcommand.TypeCheckQRCode:
+		input, err := rbc.Inputs.AsCheckQRCodeInput()
+		if err != nil {
+			return fmt.Errorf("as check qr code input: %w", err)
+		}
+		if _, err := s.qrLocationRepo.GetQRLocationByQRCode(ctx, db, input.QRCode); err != nil {
+			if xerror.IsStatus(err, xerror.StatusNotFound) {
+				return xerror.ValidationFailed(err, fmt.Sprintf("inputs.qr_code %s does not match any QR location", input.QRCode))
+			}
+			return fmt.Errorf("repo get qr location by qr code: %w", err)
+		}
 	}
 
-	return rbc, nil
+	return nil
 }
 
 func (s raybotCommandService) UpdateRaybotCommand(ctx context.Context, params service.UpdateRaybotCommandParams) (raybotcommand.RaybotCommand, error) {
@@ -102,23 +161,82 @@ func (s raybotCommandService) UpdateRaybotCommand(ctx context.Context, params se
 		return raybotcommand.RaybotCommand{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	rbc, err := s.raybotCommandRepo.UpdateRaybotCommand(
-		ctx,
-		s.sqlDBProvider.DB(),
-		repository.UpdateRaybotCommandParams{
-			ID:             params.ID,
-			Status:         params.Status,
-			SetStatus:      params.SetStatus,
-			Outputs:        params.Outputs,
-			SetOutputs:     params.SetOutputs,
-			Error:          params.Error,
-			SetError:       params.SetError,
-			CompletedAt:    params.CompletedAt,
-			SetCompletedAt: params.SetCompletedAt,
-		},
-	)
-	if err != nil {
-		return raybotcommand.RaybotCommand{}, fmt.Errorf("repo update raybot command: %w", err)
+	var rbc raybotcommand.RaybotCommand
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		var prevStatus raybotcommand.Status
+		if params.SetStatus {
+			current, err := s.raybotCommandRepo.GetRaybotCommand(ctx, db, params.ID)
+			if err != nil {
+				return fmt.Errorf("repo get raybot command: %w", err)
+			}
+			prevStatus = current.Status
+			if !current.Status.CanUpdateTo(params.Status) {
+				return xerror.Conflict(nil, "raybotCommand.invalidStatusTransition", fmt.Sprintf(
+					"Cannot change the status of the command from %s to %s", current.Status, params.Status))
+			}
+
+			// A succeeded command may check its raybot in, which locks the
+			// traffic too.
+			if current.Type == raybotcommand.TypeMoveToLocation ||
+				params.Status == raybotcommand.RaybotCommandStatusSucceeded {
+				if err := s.trafficController.lock(ctx, db); err != nil {
+					return fmt.Errorf("lock traffic: %w", err)
+				}
+			}
+		}
+
+		var err error
+		rbc, err = s.raybotCommandRepo.UpdateRaybotCommand(
+			ctx,
+			db,
+			repository.UpdateRaybotCommandParams{
+				ID:             params.ID,
+				Status:         params.Status,
+				SetStatus:      params.SetStatus,
+				Outputs:        params.Outputs,
+				SetOutputs:     params.SetOutputs,
+				Error:          params.Error,
+				SetError:       params.SetError,
+				CompletedAt:    params.CompletedAt,
+				SetCompletedAt: params.SetCompletedAt,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("repo update raybot command: %w", err)
+		}
+
+		if !params.SetStatus || rbc.Status == prevStatus {
+			return nil
+		}
+
+		if err := writeEvents(ctx, db, s.outboxRepo, pubsub.RaybotCommandStatusChanged{
+			RaybotID:  rbc.RaybotID,
+			CommandID: rbc.ID,
+			Type:      rbc.Type,
+			Status:    rbc.Status,
+			Outputs:   rbc.Outputs,
+			Error:     rbc.Error,
+		}); err != nil {
+			return err
+		}
+
+		// A command result matching no location does not move the raybot.
+		if checkIn, ok := raybotposition.CheckInFromCommand(rbc); ok {
+			if _, err := s.positionTracker.checkIn(ctx, db, checkIn); err != nil &&
+				!xerror.IsStatus(err, xerror.StatusValidationFailed) {
+				return fmt.Errorf("check in: %w", err)
+			}
+		}
+
+		if rbc.Type == raybotcommand.TypeMoveToLocation && rbc.Status.IsFinished() {
+			if err := s.trafficController.release(ctx, db, rbc.ID); err != nil {
+				return fmt.Errorf("release route: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return raybotcommand.RaybotCommand{}, fmt.Errorf("with tx: %w", err)
 	}
 
 	return rbc, nil
@@ -129,8 +247,23 @@ func (s raybotCommandService) DeleteRaybotCommand(ctx context.Context, params se
 		return fmt.Errorf("validate params: %w", err)
 	}
 
-	if err := s.raybotCommandRepo.DeleteRaybotCommandsByRaybotID(ctx, s.sqlDBProvider.DB(), params.ID); err != nil {
-		return fmt.Errorf("repo delete raybot command: %w", err)
+	// The routes of the deleted moves are released with them.
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		if err := s.trafficController.lock(ctx, db); err != nil {
+			return fmt.Errorf("lock traffic: %w", err)
+		}
+
+		if err := s.raybotCommandRepo.DeleteRaybotCommandsByRaybotID(ctx, db, params.ID); err != nil {
+			return fmt.Errorf("repo delete raybot command: %w", err)
+		}
+
+		if err := s.trafficController.schedule(ctx, db); err != nil {
+			return fmt.Errorf("schedule traffic: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("with tx: %w", err)
 	}
 
 	return nil
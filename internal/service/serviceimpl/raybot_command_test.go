@@ -0,0 +1,109 @@
+package serviceimpl_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/internal/service/serviceimpl"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+const raybotCommandID = "8a2e4c6f-0b1d-4e3a-9c5b-7d9f1a3c5e64"
+
+// fakeRaybotCommandRepo stores a single RaybotCommand.
+type fakeRaybotCommandRepo struct {
+	repository.RaybotCommandRepository
+	command raybotcommand.RaybotCommand
+	updated bool
+}
+
+func (r *fakeRaybotCommandRepo) GetRaybotCommand(context.Context, sqldb.SQLDB, string) (raybotcommand.RaybotCommand, error) {
+	return r.command, nil
+}
+
+func (r *fakeRaybotCommandRepo) UpdateRaybotCommand(
+	_ context.Context,
+	_ sqldb.SQLDB,
+	params repository.UpdateRaybotCommandParams,
+) (raybotcommand.RaybotCommand, error) {
+	r.updated = true
+	if params.SetStatus {
+		r.command.Status = params.Status
+	}
+	return r.command, nil
+}
+
+func TestRaybotCommandServiceUpdateRaybotCommandStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		typ        raybotcommand.Type
+		from       raybotcommand.Status
+		to         raybotcommand.Status
+		expectErr  bool
+		errMessage string
+	}{
+		{
+			name: "Pending to in progress",
+			typ:  raybotcommand.TypeLiftBox,
+			from: raybotcommand.RaybotCommandStatusPending,
+			to:   raybotcommand.RaybotCommandStatusInProgress,
+		},
+		{
+			name:       "Queued move dispatched manually",
+			typ:        raybotcommand.TypeMoveToLocation,
+			from:       raybotcommand.RaybotCommandStatusQueued,
+			to:         raybotcommand.RaybotCommandStatusPending,
+			expectErr:  true,
+			errMessage: "Cannot change the status of the command from QUEUED to PENDING",
+		},
+		{
+			name:       "Pending command queued manually",
+			typ:        raybotcommand.TypeLiftBox,
+			from:       raybotcommand.RaybotCommandStatusPending,
+			to:         raybotcommand.RaybotCommandStatusQueued,
+			expectErr:  true,
+			errMessage: "Cannot change the status of the command from PENDING to QUEUED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raybotCommandRepo := &fakeRaybotCommandRepo{command: raybotcommand.RaybotCommand{
+				ID:     raybotCommandID,
+				Type:   tt.typ,
+				Status: tt.from,
+			}}
+			repo := fakeRepository{
+				raybotCommandRepo: raybotCommandRepo,
+				outboxRepo:        fakeOutboxRepo{},
+			}
+			svc := serviceimpl.NewService(repo, fakeSQLDBProvider{}, nil, nil, nil, nil, nil, webhook.RetryPolicy{}, nil,
+				validator.NewValidator(), slog.Default())
+
+			rbc, err := svc.RaybotCommand().UpdateRaybotCommand(context.Background(), service.UpdateRaybotCommandParams{
+				ID:        raybotCommandID,
+				Status:    tt.to,
+				SetStatus: true,
+			})
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.True(t, xerror.IsStatus(err, xerror.StatusConflict))
+				assert.Contains(t, err.Error(), tt.errMessage)
+				assert.False(t, raybotCommandRepo.updated)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.to, rbc.Status)
+			}
+		})
+	}
+}
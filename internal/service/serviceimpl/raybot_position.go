@@ -0,0 +1,221 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	raybotposition "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_position"
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var _ service.RaybotPositionService = (*raybotPositionService)(nil)
+
+type raybotPositionService struct {
+	raybotPositionRepo repository.RaybotPositionRepository
+	raybotRepo         repository.RaybotRepository
+	qrLocationRepo     repository.QRLocationRepository
+	positionTracker    *positionTracker
+	sqlDBProvider      sqldb.Provider
+	validator          validator.Validator
+}
+
+func newRaybotPositionService(
+	raybotPositionRepo repository.RaybotPositionRepository,
+	raybotRepo repository.RaybotRepository,
+	qrLocationRepo repository.QRLocationRepository,
+	positionTracker *positionTracker,
+	sqlDBProvider sqldb.Provider,
+	validator validator.Validator,
+) *raybotPositionService {
+	return &raybotPositionService{
+		raybotPositionRepo: raybotPositionRepo,
+		raybotRepo:         raybotRepo,
+		qrLocationRepo:     qrLocationRepo,
+		positionTracker:    positionTracker,
+		sqlDBProvider:      sqlDBProvider,
+		validator:          validator,
+	}
+}
+
+func (s raybotPositionService) GetRaybotPosition(
+	ctx context.Context,
+	params service.GetRaybotPositionParams,
+) (raybotposition.Position, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return raybotposition.Position{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	position, err := s.raybotPositionRepo.GetRaybotPosition(ctx, s.sqlDBProvider.DB(), params.RaybotID)
+	if err != nil {
+		return raybotposition.Position{}, fmt.Errorf("repo get raybot position: %w", err)
+	}
+
+	return position, nil
+}
+
+func (s raybotPositionService) ListRaybotPositionHistory(
+	ctx context.Context,
+	params service.ListRaybotPositionHistoryParams,
+) (paging.List[raybotposition.Position], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[raybotposition.Position]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	positions, err := s.raybotPositionRepo.ListRaybotPositionHistory(
+		ctx,
+		s.sqlDBProvider.DB(),
+		params.RaybotID,
+		params.PagingParams,
+		params.Sorts,
+	)
+	if err != nil {
+		return paging.List[raybotposition.Position]{}, fmt.Errorf("repo list raybot position history: %w", err)
+	}
+
+	return positions, nil
+}
+
+func (s raybotPositionService) ReportRaybotPosition(
+	ctx context.Context,
+	params service.ReportRaybotPositionParams,
+) (raybotposition.Position, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return raybotposition.Position{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	var position raybotposition.Position
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		if _, err := s.raybotRepo.GetRaybot(ctx, db, params.RaybotID); err != nil {
+			return fmt.Errorf("repo get raybot: %w", err)
+		}
+
+		var err error
+		position, err = s.positionTracker.checkIn(ctx, db, raybotposition.CheckIn{
+			RaybotID: params.RaybotID,
+			QRCode:   params.QRCode,
+			Source:   raybotposition.SourceReport,
+		})
+		if err != nil {
+			return fmt.Errorf("check in: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return raybotposition.Position{}, fmt.Errorf("with tx: %w", err)
+	}
+
+	return position, nil
+}
+
+func (s raybotPositionService) ListQRLocationOccupants(
+	ctx context.Context,
+	params service.ListQRLocationOccupantsParams,
+) ([]raybotposition.Position, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+
+	db := s.sqlDBProvider.DB()
+	if _, err := s.qrLocationRepo.GetQRLocation(ctx, db, params.QRLocationID); err != nil {
+		return nil, fmt.Errorf("repo get qr location: %w", err)
+	}
+
+	positions, err := s.raybotPositionRepo.ListRaybotPositionsByLocationID(ctx, db, params.QRLocationID)
+	if err != nil {
+		return nil, fmt.Errorf("repo list raybot positions by location id: %w", err)
+	}
+
+	return positions, nil
+}
+
+// positionTracker records where the raybots are seen, and lets the traffic
+// controller release the routes they traveled.
+type positionTracker struct {
+	raybotPositionRepo repository.RaybotPositionRepository
+	qrLocationRepo     repository.QRLocationRepository
+	outboxRepo         repository.OutboxRepository
+	trafficController  *trafficController
+}
+
+func newPositionTracker(
+	raybotPositionRepo repository.RaybotPositionRepository,
+	qrLocationRepo repository.QRLocationRepository,
+	outboxRepo repository.OutboxRepository,
+	trafficController *trafficController,
+) *positionTracker {
+	return &positionTracker{
+		raybotPositionRepo: raybotPositionRepo,
+		qrLocationRepo:     qrLocationRepo,
+		outboxRepo:         outboxRepo,
+		trafficController:  trafficController,
+	}
+}
+
+// checkIn resolves the QR location of checkIn and sets it as the position
+// of its raybot. A QR code or a location which does not exist fails
+// validation.
+func (t positionTracker) checkIn(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	checkIn raybotposition.CheckIn,
+) (raybotposition.Position, error) {
+	if err := t.trafficController.lock(ctx, db); err != nil {
+		return raybotposition.Position{}, fmt.Errorf("lock traffic: %w", err)
+	}
+
+	locationID, err := t.resolveLocation(ctx, db, checkIn)
+	if err != nil {
+		return raybotposition.Position{}, err
+	}
+
+	position := raybotposition.NewPosition(checkIn.RaybotID, locationID, checkIn.Source, checkIn.CommandID)
+	if err := t.raybotPositionRepo.SetRaybotPosition(ctx, db, position); err != nil {
+		return raybotposition.Position{}, fmt.Errorf("repo set raybot position: %w", err)
+	}
+
+	if err := writeEvents(ctx, db, t.outboxRepo, pubsub.RaybotPositionChanged{
+		RaybotID:   position.RaybotID,
+		LocationID: position.LocationID,
+		Source:     position.Source,
+		CommandID:  position.CommandID,
+	}); err != nil {
+		return raybotposition.Position{}, err
+	}
+
+	if err := t.trafficController.advance(ctx, db, position.RaybotID, position.LocationID); err != nil {
+		return raybotposition.Position{}, fmt.Errorf("advance traffic: %w", err)
+	}
+
+	return position, nil
+}
+
+func (t positionTracker) resolveLocation(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	checkIn raybotposition.CheckIn,
+) (string, error) {
+	if checkIn.LocationID != "" {
+		if _, err := t.qrLocationRepo.GetQRLocation(ctx, db, checkIn.LocationID); err != nil {
+			if xerror.IsStatus(err, xerror.StatusNotFound) {
+				return "", xerror.ValidationFailed(err, fmt.Sprintf("Location %s does not exist", checkIn.LocationID))
+			}
+			return "", fmt.Errorf("repo get qr location: %w", err)
+		}
+		return checkIn.LocationID, nil
+	}
+
+	location, err := t.qrLocationRepo.GetQRLocationByQRCode(ctx, db, checkIn.QRCode)
+	if err != nil {
+		if xerror.IsStatus(err, xerror.StatusNotFound) {
+			return "", xerror.ValidationFailed(err, fmt.Sprintf("QR code %s does not match any location", checkIn.QRCode))
+		}
+		return "", fmt.Errorf("repo get qr location by qr code: %w", err)
+	}
+	return location.ID, nil
+}
@@ -0,0 +1,167 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/retention"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/archive"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var _ service.RetentionService = (*retentionService)(nil)
+
+// retentionService purges the history past its retention. Each batch is
+// locked, archived and deleted in a short transaction, so the purge does not
+// hold locks for long and several instances can run it at once.
+//
+// The archive is written before the transaction commits: a batch whose
+// commit fails is archived again by the next purge.
+type retentionService struct {
+	workflowExecutionRepo repository.WorkflowExecutionRepository
+	stepExecutionRepo     repository.StepExecutionRepository
+	raybotCommandRepo     repository.RaybotCommandRepository
+	sqlDBProvider         sqldb.Provider
+	// archiver is nil when archival is disabled.
+	archiver  archive.Archiver
+	validator validator.Validator
+}
+
+func newRetentionService(
+	workflowExecutionRepo repository.WorkflowExecutionRepository,
+	stepExecutionRepo repository.StepExecutionRepository,
+	raybotCommandRepo repository.RaybotCommandRepository,
+	sqlDBProvider sqldb.Provider,
+	archiver archive.Archiver,
+	validator validator.Validator,
+) *retentionService {
+	return &retentionService{
+		workflowExecutionRepo: workflowExecutionRepo,
+		stepExecutionRepo:     stepExecutionRepo,
+		raybotCommandRepo:     raybotCommandRepo,
+		sqlDBProvider:         sqlDBProvider,
+		archiver:              archiver,
+		validator:             validator,
+	}
+}
+
+func (s retentionService) PurgeWorkflowExecutions(ctx context.Context, params service.PurgeWorkflowExecutionsParams) (retention.Purged, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+	if !params.Status.IsFinished() {
+		return nil, xerror.ValidationFailed(nil, fmt.Sprintf("Workflow executions %s are not finished and can not be purged", params.Status))
+	}
+
+	purged := retention.Purged{}
+	err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		executions, err := s.workflowExecutionRepo.ListExpiredWorkflowExecutions(ctx, db, params.Status, params.Before, params.BatchSize)
+		if err != nil {
+			return fmt.Errorf("repo list expired workflow executions: %w", err)
+		}
+		if len(executions) == 0 {
+			return nil
+		}
+
+		ids := make([]string, len(executions))
+		for i, e := range executions {
+			ids[i] = e.ID
+		}
+
+		if s.archiver != nil {
+			steps, err := s.stepExecutionRepo.ListStepsByWorkflowExecutionIDs(ctx, db, ids)
+			if err != nil {
+				return fmt.Errorf("repo list steps by workflow execution ids: %w", err)
+			}
+
+			events, err := s.workflowExecutionRepo.ListEventsByWorkflowExecutionIDs(ctx, db, ids)
+			if err != nil {
+				return fmt.Errorf("repo list events by workflow execution ids: %w", err)
+			}
+
+			records := retention.NewWorkflowExecutionRecords(executions, steps, events)
+			if err := s.archiver.Archive(retention.TableWorkflowExecutions, toAny(records)); err != nil {
+				return fmt.Errorf("archive workflow executions: %w", err)
+			}
+		}
+
+		purged[retention.TableWorkflowExecutionEvents], err = s.workflowExecutionRepo.DeleteEventsByWorkflowExecutionIDs(ctx, db, ids)
+		if err != nil {
+			return fmt.Errorf("repo delete events by workflow execution ids: %w", err)
+		}
+
+		purged[retention.TableStepExecutions], err = s.stepExecutionRepo.DeleteStepsByWorkflowExecutionIDs(ctx, db, ids)
+		if err != nil {
+			return fmt.Errorf("repo delete steps by workflow execution ids: %w", err)
+		}
+
+		purged[retention.TableWorkflowExecutions], err = s.workflowExecutionRepo.DeleteWorkflowExecutionsByIDs(ctx, db, ids)
+		if err != nil {
+			return fmt.Errorf("repo delete workflow executions by ids: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return purged, nil
+}
+
+func (s retentionService) PurgeRaybotCommands(ctx context.Context, params service.PurgeRaybotCommandsParams) (retention.Purged, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+	if !params.Status.IsFinished() {
+		return nil, xerror.ValidationFailed(nil, fmt.Sprintf("Raybot commands %s are not finished and can not be purged", params.Status))
+	}
+
+	purged := retention.Purged{}
+	err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		commands, err := s.raybotCommandRepo.ListExpiredRaybotCommands(ctx, db, params.Status, params.Before, params.BatchSize)
+		if err != nil {
+			return fmt.Errorf("repo list expired raybot commands: %w", err)
+		}
+		if len(commands) == 0 {
+			return nil
+		}
+
+		ids := make([]string, len(commands))
+		records := make([]any, len(commands))
+		for i, c := range commands {
+			ids[i] = c.ID
+			records[i] = retention.NewRaybotCommandRecord(c)
+		}
+
+		if s.archiver != nil {
+			if err := s.archiver.Archive(retention.TableRaybotCommands, records); err != nil {
+				return fmt.Errorf("archive raybot commands: %w", err)
+			}
+		}
+
+		purged[retention.TableRaybotCommands], err = s.raybotCommandRepo.DeleteRaybotCommandsByIDs(ctx, db, ids)
+		if err != nil {
+			return fmt.Errorf("repo delete raybot commands by ids: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return purged, nil
+}
+
+func toAny[T any](items []T) []any {
+	ret := make([]any, len(items))
+	for i, item := range items {
+		ret[i] = item
+	}
+	return ret
+}
@@ -0,0 +1,194 @@
+package serviceimpl_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/retention"
+	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/internal/service/serviceimpl"
+	"github.com/tuanvumaihuynh/roboflow/pkg/archive"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+)
+
+// purgeLog records the calls made by a purge, in order.
+type purgeLog struct {
+	calls []string
+}
+
+type fakePurgeWorkflowExecutionRepo struct {
+	repository.WorkflowExecutionRepository
+	log        *purgeLog
+	executions []workflowexecution.WorkflowExecution
+}
+
+func (r fakePurgeWorkflowExecutionRepo) ListExpiredWorkflowExecutions(
+	context.Context,
+	sqldb.SQLDB,
+	workflowexecution.Status,
+	time.Time,
+	int32,
+) ([]workflowexecution.WorkflowExecution, error) {
+	r.log.calls = append(r.log.calls, "list executions")
+	return r.executions, nil
+}
+
+func (r fakePurgeWorkflowExecutionRepo) ListEventsByWorkflowExecutionIDs(_ context.Context, _ sqldb.SQLDB, ids []string) ([]workflowexecution.Event, error) {
+	r.log.calls = append(r.log.calls, "list events")
+	events := make([]workflowexecution.Event, len(ids))
+	for i, id := range ids {
+		events[i] = workflowexecution.Event{ID: int64(i + 1), WorkflowExecutionID: id}
+	}
+	return events, nil
+}
+
+func (r fakePurgeWorkflowExecutionRepo) DeleteEventsByWorkflowExecutionIDs(_ context.Context, _ sqldb.SQLDB, ids []string) (int64, error) {
+	r.log.calls = append(r.log.calls, "delete events")
+	return int64(3 * len(ids)), nil
+}
+
+func (r fakePurgeWorkflowExecutionRepo) DeleteWorkflowExecutionsByIDs(_ context.Context, _ sqldb.SQLDB, ids []string) (int64, error) {
+	r.log.calls = append(r.log.calls, "delete executions")
+	return int64(len(ids)), nil
+}
+
+type fakePurgeStepExecutionRepo struct {
+	repository.StepExecutionRepository
+	log *purgeLog
+}
+
+func (r fakePurgeStepExecutionRepo) ListStepsByWorkflowExecutionIDs(_ context.Context, _ sqldb.SQLDB, ids []string) ([]stepexecution.StepExecution, error) {
+	r.log.calls = append(r.log.calls, "list steps")
+	steps := make([]stepexecution.StepExecution, len(ids))
+	for i, id := range ids {
+		steps[i] = stepexecution.StepExecution{ID: id + "-step", WorkflowExecutionID: id}
+	}
+	return steps, nil
+}
+
+func (r fakePurgeStepExecutionRepo) DeleteStepsByWorkflowExecutionIDs(_ context.Context, _ sqldb.SQLDB, ids []string) (int64, error) {
+	r.log.calls = append(r.log.calls, "delete steps")
+	return int64(2 * len(ids)), nil
+}
+
+type fakeArchiver struct {
+	log     *purgeLog
+	records []any
+}
+
+func (a *fakeArchiver) Archive(table string, records []any) error {
+	a.log.calls = append(a.log.calls, "archive "+table)
+	a.records = append(a.records, records...)
+	return nil
+}
+
+func TestRetentionServicePurgeWorkflowExecutions(t *testing.T) {
+	executions := []workflowexecution.WorkflowExecution{
+		{ID: "6b0f3a1e-2c4d-4e5f-9a6b-7c8d9e0f1a2b", Status: workflowexecution.StatusCompleted},
+		{ID: "8d2e4f6a-1b3c-4d5e-8f7a-9b0c1d2e3f4a", Status: workflowexecution.StatusCompleted},
+	}
+
+	tests := []struct {
+		name       string
+		status     workflowexecution.Status
+		executions []workflowexecution.WorkflowExecution
+		archive    bool
+		wantCalls  []string
+		wantPurged retention.Purged
+		expectErr  bool
+		errMessage string
+	}{
+		{
+			name:       "Archive before delete",
+			status:     workflowexecution.StatusCompleted,
+			executions: executions,
+			archive:    true,
+			wantCalls: []string{
+				"list executions", "list steps", "list events", "archive workflow_executions",
+				"delete events", "delete steps", "delete executions",
+			},
+			wantPurged: retention.Purged{
+				retention.TableWorkflowExecutionEvents: 6,
+				retention.TableStepExecutions:          4,
+				retention.TableWorkflowExecutions:      2,
+			},
+		},
+		{
+			name:       "No archive when archival is disabled",
+			status:     workflowexecution.StatusCompleted,
+			executions: executions,
+			wantCalls:  []string{"list executions", "delete events", "delete steps", "delete executions"},
+			wantPurged: retention.Purged{
+				retention.TableWorkflowExecutionEvents: 6,
+				retention.TableStepExecutions:          4,
+				retention.TableWorkflowExecutions:      2,
+			},
+		},
+		{
+			name:       "No expired executions",
+			status:     workflowexecution.StatusFailed,
+			archive:    true,
+			wantCalls:  []string{"list executions"},
+			wantPurged: retention.Purged{},
+		},
+		{
+			name:       "Unfinished status",
+			status:     workflowexecution.StatusRunning,
+			executions: executions,
+			archive:    true,
+			expectErr:  true,
+			errMessage: "Workflow executions RUNNING are not finished and can not be purged",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := &purgeLog{}
+			var archiver archive.Archiver
+			fake := &fakeArchiver{log: log}
+			if tt.archive {
+				archiver = fake
+			}
+			repo := fakeRepository{
+				workflowExecutionRepo: fakePurgeWorkflowExecutionRepo{log: log, executions: tt.executions},
+				stepExecutionRepo:     fakePurgeStepExecutionRepo{log: log},
+			}
+			svc := serviceimpl.NewService(repo, fakeSQLDBProvider{}, nil, nil, nil, nil, nil, webhook.RetryPolicy{}, archiver,
+				validator.NewValidator(), slog.Default())
+
+			purged, err := svc.Retention().PurgeWorkflowExecutions(context.Background(), service.PurgeWorkflowExecutionsParams{
+				Status:    tt.status,
+				Before:    time.Now(),
+				BatchSize: 500,
+			})
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+				assert.Empty(t, log.calls)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCalls, log.calls)
+			assert.Equal(t, tt.wantPurged, purged)
+
+			if tt.archive && len(tt.executions) > 0 {
+				require.Len(t, fake.records, len(tt.executions))
+				for _, r := range fake.records {
+					record := r.(retention.WorkflowExecutionRecord)
+					assert.Len(t, record.Steps, 1)
+					assert.Len(t, record.Events, 1)
+				}
+			}
+		})
+	}
+}
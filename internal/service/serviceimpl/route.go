@@ -0,0 +1,66 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+)
+
+var _ service.RouteService = (*routeService)(nil)
+
+type routeService struct {
+	qrLocationRepo     repository.QRLocationRepository
+	trackSegmentRepo   repository.TrackSegmentRepository
+	raybotPositionRepo repository.RaybotPositionRepository
+	sqlDBProvider      sqldb.Provider
+	validator          validator.Validator
+}
+
+func newRouteService(
+	qrLocationRepo repository.QRLocationRepository,
+	trackSegmentRepo repository.TrackSegmentRepository,
+	raybotPositionRepo repository.RaybotPositionRepository,
+	sqlDBProvider sqldb.Provider,
+	validator validator.Validator,
+) *routeService {
+	return &routeService{
+		qrLocationRepo:     qrLocationRepo,
+		trackSegmentRepo:   trackSegmentRepo,
+		raybotPositionRepo: raybotPositionRepo,
+		sqlDBProvider:      sqlDBProvider,
+		validator:          validator,
+	}
+}
+
+func (s routeService) PlanRoute(ctx context.Context, params service.PlanRouteParams) (trackmap.Route, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.Route{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	db := s.sqlDBProvider.DB()
+	fromLocationID := params.FromLocationID
+	if params.RaybotID != "" {
+		position, err := s.raybotPositionRepo.GetRaybotPosition(ctx, db, params.RaybotID)
+		if err != nil {
+			return trackmap.Route{}, fmt.Errorf("repo get raybot position: %w", err)
+		}
+		fromLocationID = position.LocationID
+	}
+
+	m, err := loadTrackMap(ctx, db, s.qrLocationRepo, s.trackSegmentRepo)
+	if err != nil {
+		return trackmap.Route{}, fmt.Errorf("load track map: %w", err)
+	}
+
+	route, err := m.ShortestRoute(fromLocationID, params.ToLocationID)
+	if err != nil {
+		return trackmap.Route{}, fmt.Errorf("shortest route: %w", err)
+	}
+
+	return route, nil
+}
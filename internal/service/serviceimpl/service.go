@@ -6,20 +6,32 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/internal/simulator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/archive"
 	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/webhookhttp"
 )
 
 var _ service.Service = (*serviceimpl)(nil)
 
 type serviceimpl struct {
 	qrLocationService        *qrLocationService
+	trackMapService          *trackMapService
+	routeService             *routeService
 	raybotService            *raybotService
 	raybotCommandService     *raybotCommandService
+	raybotPositionService    *raybotPositionService
 	workflowService          *workflowService
+	workflowVersionService   *workflowVersionService
 	workflowExecutionService *workflowExecutionService
 	stepExecutionService     *stepExecutionService
+	outboxService            *outboxService
+	webhookService           *webhookService
+	deadLetterService        *deadLetterService
+	retentionService         *retentionService
 }
 
 //nolint:revive
@@ -27,25 +39,57 @@ func NewService(
 	repository repository.Repository,
 	sqlDBProvider sqldb.Provider,
 	publisher message.Publisher,
+	broadcastPublisher message.Publisher,
+	broadcastSubscriber message.Subscriber,
+	raybotSimulator simulator.RaybotSimulator,
+	webhookSender webhookhttp.Sender,
+	webhookRetryPolicy webhook.RetryPolicy,
+	archiver archive.Archiver,
 	validator validator.Validator,
 	log *slog.Logger,
 ) *serviceimpl {
 	qrLocationSvc := newQRLocationService(repository.QRLocation(), sqlDBProvider, validator)
-	raybotSvc := newRaybotService(repository.Raybot(), sqlDBProvider, validator)
-	raybotCommandSvc := newRaybotCommandService(repository.RaybotCommand(), sqlDBProvider, publisher, validator)
-	workflowSvc := newWorkflowService(repository.Workflow(), repository.WorkflowExecution(),
-		repository.StepExecution(), sqlDBProvider, publisher, validator)
+	trackMapSvc := newTrackMapService(repository.TrackSegment(), repository.QRLocation(), sqlDBProvider, validator)
+	routeSvc := newRouteService(repository.QRLocation(), repository.TrackSegment(), repository.RaybotPosition(),
+		sqlDBProvider, validator)
+	raybotSvc := newRaybotService(repository.Raybot(), sqlDBProvider, repository.Outbox(), validator)
+	trafficController := newTrafficController(repository.TrackReservation(), repository.RaybotCommand(),
+		repository.RaybotPosition(), repository.QRLocation(), repository.TrackSegment(), repository.Outbox())
+	positionTracker := newPositionTracker(repository.RaybotPosition(), repository.QRLocation(), repository.Outbox(),
+		trafficController)
+	raybotCommandSvc := newRaybotCommandService(repository.RaybotCommand(), repository.QRLocation(), trafficController,
+		positionTracker, sqlDBProvider, repository.Outbox(), validator)
+	raybotPositionSvc := newRaybotPositionService(repository.RaybotPosition(), repository.Raybot(),
+		repository.QRLocation(), positionTracker, sqlDBProvider, validator)
+	workflowSvc := newWorkflowService(repository.Workflow(), repository.WorkflowVersion(), repository.WorkflowExecution(),
+		repository.StepExecution(), repository.QRLocation(), repository.Raybot(), sqlDBProvider, repository.Outbox(), validator)
+	workflowVersionSvc := newWorkflowVersionService(repository.Workflow(), repository.WorkflowVersion(),
+		sqlDBProvider, repository.Outbox(), validator)
 	workflowExecutionSvc := newWorkflowExecutionService(repository.WorkflowExecution(),
-		repository.StepExecution(), sqlDBProvider, validator)
+		repository.StepExecution(), raybotSimulator, repository.Outbox(), broadcastPublisher, broadcastSubscriber, sqlDBProvider, validator, log)
 	stepExecutionSvc := newStepExecutionService(repository.StepExecution(), sqlDBProvider, validator)
+	outboxSvc := newOutboxService(repository.Outbox(), sqlDBProvider, publisher, validator)
+	webhookSvc := newWebhookService(repository.WebhookSubscription(), repository.WebhookDelivery(), sqlDBProvider,
+		webhookSender, webhookRetryPolicy, validator)
+	deadLetterSvc := newDeadLetterService(repository.DeadLetterMessage(), repository.Outbox(), sqlDBProvider, validator)
+	retentionSvc := newRetentionService(repository.WorkflowExecution(), repository.StepExecution(),
+		repository.RaybotCommand(), sqlDBProvider, archiver, validator)
 
 	return &serviceimpl{
 		qrLocationService:        qrLocationSvc,
+		trackMapService:          trackMapSvc,
+		routeService:             routeSvc,
 		raybotService:            raybotSvc,
 		raybotCommandService:     raybotCommandSvc,
+		raybotPositionService:    raybotPositionSvc,
 		workflowService:          workflowSvc,
+		workflowVersionService:   workflowVersionSvc,
 		workflowExecutionService: workflowExecutionSvc,
 		stepExecutionService:     stepExecutionSvc,
+		outboxService:            outboxSvc,
+		webhookService:           webhookSvc,
+		deadLetterService:        deadLetterSvc,
+		retentionService:         retentionSvc,
 	}
 }
 
@@ -53,6 +97,14 @@ func (s *serviceimpl) QRLocation() service.QRLocationService {
 	return s.qrLocationService
 }
 
+func (s *serviceimpl) TrackMap() service.TrackMapService {
+	return s.trackMapService
+}
+
+func (s *serviceimpl) Route() service.RouteService {
+	return s.routeService
+}
+
 func (s *serviceimpl) Raybot() service.RaybotService {
 	return s.raybotService
 }
@@ -61,10 +113,18 @@ func (s *serviceimpl) RaybotCommand() service.RaybotCommandService {
 	return s.raybotCommandService
 }
 
+func (s *serviceimpl) RaybotPosition() service.RaybotPositionService {
+	return s.raybotPositionService
+}
+
 func (s *serviceimpl) Workflow() service.WorkflowService {
 	return s.workflowService
 }
 
+func (s *serviceimpl) WorkflowVersion() service.WorkflowVersionService {
+	return s.workflowVersionService
+}
+
 func (s *serviceimpl) WorkflowExecution() service.WorkflowExecutionService {
 	return s.workflowExecutionService
 }
@@ -72,3 +132,19 @@ func (s *serviceimpl) WorkflowExecution() service.WorkflowExecutionService {
 func (s *serviceimpl) StepExecution() service.StepExecutionService {
 	return s.stepExecutionService
 }
+
+func (s *serviceimpl) Outbox() service.OutboxService {
+	return s.outboxService
+}
+
+func (s *serviceimpl) Webhook() service.WebhookService {
+	return s.webhookService
+}
+
+func (s *serviceimpl) DeadLetter() service.DeadLetterService {
+	return s.deadLetterService
+}
+
+func (s *serviceimpl) Retention() service.RetentionService {
+	return s.retentionService
+}
@@ -8,6 +8,7 @@ import (
 	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
 )
 
@@ -44,14 +45,23 @@ func (s stepExecutionService) GetStepExecution(ctx context.Context, params servi
 	return stepExecution, nil
 }
 
-func (s stepExecutionService) ListStepsByWorkflowExecutionID(ctx context.Context, params service.ListStepsByWorkflowExecutionIDParams) ([]stepexecution.StepExecution, error) {
+func (s stepExecutionService) ListStepsByWorkflowExecutionID(
+	ctx context.Context,
+	params service.ListStepsByWorkflowExecutionIDParams,
+) (paging.List[stepexecution.StepExecution], error) {
 	if err := s.validator.Validate(params); err != nil {
-		return nil, fmt.Errorf("validate params: %w", err)
+		return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	steps, err := s.stepExecutionRepo.ListStepsByWorkflowExecutionID(ctx, s.sqlDBProvider.DB(), params.WorkflowExecutionID)
+	steps, err := s.stepExecutionRepo.ListStepsByWorkflowExecutionID(
+		ctx,
+		s.sqlDBProvider.DB(),
+		params.WorkflowExecutionID,
+		params.PagingParams,
+		params.Sorts,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("repo list steps by workflow execution id: %w", err)
+		return paging.List[stepexecution.StepExecution]{}, fmt.Errorf("repo list steps by workflow execution id: %w", err)
 	}
 
 	return steps, nil
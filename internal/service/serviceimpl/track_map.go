@@ -0,0 +1,172 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var _ service.TrackMapService = (*trackMapService)(nil)
+
+type trackMapService struct {
+	trackSegmentRepo repository.TrackSegmentRepository
+	qrLocationRepo   repository.QRLocationRepository
+	sqlDBProvider    sqldb.Provider
+	validator        validator.Validator
+}
+
+func newTrackMapService(
+	trackSegmentRepo repository.TrackSegmentRepository,
+	qrLocationRepo repository.QRLocationRepository,
+	sqlDBProvider sqldb.Provider,
+	validator validator.Validator,
+) *trackMapService {
+	return &trackMapService{
+		trackSegmentRepo: trackSegmentRepo,
+		qrLocationRepo:   qrLocationRepo,
+		sqlDBProvider:    sqlDBProvider,
+		validator:        validator,
+	}
+}
+
+func (s trackMapService) GetTrackMap(ctx context.Context) (trackmap.Map, error) {
+	m, err := loadTrackMap(ctx, s.sqlDBProvider.DB(), s.qrLocationRepo, s.trackSegmentRepo)
+	if err != nil {
+		return trackmap.Map{}, fmt.Errorf("load track map: %w", err)
+	}
+
+	return m, nil
+}
+
+func (s trackMapService) GetTrackSegment(ctx context.Context, params service.GetTrackSegmentParams) (trackmap.Segment, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.Segment{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	segment, err := s.trackSegmentRepo.GetTrackSegment(ctx, s.sqlDBProvider.DB(), params.ID)
+	if err != nil {
+		return trackmap.Segment{}, fmt.Errorf("repo get track segment: %w", err)
+	}
+
+	return segment, nil
+}
+
+func (s trackMapService) ListTrackSegments(
+	ctx context.Context,
+	params service.ListTrackSegmentsParams,
+) (paging.List[trackmap.Segment], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[trackmap.Segment]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	segments, err := s.trackSegmentRepo.ListTrackSegments(ctx, s.sqlDBProvider.DB(), params.PagingParams, params.Sorts)
+	if err != nil {
+		return paging.List[trackmap.Segment]{}, fmt.Errorf("repo list track segments: %w", err)
+	}
+
+	return segments, nil
+}
+
+func (s trackMapService) CreateTrackSegment(
+	ctx context.Context,
+	params service.CreateTrackSegmentParams,
+) (trackmap.Segment, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.Segment{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.checkLocationsExist(ctx, params.FromLocationID, params.ToLocationID); err != nil {
+		return trackmap.Segment{}, err
+	}
+
+	segment := trackmap.NewSegment(params.FromLocationID, params.ToLocationID, params.Distance, params.Direction)
+	if err := s.trackSegmentRepo.CreateTrackSegment(ctx, s.sqlDBProvider.DB(), segment); err != nil {
+		return trackmap.Segment{}, fmt.Errorf("repo create track segment: %w", err)
+	}
+
+	return segment, nil
+}
+
+func (s trackMapService) UpdateTrackSegment(
+	ctx context.Context,
+	params service.UpdateTrackSegmentParams,
+) (trackmap.Segment, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.Segment{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.checkLocationsExist(ctx, params.FromLocationID, params.ToLocationID); err != nil {
+		return trackmap.Segment{}, err
+	}
+
+	segment, err := s.trackSegmentRepo.UpdateTrackSegment(ctx, s.sqlDBProvider.DB(), repository.UpdateTrackSegmentParams{
+		ID:                params.ID,
+		FromLocationID:    params.FromLocationID,
+		SetFromLocationID: true,
+		ToLocationID:      params.ToLocationID,
+		SetToLocationID:   true,
+		Distance:          params.Distance,
+		SetDistance:       true,
+		Direction:         params.Direction,
+		SetDirection:      true,
+	})
+	if err != nil {
+		return trackmap.Segment{}, fmt.Errorf("repo update track segment: %w", err)
+	}
+
+	return segment, nil
+}
+
+func (s trackMapService) DeleteTrackSegment(ctx context.Context, params service.DeleteTrackSegmentParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.trackSegmentRepo.DeleteTrackSegment(ctx, s.sqlDBProvider.DB(), params.ID); err != nil {
+		return fmt.Errorf("repo delete track segment: %w", err)
+	}
+
+	return nil
+}
+
+// checkLocationsExist returns a validation error if a QR location does not
+// exist.
+func (s trackMapService) checkLocationsExist(ctx context.Context, ids ...string) error {
+	for _, id := range ids {
+		if _, err := s.qrLocationRepo.GetQRLocation(ctx, s.sqlDBProvider.DB(), id); err != nil {
+			if xerror.IsStatus(err, xerror.StatusNotFound) {
+				return xerror.ValidationFailed(nil, fmt.Sprintf("Location %s does not exist", id))
+			}
+			return fmt.Errorf("repo get qr location %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// loadTrackMap reads the Map of every QR location and Segment.
+func loadTrackMap(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	qrLocationRepo repository.QRLocationRepository,
+	trackSegmentRepo repository.TrackSegmentRepository,
+) (trackmap.Map, error) {
+	locations, err := qrLocationRepo.ListAllQRLocations(ctx, db)
+	if err != nil {
+		return trackmap.Map{}, fmt.Errorf("repo list all qr locations: %w", err)
+	}
+
+	segments, err := trackSegmentRepo.ListAllTrackSegments(ctx, db)
+	if err != nil {
+		return trackmap.Map{}, fmt.Errorf("repo list all track segments: %w", err)
+	}
+
+	return trackmap.Map{Locations: locations, Segments: segments}, nil
+}
@@ -0,0 +1,264 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	trackreservation "github.com/tuanvumaihuynh/roboflow/internal/model/track_reservation"
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+// trafficController reserves the routes of the MOVE_TO_LOCATION commands, so
+// the raybots sharing the track never travel the same rail at the same time.
+// A move is created QUEUED and dispatched, set PENDING, once its route is
+// reserved. The part of its route behind the raybot is released as the
+// raybot checks in along it, the rest when the move succeeds or fails.
+type trafficController struct {
+	trackReservationRepo repository.TrackReservationRepository
+	raybotCommandRepo    repository.RaybotCommandRepository
+	raybotPositionRepo   repository.RaybotPositionRepository
+	qrLocationRepo       repository.QRLocationRepository
+	trackSegmentRepo     repository.TrackSegmentRepository
+	outboxRepo           repository.OutboxRepository
+}
+
+func newTrafficController(
+	trackReservationRepo repository.TrackReservationRepository,
+	raybotCommandRepo repository.RaybotCommandRepository,
+	raybotPositionRepo repository.RaybotPositionRepository,
+	qrLocationRepo repository.QRLocationRepository,
+	trackSegmentRepo repository.TrackSegmentRepository,
+	outboxRepo repository.OutboxRepository,
+) *trafficController {
+	return &trafficController{
+		trackReservationRepo: trackReservationRepo,
+		raybotCommandRepo:    raybotCommandRepo,
+		raybotPositionRepo:   raybotPositionRepo,
+		qrLocationRepo:       qrLocationRepo,
+		trackSegmentRepo:     trackSegmentRepo,
+		outboxRepo:           outboxRepo,
+	}
+}
+
+// reserve queues the reservation of the route of rbc, a QUEUED move with
+// validated inputs created in the same transaction, and schedules the
+// reservations. It returns the status of rbc once scheduled, or the error rbc
+// is rejected with.
+func (c trafficController) reserve(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	rbc raybotcommand.RaybotCommand,
+) (raybotcommand.Status, error) {
+	input, err := rbc.Inputs.AsMoveToLocationInput()
+	if err != nil {
+		return "", fmt.Errorf("as move to location input: %w", err)
+	}
+
+	traffic, err := c.loadTraffic(ctx, db)
+	if err != nil {
+		return "", fmt.Errorf("load traffic: %w", err)
+	}
+
+	reservation := trackreservation.NewReservation(rbc.ID, rbc.RaybotID, input.Location)
+	if err := c.trackReservationRepo.CreateTrackReservation(ctx, db, reservation); err != nil {
+		return "", fmt.Errorf("repo create track reservation: %w", err)
+	}
+	traffic.Reservations = append(traffic.Reservations, reservation)
+
+	plan := traffic.Schedule()
+	for _, rejection := range plan.Rejected {
+		if rejection.Reservation.CommandID == rbc.ID {
+			return "", rejection.Err
+		}
+	}
+
+	if err := c.apply(ctx, db, plan, rbc.ID); err != nil {
+		return "", fmt.Errorf("apply plan: %w", err)
+	}
+
+	for _, r := range plan.Granted {
+		if r.CommandID == rbc.ID {
+			return raybotcommand.RaybotCommandStatusPending, nil
+		}
+	}
+	return raybotcommand.RaybotCommandStatusQueued, nil
+}
+
+// lock locks the reservations until the end of the transaction. A
+// transaction updating a move locks them first, before the command row,
+// so it does not deadlock with one scheduling the reservations.
+func (c trafficController) lock(ctx context.Context, db sqldb.SQLDB) error {
+	if err := c.trackReservationRepo.LockTrackReservations(ctx, db); err != nil {
+		return fmt.Errorf("repo lock track reservations: %w", err)
+	}
+
+	return nil
+}
+
+// release releases the reservation of a finished command and schedules the
+// queued ones.
+func (c trafficController) release(ctx context.Context, db sqldb.SQLDB, commandID string) error {
+	if err := c.lock(ctx, db); err != nil {
+		return err
+	}
+
+	if err := c.trackReservationRepo.DeleteTrackReservation(ctx, db, commandID); err != nil {
+		return fmt.Errorf("repo delete track reservation: %w", err)
+	}
+
+	return c.schedule(ctx, db)
+}
+
+// advance releases the part of the granted route behind a raybot which
+// checked in at locationID, and schedules the queued reservations, which the
+// raybot may have stopped blocking.
+func (c trafficController) advance(ctx context.Context, db sqldb.SQLDB, raybotID, locationID string) error {
+	traffic, err := c.loadTraffic(ctx, db)
+	if err != nil {
+		return fmt.Errorf("load traffic: %w", err)
+	}
+
+	for i, r := range traffic.Reservations {
+		if r.RaybotID != raybotID || r.Status != trackreservation.StatusGranted {
+			continue
+		}
+		if !r.Advance(locationID) {
+			break
+		}
+		if err := c.trackReservationRepo.UpdateTrackReservation(ctx, db, r); err != nil {
+			return fmt.Errorf("repo update track reservation: %w", err)
+		}
+		traffic.Reservations[i] = r
+		break
+	}
+
+	if err := c.apply(ctx, db, traffic.Schedule(), ""); err != nil {
+		return fmt.Errorf("apply plan: %w", err)
+	}
+
+	return nil
+}
+
+// schedule grants the queued reservations whose route is free.
+func (c trafficController) schedule(ctx context.Context, db sqldb.SQLDB) error {
+	traffic, err := c.loadTraffic(ctx, db)
+	if err != nil {
+		return fmt.Errorf("load traffic: %w", err)
+	}
+
+	if err := c.apply(ctx, db, traffic.Schedule(), ""); err != nil {
+		return fmt.Errorf("apply plan: %w", err)
+	}
+
+	return nil
+}
+
+// loadTraffic locks the reservations and loads the state to schedule them
+// from.
+func (c trafficController) loadTraffic(ctx context.Context, db sqldb.SQLDB) (trackreservation.Traffic, error) {
+	if err := c.lock(ctx, db); err != nil {
+		return trackreservation.Traffic{}, err
+	}
+
+	m, err := loadTrackMap(ctx, db, c.qrLocationRepo, c.trackSegmentRepo)
+	if err != nil {
+		return trackreservation.Traffic{}, fmt.Errorf("load track map: %w", err)
+	}
+
+	reservations, err := c.trackReservationRepo.ListActiveTrackReservations(ctx, db)
+	if err != nil {
+		return trackreservation.Traffic{}, fmt.Errorf("repo list active track reservations: %w", err)
+	}
+
+	positions, err := c.raybotPositionRepo.ListAllRaybotPositions(ctx, db)
+	if err != nil {
+		return trackreservation.Traffic{}, fmt.Errorf("repo list all raybot positions: %w", err)
+	}
+	locations := make(map[string]string, len(positions))
+	for _, p := range positions {
+		locations[p.RaybotID] = p.LocationID
+	}
+
+	return trackreservation.Traffic{
+		Map:             m,
+		Reservations:    reservations,
+		RaybotLocations: locations,
+	}, nil
+}
+
+// apply grants and rejects the reservations of plan. The command createdID,
+// which is being created, gets its status without a status change event.
+func (c trafficController) apply(ctx context.Context, db sqldb.SQLDB, plan trackreservation.Plan, createdID string) error {
+	for _, r := range plan.Granted {
+		if err := c.trackReservationRepo.UpdateTrackReservation(ctx, db, r); err != nil {
+			return fmt.Errorf("repo update track reservation: %w", err)
+		}
+
+		if err := c.setCommandStatus(ctx, db, r.CommandID, raybotcommand.RaybotCommandStatusPending, nil, createdID); err != nil {
+			return fmt.Errorf("dispatch command: %w", err)
+		}
+	}
+
+	for _, rejection := range plan.Rejected {
+		if err := c.trackReservationRepo.DeleteTrackReservation(ctx, db, rejection.Reservation.CommandID); err != nil {
+			return fmt.Errorf("repo delete track reservation: %w", err)
+		}
+
+		msg := rejection.Err.Error()
+		var xErr xerror.XError
+		if errors.As(rejection.Err, &xErr) {
+			msg = xErr.Msg()
+		}
+		if err := c.setCommandStatus(ctx, db, rejection.Reservation.CommandID, raybotcommand.RaybotCommandStatusFailed, &msg, createdID); err != nil {
+			return fmt.Errorf("fail command: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c trafficController) setCommandStatus(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	commandID string,
+	status raybotcommand.Status,
+	errMsg *string,
+	createdID string,
+) error {
+	params := repository.UpdateRaybotCommandParams{
+		ID:        commandID,
+		Status:    status,
+		SetStatus: true,
+	}
+	if status == raybotcommand.RaybotCommandStatusFailed {
+		now := time.Now()
+		params.Error = errMsg
+		params.SetError = true
+		params.CompletedAt = &now
+		params.SetCompletedAt = true
+	}
+
+	rbc, err := c.raybotCommandRepo.UpdateRaybotCommand(ctx, db, params)
+	if err != nil {
+		return fmt.Errorf("repo update raybot command: %w", err)
+	}
+
+	if commandID == createdID {
+		return nil
+	}
+
+	return writeEvents(ctx, db, c.outboxRepo, pubsub.RaybotCommandStatusChanged{
+		RaybotID:  rbc.RaybotID,
+		CommandID: rbc.ID,
+		Type:      rbc.Type,
+		Status:    rbc.Status,
+		Outputs:   rbc.Outputs,
+		Error:     rbc.Error,
+	})
+}
@@ -0,0 +1,311 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/webhookhttp"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var _ service.WebhookService = (*webhookService)(nil)
+
+type webhookService struct {
+	webhookSubscriptionRepo repository.WebhookSubscriptionRepository
+	webhookDeliveryRepo     repository.WebhookDeliveryRepository
+	sqlDBProvider           sqldb.Provider
+	sender                  webhookhttp.Sender
+	retryPolicy             webhook.RetryPolicy
+	validator               validator.Validator
+}
+
+func newWebhookService(
+	webhookSubscriptionRepo repository.WebhookSubscriptionRepository,
+	webhookDeliveryRepo repository.WebhookDeliveryRepository,
+	sqlDBProvider sqldb.Provider,
+	sender webhookhttp.Sender,
+	retryPolicy webhook.RetryPolicy,
+	validator validator.Validator,
+) *webhookService {
+	return &webhookService{
+		webhookSubscriptionRepo: webhookSubscriptionRepo,
+		webhookDeliveryRepo:     webhookDeliveryRepo,
+		sqlDBProvider:           sqlDBProvider,
+		sender:                  sender,
+		retryPolicy:             retryPolicy,
+		validator:               validator,
+	}
+}
+
+func (s webhookService) GetWebhookSubscription(
+	ctx context.Context,
+	params service.GetWebhookSubscriptionParams,
+) (webhook.Subscription, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return webhook.Subscription{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	sub, err := s.webhookSubscriptionRepo.GetWebhookSubscription(ctx, s.sqlDBProvider.DB(), params.ID)
+	if err != nil {
+		return webhook.Subscription{}, fmt.Errorf("repo get webhook subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+func (s webhookService) ListWebhookSubscriptions(
+	ctx context.Context,
+	params service.ListWebhookSubscriptionsParams,
+) (paging.List[webhook.Subscription], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[webhook.Subscription]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	subs, err := s.webhookSubscriptionRepo.ListWebhookSubscriptions(ctx, s.sqlDBProvider.DB(), params.PagingParams, params.Sorts)
+	if err != nil {
+		return paging.List[webhook.Subscription]{}, fmt.Errorf("repo list webhook subscriptions: %w", err)
+	}
+
+	return subs, nil
+}
+
+func (s webhookService) CreateWebhookSubscription(
+	ctx context.Context,
+	params service.CreateWebhookSubscriptionParams,
+) (webhook.Subscription, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return webhook.Subscription{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	eventTypes, err := normalizeEventTypes(params.EventTypes)
+	if err != nil {
+		return webhook.Subscription{}, err
+	}
+
+	sub := webhook.NewSubscription(params.URL, params.Secret, eventTypes, params.IsActive)
+	if err := s.webhookSubscriptionRepo.CreateWebhookSubscription(ctx, s.sqlDBProvider.DB(), sub); err != nil {
+		return webhook.Subscription{}, fmt.Errorf("repo create webhook subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+func (s webhookService) UpdateWebhookSubscription(
+	ctx context.Context,
+	params service.UpdateWebhookSubscriptionParams,
+) (webhook.Subscription, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return webhook.Subscription{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	eventTypes, err := normalizeEventTypes(params.EventTypes)
+	if err != nil {
+		return webhook.Subscription{}, err
+	}
+
+	updateParams := repository.UpdateWebhookSubscriptionParams{
+		ID:            params.ID,
+		URL:           params.URL,
+		SetURL:        true,
+		EventTypes:    eventTypes,
+		SetEventTypes: true,
+		IsActive:      params.IsActive,
+		SetIsActive:   true,
+	}
+	if params.Secret != nil {
+		updateParams.Secret = *params.Secret
+		updateParams.SetSecret = true
+	}
+
+	sub, err := s.webhookSubscriptionRepo.UpdateWebhookSubscription(ctx, s.sqlDBProvider.DB(), updateParams)
+	if err != nil {
+		return webhook.Subscription{}, fmt.Errorf("repo update webhook subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+func (s webhookService) DeleteWebhookSubscription(ctx context.Context, params service.DeleteWebhookSubscriptionParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.webhookSubscriptionRepo.DeleteWebhookSubscription(ctx, s.sqlDBProvider.DB(), params.ID); err != nil {
+		return fmt.Errorf("repo delete webhook subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (s webhookService) ListWebhookDeliveries(
+	ctx context.Context,
+	params service.ListWebhookDeliveriesParams,
+) (paging.List[webhook.Delivery], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[webhook.Delivery]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	db := s.sqlDBProvider.DB()
+
+	// Tell an unknown subscription from one without deliveries
+	if _, err := s.webhookSubscriptionRepo.GetWebhookSubscription(ctx, db, params.SubscriptionID); err != nil {
+		return paging.List[webhook.Delivery]{}, fmt.Errorf("repo get webhook subscription: %w", err)
+	}
+
+	deliveries, err := s.webhookDeliveryRepo.ListWebhookDeliveriesBySubscriptionID(
+		ctx,
+		db,
+		params.SubscriptionID,
+		params.PagingParams,
+		params.Sorts,
+		params.Status,
+	)
+	if err != nil {
+		return paging.List[webhook.Delivery]{}, fmt.Errorf("repo list webhook deliveries by subscription id: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (s webhookService) RedeliverWebhookDelivery(
+	ctx context.Context,
+	params service.RedeliverWebhookDeliveryParams,
+) (webhook.Delivery, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return webhook.Delivery{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	var delivery webhook.Delivery
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		var err error
+		delivery, err = s.webhookDeliveryRepo.GetWebhookDeliveryForUpdate(ctx, db, params.SubscriptionID, params.ID)
+		if err != nil {
+			return fmt.Errorf("repo get webhook delivery for update: %w", err)
+		}
+
+		delivery.Redeliver(time.Now())
+		if err := s.webhookDeliveryRepo.UpdateWebhookDelivery(ctx, db, delivery); err != nil {
+			return fmt.Errorf("repo update webhook delivery: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return webhook.Delivery{}, fmt.Errorf("with tx: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (s webhookService) DispatchWebhookEvent(ctx context.Context, params service.DispatchWebhookEventParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		subs, err := s.webhookSubscriptionRepo.ListActiveWebhookSubscriptionsByEventType(ctx, db, params.EventType)
+		if err != nil {
+			return fmt.Errorf("repo list active webhook subscriptions by event type: %w", err)
+		}
+
+		for _, sub := range subs {
+			delivery := webhook.NewDelivery(sub.ID, params.EventID, params.EventType, params.Payload)
+			if err := s.webhookDeliveryRepo.CreateWebhookDelivery(ctx, db, delivery); err != nil {
+				return fmt.Errorf("repo create webhook delivery: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("with tx: %w", err)
+	}
+
+	return nil
+}
+
+func (s webhookService) DeliverWebhooks(ctx context.Context, params service.DeliverWebhooksParams) (int, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return 0, fmt.Errorf("validate params: %w", err)
+	}
+
+	db := s.sqlDBProvider.DB()
+
+	deliveries, err := s.webhookDeliveryRepo.ClaimDueWebhookDeliveries(ctx, db, params.BatchSize, time.Now().Add(params.Lease))
+	if err != nil {
+		return 0, fmt.Errorf("repo claim due webhook deliveries: %w", err)
+	}
+
+	subs := make(map[string]webhook.Subscription)
+	for _, d := range deliveries {
+		if _, ok := subs[d.SubscriptionID]; ok {
+			continue
+		}
+		sub, err := s.webhookSubscriptionRepo.GetWebhookSubscription(ctx, db, d.SubscriptionID)
+		if err != nil {
+			return 0, fmt.Errorf("repo get webhook subscription: %w", err)
+		}
+		subs[d.SubscriptionID] = sub
+	}
+
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		wg.Add(1)
+		go func(d *webhook.Delivery) {
+			defer wg.Done()
+			s.attemptDelivery(ctx, subs[d.SubscriptionID], d)
+		}(&deliveries[i])
+	}
+	wg.Wait()
+
+	// A delivery not recorded is attempted again once its lease expires
+	var errs []error
+	for _, d := range deliveries {
+		if err := s.webhookDeliveryRepo.UpdateWebhookDelivery(ctx, db, d); err != nil {
+			errs = append(errs, fmt.Errorf("repo update webhook delivery %s: %w", d.ID, err))
+		}
+	}
+
+	return len(deliveries), errors.Join(errs...)
+}
+
+func (s webhookService) attemptDelivery(ctx context.Context, sub webhook.Subscription, d *webhook.Delivery) {
+	status, err := s.sender.Send(ctx, webhookhttp.Request{
+		URL:        sub.URL,
+		Secret:     sub.Secret,
+		EventType:  d.EventType,
+		DeliveryID: d.ID,
+		Body:       d.Payload,
+	})
+	if err != nil {
+		//nolint:gosec // HTTP status codes fit in an int32
+		d.RecordFailure(int32(status), err.Error(), time.Now(), s.retryPolicy)
+		return
+	}
+
+	//nolint:gosec // HTTP status codes fit in an int32
+	d.RecordSuccess(int32(status), time.Now())
+}
+
+// normalizeEventTypes sorts and deduplicates the event types of a
+// Subscription, each of them must be in the domain event catalogue.
+func normalizeEventTypes(eventTypes []string) ([]string, error) {
+	for _, t := range eventTypes {
+		if !slices.ContainsFunc(pubsub.Catalogue, func(e pubsub.Event) bool { return e.EventType() == t }) {
+			return nil, xerror.ValidationFailed(nil, fmt.Sprintf("Unknown event type: %s", t))
+		}
+	}
+
+	normalized := slices.Clone(eventTypes)
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
@@ -0,0 +1,227 @@
+package serviceimpl_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/internal/service/serviceimpl"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/webhookhttp"
+)
+
+const webhookSubscriptionID = "3c9d5e7a-1b2f-4c6d-8e0a-5f7b9d1c3e42"
+
+type fakeWebhookSubscriptionRepo struct {
+	repository.WebhookSubscriptionRepository
+	sub webhook.Subscription
+}
+
+func (r fakeWebhookSubscriptionRepo) GetWebhookSubscription(context.Context, sqldb.SQLDB, string) (webhook.Subscription, error) {
+	return r.sub, nil
+}
+
+// fakeWebhookDeliveryRepo stores a single Delivery.
+type fakeWebhookDeliveryRepo struct {
+	repository.WebhookDeliveryRepository
+	mu       sync.Mutex
+	delivery webhook.Delivery
+}
+
+func (r *fakeWebhookDeliveryRepo) GetWebhookDeliveryForUpdate(context.Context, sqldb.SQLDB, string, string) (webhook.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.delivery, nil
+}
+
+func (r *fakeWebhookDeliveryRepo) ClaimDueWebhookDeliveries(_ context.Context, _ sqldb.SQLDB, _ int32, leaseUntil time.Time) ([]webhook.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.delivery.Status != webhook.DeliveryStatusPending || r.delivery.NextAttemptAt.After(time.Now()) {
+		return nil, nil
+	}
+	r.delivery.NextAttemptAt = leaseUntil
+	return []webhook.Delivery{r.delivery}, nil
+}
+
+func (r *fakeWebhookDeliveryRepo) UpdateWebhookDelivery(_ context.Context, _ sqldb.SQLDB, delivery webhook.Delivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.delivery = delivery
+	return nil
+}
+
+// newWebhookEndpoint serves the given status codes in turn, one per request.
+func newWebhookEndpoint(t *testing.T, statuses ...int) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !assert.NotEmpty(t, statuses, "unexpected request") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		assert.NotEmpty(t, r.Header.Get(webhookhttp.HeaderSignature))
+		w.WriteHeader(statuses[0])
+		statuses = statuses[1:]
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newWebhookService(t *testing.T, url string, deliveryRepo *fakeWebhookDeliveryRepo, policy webhook.RetryPolicy) service.WebhookService {
+	t.Helper()
+
+	repo := fakeRepository{
+		webhookSubscriptionRepo: fakeWebhookSubscriptionRepo{sub: webhook.NewSubscription(url, "secret", nil, true)},
+		webhookDeliveryRepo:     deliveryRepo,
+	}
+	return serviceimpl.NewService(repo, fakeSQLDBProvider{}, nil, nil, nil, nil, webhookhttp.NewClient(time.Second), policy, nil,
+		validator.NewValidator(), slog.Default()).Webhook()
+}
+
+func deliverWebhooks(t *testing.T, svc service.WebhookService) int {
+	t.Helper()
+
+	n, err := svc.DeliverWebhooks(context.Background(), service.DeliverWebhooksParams{
+		BatchSize: 10,
+		Lease:     time.Minute,
+	})
+	require.NoError(t, err)
+
+	return n
+}
+
+func TestWebhookServiceDeliverWebhooks(t *testing.T) {
+	tests := []struct {
+		name           string
+		statuses       []int
+		maxAttempts    int32
+		wantStatus     webhook.DeliveryStatus
+		wantAttempts   int32
+		wantResponse   int32
+		wantRetryAfter time.Duration
+	}{
+		{
+			name:         "Acknowledged",
+			statuses:     []int{http.StatusOK},
+			maxAttempts:  3,
+			wantStatus:   webhook.DeliveryStatusSucceeded,
+			wantAttempts: 1,
+			wantResponse: http.StatusOK,
+		},
+		{
+			name:           "Failed attempt is retried later",
+			statuses:       []int{http.StatusInternalServerError},
+			maxAttempts:    3,
+			wantStatus:     webhook.DeliveryStatusPending,
+			wantAttempts:   1,
+			wantResponse:   http.StatusInternalServerError,
+			wantRetryAfter: time.Hour,
+		},
+		{
+			name:         "Last failed attempt is dead",
+			statuses:     []int{http.StatusBadGateway},
+			maxAttempts:  1,
+			wantStatus:   webhook.DeliveryStatusDead,
+			wantAttempts: 1,
+			wantResponse: http.StatusBadGateway,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := newWebhookEndpoint(t, tt.statuses...)
+			deliveryRepo := &fakeWebhookDeliveryRepo{
+				delivery: webhook.NewDelivery(webhookSubscriptionID, "event-1", "workflow_execution.completed", []byte(`{}`)),
+			}
+			svc := newWebhookService(t, endpoint.URL, deliveryRepo, webhook.RetryPolicy{
+				MaxAttempts:     tt.maxAttempts,
+				InitialInterval: time.Hour,
+				MaxInterval:     time.Hour,
+			})
+
+			start := time.Now()
+			assert.Equal(t, 1, deliverWebhooks(t, svc))
+
+			d := deliveryRepo.delivery
+			assert.Equal(t, tt.wantStatus, d.Status)
+			assert.Equal(t, tt.wantAttempts, d.Attempts)
+			require.NotNil(t, d.LastResponseStatus)
+			assert.Equal(t, tt.wantResponse, *d.LastResponseStatus)
+			if tt.wantRetryAfter > 0 {
+				assert.WithinRange(t, d.NextAttemptAt, start.Add(tt.wantRetryAfter), time.Now().Add(tt.wantRetryAfter))
+				// Not due again before the retry interval
+				assert.Zero(t, deliverWebhooks(t, svc))
+			}
+		})
+	}
+}
+
+func TestWebhookServiceDeliverWebhooksUnreachable(t *testing.T) {
+	endpoint := httptest.NewServer(http.NotFoundHandler())
+	endpoint.Close()
+
+	deliveryRepo := &fakeWebhookDeliveryRepo{
+		delivery: webhook.NewDelivery(webhookSubscriptionID, "event-1", "workflow_execution.completed", []byte(`{}`)),
+	}
+	svc := newWebhookService(t, endpoint.URL, deliveryRepo, webhook.RetryPolicy{
+		MaxAttempts:     3,
+		InitialInterval: time.Hour,
+		MaxInterval:     time.Hour,
+	})
+
+	assert.Equal(t, 1, deliverWebhooks(t, svc))
+
+	d := deliveryRepo.delivery
+	assert.Equal(t, webhook.DeliveryStatusPending, d.Status)
+	assert.Equal(t, int32(1), d.Attempts)
+	assert.Nil(t, d.LastResponseStatus)
+	require.NotNil(t, d.LastError)
+}
+
+func TestWebhookServiceRedeliverWebhookDelivery(t *testing.T) {
+	endpoint := newWebhookEndpoint(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusNoContent)
+	deliveryRepo := &fakeWebhookDeliveryRepo{
+		delivery: webhook.NewDelivery(webhookSubscriptionID, "event-1", "workflow_execution.completed", []byte(`{}`)),
+	}
+	svc := newWebhookService(t, endpoint.URL, deliveryRepo, webhook.RetryPolicy{
+		MaxAttempts:     2,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+	})
+
+	// Both attempts fail, the delivery is dead and no longer claimed
+	assert.Equal(t, 1, deliverWebhooks(t, svc))
+	time.Sleep(2 * time.Millisecond)
+	assert.Equal(t, 1, deliverWebhooks(t, svc))
+	require.Equal(t, webhook.DeliveryStatusDead, deliveryRepo.delivery.Status)
+	time.Sleep(2 * time.Millisecond)
+	assert.Zero(t, deliverWebhooks(t, svc))
+
+	d, err := svc.RedeliverWebhookDelivery(context.Background(), service.RedeliverWebhookDeliveryParams{
+		SubscriptionID: deliveryRepo.delivery.SubscriptionID,
+		ID:             deliveryRepo.delivery.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, webhook.DeliveryStatusPending, d.Status)
+	assert.Zero(t, d.Attempts)
+
+	assert.Equal(t, 1, deliverWebhooks(t, svc))
+	assert.Equal(t, webhook.DeliveryStatusSucceeded, deliveryRepo.delivery.Status)
+	assert.Equal(t, int32(1), deliveryRepo.delivery.Attempts)
+}
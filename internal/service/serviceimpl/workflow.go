@@ -2,10 +2,9 @@ package serviceimpl
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"slices"
 
-	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/uuid"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
@@ -27,27 +26,36 @@ var (
 
 type workflowService struct {
 	workflowRepo          repository.WorkflowRepository
+	workflowVersionRepo   repository.WorkflowVersionRepository
 	workflowExecutionRepo repository.WorkflowExecutionRepository
 	stepExecutionRepo     repository.StepExecutionRepository
+	qrLocationRepo        repository.QRLocationRepository
+	raybotRepo            repository.RaybotRepository
 	sqlDBProvider         sqldb.Provider
-	publisher             message.Publisher
+	outboxRepo            repository.OutboxRepository
 	validator             validator.Validator
 }
 
 func newWorkflowService(
 	workflowRepo repository.WorkflowRepository,
+	workflowVersionRepo repository.WorkflowVersionRepository,
 	workflowExecutionRepo repository.WorkflowExecutionRepository,
 	stepExecutionRepo repository.StepExecutionRepository,
+	qrLocationRepo repository.QRLocationRepository,
+	raybotRepo repository.RaybotRepository,
 	sqlDBProvider sqldb.Provider,
-	publisher message.Publisher,
+	outboxRepo repository.OutboxRepository,
 	validator validator.Validator,
 ) *workflowService {
 	return &workflowService{
 		workflowRepo:          workflowRepo,
+		workflowVersionRepo:   workflowVersionRepo,
 		workflowExecutionRepo: workflowExecutionRepo,
 		stepExecutionRepo:     stepExecutionRepo,
+		qrLocationRepo:        qrLocationRepo,
+		raybotRepo:            raybotRepo,
 		sqlDBProvider:         sqlDBProvider,
-		publisher:             publisher,
+		outboxRepo:            outboxRepo,
 		validator:             validator,
 	}
 }
@@ -70,7 +78,15 @@ func (s workflowService) ListWorkflows(ctx context.Context, params service.ListW
 		return paging.List[workflow.Workflow]{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	wfs, err := s.workflowRepo.ListWorkflows(ctx, s.sqlDBProvider.DB(), params.PagingParams, params.Sorts)
+	wfs, err := s.workflowRepo.ListWorkflows(
+		ctx,
+		s.sqlDBProvider.DB(),
+		params.PagingParams,
+		params.Sorts,
+		params.Filters,
+		params.Search,
+		params.IsTemplate,
+	)
 	if err != nil {
 		return paging.List[workflow.Workflow]{}, fmt.Errorf("repo list workflows: %w", err)
 	}
@@ -83,15 +99,24 @@ func (s workflowService) CreateWorkflow(ctx context.Context, params service.Crea
 		return workflow.Workflow{}, fmt.Errorf("validate params: %w", err)
 	}
 
+	if params.IsTemplate {
+		if err := params.Data.ValidateTemplate(params.TemplateParameters); err != nil {
+			return workflow.Workflow{}, fmt.Errorf("validate template: %w", err)
+		}
+	}
+
 	isValid := true
 	if err := params.Data.Validate(); err != nil {
 		isValid = false
 	}
 
 	wf := workflow.NewWorkflow(params.Name, params.Description, isValid, params.Data)
-	err := s.workflowRepo.CreateWorkflow(ctx, s.sqlDBProvider.DB(), wf)
-	if err != nil {
-		return workflow.Workflow{}, fmt.Errorf("repo create workflow: %w", err)
+	wf.IsTemplate = params.IsTemplate
+	if params.TemplateParameters != nil {
+		wf.TemplateParameters = params.TemplateParameters
+	}
+	if err := s.createWorkflow(ctx, wf); err != nil {
+		return workflow.Workflow{}, err
 	}
 
 	return wf, nil
@@ -109,33 +134,116 @@ func (s workflowService) UpdateWorkflow(ctx context.Context, params service.Upda
 		}
 	}
 
-	wf, err := s.workflowRepo.UpdateWorkflow(ctx, s.sqlDBProvider.DB(), repository.UpdateWorkflowParams{
-		ID:             params.ID,
-		Name:           params.Name,
-		SetName:        params.SetName,
-		Description:    params.Description,
-		SetDescription: params.SetDescription,
-		IsDraft:        params.IsDraft,
-		SetIsDraft:     params.SetIsDraft,
-		IsValid:        isValid,
-		SetIsValid:     true,
-		Data:           params.Data,
-		SetData:        params.SetData,
-	})
-	if err != nil {
-		return workflow.Workflow{}, fmt.Errorf("repo update workflow: %w", err)
+	// Setting IsDraft to false publishes the workflow, editing the data
+	// otherwise leaves unpublished changes in the draft.
+	publish := params.SetIsDraft && !params.IsDraft
+	isDraft, setIsDraft := params.IsDraft, params.SetIsDraft
+	if publish {
+		setIsDraft = false
+	} else if params.SetData {
+		isDraft, setIsDraft = true, true
+	}
+
+	var wf workflow.Workflow
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		if params.SetData || params.SetIsTemplate || params.SetTemplateParameters {
+			current, err := s.workflowRepo.GetWorkflowForUpdate(ctx, db, params.ID)
+			if err != nil {
+				return fmt.Errorf("repo get workflow for update: %w", err)
+			}
+			if err := validateTemplateUpdate(current, params); err != nil {
+				return fmt.Errorf("validate template: %w", err)
+			}
+		}
+
+		var err error
+		wf, err = s.workflowRepo.UpdateWorkflow(ctx, db, repository.UpdateWorkflowParams{
+			ID:                    params.ID,
+			Name:                  params.Name,
+			SetName:               params.SetName,
+			Description:           params.Description,
+			SetDescription:        params.SetDescription,
+			IsDraft:               isDraft,
+			SetIsDraft:            setIsDraft,
+			IsValid:               isValid,
+			SetIsValid:            params.SetData,
+			Data:                  params.Data,
+			SetData:               params.SetData,
+			IsTemplate:            params.IsTemplate,
+			SetIsTemplate:         params.SetIsTemplate,
+			TemplateParameters:    params.TemplateParameters,
+			SetTemplateParameters: params.SetTemplateParameters,
+		})
+		if err != nil {
+			return fmt.Errorf("repo update workflow: %w", err)
+		}
+
+		if publish {
+			_, wf, err = publishWorkflow(ctx, db, s.workflowRepo, s.workflowVersionRepo, s.outboxRepo, wf.ID, nil)
+			if err != nil {
+				return fmt.Errorf("publish workflow: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return workflow.Workflow{}, fmt.Errorf("with tx: %w", err)
 	}
 
 	return wf, nil
 }
 
+// createWorkflow creates the workflow and writes its WorkflowCreated event.
+func (s workflowService) createWorkflow(ctx context.Context, wf workflow.Workflow) error {
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		if err := s.workflowRepo.CreateWorkflow(ctx, db, wf); err != nil {
+			return fmt.Errorf("repo create workflow: %w", err)
+		}
+
+		return writeEvents(ctx, db, s.outboxRepo, pubsub.WorkflowCreated{
+			WorkflowID: wf.ID,
+			Name:       wf.Name,
+			IsTemplate: wf.IsTemplate,
+		})
+	}); err != nil {
+		return fmt.Errorf("with tx: %w", err)
+	}
+
+	return nil
+}
+
+// validateTemplateUpdate validates the placeholders of the workflow as it
+// will be after the update, if it is a template.
+func validateTemplateUpdate(current workflow.Workflow, params service.UpdateWorkflowParams) error {
+	if params.SetData {
+		current.Data = params.Data
+	}
+	if params.SetIsTemplate {
+		current.IsTemplate = params.IsTemplate
+	}
+	if params.SetTemplateParameters {
+		current.TemplateParameters = params.TemplateParameters
+	}
+	if !current.IsTemplate {
+		return nil
+	}
+
+	return current.Data.ValidateTemplate(current.TemplateParameters)
+}
+
 func (s workflowService) DeleteWorkflow(ctx context.Context, params service.DeleteWorkflowParams) error {
 	if err := s.validator.Validate(params); err != nil {
 		return fmt.Errorf("validate params: %w", err)
 	}
 
-	if err := s.workflowRepo.DeleteWorkflow(ctx, s.sqlDBProvider.DB(), params.ID); err != nil {
-		return fmt.Errorf("repo delete workflow: %w", err)
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		if err := s.workflowRepo.DeleteWorkflow(ctx, db, params.ID); err != nil {
+			return fmt.Errorf("repo delete workflow: %w", err)
+		}
+
+		return writeEvents(ctx, db, s.outboxRepo, pubsub.WorkflowDeleted{WorkflowID: params.ID})
+	}); err != nil {
+		return fmt.Errorf("with tx: %w", err)
 	}
 
 	return nil
@@ -151,16 +259,23 @@ func (s workflowService) RunWorkflow(ctx context.Context, params service.RunWork
 		return "", fmt.Errorf("repo get workflow: %w", err)
 	}
 
-	if wf.IsDraft {
-		return "", xerror.ValidationFailed(nil, "Workflow is in draft mode")
+	if wf.IsTemplate {
+		return "", xerror.ValidationFailed(nil, "Templates cannot be run, instantiate them instead")
 	}
-	if !wf.IsValid {
-		return "", xerror.ValidationFailed(nil, "Workflow is not valid")
+
+	// Executions always run the live version, never the draft
+	if wf.PublishedVersion == nil {
+		return "", xerror.ValidationFailed(nil, "Workflow has not been published")
+	}
+
+	version, err := s.workflowVersionRepo.GetWorkflowVersion(ctx, s.sqlDBProvider.DB(), wf.ID, *wf.PublishedVersion)
+	if err != nil {
+		return "", fmt.Errorf("repo get workflow version: %w", err)
 	}
 
 	// Get trigger node
 	var triggerNode *node.Node
-	for _, n := range wf.Data.Nodes {
+	for _, n := range version.Data.Nodes {
 		if n.Type == node.TypeTrigger {
 			triggerNode = &n
 			break
@@ -183,8 +298,15 @@ func (s workflowService) RunWorkflow(ctx context.Context, params service.RunWork
 			return "", xerror.ValidationFailed(nil, "Invalid runtime variables")
 		}
 		for _, v := range onDemandTriggerData.RuntimeVariables {
-			if v.Required && params.RuntimeVariables[v.Key] == nil {
-				return "", xerror.ValidationFailed(nil, fmt.Sprintf("Runtime variable %s is required", v.Key))
+			value := params.RuntimeVariables[v.Key]
+			if value == nil {
+				if v.Required {
+					return "", xerror.ValidationFailed(nil, fmt.Sprintf("Runtime variable %s is required", v.Key))
+				}
+				continue
+			}
+			if err := s.validateRuntimeVariable(ctx, v, value); err != nil {
+				return "", err
 			}
 		}
 	default:
@@ -192,7 +314,7 @@ func (s workflowService) RunWorkflow(ctx context.Context, params service.RunWork
 	}
 
 	// Create workflow execution
-	wfe := workflowexecution.NewWorkflowExecution(wf.ID, wf.Data, params.RuntimeVariables)
+	wfe := workflowexecution.NewWorkflowExecution(version, params.RuntimeVariables, params.DryRun)
 
 	// Add trigger node to steps
 	var steps []stepexecution.StepExecution
@@ -200,7 +322,7 @@ func (s workflowService) RunWorkflow(ctx context.Context, params service.RunWork
 	steps = append(steps, triggerStep)
 
 	// Add other nodes to steps
-	for _, n := range wf.Data.Nodes {
+	for _, n := range version.Data.Nodes {
 		if n.Type == node.TypeTrigger {
 			continue
 		}
@@ -217,24 +339,280 @@ func (s workflowService) RunWorkflow(ctx context.Context, params service.RunWork
 			return fmt.Errorf("repo batch create step executions: %w", err)
 		}
 
-		return nil
+		// The worker runs the execution once the relay publishes the event
+		return writeEvents(ctx, db, s.outboxRepo, pubsub.WorkflowExecutionCreated{
+			WorkflowExecutionID: wfe.ID,
+		})
 	}); err != nil {
 		return "", fmt.Errorf("with tx: %w", err)
 	}
 
-	// Publish event
-	ev := pubsub.WorkflowExecutionCreated{
-		WorkflowExecutionID: wfe.ID,
+	return wfe.ID, nil
+}
+
+// validateRuntimeVariable validates the value of a runtime variable against its
+// definition and checks that referenced QR locations and raybots exist.
+func (s workflowService) validateRuntimeVariable(ctx context.Context, v node.RuntimeVariable, value any) error {
+	if err := v.ValidateValue(value); err != nil {
+		return xerror.ValidationFailed(err, fmt.Sprintf("Runtime variable %s %s", v.Key, err))
+	}
+
+	refType := v.InputType
+	if v.InputType == node.InputTypeList {
+		refType = v.ItemType
+	}
+	// Only QR locations and raybots are references, the values of the other
+	// types are not strings.
+	if refType != node.InputTypeQRLocation && refType != node.InputTypeRaybot {
+		return nil
+	}
+
+	refIDs := []any{value}
+	if v.InputType == node.InputTypeList {
+		refIDs = value.([]any)
+	}
+
+	for _, id := range refIDs {
+		exists, err := s.referenceExists(ctx, refType, id.(string))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return xerror.ValidationFailed(nil, fmt.Sprintf("Runtime variable %s references unknown %s %s", v.Key, refType, id))
+		}
+	}
+
+	return nil
+}
+
+// referenceExists reports whether the raybot or QR location with the given ID
+// exists. Other input types are not references and always exist.
+func (s workflowService) referenceExists(ctx context.Context, inputType node.InputType, id string) (bool, error) {
+	var err error
+	switch inputType {
+	case node.InputTypeQRLocation:
+		_, err = s.qrLocationRepo.GetQRLocation(ctx, s.sqlDBProvider.DB(), id)
+	case node.InputTypeRaybot:
+		_, err = s.raybotRepo.GetRaybot(ctx, s.sqlDBProvider.DB(), id)
+	default:
+		return true, nil
 	}
-	payload, err := json.Marshal(ev)
 	if err != nil {
-		return "", fmt.Errorf("marshal event: %w", err)
+		if xerror.IsStatus(err, xerror.StatusNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get %s %s: %w", inputType, id, err)
 	}
 
-	msg := message.NewMessage(uuid.NewString(), payload)
-	if err := s.publisher.Publish(pubsub.WorkflowExecutionCreatedTopic, msg); err != nil {
-		return "", fmt.Errorf("publisher publish event: %w", err)
+	return true, nil
+}
+
+func (s workflowService) DuplicateWorkflow(ctx context.Context, params service.DuplicateWorkflowParams) (workflow.Workflow, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return workflow.Workflow{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	return wfe.ID, nil
+	src, err := s.workflowRepo.GetWorkflow(ctx, s.sqlDBProvider.DB(), params.ID)
+	if err != nil {
+		return workflow.Workflow{}, fmt.Errorf("repo get workflow: %w", err)
+	}
+
+	data, err := src.Data.CloneWithNewIDs()
+	if err != nil {
+		return workflow.Workflow{}, fmt.Errorf("clone data with new ids: %w", err)
+	}
+
+	description := src.Description
+	if params.Description != nil {
+		description = params.Description
+	}
+
+	wf := workflow.NewWorkflow(params.Name, description, src.IsValid, data)
+	wf.IsTemplate = src.IsTemplate
+	wf.TemplateParameters = slices.Clone(src.TemplateParameters)
+	if err := s.createWorkflow(ctx, wf); err != nil {
+		return workflow.Workflow{}, err
+	}
+
+	return wf, nil
+}
+
+func (s workflowService) InstantiateWorkflowTemplate(ctx context.Context, params service.InstantiateWorkflowTemplateParams) (workflow.Workflow, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return workflow.Workflow{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	tpl, err := s.workflowRepo.GetWorkflow(ctx, s.sqlDBProvider.DB(), params.ID)
+	if err != nil {
+		return workflow.Workflow{}, fmt.Errorf("repo get workflow: %w", err)
+	}
+	if !tpl.IsTemplate {
+		return workflow.Workflow{}, xerror.ValidationFailed(nil, "Workflow is not a template")
+	}
+
+	declared := make(map[string]struct{}, len(tpl.TemplateParameters))
+	for _, p := range tpl.TemplateParameters {
+		declared[p.Key] = struct{}{}
+
+		value, ok := params.Parameters[p.Key]
+		if !ok {
+			return workflow.Workflow{}, xerror.ValidationFailed(nil, fmt.Sprintf("Template parameter %s is required", p.Key))
+		}
+		if uuid.Validate(value) != nil {
+			return workflow.Workflow{}, xerror.ValidationFailed(nil, fmt.Sprintf("Template parameter %s must be a valid %s id", p.Key, p.InputType))
+		}
+
+		exists, err := s.referenceExists(ctx, p.InputType, value)
+		if err != nil {
+			return workflow.Workflow{}, err
+		}
+		if !exists {
+			return workflow.Workflow{}, xerror.ValidationFailed(nil, fmt.Sprintf("Template parameter %s references unknown %s %s", p.Key, p.InputType, value))
+		}
+	}
+	for key := range params.Parameters {
+		if _, ok := declared[key]; !ok {
+			return workflow.Workflow{}, xerror.ValidationFailed(nil, fmt.Sprintf("Unknown template parameter %s", key))
+		}
+	}
+
+	data, err := tpl.Data.CloneWithNewIDs()
+	if err != nil {
+		return workflow.Workflow{}, fmt.Errorf("clone data with new ids: %w", err)
+	}
+	data, err = data.FillPlaceholders(params.Parameters)
+	if err != nil {
+		return workflow.Workflow{}, fmt.Errorf("fill placeholders: %w", err)
+	}
+
+	description := tpl.Description
+	if params.Description != nil {
+		description = params.Description
+	}
+
+	isValid := true
+	if err := data.Validate(); err != nil {
+		isValid = false
+	}
+
+	wf := workflow.NewWorkflow(params.Name, description, isValid, data)
+	if err := s.createWorkflow(ctx, wf); err != nil {
+		return workflow.Workflow{}, err
+	}
+
+	return wf, nil
+}
+
+func (s workflowService) ExportWorkflow(ctx context.Context, params service.ExportWorkflowParams) (workflow.Document, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return workflow.Document{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	wf, err := s.workflowRepo.GetWorkflow(ctx, s.sqlDBProvider.DB(), params.ID)
+	if err != nil {
+		return workflow.Document{}, fmt.Errorf("repo get workflow: %w", err)
+	}
+
+	ids, err := wf.Data.QRLocationIDs()
+	if err != nil {
+		return workflow.Document{}, fmt.Errorf("get qr location ids: %w", err)
+	}
+
+	qrLocations := make([]workflow.DocumentQRLocation, 0, len(ids))
+	for _, id := range ids {
+		qrLocation, err := s.qrLocationRepo.GetQRLocation(ctx, s.sqlDBProvider.DB(), id)
+		if err != nil {
+			// A draft may still reference a deleted location, it is reported as
+			// missing on import since it is not listed in the document.
+			if xerror.IsStatus(err, xerror.StatusNotFound) {
+				continue
+			}
+			return workflow.Document{}, fmt.Errorf("repo get qr location: %w", err)
+		}
+
+		qrLocations = append(qrLocations, workflow.DocumentQRLocation{
+			ID:     qrLocation.ID,
+			Name:   qrLocation.Name,
+			QRCode: qrLocation.QRCode,
+		})
+	}
+
+	return workflow.NewDocument(wf, qrLocations), nil
+}
+
+func (s workflowService) ImportWorkflow(ctx context.Context, params service.ImportWorkflowParams) (service.ImportWorkflowResult, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return service.ImportWorkflowResult{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	doc := params.Document
+	if doc.FormatVersion > workflow.DocumentFormatVersion {
+		return service.ImportWorkflowResult{}, xerror.ValidationFailed(nil,
+			fmt.Sprintf("Unsupported document format version %d, expected at most %d", doc.FormatVersion, workflow.DocumentFormatVersion))
+	}
+	if doc.IsTemplate {
+		if err := doc.Data.ValidateTemplate(doc.TemplateParameters); err != nil {
+			return service.ImportWorkflowResult{}, fmt.Errorf("validate template: %w", err)
+		}
+	}
+
+	data, err := doc.Data.CloneWithNewIDs()
+	if err != nil {
+		return service.ImportWorkflowResult{}, fmt.Errorf("clone data with new ids: %w", err)
+	}
+
+	// Resolve the QR locations of the document by code.
+	mapping := make(map[string]string, len(doc.QRLocations))
+	listed := make(map[string]struct{}, len(doc.QRLocations))
+	missing := []string{}
+	for _, l := range doc.QRLocations {
+		listed[l.ID] = struct{}{}
+
+		qrLocation, err := s.qrLocationRepo.GetQRLocationByQRCode(ctx, s.sqlDBProvider.DB(), l.QRCode)
+		if err != nil {
+			if xerror.IsStatus(err, xerror.StatusNotFound) {
+				missing = append(missing, l.QRCode)
+				continue
+			}
+			return service.ImportWorkflowResult{}, fmt.Errorf("repo get qr location by qr code: %w", err)
+		}
+
+		mapping[l.ID] = qrLocation.ID
+	}
+
+	data, err = data.ReplaceQRLocationIDs(mapping)
+	if err != nil {
+		return service.ImportWorkflowResult{}, fmt.Errorf("replace qr location ids: %w", err)
+	}
+
+	// References to locations not listed in the document cannot be resolved.
+	ids, err := doc.Data.QRLocationIDs()
+	if err != nil {
+		return service.ImportWorkflowResult{}, fmt.Errorf("get qr location ids: %w", err)
+	}
+	hasUnlisted := false
+	for _, id := range ids {
+		if _, ok := listed[id]; !ok {
+			hasUnlisted = true
+		}
+	}
+
+	isValid := len(missing) == 0 && !hasUnlisted
+	if err := data.Validate(); err != nil {
+		isValid = false
+	}
+
+	wf := workflow.NewWorkflow(doc.Name, doc.Description, isValid, data)
+	wf.IsTemplate = doc.IsTemplate
+	if doc.TemplateParameters != nil {
+		wf.TemplateParameters = doc.TemplateParameters
+	}
+	if err := s.createWorkflow(ctx, wf); err != nil {
+		return service.ImportWorkflowResult{}, err
+	}
+
+	return service.ImportWorkflowResult{
+		Workflow:           wf,
+		MissingQRLocations: missing,
+	}, nil
 }
@@ -2,16 +2,26 @@ package serviceimpl
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+
 	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
 	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow/node"
 	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
 	"github.com/tuanvumaihuynh/roboflow/internal/repository"
 	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/internal/simulator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/ptr"
 	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
@@ -22,21 +32,36 @@ var _ service.WorkflowExecutionService = (*workflowExecutionService)(nil)
 type workflowExecutionService struct {
 	workflowExecutionRepo repository.WorkflowExecutionRepository
 	stepExecutionRepo     repository.StepExecutionRepository
+	raybotSimulator       simulator.RaybotSimulator
+	outboxRepo            repository.OutboxRepository
+	broadcastPublisher    message.Publisher
+	broadcastSubscriber   message.Subscriber
 	sqlDBProvider         sqldb.Provider
 	validator             validator.Validator
+	log                   *slog.Logger
 }
 
 func newWorkflowExecutionService(
 	workflowExecutionRepo repository.WorkflowExecutionRepository,
 	stepExecutionRepo repository.StepExecutionRepository,
+	raybotSimulator simulator.RaybotSimulator,
+	outboxRepo repository.OutboxRepository,
+	broadcastPublisher message.Publisher,
+	broadcastSubscriber message.Subscriber,
 	sqlDBProvider sqldb.Provider,
 	validator validator.Validator,
+	log *slog.Logger,
 ) *workflowExecutionService {
 	return &workflowExecutionService{
 		workflowExecutionRepo: workflowExecutionRepo,
 		stepExecutionRepo:     stepExecutionRepo,
+		raybotSimulator:       raybotSimulator,
+		outboxRepo:            outboxRepo,
+		broadcastPublisher:    broadcastPublisher,
+		broadcastSubscriber:   broadcastSubscriber,
 		sqlDBProvider:         sqlDBProvider,
 		validator:             validator,
+		log:                   log,
 	}
 }
 
@@ -58,7 +83,7 @@ func (s workflowExecutionService) ListWorkflowExecutionsByWorkflowID(ctx context
 		return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	we, err := s.workflowExecutionRepo.ListWorkflowExecutionsByWorkflowID(ctx, s.sqlDBProvider.DB(), params.PagingParams, params.Sorts, params.WorkflowID)
+	we, err := s.workflowExecutionRepo.ListWorkflowExecutionsByWorkflowID(ctx, s.sqlDBProvider.DB(), params.PagingParams, params.Sorts, params.WorkflowID, params.IsSimulated)
 	if err != nil {
 		return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("repo list workflow executions by workflow id: %w", err)
 	}
@@ -66,80 +91,374 @@ func (s workflowExecutionService) ListWorkflowExecutionsByWorkflowID(ctx context
 	return we, nil
 }
 
+func (s workflowExecutionService) ListWorkflowExecutions(ctx context.Context, params service.ListWorkflowExecutionsParams) (paging.List[workflowexecution.WorkflowExecution], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	we, err := s.workflowExecutionRepo.ListWorkflowExecutions(ctx, s.sqlDBProvider.DB(), params.PagingParams, params.Sorts,
+		excludeSimulated(params.Filters))
+	if err != nil {
+		return paging.List[workflowexecution.WorkflowExecution]{}, fmt.Errorf("repo list workflow executions: %w", err)
+	}
+
+	return we, nil
+}
+
+func (s workflowExecutionService) CountWorkflowExecutionsByStatus(ctx context.Context, params service.CountWorkflowExecutionsByStatusParams) (map[workflowexecution.Status]int64, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+
+	counts, err := s.workflowExecutionRepo.CountWorkflowExecutionsByStatus(ctx, s.sqlDBProvider.DB(),
+		excludeSimulated(params.Filters))
+	if err != nil {
+		return nil, fmt.Errorf("repo count workflow executions by status: %w", err)
+	}
+
+	return counts, nil
+}
+
+// excludeSimulated returns the filters excluding the dry runs, which are not
+// production executions, unless the filters select them by is_simulated.
+func excludeSimulated(filters []filter.Filter) []filter.Filter {
+	for _, f := range filters {
+		if f.Col == "is_simulated" {
+			return filters
+		}
+	}
+	return append(slices.Clone(filters), filter.Filter{
+		Col:      "is_simulated",
+		Operator: filter.OperatorEq,
+		Values:   []string{"false"},
+	})
+}
+
+func (s workflowExecutionService) StreamWorkflowExecutionEvents(ctx context.Context, params service.StreamWorkflowExecutionEventsParams) (<-chan workflowexecution.Event, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+
+	wfe, err := s.workflowExecutionRepo.GetWorkflowExecution(ctx, s.sqlDBProvider.DB(), params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("repo get workflow execution: %w", err)
+	}
+
+	// Subscribe before reading the stored events, so that no event
+	// is lost between the two.
+	ctx, cancel := context.WithCancel(ctx)
+	msgs, err := s.broadcastSubscriber.Subscribe(ctx, pubsub.WorkflowExecutionEventTopic)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("subscriber subscribe: %w", err)
+	}
+
+	stored, err := s.workflowExecutionRepo.ListWorkflowExecutionEvents(ctx, s.sqlDBProvider.DB(), params.ID, params.LastEventID)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("repo list workflow execution events: %w", err)
+	}
+
+	events := make(chan workflowexecution.Event)
+	go func() {
+		defer cancel()
+		defer close(events)
+
+		// unseen holds the stored events that may still be broadcast,
+		// each is skipped once and forgotten, so it never outgrows stored.
+		unseen := make(map[int64]struct{}, len(stored))
+		for _, ev := range stored {
+			unseen[ev.ID] = struct{}{}
+		}
+
+		// send returns false once the stream is over.
+		send := func(ev workflowexecution.Event) bool {
+			select {
+			case events <- ev:
+			case <-ctx.Done():
+				return false
+			}
+			return !ev.IsFinal()
+		}
+
+		for _, ev := range stored {
+			if !send(ev) {
+				return
+			}
+		}
+		// Events are stored with the change they describe, so nothing
+		// follows the stored events of a finished execution.
+		if wfe.Status.IsFinished() {
+			return
+		}
+
+		for msg := range msgs {
+			msg.Ack()
+
+			var payload pubsub.WorkflowExecutionEvent
+			if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+				continue
+			}
+			if payload.WorkflowExecutionID != params.ID || payload.ID <= params.LastEventID {
+				continue
+			}
+			if _, ok := unseen[payload.ID]; ok {
+				delete(unseen, payload.ID)
+				continue
+			}
+
+			if !send(workflowexecution.Event{
+				ID:                  payload.ID,
+				WorkflowExecutionID: payload.WorkflowExecutionID,
+				StepExecutionID:     payload.StepExecutionID,
+				Type:                payload.Type,
+				Status:              payload.Status,
+				Outputs:             payload.Outputs,
+				Error:               payload.Error,
+				CreatedAt:           payload.CreatedAt,
+			}) {
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
 func (s workflowExecutionService) ProcessRunWorkflowExecution(ctx context.Context, params service.ProcessRunWorkflowExecutionParams) error {
 	if err := s.validator.Validate(params); err != nil {
 		return fmt.Errorf("validate params: %w", err)
 	}
 
+	wfe, err := s.workflowExecutionRepo.GetWorkflowExecution(ctx, s.sqlDBProvider.DB(), params.WorkflowExecutionID)
+	if err != nil {
+		return fmt.Errorf("repo get workflow execution: %w", err)
+	}
+	// The message may be delivered more than once, only run pending executions
+	if wfe.Status != workflowexecution.StatusPending {
+		return nil
+	}
+
 	// Get all steps for this workflow execution
-	steps, err := s.stepExecutionRepo.ListStepsByWorkflowExecutionID(
+	steps, err := s.stepExecutionRepo.ListStepsByWorkflowExecutionIDs(
 		ctx,
 		s.sqlDBProvider.DB(),
-		params.WorkflowExecutionID,
+		[]string{params.WorkflowExecutionID},
 	)
 	if err != nil {
-		return fmt.Errorf("repo list steps by workflow execution id: %w", err)
+		return fmt.Errorf("repo list steps by workflow execution ids: %w", err)
 	}
 
 	// Update workflow execution status to running
-	wfe, err := s.workflowExecutionRepo.UpdateWorkflowExecution(
-		ctx,
-		s.sqlDBProvider.DB(),
-		repository.UpdateWorkflowExecutionParams{
-			ID:           params.WorkflowExecutionID,
-			Status:       workflowexecution.StatusRunning,
-			SetStatus:    true,
-			StartedAt:    ptr.New(time.Now()),
-			SetStartedAt: true,
-		},
-	)
+	wfe, err = s.updateWorkflowExecution(ctx, repository.UpdateWorkflowExecutionParams{
+		ID:           params.WorkflowExecutionID,
+		Status:       workflowexecution.StatusRunning,
+		SetStatus:    true,
+		StartedAt:    ptr.New(time.Now()),
+		SetStartedAt: true,
+	})
 	if err != nil {
-		return fmt.Errorf("repo update workflow execution status: %w", err)
+		return fmt.Errorf("update workflow execution status: %w", err)
 	}
 
 	// Build execution graph
 	graph := stepexecution.BuildExecutionGraph(wfe.Data.Edges, steps)
 
 	// Execute workflow
-	if err := s.executeWorkflow(ctx, graph); err != nil {
+	if err := s.executeWorkflow(ctx, wfe, graph); err != nil {
 		// Update workflow execution status to failed
-		_, err := s.workflowExecutionRepo.UpdateWorkflowExecution(
-			ctx,
-			s.sqlDBProvider.DB(),
-			repository.UpdateWorkflowExecutionParams{
-				ID:             params.WorkflowExecutionID,
-				Status:         workflowexecution.StatusFailed,
-				SetStatus:      true,
-				CompletedAt:    ptr.New(time.Now()),
-				SetCompletedAt: true,
-			},
-		)
-		if err != nil {
-			return fmt.Errorf("repo update workflow execution status: %w", err)
+		_, updateErr := s.updateWorkflowExecution(ctx, repository.UpdateWorkflowExecutionParams{
+			ID:             params.WorkflowExecutionID,
+			Status:         workflowexecution.StatusFailed,
+			SetStatus:      true,
+			Error:          ptr.New(err.Error()),
+			SetError:       true,
+			CompletedAt:    ptr.New(time.Now()),
+			SetCompletedAt: true,
+		})
+		if updateErr != nil {
+			return fmt.Errorf("update workflow execution status: %w", updateErr)
 		}
 		return fmt.Errorf("execute workflow: %w", err)
 	}
 
 	// Update workflow execution status to completed
-	_, err = s.workflowExecutionRepo.UpdateWorkflowExecution(
-		ctx,
-		s.sqlDBProvider.DB(),
-		repository.UpdateWorkflowExecutionParams{
-			ID:             params.WorkflowExecutionID,
-			Status:         workflowexecution.StatusCompleted,
-			SetStatus:      true,
-			CompletedAt:    ptr.New(time.Now()),
-			SetCompletedAt: true,
-		},
-	)
+	_, err = s.updateWorkflowExecution(ctx, repository.UpdateWorkflowExecutionParams{
+		ID:             params.WorkflowExecutionID,
+		Status:         workflowexecution.StatusCompleted,
+		SetStatus:      true,
+		CompletedAt:    ptr.New(time.Now()),
+		SetCompletedAt: true,
+	})
+	if err != nil {
+		return fmt.Errorf("update workflow execution status: %w", err)
+	}
+
+	return nil
+}
+
+// updateWorkflowExecution updates a WorkflowExecution, records the change as
+// an Event and writes the matching domain event in the same transaction, then
+// broadcasts the Event.
+func (s workflowExecutionService) updateWorkflowExecution(ctx context.Context, params repository.UpdateWorkflowExecutionParams) (workflowexecution.WorkflowExecution, error) {
+	var wfe workflowexecution.WorkflowExecution
+	var ev workflowexecution.Event
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		var err error
+		wfe, err = s.workflowExecutionRepo.UpdateWorkflowExecution(ctx, db, params)
+		if err != nil {
+			return fmt.Errorf("repo update workflow execution: %w", err)
+		}
+
+		ev, err = s.workflowExecutionRepo.CreateWorkflowExecutionEvent(ctx, db, workflowexecution.NewExecutionEvent(wfe))
+		if err != nil {
+			return fmt.Errorf("repo create workflow execution event: %w", err)
+		}
+
+		if event := workflowExecutionDomainEvent(wfe); event != nil {
+			return writeEvents(ctx, db, s.outboxRepo, event)
+		}
+
+		return nil
+	}); err != nil {
+		return workflowexecution.WorkflowExecution{}, fmt.Errorf("with tx: %w", err)
+	}
+
+	s.publishEvent(ev)
+
+	return wfe, nil
+}
+
+// updateStepExecution updates a StepExecution, records the change as an Event
+// and writes the matching domain event in the same transaction, then
+// broadcasts the Event.
+func (s workflowExecutionService) updateStepExecution(ctx context.Context, params repository.UpdateStepExecutionParams) error {
+	var ev workflowexecution.Event
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		step, err := s.stepExecutionRepo.UpdateStepExecution(ctx, db, params)
+		if err != nil {
+			return fmt.Errorf("repo update step execution: %w", err)
+		}
+
+		ev, err = s.workflowExecutionRepo.CreateWorkflowExecutionEvent(ctx, db, workflowexecution.NewStepEvent(step))
+		if err != nil {
+			return fmt.Errorf("repo create workflow execution event: %w", err)
+		}
+
+		if event := stepExecutionDomainEvent(step); event != nil {
+			return writeEvents(ctx, db, s.outboxRepo, event)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("with tx: %w", err)
+	}
+
+	s.publishEvent(ev)
+
+	return nil
+}
+
+// workflowExecutionDomainEvent returns the domain event for the status of
+// wfe, or nil if the status has none.
+func workflowExecutionDomainEvent(wfe workflowexecution.WorkflowExecution) pubsub.Event {
+	switch wfe.Status {
+	case workflowexecution.StatusRunning:
+		return pubsub.WorkflowExecutionStarted{
+			WorkflowExecutionID: wfe.ID,
+			WorkflowID:          wfe.WorkflowID,
+			IsSimulated:         wfe.IsSimulated,
+		}
+	case workflowexecution.StatusCompleted:
+		return pubsub.WorkflowExecutionCompleted{
+			WorkflowExecutionID: wfe.ID,
+			WorkflowID:          wfe.WorkflowID,
+			IsSimulated:         wfe.IsSimulated,
+			Outputs:             wfe.Outputs,
+		}
+	case workflowexecution.StatusFailed:
+		return pubsub.WorkflowExecutionFailed{
+			WorkflowExecutionID: wfe.ID,
+			WorkflowID:          wfe.WorkflowID,
+			IsSimulated:         wfe.IsSimulated,
+			Error:               wfe.Error,
+		}
+	case workflowexecution.StatusCancelled:
+		return pubsub.WorkflowExecutionCancelled{
+			WorkflowExecutionID: wfe.ID,
+			WorkflowID:          wfe.WorkflowID,
+			IsSimulated:         wfe.IsSimulated,
+		}
+	default:
+		return nil
+	}
+}
+
+// stepExecutionDomainEvent returns the domain event for the status of step,
+// or nil if the status has none.
+func stepExecutionDomainEvent(step stepexecution.StepExecution) pubsub.Event {
+	switch step.Status {
+	case stepexecution.StatusRunning:
+		return pubsub.StepExecutionStarted{
+			StepExecutionID:     step.ID,
+			WorkflowExecutionID: step.WorkflowExecutionID,
+			NodeID:              step.Node.ID,
+			NodeType:            string(step.Node.Type),
+		}
+	case stepexecution.StatusCompleted:
+		return pubsub.StepExecutionCompleted{
+			StepExecutionID:     step.ID,
+			WorkflowExecutionID: step.WorkflowExecutionID,
+			NodeID:              step.Node.ID,
+			NodeType:            string(step.Node.Type),
+			Outputs:             step.Outputs,
+		}
+	case stepexecution.StatusFailed:
+		return pubsub.StepExecutionFailed{
+			StepExecutionID:     step.ID,
+			WorkflowExecutionID: step.WorkflowExecutionID,
+			NodeID:              step.Node.ID,
+			NodeType:            string(step.Node.Type),
+			Error:               step.Error,
+		}
+	default:
+		return nil
+	}
+}
+
+func (s workflowExecutionService) publishEvent(ev workflowexecution.Event) {
+	// The Event is already stored, so a failed broadcast is only logged,
+	// streaming clients catch up from the stored events when they reconnect.
+	if err := s.broadcastEvent(ev); err != nil {
+		s.log.Error("error broadcasting workflow execution event",
+			slog.Int64("event_id", ev.ID), slog.Any("error", err))
+	}
+}
+
+func (s workflowExecutionService) broadcastEvent(ev workflowexecution.Event) error {
+	payload, err := json.Marshal(pubsub.WorkflowExecutionEvent{
+		ID:                  ev.ID,
+		WorkflowExecutionID: ev.WorkflowExecutionID,
+		StepExecutionID:     ev.StepExecutionID,
+		Type:                ev.Type,
+		Status:              ev.Status,
+		Outputs:             ev.Outputs,
+		Error:               ev.Error,
+		CreatedAt:           ev.CreatedAt,
+	})
 	if err != nil {
-		return fmt.Errorf("repo update workflow execution status: %w", err)
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	msg := message.NewMessage(uuid.NewString(), payload)
+	if err := s.broadcastPublisher.Publish(pubsub.WorkflowExecutionEventTopic, msg); err != nil {
+		return fmt.Errorf("publisher publish event: %w", err)
 	}
 
 	return nil
 }
 
-func (s workflowExecutionService) executeWorkflow(ctx context.Context, graph stepexecution.ExecutionGraph) error {
+func (s workflowExecutionService) executeWorkflow(ctx context.Context, wfe workflowexecution.WorkflowExecution, graph stepexecution.ExecutionGraph) error {
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(graph))
 
@@ -147,7 +466,7 @@ func (s workflowExecutionService) executeWorkflow(ctx context.Context, graph ste
 	for _, n := range graph {
 		if n.Step.Node.Type == node.TypeTrigger {
 			wg.Add(1)
-			go s.executeNode(ctx, n, &wg, errChan)
+			go s.executeNode(ctx, wfe, graph, n, &wg, errChan)
 			break
 		}
 	}
@@ -168,7 +487,14 @@ func (s workflowExecutionService) executeWorkflow(ctx context.Context, graph ste
 	return nil
 }
 
-func (s workflowExecutionService) executeNode(ctx context.Context, n *stepexecution.ExecutionNode, wg *sync.WaitGroup, errChan chan<- error) {
+func (s workflowExecutionService) executeNode(
+	ctx context.Context,
+	wfe workflowexecution.WorkflowExecution,
+	graph stepexecution.ExecutionGraph,
+	n *stepexecution.ExecutionNode,
+	wg *sync.WaitGroup,
+	errChan chan<- error,
+) {
 	defer wg.Done()
 
 	// Check if node has already been executed
@@ -181,38 +507,30 @@ func (s workflowExecutionService) executeNode(ctx context.Context, n *stepexecut
 	n.IsExecutedMu.Unlock()
 
 	// Update step status to running
-	_, err := s.stepExecutionRepo.UpdateStepExecution(
-		ctx,
-		s.sqlDBProvider.DB(),
-		repository.UpdateStepExecutionParams{
-			ID:           n.Step.ID,
-			Status:       stepexecution.StatusRunning,
-			SetStatus:    true,
-			StartedAt:    ptr.New(time.Now()),
-			SetStartedAt: true,
-		},
-	)
+	err := s.updateStepExecution(ctx, repository.UpdateStepExecutionParams{
+		ID:           n.Step.ID,
+		Status:       stepexecution.StatusRunning,
+		SetStatus:    true,
+		StartedAt:    ptr.New(time.Now()),
+		SetStartedAt: true,
+	})
 	if err != nil {
 		errChan <- fmt.Errorf("update step status: %w", err)
 	}
 
 	// Execute node logic
-	outputs, err := s.executeNodeLogic(ctx, n)
+	outputs, err := s.executeNodeLogic(ctx, wfe, graph, n)
 	if err != nil {
 		// Update step status to failed
-		_, updateErr := s.stepExecutionRepo.UpdateStepExecution(
-			ctx,
-			s.sqlDBProvider.DB(),
-			repository.UpdateStepExecutionParams{
-				ID:             n.Step.ID,
-				Status:         stepexecution.StatusFailed,
-				SetStatus:      true,
-				Error:          ptr.New(err.Error()),
-				SetError:       true,
-				CompletedAt:    ptr.New(time.Now()),
-				SetCompletedAt: true,
-			},
-		)
+		updateErr := s.updateStepExecution(ctx, repository.UpdateStepExecutionParams{
+			ID:             n.Step.ID,
+			Status:         stepexecution.StatusFailed,
+			SetStatus:      true,
+			Error:          ptr.New(err.Error()),
+			SetError:       true,
+			CompletedAt:    ptr.New(time.Now()),
+			SetCompletedAt: true,
+		})
 		if updateErr != nil {
 			errChan <- fmt.Errorf("update step status: %w", updateErr)
 		}
@@ -221,38 +539,155 @@ func (s workflowExecutionService) executeNode(ctx context.Context, n *stepexecut
 	}
 
 	// Update step with outputs and completed status
-	_, err = s.stepExecutionRepo.UpdateStepExecution(
-		ctx,
-		s.sqlDBProvider.DB(),
-		repository.UpdateStepExecutionParams{
-			ID:             n.Step.ID,
-			Status:         stepexecution.StatusCompleted,
-			SetStatus:      true,
-			Outputs:        outputs,
-			SetOutputs:     true,
-			CompletedAt:    ptr.New(time.Now()),
-			SetCompletedAt: true,
-		},
-	)
+	err = s.updateStepExecution(ctx, repository.UpdateStepExecutionParams{
+		ID:             n.Step.ID,
+		Status:         stepexecution.StatusCompleted,
+		SetStatus:      true,
+		Outputs:        outputs,
+		SetOutputs:     true,
+		CompletedAt:    ptr.New(time.Now()),
+		SetCompletedAt: true,
+	})
 	if err != nil {
 		errChan <- fmt.Errorf("update step outputs: %w", err)
 		return
 	}
+	// Children start after this write, so they can read the outputs
+	n.SetOutputs(outputs)
 
 	// Execute children
 	for _, child := range n.Children {
 		wg.Add(1)
-		go s.executeNode(ctx, child, wg, errChan)
+		go s.executeNode(ctx, wfe, graph, child, wg, errChan)
 	}
 }
 
 // Execute node logic based on node type and return outputs.
-func (s workflowExecutionService) executeNodeLogic(_ context.Context, n *stepexecution.ExecutionNode) (map[string]any, error) {
+func (s workflowExecutionService) executeNodeLogic(
+	ctx context.Context,
+	wfe workflowexecution.WorkflowExecution,
+	graph stepexecution.ExecutionGraph,
+	n *stepexecution.ExecutionNode,
+) (map[string]any, error) {
 	switch n.Step.Node.Type {
 	case node.TypeTrigger:
 		return n.Step.Inputs, nil
+	case node.TypeControlRaybot:
+		return s.executeControlRaybot(ctx, wfe, graph, n)
 	// Add other node type handlers here
 	default:
 		return nil, fmt.Errorf("unsupported node type: %s", n.Step.Node.Type)
 	}
 }
+
+func (s workflowExecutionService) executeControlRaybot(
+	ctx context.Context,
+	wfe workflowexecution.WorkflowExecution,
+	graph stepexecution.ExecutionGraph,
+	n *stepexecution.ExecutionNode,
+) (map[string]any, error) {
+	// Dispatching to real raybots is not supported yet, only dry runs can
+	// execute CONTROL_RAYBOT steps.
+	if !wfe.IsSimulated {
+		return nil, fmt.Errorf("unsupported node type: %s", n.Step.Node.Type)
+	}
+
+	data, err := n.Step.Node.Data.AsControlRaybotData()
+	if err != nil {
+		return nil, fmt.Errorf("get control raybot data: %w", err)
+	}
+
+	cmdType := raybotcommand.Type(data.ControlRaybotType)
+	inputs, err := controlRaybotCommandInputs(graph, data)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s inputs: %w", cmdType, err)
+	}
+
+	if data.TimeoutSec > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(data.TimeoutSec)*time.Second)
+		defer cancel()
+	}
+
+	outputs, err := s.raybotSimulator.RunCommand(ctx, cmdType, inputs)
+	if err != nil {
+		return nil, fmt.Errorf("simulated raybot run command: %w", err)
+	}
+
+	ret := map[string]any{}
+	if err := json.Unmarshal(outputs.Raw(), &ret); err != nil {
+		return nil, fmt.Errorf("unmarshal outputs: %w", err)
+	}
+
+	return ret, nil
+}
+
+// controlRaybotCommandInputs builds the inputs of the raybot command of a
+// CONTROL_RAYBOT step, resolving its dynamic values against the graph.
+func controlRaybotCommandInputs(graph stepexecution.ExecutionGraph, data node.ControlRaybotData) (raybotcommand.Inputs, error) {
+	var inputs raybotcommand.Inputs
+	switch data.ControlRaybotType {
+	case node.ControlRaybotTypeMoveToLocation:
+		in, err := data.Input.AsMoveToLocationInput()
+		if err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("get move to location input: %w", err)
+		}
+		location, err := stepexecution.ResolveDynamicValue(graph, in.Location)
+		if err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("resolve location: %w", err)
+		}
+		direction, err := stepexecution.ResolveDynamicValue(graph, in.Direction)
+		if err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("resolve direction: %w", err)
+		}
+		if err := inputs.FromMoveToLocationInput(raybotcommand.MoveToLocationInput{
+			Location:  location,
+			Direction: raybotcommand.MoveDirection(direction),
+		}); err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("marshal move to location input: %w", err)
+		}
+	case node.ControlRaybotTypeLiftBox:
+		in, err := data.Input.AsLiftBoxInput()
+		if err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("get lift box input: %w", err)
+		}
+		distance, err := stepexecution.ResolveDynamicValue(graph, in.Distance)
+		if err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("resolve distance: %w", err)
+		}
+		if distance == nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("distance is required")
+		}
+		if err := inputs.FromLiftBoxInput(raybotcommand.LiftBoxInput{Distance: *distance}); err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("marshal lift box input: %w", err)
+		}
+	case node.ControlRaybotTypeDropBox:
+		in, err := data.Input.AsDropBoxInput()
+		if err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("get drop box input: %w", err)
+		}
+		distance, err := stepexecution.ResolveDynamicValue(graph, in.Distance)
+		if err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("resolve distance: %w", err)
+		}
+		if err := inputs.FromDropBoxInput(raybotcommand.DropBoxInput{Distance: distance}); err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("marshal drop box input: %w", err)
+		}
+	case node.ControlRaybotTypeCheckQRCode:
+		in, err := data.Input.AsCheckQRCodeInput()
+		if err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("get check qr code input: %w", err)
+		}
+		qrCode, err := stepexecution.ResolveDynamicValue(graph, in.QRCode)
+		if err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("resolve qr code: %w", err)
+		}
+		if err := inputs.FromCheckQRCodeInput(raybotcommand.CheckQRCodeInput{QRCode: qrCode}); err != nil {
+			return raybotcommand.Inputs{}, fmt.Errorf("marshal check qr code input: %w", err)
+		}
+	default:
+		inputs = raybotcommand.NewInputs(json.RawMessage(`{}`))
+	}
+
+	return inputs, nil
+}
@@ -0,0 +1,93 @@
+package serviceimpl_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/internal/service/serviceimpl"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+)
+
+type fakeCountWorkflowExecutionRepo struct {
+	fakeWorkflowExecutionRepo
+	filters []filter.Filter
+}
+
+func (r *fakeCountWorkflowExecutionRepo) CountWorkflowExecutionsByStatus(
+	_ context.Context,
+	_ sqldb.SQLDB,
+	filters []filter.Filter,
+) (map[workflowexecution.Status]int64, error) {
+	r.filters = filters
+	return map[workflowexecution.Status]int64{}, nil
+}
+
+func TestWorkflowExecutionServiceCountWorkflowExecutionsByStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		filters   []filter.Filter
+		want      []filter.Filter
+		expectErr bool
+	}{
+		{
+			name:    "Dry runs excluded by default",
+			filters: []filter.Filter{{Col: "status", Operator: filter.OperatorEq, Values: []string{"FAILED"}}},
+			want: []filter.Filter{
+				{Col: "status", Operator: filter.OperatorEq, Values: []string{"FAILED"}},
+				{Col: "is_simulated", Operator: filter.OperatorEq, Values: []string{"false"}},
+			},
+		},
+		{
+			name:    "Dry runs selected by filter",
+			filters: []filter.Filter{{Col: "is_simulated", Operator: filter.OperatorEq, Values: []string{"true"}}},
+			want:    []filter.Filter{{Col: "is_simulated", Operator: filter.OperatorEq, Values: []string{"true"}}},
+		},
+		{
+			name: "Raybot filter",
+			filters: []filter.Filter{
+				{Col: "raybot_id", Operator: filter.OperatorEq, Values: []string{"4c6f9a2e-1b3d-4e5f-8a7b-9c0d1e2f3a4b"}},
+			},
+			want: []filter.Filter{
+				{Col: "raybot_id", Operator: filter.OperatorEq, Values: []string{"4c6f9a2e-1b3d-4e5f-8a7b-9c0d1e2f3a4b"}},
+				{Col: "is_simulated", Operator: filter.OperatorEq, Values: []string{"false"}},
+			},
+		},
+		{
+			name:      "Unknown status",
+			filters:   []filter.Filter{{Col: "status", Operator: filter.OperatorIn, Values: []string{"FAILED", "DONE"}}},
+			expectErr: true,
+		},
+		{
+			name:      "Unknown trigger type",
+			filters:   []filter.Filter{{Col: "trigger_type", Operator: filter.OperatorEq, Values: []string{"SCHEDULE"}}},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflowExecutionRepo := &fakeCountWorkflowExecutionRepo{}
+			svc := serviceimpl.NewService(fakeRepository{workflowExecutionRepo: workflowExecutionRepo}, fakeSQLDBProvider{},
+				nil, nil, nil, nil, nil, webhook.RetryPolicy{}, nil, validator.NewValidator(), slog.Default())
+
+			_, err := svc.WorkflowExecution().CountWorkflowExecutionsByStatus(context.Background(),
+				service.CountWorkflowExecutionsByStatusParams{Filters: tt.filters})
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Nil(t, workflowExecutionRepo.filters)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, workflowExecutionRepo.filters)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package serviceimpl_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/outbox"
+	qrlocation "github.com/tuanvumaihuynh/roboflow/internal/model/qr_location"
+	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/internal/service/serviceimpl"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+const (
+	workflowID   = "6f1c2a52-37a4-4c55-9d2b-0f3c3e2a9b10"
+	qrLocationID = "0b8f1c9e-4f4a-4a5e-8b0e-6f2d1a7c3e21"
+)
+
+// fakeRepository serves the repositories a test sets, the others are nil.
+type fakeRepository struct {
+	workflowRepo            repository.WorkflowRepository
+	workflowVersionRepo     repository.WorkflowVersionRepository
+	workflowExecutionRepo   repository.WorkflowExecutionRepository
+	stepExecutionRepo       repository.StepExecutionRepository
+	qrLocationRepo          repository.QRLocationRepository
+	outboxRepo              repository.OutboxRepository
+	raybotCommandRepo       repository.RaybotCommandRepository
+	webhookSubscriptionRepo repository.WebhookSubscriptionRepository
+	webhookDeliveryRepo     repository.WebhookDeliveryRepository
+}
+
+func (r fakeRepository) QRLocation() repository.QRLocationRepository {
+	return r.qrLocationRepo
+}
+
+func (r fakeRepository) TrackSegment() repository.TrackSegmentRepository {
+	return nil
+}
+
+func (r fakeRepository) TrackReservation() repository.TrackReservationRepository {
+	return nil
+}
+
+func (r fakeRepository) Raybot() repository.RaybotRepository {
+	return nil
+}
+
+func (r fakeRepository) RaybotCommand() repository.RaybotCommandRepository {
+	return r.raybotCommandRepo
+}
+
+func (r fakeRepository) RaybotPosition() repository.RaybotPositionRepository {
+	return nil
+}
+
+func (r fakeRepository) Workflow() repository.WorkflowRepository {
+	return r.workflowRepo
+}
+
+func (r fakeRepository) WorkflowVersion() repository.WorkflowVersionRepository {
+	return r.workflowVersionRepo
+}
+
+func (r fakeRepository) WorkflowExecution() repository.WorkflowExecutionRepository {
+	return r.workflowExecutionRepo
+}
+
+func (r fakeRepository) StepExecution() repository.StepExecutionRepository {
+	return r.stepExecutionRepo
+}
+
+func (r fakeRepository) Outbox() repository.OutboxRepository {
+	return r.outboxRepo
+}
+
+func (r fakeRepository) WebhookSubscription() repository.WebhookSubscriptionRepository {
+	return r.webhookSubscriptionRepo
+}
+
+func (r fakeRepository) WebhookDelivery() repository.WebhookDeliveryRepository {
+	return r.webhookDeliveryRepo
+}
+
+func (r fakeRepository) DeadLetterMessage() repository.DeadLetterMessageRepository {
+	return nil
+}
+
+type fakeSQLDBProvider struct{}
+
+func (fakeSQLDBProvider) DB() sqldb.SQLDB { return nil }
+
+func (fakeSQLDBProvider) WithTx(_ context.Context, fn func(db sqldb.SQLDB) error) error {
+	return fn(nil)
+}
+
+type fakeWorkflowRepo struct {
+	repository.WorkflowRepository
+	wf workflow.Workflow
+}
+
+func (r fakeWorkflowRepo) GetWorkflow(context.Context, sqldb.SQLDB, string) (workflow.Workflow, error) {
+	return r.wf, nil
+}
+
+type fakeWorkflowVersionRepo struct {
+	repository.WorkflowVersionRepository
+	version workflow.Version
+}
+
+func (r fakeWorkflowVersionRepo) GetWorkflowVersion(context.Context, sqldb.SQLDB, string, int32) (workflow.Version, error) {
+	return r.version, nil
+}
+
+type fakeWorkflowExecutionRepo struct {
+	repository.WorkflowExecutionRepository
+	created []workflowexecution.WorkflowExecution
+}
+
+func (r *fakeWorkflowExecutionRepo) CreateWorkflowExecution(_ context.Context, _ sqldb.SQLDB, wfe workflowexecution.WorkflowExecution) error {
+	r.created = append(r.created, wfe)
+	return nil
+}
+
+type fakeStepExecutionRepo struct {
+	repository.StepExecutionRepository
+}
+
+func (fakeStepExecutionRepo) BatchCreateStepExecutions(context.Context, sqldb.SQLDB, []stepexecution.StepExecution) error {
+	return nil
+}
+
+type fakeQRLocationRepo struct {
+	repository.QRLocationRepository
+}
+
+func (fakeQRLocationRepo) GetQRLocation(_ context.Context, _ sqldb.SQLDB, id string) (qrlocation.QRLocation, error) {
+	if id != qrLocationID {
+		return qrlocation.QRLocation{}, xerror.NotFound(nil, "qrLocation.notFound", "QR location not found")
+	}
+	return qrlocation.QRLocation{ID: id}, nil
+}
+
+type fakeOutboxRepo struct {
+	repository.OutboxRepository
+}
+
+func (fakeOutboxRepo) CreateOutboxMessage(context.Context, sqldb.SQLDB, outbox.Message) error {
+	return nil
+}
+
+func newPublishedWorkflowData(t *testing.T, runtimeVariables string) workflow.Data {
+	t.Helper()
+
+	var data workflow.Data
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"nodes": [
+			{
+				"id": "trigger",
+				"type": "TRIGGER",
+				"data": {"trigger_type": "ON_DEMAND", "runtime_variables": `+runtimeVariables+`}
+			}
+		]
+	}`), &data))
+
+	return data
+}
+
+func TestWorkflowServiceRunWorkflow(t *testing.T) {
+	tests := []struct {
+		name             string
+		runtimeVariables string
+		values           map[string]any
+		expectErr        bool
+		errMessage       string
+	}{
+		{
+			name:             "Number variable",
+			runtimeVariables: `[{"key": "speed", "input_type": "NUMBER", "required": true, "min": 0}]`,
+			values:           map[string]any{"speed": 1.5},
+		},
+		{
+			name:             "Boolean variable",
+			runtimeVariables: `[{"key": "loop", "input_type": "BOOLEAN", "required": true}]`,
+			values:           map[string]any{"loop": true},
+		},
+		{
+			name:             "List of integers variable",
+			runtimeVariables: `[{"key": "slots", "input_type": "LIST", "item_type": "INTEGER", "required": true}]`,
+			values:           map[string]any{"slots": []any{float64(1), float64(2)}},
+		},
+		{
+			name:             "QR location variable",
+			runtimeVariables: `[{"key": "dock", "input_type": "QR_LOCATION", "required": true}]`,
+			values:           map[string]any{"dock": qrLocationID},
+		},
+		{
+			name:             "Unknown QR location",
+			runtimeVariables: `[{"key": "dock", "input_type": "QR_LOCATION", "required": true}]`,
+			values:           map[string]any{"dock": "5d1b7c2e-9a3f-4e6b-8c1d-2f4a6b8c0d12"},
+			expectErr:        true,
+			errMessage:       "Runtime variable dock references unknown QR_LOCATION",
+		},
+		{
+			name:             "Number of wrong type",
+			runtimeVariables: `[{"key": "speed", "input_type": "NUMBER", "required": true}]`,
+			values:           map[string]any{"speed": "fast"},
+			expectErr:        true,
+			errMessage:       "Runtime variable speed must be a number",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := newPublishedWorkflowData(t, tt.runtimeVariables)
+			publishedVersion := int32(1)
+			workflowExecutionRepo := &fakeWorkflowExecutionRepo{}
+			repo := fakeRepository{
+				workflowRepo: fakeWorkflowRepo{wf: workflow.Workflow{
+					ID:               workflowID,
+					Data:             data,
+					PublishedVersion: &publishedVersion,
+				}},
+				workflowVersionRepo:   fakeWorkflowVersionRepo{version: workflow.NewVersion(workflowID, publishedVersion, data)},
+				workflowExecutionRepo: workflowExecutionRepo,
+				stepExecutionRepo:     fakeStepExecutionRepo{},
+				qrLocationRepo:        fakeQRLocationRepo{},
+				outboxRepo:            fakeOutboxRepo{},
+			}
+			svc := serviceimpl.NewService(repo, fakeSQLDBProvider{}, nil, nil, nil, nil, nil, webhook.RetryPolicy{}, nil,
+				validator.NewValidator(), slog.Default())
+
+			id, err := svc.Workflow().RunWorkflow(context.Background(), service.RunWorkflowParams{
+				ID:               workflowID,
+				RuntimeVariables: tt.values,
+			})
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+				assert.Empty(t, workflowExecutionRepo.created)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, workflowExecutionRepo.created, 1)
+				assert.Equal(t, id, workflowExecutionRepo.created[0].ID)
+			}
+		})
+	}
+}
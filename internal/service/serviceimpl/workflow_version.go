@@ -0,0 +1,208 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/db/sqldb"
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/internal/pubsub"
+	"github.com/tuanvumaihuynh/roboflow/internal/repository"
+	"github.com/tuanvumaihuynh/roboflow/internal/service"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/validator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var _ service.WorkflowVersionService = (*workflowVersionService)(nil)
+
+type workflowVersionService struct {
+	workflowRepo        repository.WorkflowRepository
+	workflowVersionRepo repository.WorkflowVersionRepository
+	sqlDBProvider       sqldb.Provider
+	outboxRepo          repository.OutboxRepository
+	validator           validator.Validator
+}
+
+func newWorkflowVersionService(
+	workflowRepo repository.WorkflowRepository,
+	workflowVersionRepo repository.WorkflowVersionRepository,
+	sqlDBProvider sqldb.Provider,
+	outboxRepo repository.OutboxRepository,
+	validator validator.Validator,
+) *workflowVersionService {
+	return &workflowVersionService{
+		workflowRepo:        workflowRepo,
+		workflowVersionRepo: workflowVersionRepo,
+		sqlDBProvider:       sqlDBProvider,
+		outboxRepo:          outboxRepo,
+		validator:           validator,
+	}
+}
+
+func (s workflowVersionService) PublishWorkflow(ctx context.Context, params service.PublishWorkflowParams) (workflow.Version, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return workflow.Version{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	var version workflow.Version
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		var err error
+		version, _, err = publishWorkflow(ctx, db, s.workflowRepo, s.workflowVersionRepo, s.outboxRepo, params.WorkflowID, nil)
+		return err
+	}); err != nil {
+		return workflow.Version{}, fmt.Errorf("with tx: %w", err)
+	}
+
+	return version, nil
+}
+
+func (s workflowVersionService) GetWorkflowVersion(ctx context.Context, params service.GetWorkflowVersionParams) (workflow.Version, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return workflow.Version{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	version, err := s.workflowVersionRepo.GetWorkflowVersion(ctx, s.sqlDBProvider.DB(), params.WorkflowID, params.Version)
+	if err != nil {
+		return workflow.Version{}, fmt.Errorf("repo get workflow version: %w", err)
+	}
+
+	return version, nil
+}
+
+func (s workflowVersionService) ListWorkflowVersions(ctx context.Context, params service.ListWorkflowVersionsParams) (paging.List[workflow.Version], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[workflow.Version]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	versions, err := s.workflowVersionRepo.ListWorkflowVersions(ctx, s.sqlDBProvider.DB(), params.PagingParams, params.Sorts, params.WorkflowID)
+	if err != nil {
+		return paging.List[workflow.Version]{}, fmt.Errorf("repo list workflow versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+func (s workflowVersionService) DiffWorkflowVersions(ctx context.Context, params service.DiffWorkflowVersionsParams) (workflow.DataDiff, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return workflow.DataDiff{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	version, err := s.workflowVersionRepo.GetWorkflowVersion(ctx, s.sqlDBProvider.DB(), params.WorkflowID, params.Version)
+	if err != nil {
+		return workflow.DataDiff{}, fmt.Errorf("repo get workflow version: %w", err)
+	}
+
+	baseVersion := params.Version - 1
+	if params.BaseVersion != nil {
+		baseVersion = *params.BaseVersion
+	}
+
+	// The first version is compared against an empty workflow
+	var baseData workflow.Data
+	if baseVersion > 0 {
+		base, err := s.workflowVersionRepo.GetWorkflowVersion(ctx, s.sqlDBProvider.DB(), params.WorkflowID, baseVersion)
+		if err != nil {
+			return workflow.DataDiff{}, fmt.Errorf("repo get base workflow version: %w", err)
+		}
+		baseData = base.Data
+	}
+
+	diff, err := workflow.Diff(baseData, version.Data)
+	if err != nil {
+		return workflow.DataDiff{}, fmt.Errorf("diff workflow data: %w", err)
+	}
+
+	return diff, nil
+}
+
+func (s workflowVersionService) RollbackWorkflow(ctx context.Context, params service.RollbackWorkflowParams) (workflow.Workflow, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return workflow.Workflow{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	var wf workflow.Workflow
+	if err := s.sqlDBProvider.WithTx(ctx, func(db sqldb.SQLDB) error {
+		version, err := s.workflowVersionRepo.GetWorkflowVersion(ctx, db, params.WorkflowID, params.Version)
+		if err != nil {
+			return fmt.Errorf("repo get workflow version: %w", err)
+		}
+
+		_, wf, err = publishWorkflow(ctx, db, s.workflowRepo, s.workflowVersionRepo, s.outboxRepo, params.WorkflowID, &version.Data)
+		return err
+	}); err != nil {
+		return workflow.Workflow{}, fmt.Errorf("with tx: %w", err)
+	}
+
+	return wf, nil
+}
+
+// publishWorkflow creates the next Version of a workflow, makes it the live
+// version and writes its WorkflowPublished event.
+// The Version is created from data if it is not nil, in which case data also
+// replaces the draft, otherwise from the current draft.
+// It must be called inside a transaction.
+func publishWorkflow(
+	ctx context.Context,
+	db sqldb.SQLDB,
+	workflowRepo repository.WorkflowRepository,
+	workflowVersionRepo repository.WorkflowVersionRepository,
+	outboxRepo repository.OutboxRepository,
+	workflowID string,
+	data *workflow.Data,
+) (workflow.Version, workflow.Workflow, error) {
+	// Lock the workflow so concurrent publishes get consecutive version numbers
+	wf, err := workflowRepo.GetWorkflowForUpdate(ctx, db, workflowID)
+	if err != nil {
+		return workflow.Version{}, workflow.Workflow{}, fmt.Errorf("repo get workflow for update: %w", err)
+	}
+	if wf.IsTemplate {
+		return workflow.Version{}, workflow.Workflow{}, xerror.ValidationFailed(nil, "Templates cannot be published, instantiate them instead")
+	}
+
+	publishData := wf.Data
+	if data != nil {
+		publishData = *data
+	} else if !wf.IsValid {
+		return workflow.Version{}, workflow.Workflow{}, xerror.ValidationFailed(nil, "Workflow is not valid")
+	}
+
+	latest, err := workflowVersionRepo.GetLatestVersionNumber(ctx, db, workflowID)
+	if err != nil {
+		return workflow.Version{}, workflow.Workflow{}, fmt.Errorf("repo get latest version number: %w", err)
+	}
+
+	version := workflow.NewVersion(workflowID, latest+1, publishData)
+	if err := workflowVersionRepo.CreateWorkflowVersion(ctx, db, version); err != nil {
+		return workflow.Version{}, workflow.Workflow{}, fmt.Errorf("repo create workflow version: %w", err)
+	}
+
+	updateParams := repository.UpdateWorkflowParams{
+		ID:                  workflowID,
+		IsDraft:             false,
+		SetIsDraft:          true,
+		PublishedVersion:    &version.Version,
+		SetPublishedVersion: true,
+	}
+	if data != nil {
+		updateParams.Data = *data
+		updateParams.SetData = true
+		updateParams.IsValid = true
+		updateParams.SetIsValid = true
+	}
+
+	wf, err = workflowRepo.UpdateWorkflow(ctx, db, updateParams)
+	if err != nil {
+		return workflow.Version{}, workflow.Workflow{}, fmt.Errorf("repo update workflow: %w", err)
+	}
+
+	if err := writeEvents(ctx, db, outboxRepo, pubsub.WorkflowPublished{
+		WorkflowID:        version.WorkflowID,
+		WorkflowVersionID: version.ID,
+		Version:           version.Version,
+	}); err != nil {
+		return workflow.Version{}, workflow.Workflow{}, err
+	}
+
+	return version, wf, nil
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	stepexecution "github.com/tuanvumaihuynh/roboflow/internal/model/step_execution"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
 
 type GetStepExecutionParams struct {
@@ -12,7 +14,9 @@ type GetStepExecutionParams struct {
 }
 
 type ListStepsByWorkflowExecutionIDParams struct {
-	WorkflowExecutionID string `validate:"required,uuid"`
+	WorkflowExecutionID string        `validate:"required,uuid"`
+	PagingParams        paging.Params `validate:"required"`
+	Sorts               []sort.Sort   `validate:"sort=status started_at completed_at created_at updated_at"`
 }
 
 type UpdateStepExecutionParams struct {
@@ -35,8 +39,9 @@ type StepExecutionService interface {
 	// GetStepExecution gets a StepExecution by ID.
 	GetStepExecution(ctx context.Context, params GetStepExecutionParams) (stepexecution.StepExecution, error)
 
-	// ListStepsByWorkflowExecutionID lists all Steps by WorkflowExecution ID.
-	ListStepsByWorkflowExecutionID(ctx context.Context, params ListStepsByWorkflowExecutionIDParams) ([]stepexecution.StepExecution, error)
+	// ListStepsByWorkflowExecutionID lists the Steps of a WorkflowExecution
+	// a page at a time.
+	ListStepsByWorkflowExecutionID(ctx context.Context, params ListStepsByWorkflowExecutionIDParams) (paging.List[stepexecution.StepExecution], error)
 
 	// UpdateStepExecution updates a StepExecution.
 	UpdateStepExecution(ctx context.Context, params UpdateStepExecutionParams) (stepexecution.StepExecution, error)
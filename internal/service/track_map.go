@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	trackmap "github.com/tuanvumaihuynh/roboflow/internal/model/track_map"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type GetTrackSegmentParams struct {
+	ID string `validate:"required,uuid"`
+}
+
+type ListTrackSegmentsParams struct {
+	PagingParams paging.Params `validate:"required"`
+	Sorts        []sort.Sort   `validate:"sort=distance direction created_at updated_at"`
+}
+
+type CreateTrackSegmentParams struct {
+	FromLocationID string                      `validate:"required,uuid"`
+	ToLocationID   string                      `validate:"required,uuid,nefield=FromLocationID"`
+	Distance       int32                       `validate:"required,min=1"`
+	Direction      raybotcommand.MoveDirection `validate:"required,enum"`
+}
+
+type UpdateTrackSegmentParams struct {
+	ID             string                      `validate:"required,uuid"`
+	FromLocationID string                      `validate:"required,uuid"`
+	ToLocationID   string                      `validate:"required,uuid,nefield=FromLocationID"`
+	Distance       int32                       `validate:"required,min=1"`
+	Direction      raybotcommand.MoveDirection `validate:"required,enum"`
+}
+
+type DeleteTrackSegmentParams struct {
+	ID string `validate:"required,uuid"`
+}
+
+type TrackMapService interface {
+	// GetTrackMap gets the Map of every QR location and Segment.
+	GetTrackMap(ctx context.Context) (trackmap.Map, error)
+
+	// GetTrackSegment gets a Segment by its ID.
+	GetTrackSegment(ctx context.Context, params GetTrackSegmentParams) (trackmap.Segment, error)
+
+	// ListTrackSegments lists all Segments.
+	ListTrackSegments(ctx context.Context, params ListTrackSegmentsParams) (paging.List[trackmap.Segment], error)
+
+	// CreateTrackSegment creates a new Segment between two existing QR
+	// locations. There is at most one Segment from a location to another.
+	CreateTrackSegment(ctx context.Context, params CreateTrackSegmentParams) (trackmap.Segment, error)
+
+	// UpdateTrackSegment updates a Segment.
+	UpdateTrackSegment(ctx context.Context, params UpdateTrackSegmentParams) (trackmap.Segment, error)
+
+	// DeleteTrackSegment deletes a Segment.
+	DeleteTrackSegment(ctx context.Context, params DeleteTrackSegmentParams) error
+}
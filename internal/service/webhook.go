@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/webhook"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type GetWebhookSubscriptionParams struct {
+	ID string `validate:"required,uuid"`
+}
+
+type ListWebhookSubscriptionsParams struct {
+	PagingParams paging.Params `validate:"required"`
+	Sorts        []sort.Sort   `validate:"sort=url is_active created_at updated_at"`
+}
+
+type CreateWebhookSubscriptionParams struct {
+	URL        string   `validate:"required,http_url,max=2048"`
+	Secret     string   `validate:"required,min=16,max=255"`
+	EventTypes []string `validate:"max=100,dive,required"`
+	IsActive   bool
+}
+
+type UpdateWebhookSubscriptionParams struct {
+	ID         string   `validate:"required,uuid"`
+	URL        string   `validate:"required,http_url,max=2048"`
+	Secret     *string  `validate:"omitempty,min=16,max=255"`
+	EventTypes []string `validate:"max=100,dive,required"`
+	IsActive   bool
+}
+
+type DeleteWebhookSubscriptionParams struct {
+	ID string `validate:"required,uuid"`
+}
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID string                  `validate:"required,uuid"`
+	PagingParams   paging.Params           `validate:"required"`
+	Sorts          []sort.Sort             `validate:"sort=event_type status attempts next_attempt_at last_attempt_at created_at updated_at"`
+	Status         *webhook.DeliveryStatus `validate:"omitempty,enum"`
+}
+
+type RedeliverWebhookDeliveryParams struct {
+	SubscriptionID string `validate:"required,uuid"`
+	ID             string `validate:"required,uuid"`
+}
+
+type DispatchWebhookEventParams struct {
+	EventID   string `validate:"required"`
+	EventType string `validate:"required"`
+	Payload   []byte `validate:"required"`
+}
+
+type DeliverWebhooksParams struct {
+	BatchSize int32 `validate:"required,min=1,max=1000"`
+	// Lease is how long the sent Deliveries are not claimed by another
+	// instance, it must be longer than the request timeout.
+	Lease time.Duration `validate:"required"`
+}
+
+type WebhookService interface {
+	// GetWebhookSubscription gets a Subscription by its ID.
+	GetWebhookSubscription(ctx context.Context, params GetWebhookSubscriptionParams) (webhook.Subscription, error)
+
+	// ListWebhookSubscriptions lists all Subscriptions.
+	ListWebhookSubscriptions(ctx context.Context, params ListWebhookSubscriptionsParams) (paging.List[webhook.Subscription], error)
+
+	// CreateWebhookSubscription creates a new Subscription. The event types
+	// must be in the domain event catalogue.
+	CreateWebhookSubscription(ctx context.Context, params CreateWebhookSubscriptionParams) (webhook.Subscription, error)
+
+	// UpdateWebhookSubscription updates a Subscription. The secret is only
+	// changed when set.
+	UpdateWebhookSubscription(ctx context.Context, params UpdateWebhookSubscriptionParams) (webhook.Subscription, error)
+
+	// DeleteWebhookSubscription deletes a Subscription and its Deliveries.
+	DeleteWebhookSubscription(ctx context.Context, params DeleteWebhookSubscriptionParams) error
+
+	// ListWebhookDeliveries lists the Deliveries of a Subscription.
+	ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) (paging.List[webhook.Delivery], error)
+
+	// RedeliverWebhookDelivery schedules a Delivery to be sent again now,
+	// with the full retry schedule.
+	RedeliverWebhookDelivery(ctx context.Context, params RedeliverWebhookDeliveryParams) (webhook.Delivery, error)
+
+	// DispatchWebhookEvent creates a Delivery of the event for every active
+	// Subscription receiving it. Dispatching the same event twice creates no
+	// new Delivery.
+	DispatchWebhookEvent(ctx context.Context, params DispatchWebhookEventParams) error
+
+	// DeliverWebhooks sends a batch of Deliveries due for an attempt and
+	// records the outcome of each attempt. It returns the number of sent
+	// Deliveries.
+	DeliverWebhooks(ctx context.Context, params DeliverWebhooksParams) (int, error)
+}
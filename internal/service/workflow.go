@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
@@ -13,26 +14,35 @@ type GetWorkflowParams struct {
 }
 
 type ListWorkflowsParams struct {
-	PagingParams paging.Params `validate:"required"`
-	Sorts        []sort.Sort   `validate:"sort=name is_draft created_at updated_at"`
+	PagingParams paging.Params   `validate:"required"`
+	Sorts        []sort.Sort     `validate:"sort=name is_draft created_at updated_at"`
+	Filters      []filter.Filter `validate:"filter=name is_draft:bool is_valid:bool created_at:time updated_at:time"`
+	Search       string          `validate:"max=100"`
+	IsTemplate   *bool
 }
 
 type CreateWorkflowParams struct {
-	Name        string        `validate:"required,alphanumspace,min=1,max=100"`
-	Description *string       `validate:"omitempty,min=1,max=100"`
-	Data        workflow.Data `validate:"required"`
+	Name               string        `validate:"required,alphanumspace,min=1,max=100"`
+	Description        *string       `validate:"omitempty,min=1,max=100"`
+	Data               workflow.Data `validate:"required"`
+	IsTemplate         bool
+	TemplateParameters []workflow.TemplateParameter `validate:"dive"`
 }
 
 type UpdateWorkflowParams struct {
-	ID             string `validate:"required,uuid"`
-	Name           string `validate:"required_if=SetName true,omitempty,alphanumspace,min=1,max=100"`
-	SetName        bool
-	Description    *string `validate:"required_if=SetDescription true,omitempty,min=1,max=100"`
-	SetDescription bool
-	IsDraft        bool `validate:"required_if=SetIsDraft true"`
-	SetIsDraft     bool
-	Data           workflow.Data `validate:"required_if=SetData true,omitempty"`
-	SetData        bool
+	ID                    string `validate:"required,uuid"`
+	Name                  string `validate:"required_if=SetName true,omitempty,alphanumspace,min=1,max=100"`
+	SetName               bool
+	Description           *string `validate:"required_if=SetDescription true,omitempty,min=1,max=100"`
+	SetDescription        bool
+	IsDraft               bool
+	SetIsDraft            bool
+	Data                  workflow.Data `validate:"required_if=SetData true,omitempty"`
+	SetData               bool
+	IsTemplate            bool
+	SetIsTemplate         bool
+	TemplateParameters    []workflow.TemplateParameter `validate:"dive"`
+	SetTemplateParameters bool
 }
 
 type DeleteWorkflowParams struct {
@@ -42,6 +52,40 @@ type DeleteWorkflowParams struct {
 type RunWorkflowParams struct {
 	ID               string         `validate:"required,uuid"`
 	RuntimeVariables map[string]any `validate:"required,dive"`
+	// DryRun runs the CONTROL_RAYBOT steps against a simulated raybot
+	// instead of real robots.
+	DryRun bool
+}
+
+type DuplicateWorkflowParams struct {
+	ID   string `validate:"required,uuid"`
+	Name string `validate:"required,alphanumspace,min=1,max=100"`
+	// Description defaults to the description of the duplicated workflow.
+	Description *string `validate:"omitempty,min=1,max=100"`
+}
+
+type InstantiateWorkflowTemplateParams struct {
+	ID   string `validate:"required,uuid"`
+	Name string `validate:"required,alphanumspace,min=1,max=100"`
+	// Description defaults to the description of the template.
+	Description *string `validate:"omitempty,min=1,max=100"`
+	// Parameters are the raybot or QR location IDs keyed by template parameter key.
+	Parameters map[string]string
+}
+
+type ExportWorkflowParams struct {
+	ID string `validate:"required,uuid"`
+}
+
+type ImportWorkflowParams struct {
+	Document workflow.Document `validate:"required"`
+}
+
+type ImportWorkflowResult struct {
+	Workflow workflow.Workflow
+	// MissingQRLocations lists the codes of the QR locations of the document
+	// that do not exist on this site.
+	MissingQRLocations []string
 }
 
 type WorkflowService interface {
@@ -62,4 +106,19 @@ type WorkflowService interface {
 
 	// RunWorkflow runs a workflow.
 	RunWorkflow(ctx context.Context, params RunWorkflowParams) (workflowExecutionID string, err error)
+
+	// DuplicateWorkflow creates a new draft workflow from a copy of the draft of a workflow.
+	DuplicateWorkflow(ctx context.Context, params DuplicateWorkflowParams) (workflow.Workflow, error)
+
+	// InstantiateWorkflowTemplate creates a new draft workflow from a template,
+	// replacing the placeholders of its parameters with the given values.
+	InstantiateWorkflowTemplate(ctx context.Context, params InstantiateWorkflowTemplateParams) (workflow.Workflow, error)
+
+	// ExportWorkflow exports the draft of a workflow as a portable document.
+	ExportWorkflow(ctx context.Context, params ExportWorkflowParams) (workflow.Document, error)
+
+	// ImportWorkflow creates a new workflow from a portable document.
+	// The workflow is created even if some QR locations are missing, but it is
+	// marked invalid until the references are fixed.
+	ImportWorkflow(ctx context.Context, params ImportWorkflowParams) (ImportWorkflowResult, error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	workflowexecution "github.com/tuanvumaihuynh/roboflow/internal/model/workflow_execution"
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
@@ -16,6 +17,22 @@ type ListWorkflowExecutionsByWorkflowIDParams struct {
 	WorkflowID   string        `validate:"required,uuid"`
 	PagingParams paging.Params `validate:"required"`
 	Sorts        []sort.Sort   `validate:"sort=status started_at completed_at created_at updated_at"`
+	IsSimulated  *bool
+}
+
+type ListWorkflowExecutionsParams struct {
+	PagingParams paging.Params   `validate:"required"`
+	Sorts        []sort.Sort     `validate:"sort=status started_at completed_at created_at updated_at"`
+	Filters      []filter.Filter `validate:"filter=status:oneof=PENDING/RUNNING/COMPLETED/FAILED/CANCELLED workflow_id:uuid trigger_type:oneof=ON_DEMAND raybot_id:uuid is_simulated:bool started_at:time completed_at:time created_at:time"`
+}
+
+type CountWorkflowExecutionsByStatusParams struct {
+	Filters []filter.Filter `validate:"filter=status:oneof=PENDING/RUNNING/COMPLETED/FAILED/CANCELLED workflow_id:uuid trigger_type:oneof=ON_DEMAND raybot_id:uuid is_simulated:bool started_at:time completed_at:time created_at:time"`
+}
+
+type StreamWorkflowExecutionEventsParams struct {
+	ID          string `validate:"required,uuid"`
+	LastEventID int64  `validate:"min=0"`
 }
 
 type ProcessRunWorkflowExecutionParams struct {
@@ -29,6 +46,21 @@ type WorkflowExecutionService interface {
 	// ListWorkflowExecutionsByWorkflowID lists all WorkflowExecutions by Workflow ID.
 	ListWorkflowExecutionsByWorkflowID(ctx context.Context, params ListWorkflowExecutionsByWorkflowIDParams) (paging.List[workflowexecution.WorkflowExecution], error)
 
+	// ListWorkflowExecutions lists the WorkflowExecutions of all Workflows.
+	// Dry runs are excluded unless the Filters select them by is_simulated.
+	ListWorkflowExecutions(ctx context.Context, params ListWorkflowExecutionsParams) (paging.List[workflowexecution.WorkflowExecution], error)
+
+	// CountWorkflowExecutionsByStatus counts the WorkflowExecutions of all
+	// Workflows by Status. Every Status has a count. Dry runs are excluded
+	// unless the Filters select them by is_simulated.
+	CountWorkflowExecutionsByStatus(ctx context.Context, params CountWorkflowExecutionsByStatusParams) (map[workflowexecution.Status]int64, error)
+
+	// StreamWorkflowExecutionEvents streams the Events of a WorkflowExecution
+	// with an ID greater than LastEventID, first the stored ones and then the
+	// live ones. The channel is closed after the final Event of the execution
+	// or when ctx is done.
+	StreamWorkflowExecutionEvents(ctx context.Context, params StreamWorkflowExecutionEventsParams) (<-chan workflowexecution.Event, error)
+
 	// ProcessRunWorkflowExecution processes a run WorkflowExecution.
 	ProcessRunWorkflowExecution(ctx context.Context, params ProcessRunWorkflowExecutionParams) error
 }
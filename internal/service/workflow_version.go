@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"github.com/tuanvumaihuynh/roboflow/internal/model/workflow"
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+type PublishWorkflowParams struct {
+	WorkflowID string `validate:"required,uuid"`
+}
+
+type GetWorkflowVersionParams struct {
+	WorkflowID string `validate:"required,uuid"`
+	Version    int32  `validate:"required,min=1"`
+}
+
+type ListWorkflowVersionsParams struct {
+	WorkflowID   string        `validate:"required,uuid"`
+	PagingParams paging.Params `validate:"required"`
+	Sorts        []sort.Sort   `validate:"sort=version created_at"`
+}
+
+type DiffWorkflowVersionsParams struct {
+	WorkflowID string `validate:"required,uuid"`
+	Version    int32  `validate:"required,min=1"`
+	// BaseVersion is the version to compare against, defaults to the previous version.
+	BaseVersion *int32 `validate:"omitempty,min=1"`
+}
+
+type RollbackWorkflowParams struct {
+	WorkflowID string `validate:"required,uuid"`
+	Version    int32  `validate:"required,min=1"`
+}
+
+type WorkflowVersionService interface {
+	// PublishWorkflow creates a new Version from the draft of a Workflow and makes it the live version.
+	PublishWorkflow(ctx context.Context, params PublishWorkflowParams) (workflow.Version, error)
+
+	// GetWorkflowVersion gets a Version of a Workflow by its number.
+	GetWorkflowVersion(ctx context.Context, params GetWorkflowVersionParams) (workflow.Version, error)
+
+	// ListWorkflowVersions lists all Versions of a Workflow.
+	ListWorkflowVersions(ctx context.Context, params ListWorkflowVersionsParams) (paging.List[workflow.Version], error)
+
+	// DiffWorkflowVersions compares a Version of a Workflow with a base Version.
+	DiffWorkflowVersions(ctx context.Context, params DiffWorkflowVersionsParams) (workflow.DataDiff, error)
+
+	// RollbackWorkflow publishes the data of a previous Version as a new Version.
+	RollbackWorkflow(ctx context.Context, params RollbackWorkflowParams) (workflow.Workflow, error)
+}
@@ -0,0 +1,132 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+// ErrSimulatedFailure is returned for the commands the simulator fails on purpose.
+var ErrSimulatedFailure = errors.New("simulated failure")
+
+// RaybotSimulator runs raybot commands in place of a real raybot.
+type RaybotSimulator interface {
+	// RunCommand runs the command and returns its outputs once it is completed.
+	RunCommand(ctx context.Context, cmdType raybotcommand.Type, inputs raybotcommand.Inputs) (raybotcommand.Outputs, error)
+}
+
+var _ RaybotSimulator = (*Raybot)(nil)
+
+// Raybot is a RaybotSimulator that completes every command after a fixed
+// latency. It fails some commands on purpose, see config.SimulatorConfig,
+// and answers SCAN_LOCATION commands with canned locations.
+type Raybot struct {
+	latency       time.Duration
+	failureRate   float64
+	failCommands  map[raybotcommand.Type]struct{}
+	scanLocations []string
+}
+
+// NewRaybot creates a simulated raybot, it returns an error if the failure
+// rate is not between 0 and 1 or a failing command type is unknown.
+func NewRaybot(conf config.SimulatorConfig) (*Raybot, error) {
+	if conf.FailureRate < 0 || conf.FailureRate > 1 {
+		return nil, fmt.Errorf("failure rate must be between 0 and 1, got %v", conf.FailureRate)
+	}
+
+	failCommands := make(map[raybotcommand.Type]struct{}, len(conf.FailCommands))
+	for _, t := range conf.FailCommands {
+		if _, ok := raybotcommand.TypeMap[raybotcommand.Type(t)]; !ok {
+			return nil, fmt.Errorf("unknown failing command type: %s", t)
+		}
+		failCommands[raybotcommand.Type(t)] = struct{}{}
+	}
+
+	scanLocations := conf.ScanLocations
+	if scanLocations == nil {
+		scanLocations = []string{}
+	}
+
+	return &Raybot{
+		latency:       conf.Latency,
+		failureRate:   conf.FailureRate,
+		failCommands:  failCommands,
+		scanLocations: scanLocations,
+	}, nil
+}
+
+func (r *Raybot) RunCommand(ctx context.Context, cmdType raybotcommand.Type, inputs raybotcommand.Inputs) (raybotcommand.Outputs, error) {
+	if err := validateInputs(cmdType, inputs); err != nil {
+		return raybotcommand.Outputs{}, fmt.Errorf("invalid %s inputs: %w", cmdType, err)
+	}
+
+	timer := time.NewTimer(r.latency)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return raybotcommand.Outputs{}, ctx.Err()
+	case <-timer.C:
+	}
+
+	if _, ok := r.failCommands[cmdType]; ok || rand.Float64() < r.failureRate {
+		return raybotcommand.Outputs{}, fmt.Errorf("%s: %w", cmdType, ErrSimulatedFailure)
+	}
+
+	var outputs raybotcommand.Outputs
+	var err error
+	switch cmdType {
+	case raybotcommand.TypeScanLocation:
+		err = outputs.FromScanLocationOutputs(raybotcommand.ScanLocationOutputs{
+			Locations: r.scanLocations,
+		})
+	default:
+		err = outputs.FromEmptyOutputs()
+	}
+	if err != nil {
+		return raybotcommand.Outputs{}, fmt.Errorf("marshal outputs: %w", err)
+	}
+
+	return outputs, nil
+}
+
+// validateInputs checks that the inputs decode to the input of the command
+// type, like a real raybot would before running the command.
+func validateInputs(cmdType raybotcommand.Type, inputs raybotcommand.Inputs) error {
+	switch cmdType {
+	case raybotcommand.TypeMoveToLocation:
+		input, err := inputs.AsMoveToLocationInput()
+		if err != nil {
+			return err
+		}
+		if input.Location == "" {
+			return errors.New("location is required")
+		}
+	case raybotcommand.TypeLiftBox:
+		if _, err := inputs.AsLiftBoxInput(); err != nil {
+			return err
+		}
+	case raybotcommand.TypeDropBox:
+		if _, err := inputs.AsDropBoxInput(); err != nil {
+			return err
+		}
+	case raybotcommand.TypeCheckQRCode:
+		input, err := inputs.AsCheckQRCodeInput()
+		if err != nil {
+			return err
+		}
+		if input.QRCode == "" {
+			return errors.New("qr_code is required")
+		}
+	case raybotcommand.TypeSpeak:
+		if _, err := inputs.AsSpeakInput(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
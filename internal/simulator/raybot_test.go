@@ -0,0 +1,148 @@
+package simulator_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	raybotcommand "github.com/tuanvumaihuynh/roboflow/internal/model/raybot_command"
+	"github.com/tuanvumaihuynh/roboflow/internal/simulator"
+	"github.com/tuanvumaihuynh/roboflow/pkg/config"
+)
+
+func TestRaybotRunCommand(t *testing.T) {
+	tests := []struct {
+		name            string
+		conf            config.SimulatorConfig
+		cmdType         raybotcommand.Type
+		inputs          string
+		expectedOutputs string
+		expectErr       bool
+		errMessage      string
+	}{
+		{
+			name:            "Move to location",
+			cmdType:         raybotcommand.TypeMoveToLocation,
+			inputs:          `{"location":"QR_A1","direction":"FORWARD"}`,
+			expectedOutputs: `{}`,
+		},
+		{
+			name:       "Move to location with invalid direction",
+			cmdType:    raybotcommand.TypeMoveToLocation,
+			inputs:     `{"location":"QR_A1","direction":"LEFT"}`,
+			expectErr:  true,
+			errMessage: "invalid MOVE_TO_LOCATION inputs",
+		},
+		{
+			name:       "Check QR without code",
+			cmdType:    raybotcommand.TypeCheckQRCode,
+			inputs:     `{}`,
+			expectErr:  true,
+			errMessage: "qr_code is required",
+		},
+		{
+			name:            "Scan location returns canned locations",
+			conf:            config.SimulatorConfig{ScanLocations: []string{"QR_A1", "QR_A2"}},
+			cmdType:         raybotcommand.TypeScanLocation,
+			inputs:          `{}`,
+			expectedOutputs: `{"locations":["QR_A1","QR_A2"]}`,
+		},
+		{
+			name:            "Scan location without canned locations",
+			cmdType:         raybotcommand.TypeScanLocation,
+			inputs:          `{}`,
+			expectedOutputs: `{"locations":[]}`,
+		},
+		{
+			name:       "Command configured to fail",
+			conf:       config.SimulatorConfig{FailCommands: []string{"LIFT_BOX"}},
+			cmdType:    raybotcommand.TypeLiftBox,
+			inputs:     `{"distance":10}`,
+			expectErr:  true,
+			errMessage: "LIFT_BOX: simulated failure",
+		},
+		{
+			name:       "Failure rate of one",
+			conf:       config.SimulatorConfig{FailureRate: 1},
+			cmdType:    raybotcommand.TypeStop,
+			inputs:     `{}`,
+			expectErr:  true,
+			errMessage: "simulated failure",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := simulator.NewRaybot(tt.conf)
+			require.NoError(t, err)
+
+			outputs, err := r.RunCommand(context.Background(), tt.cmdType, raybotcommand.NewInputs(json.RawMessage(tt.inputs)))
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+			} else {
+				require.NoError(t, err)
+				assert.JSONEq(t, tt.expectedOutputs, string(outputs.Raw()))
+			}
+		})
+	}
+}
+
+func TestRaybotRunCommandCancelled(t *testing.T) {
+	r, err := simulator.NewRaybot(config.SimulatorConfig{Latency: time.Hour})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = r.RunCommand(ctx, raybotcommand.TypeStop, raybotcommand.NewInputs(json.RawMessage(`{}`)))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNewRaybot(t *testing.T) {
+	tests := []struct {
+		name       string
+		conf       config.SimulatorConfig
+		expectErr  bool
+		errMessage string
+	}{
+		{
+			name: "Valid config",
+			conf: config.SimulatorConfig{FailureRate: 0.5, FailCommands: []string{"LIFT_BOX", "DROP_BOX"}},
+		},
+		{
+			name:       "Failure rate above one",
+			conf:       config.SimulatorConfig{FailureRate: 1.5},
+			expectErr:  true,
+			errMessage: "failure rate must be between 0 and 1",
+		},
+		{
+			name:       "Negative failure rate",
+			conf:       config.SimulatorConfig{FailureRate: -0.1},
+			expectErr:  true,
+			errMessage: "failure rate must be between 0 and 1",
+		},
+		{
+			name:       "Unknown failing command type",
+			conf:       config.SimulatorConfig{FailCommands: []string{"LIFT"}},
+			expectErr:  true,
+			errMessage: "unknown failing command type: LIFT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := simulator.NewRaybot(tt.conf)
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Package archive writes records to gzip compressed JSON Lines files, one
+// JSON document per line, to keep them once they are deleted from the
+// database.
+package archive
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Archiver archives records.
+type Archiver interface {
+	// Archive writes the records of a table, they are durably stored once it
+	// returns.
+	Archive(table string, records []any) error
+}
+
+var _ Archiver = (*Dir)(nil)
+
+// Dir is an Archiver writing each batch of records to a new file of the
+// directory of its table, named after the time it was written, such as
+// workflow_executions/workflow_executions-20260102T030405.000000000Z-1a2b3c4d.jsonl.gz.
+// A file is written under a temporary name then renamed, so the files with a
+// .jsonl.gz extension are complete.
+type Dir struct {
+	dir string
+	now func() time.Time
+}
+
+// NewDir creates a new Dir archiving to dir, created when missing.
+func NewDir(dir string) *Dir {
+	return &Dir{dir: dir, now: time.Now}
+}
+
+// Ext is the extension of the archive files.
+const Ext = ".jsonl.gz"
+
+func (d *Dir) Archive(table string, records []any) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if table == "" || filepath.Base(table) != table {
+		return fmt.Errorf("invalid table name: %q", table)
+	}
+
+	dir := filepath.Join(d.dir, table)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create directory: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s-%s%s",
+		table, d.now().UTC().Format("20060102T150405.000000000Z"), uuid.NewString()[:8], Ext)
+	tmp, err := os.CreateTemp(dir, "."+name+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // Removing the renamed file fails, as expected.
+	defer tmp.Close()
+
+	if err := write(tmp, records); err != nil {
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("sync file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(dir, name)); err != nil {
+		return fmt.Errorf("rename file: %w", err)
+	}
+
+	return nil
+}
+
+func write(f *os.File, records []any) error {
+	gz := gzip.NewWriter(f)
+	enc := json.NewEncoder(gz)
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("encode record: %w", err)
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("compress records: %w", err)
+	}
+	return nil
+}
+
+// ReadFile reads the records of an archive file.
+func ReadFile(path string) ([]json.RawMessage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("decompress file: %w", err)
+	}
+	defer gz.Close()
+
+	records := []json.RawMessage{}
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(nil, 64<<20)
+	for scanner.Scan() {
+		records = append(records, json.RawMessage(append([]byte(nil), scanner.Bytes()...)))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	return records, nil
+}
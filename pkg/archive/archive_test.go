@@ -0,0 +1,58 @@
+package archive_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/archive"
+)
+
+func TestDirArchive(t *testing.T) {
+	dir := t.TempDir()
+	a := archive.NewDir(dir)
+
+	records := []any{
+		map[string]any{"id": "1", "status": "COMPLETED"},
+		map[string]any{"id": "2", "status": "FAILED"},
+	}
+	require.NoError(t, a.Archive("workflow_executions", records))
+	require.NoError(t, a.Archive("workflow_executions", records[:1]))
+	require.NoError(t, a.Archive("workflow_executions", nil))
+
+	files, err := filepath.Glob(filepath.Join(dir, "workflow_executions", "*"))
+	require.NoError(t, err)
+	require.Len(t, files, 2, "one file per batch, no temporary file left")
+
+	var lines []string
+	for _, f := range files {
+		assert.True(t, strings.HasSuffix(f, archive.Ext), f)
+		got, err := archive.ReadFile(f)
+		require.NoError(t, err)
+		for _, r := range got {
+			lines = append(lines, string(r))
+		}
+	}
+	assert.ElementsMatch(t, []string{
+		`{"id":"1","status":"COMPLETED"}`,
+		`{"id":"2","status":"FAILED"}`,
+		`{"id":"1","status":"COMPLETED"}`,
+	}, lines)
+}
+
+func TestDirArchiveInvalidTable(t *testing.T) {
+	dir := t.TempDir()
+	a := archive.NewDir(dir)
+
+	for _, table := range []string{"", "../outside", "a/b"} {
+		assert.Error(t, a.Archive(table, []any{1}), table)
+	}
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
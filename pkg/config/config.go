@@ -12,7 +12,12 @@ type Config struct {
 	Log        LogConfig        `envPrefix:"LOG_"`
 	HTTPServer HTTPServerConfig `envPrefix:"HTTP_SERVER_"`
 	Postgres   PostgresConfig   `envPrefix:"PG_"`
+	PubSub     PubSubConfig     `envPrefix:"PUBSUB_"`
 	Nats       NatsConfig       `envPrefix:"NATS_"`
+	Simulator  SimulatorConfig  `envPrefix:"SIMULATOR_"`
+	Outbox     OutboxConfig     `envPrefix:"OUTBOX_"`
+	Webhook    WebhookConfig    `envPrefix:"WEBHOOK_"`
+	Retention  RetentionConfig  `envPrefix:"RETENTION_"`
 }
 
 func Load() (*Config, error) {
@@ -3,4 +3,5 @@ package config
 type HTTPServerConfig struct {
 	Port          int  `env:"PORT" envDefault:"8080"`
 	EnableSwagger bool `env:"ENABLE_SWAGGER" envDefault:"true"`
+	EnableMetrics bool `env:"ENABLE_METRICS" envDefault:"true"`
 }
@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+// OutboxConfig configures the relay publishing the outbox messages.
+type OutboxConfig struct {
+	// RelayInterval is how often the relay polls for messages to publish.
+	RelayInterval time.Duration `env:"RELAY_INTERVAL" envDefault:"500ms"`
+	// RelayBatchSize is the maximum number of messages published per transaction.
+	RelayBatchSize int32 `env:"RELAY_BATCH_SIZE" envDefault:"100"`
+	// Retention is how long published messages are kept before being deleted.
+	Retention time.Duration `env:"RETENTION" envDefault:"24h"`
+	// CleanupInterval is how often published messages past the retention are deleted.
+	CleanupInterval time.Duration `env:"CLEANUP_INTERVAL" envDefault:"1h"`
+}
@@ -0,0 +1,16 @@
+package config
+
+import "time"
+
+// PubSubConfig configures the backend of the publishers and subscribers.
+type PubSubConfig struct {
+	// Driver is the backend: nats-embedded, nats, postgres or gochannel.
+	// Empty picks nats when NATS_URL is set and nats-embedded otherwise.
+	Driver string `env:"DRIVER"`
+	// PostgresPollInterval is how often the postgres driver polls for new
+	// messages.
+	PostgresPollInterval time.Duration `env:"POSTGRES_POLL_INTERVAL" envDefault:"1s"`
+	// PostgresCleanupInterval is how often the postgres driver deletes the
+	// messages acked by every consumer group. 0 disables the cleanup.
+	PostgresCleanupInterval time.Duration `env:"POSTGRES_CLEANUP_INTERVAL" envDefault:"1h"`
+}
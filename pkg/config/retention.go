@@ -0,0 +1,28 @@
+package config
+
+import "time"
+
+// RetentionConfig configures the purge of the execution and command history.
+// Nothing is purged until a retention is set.
+type RetentionConfig struct {
+	// Interval is how often the history past the retention is purged.
+	Interval time.Duration `env:"INTERVAL" envDefault:"1h"`
+	// BatchSize is the maximum number of records purged per transaction.
+	BatchSize int32 `env:"BATCH_SIZE" envDefault:"500"`
+	// BatchPause is the pause between two batches, leaving the database to
+	// the other queries.
+	BatchPause time.Duration `env:"BATCH_PAUSE" envDefault:"100ms"`
+	// WorkflowExecutions is how long the workflow executions are kept after
+	// completion, by finished status, such as COMPLETED:168h,FAILED:720h.
+	// Their steps are purged with them. The executions of a status missing
+	// are kept forever.
+	WorkflowExecutions map[string]time.Duration `env:"WORKFLOW_EXECUTIONS" envKeyValSeparator:":"`
+	// RaybotCommands is how long the raybot commands are kept after
+	// completion, by finished status, such as SUCCEEDED:168h,FAILED:720h.
+	// The commands of a status missing are kept forever.
+	RaybotCommands map[string]time.Duration `env:"RAYBOT_COMMANDS" envKeyValSeparator:":"`
+	// ArchiveDir is the directory the purged records are archived to, as
+	// gzip compressed JSON Lines files. If not provided, they are not
+	// archived.
+	ArchiveDir string `env:"ARCHIVE_DIR"`
+}
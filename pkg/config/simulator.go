@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+// SimulatorConfig configures the simulated raybot used by dry run executions.
+type SimulatorConfig struct {
+	// Latency is how long the simulated raybot takes to complete a command.
+	Latency time.Duration `env:"LATENCY" envDefault:"1s"`
+	// FailureRate is the probability, between 0 and 1, that a command fails.
+	FailureRate float64 `env:"FAILURE_RATE" envDefault:"0"`
+	// FailCommands are the command types that always fail, e.g. LIFT_BOX,DROP_BOX.
+	FailCommands []string `env:"FAIL_COMMANDS" envSeparator:","`
+	// ScanLocations are the QR codes returned by SCAN_LOCATION commands.
+	ScanLocations []string `env:"SCAN_LOCATIONS" envSeparator:","`
+}
@@ -0,0 +1,20 @@
+package config
+
+import "time"
+
+// WebhookConfig configures the delivery of the webhooks.
+type WebhookConfig struct {
+	// DeliveryInterval is how often the worker polls for deliveries to send.
+	DeliveryInterval time.Duration `env:"DELIVERY_INTERVAL" envDefault:"1s"`
+	// DeliveryBatchSize is the maximum number of deliveries sent at once.
+	DeliveryBatchSize int32 `env:"DELIVERY_BATCH_SIZE" envDefault:"50"`
+	// Timeout is the timeout of a webhook request.
+	Timeout time.Duration `env:"TIMEOUT" envDefault:"10s"`
+	// MaxAttempts is the number of attempts before a delivery is dead.
+	MaxAttempts int32 `env:"MAX_ATTEMPTS" envDefault:"10"`
+	// InitialRetryInterval is the interval before the first retry, it
+	// doubles after every failed attempt.
+	InitialRetryInterval time.Duration `env:"INITIAL_RETRY_INTERVAL" envDefault:"30s"`
+	// MaxRetryInterval is the maximum interval between two attempts.
+	MaxRetryInterval time.Duration `env:"MAX_RETRY_INTERVAL" envDefault:"6h"`
+}
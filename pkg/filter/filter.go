@@ -0,0 +1,164 @@
+package filter
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var (
+	ErrInvalidFilter = xerror.BadRequest(
+		nil,
+		"filter.invalid",
+		"Invalid filter, filters are field:operator:value separated by commas",
+	)
+)
+
+type Operator string
+
+const (
+	OperatorEq  Operator = "eq"
+	OperatorNe  Operator = "ne"
+	OperatorGt  Operator = "gt"
+	OperatorGte Operator = "gte"
+	OperatorLt  Operator = "lt"
+	OperatorLte Operator = "lte"
+	// OperatorIn matches any of the values, separated by "|".
+	OperatorIn Operator = "in"
+)
+
+var operators = map[Operator]struct{}{
+	OperatorEq:  {},
+	OperatorNe:  {},
+	OperatorGt:  {},
+	OperatorGte: {},
+	OperatorLt:  {},
+	OperatorLte: {},
+	OperatorIn:  {},
+}
+
+// Kind is the kind of the values of a field.
+type Kind string
+
+const (
+	KindString Kind = ""
+	// KindTime values are RFC 3339 date-times or dates, such as 2026-01-02.
+	KindTime Kind = "time"
+	KindBool Kind = "bool"
+	KindUUID Kind = "uuid"
+	// KindOneOf prefixes the values a field takes, separated by "/", such as
+	// oneof=PENDING/RUNNING.
+	KindOneOf Kind = "oneof="
+)
+
+// Valid reports whether value is a value of kind k.
+func (k Kind) Valid(value string) bool {
+	switch k {
+	case KindString:
+		return true
+	case KindTime:
+		if _, err := time.Parse(time.DateOnly, value); err == nil {
+			return true
+		}
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case KindBool:
+		_, err := strconv.ParseBool(value)
+		return err == nil
+	case KindUUID:
+		return uuid.Validate(value) == nil
+	default:
+		values, ok := strings.CutPrefix(string(k), string(KindOneOf))
+		return ok && slices.Contains(strings.Split(values, "/"), value)
+	}
+}
+
+type Filter struct {
+	Col      string
+	Operator Operator
+	// Values holds one value, or the values of OperatorIn.
+	Values []string
+}
+
+// Attach attaches filter to builder.
+func (f Filter) Attach(b sq.SelectBuilder) sq.SelectBuilder {
+	switch f.Operator {
+	case OperatorNe:
+		return b.Where(sq.NotEq{f.Col: f.Values[0]})
+	case OperatorGt:
+		return b.Where(sq.Gt{f.Col: f.Values[0]})
+	case OperatorGte:
+		return b.Where(sq.GtOrEq{f.Col: f.Values[0]})
+	case OperatorLt:
+		return b.Where(sq.Lt{f.Col: f.Values[0]})
+	case OperatorLte:
+		return b.Where(sq.LtOrEq{f.Col: f.Values[0]})
+	case OperatorIn:
+		return b.Where(sq.Eq{f.Col: f.Values})
+	default:
+		return b.Where(sq.Eq{f.Col: f.Values[0]})
+	}
+}
+
+// NewListFromString creates a list of Filter from a string, such as
+// "status:eq:FAILED,created_at:gte:2026-01-01". The values keep their colons,
+// but can not hold commas.
+func NewListFromString(s string) ([]Filter, error) {
+	if s == "" {
+		return []Filter{}, nil
+	}
+	conditions := strings.Split(s, ",")
+	filters := make([]Filter, len(conditions))
+
+	for i, c := range conditions {
+		parts := strings.SplitN(c, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, ErrInvalidFilter
+		}
+
+		operator := Operator(parts[1])
+		if _, ok := operators[operator]; !ok {
+			return nil, ErrInvalidFilter
+		}
+
+		values := []string{parts[2]}
+		if operator == OperatorIn {
+			values = strings.Split(parts[2], "|")
+		}
+
+		filters[i] = Filter{
+			Col:      parts[0],
+			Operator: operator,
+			Values:   values,
+		}
+	}
+
+	return filters, nil
+}
+
+// Search returns the condition matching the rows whose column holds, for
+// each word of text, a word starting with it. ok is false when text has no
+// words. The column should have a GIN index on to_tsvector('simple', col).
+func Search(col, text string) (cond sq.Sqlizer, ok bool) {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return nil, false
+	}
+
+	for i, w := range words {
+		words[i] = w + ":*"
+	}
+	return sq.Expr(
+		"to_tsvector('simple', "+col+") @@ to_tsquery('simple', ?)",
+		strings.Join(words, " & "),
+	), true
+}
@@ -0,0 +1,153 @@
+package filter_test
+
+import (
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
+)
+
+func TestNewListFromString(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  []filter.Filter
+		expectErr bool
+	}{
+		{
+			name:  "Single filter",
+			input: "status:eq:FAILED",
+			expected: []filter.Filter{
+				{Col: "status", Operator: filter.OperatorEq, Values: []string{"FAILED"}},
+			},
+		},
+		{
+			name:  "Multiple filters",
+			input: "status:ne:FAILED,created_at:gte:2026-01-01",
+			expected: []filter.Filter{
+				{Col: "status", Operator: filter.OperatorNe, Values: []string{"FAILED"}},
+				{Col: "created_at", Operator: filter.OperatorGte, Values: []string{"2026-01-01"}},
+			},
+		},
+		{
+			name:  "Value with colons",
+			input: "created_at:lt:2026-01-01T10:00:00Z",
+			expected: []filter.Filter{
+				{Col: "created_at", Operator: filter.OperatorLt, Values: []string{"2026-01-01T10:00:00Z"}},
+			},
+		},
+		{
+			name:  "In operator",
+			input: "status:in:FAILED|CANCELED",
+			expected: []filter.Filter{
+				{Col: "status", Operator: filter.OperatorIn, Values: []string{"FAILED", "CANCELED"}},
+			},
+		},
+		{
+			name:     "Empty input",
+			input:    "",
+			expected: []filter.Filter{},
+		},
+		{name: "Missing value", input: "status:eq:", expectErr: true},
+		{name: "Missing operator", input: "status:FAILED", expectErr: true},
+		{name: "Missing field", input: ":eq:FAILED", expectErr: true},
+		{name: "Unknown operator", input: "status:like:FAILED", expectErr: true},
+		{name: "Trailing comma", input: "status:eq:FAILED,", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := filter.NewListFromString(tt.input)
+
+			if tt.expectErr {
+				assert.ErrorIs(t, err, filter.ErrInvalidFilter)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestKindValid(t *testing.T) {
+	tests := []struct {
+		kind  filter.Kind
+		value string
+		valid bool
+	}{
+		{kind: filter.KindString, value: "anything", valid: true},
+		{kind: filter.KindTime, value: "2026-01-02", valid: true},
+		{kind: filter.KindTime, value: "2026-01-02T10:00:00Z", valid: true},
+		{kind: filter.KindTime, value: "2026-01-02T10:00:00+07:00", valid: true},
+		{kind: filter.KindTime, value: "yesterday", valid: false},
+		{kind: filter.KindBool, value: "true", valid: true},
+		{kind: filter.KindBool, value: "yes", valid: false},
+		{kind: filter.KindUUID, value: "123e4567-e89b-12d3-a456-426614174000", valid: true},
+		{kind: filter.KindUUID, value: "123", valid: false},
+		{kind: filter.Kind("oneof=PENDING/RUNNING"), value: "RUNNING", valid: true},
+		{kind: filter.Kind("oneof=PENDING/RUNNING"), value: "DONE", valid: false},
+		{kind: filter.Kind("oneof=PENDING/RUNNING"), value: "PENDING/RUNNING", valid: false},
+		{kind: filter.Kind("int"), value: "anything", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.kind)+" "+tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.valid, tt.kind.Valid(tt.value))
+		})
+	}
+}
+
+func TestFilterAttach(t *testing.T) {
+	tests := []struct {
+		name         string
+		filter       filter.Filter
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name:         "Eq",
+			filter:       filter.Filter{Col: "status", Operator: filter.OperatorEq, Values: []string{"FAILED"}},
+			expectedSQL:  "SELECT * FROM t WHERE status = $1",
+			expectedArgs: []any{"FAILED"},
+		},
+		{
+			name:         "Gte",
+			filter:       filter.Filter{Col: "created_at", Operator: filter.OperatorGte, Values: []string{"2026-01-01"}},
+			expectedSQL:  "SELECT * FROM t WHERE created_at >= $1",
+			expectedArgs: []any{"2026-01-01"},
+		},
+		{
+			name:         "In",
+			filter:       filter.Filter{Col: "status", Operator: filter.OperatorIn, Values: []string{"FAILED", "CANCELED"}},
+			expectedSQL:  "SELECT * FROM t WHERE status IN ($1,$2)",
+			expectedArgs: []any{"FAILED", "CANCELED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("*").From("t")
+
+			sql, args, err := tt.filter.Attach(query).ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSQL, sql)
+			assert.Equal(t, tt.expectedArgs, args)
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	cond, ok := filter.Search("name", "  Dock  B-12 ")
+	require.True(t, ok)
+
+	sql, args, err := cond.ToSql()
+	require.NoError(t, err)
+	assert.Equal(t, "to_tsvector('simple', name) @@ to_tsquery('simple', ?)", sql)
+	assert.Equal(t, []any{"Dock:* & B:* & 12:*"}, args)
+
+	_, ok = filter.Search("name", " & !")
+	assert.False(t, ok)
+}
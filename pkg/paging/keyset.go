@@ -0,0 +1,180 @@
+package paging
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+	"github.com/tuanvumaihuynh/roboflow/pkg/xerror"
+)
+
+var (
+	ErrInvalidCursor = xerror.BadRequest(nil, "paging.invalidCursor", "Invalid cursor, it must come from a list with the same sort")
+)
+
+// Keyset pages through a list by the sort key of its items, the page after a
+// cursor holds the items following the one the cursor was made from. Unlike
+// OFFSET, reading a page does not scan the pages before it.
+type Keyset struct {
+	order    []sort.Sort
+	nullable []string
+}
+
+// NewKeyset returns the keyset of a list sorted by sorts, the idCol column
+// breaking the ties in the direction of the last sort. The nullable columns
+// may hold NULL, which Postgres sorts last in ascending order and first in
+// descending order.
+func NewKeyset(sorts []sort.Sort, idCol string, nullable ...string) Keyset {
+	order := slices.Clone(sorts)
+	direction := sort.OrderASC
+	if len(sorts) > 0 {
+		direction = sorts[len(sorts)-1].Order
+	}
+	order = append(order, sort.Sort{Col: idCol, Order: direction})
+
+	return Keyset{order: order, nullable: nullable}
+}
+
+// cursor is the payload of an opaque cursor.
+type cursor struct {
+	// Order is the order the cursor was made for.
+	Order string `json:"o"`
+	// Values are the values of the sort key of the item.
+	Values []any `json:"v"`
+}
+
+// Attach orders the builder by the keyset and, when c is not empty, selects
+// the items after the cursor.
+func (k Keyset) Attach(b sq.SelectBuilder, c string) (sq.SelectBuilder, error) {
+	if c != "" {
+		values, err := k.decode(c)
+		if err != nil {
+			return b, err
+		}
+		b = b.Where(k.after(values))
+	}
+
+	for _, s := range k.order {
+		b = s.Attach(b)
+	}
+	return b, nil
+}
+
+// Cursor returns the cursor of the item whose values by column are key.
+func (k Keyset) Cursor(key map[string]any) (string, error) {
+	values := make([]any, len(k.order))
+	for i, s := range k.order {
+		v, ok := key[s.Col]
+		if !ok {
+			return "", fmt.Errorf("missing value of sort column %s", s.Col)
+		}
+		values[i] = v
+	}
+
+	b, err := json.Marshal(cursor{Order: k.orderString(), Values: values})
+	if err != nil {
+		return "", fmt.Errorf("marshal cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (k Keyset) decode(c string) ([]any, error) {
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var payload cursor
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if payload.Order != k.orderString() || len(payload.Values) != len(k.order) {
+		return nil, ErrInvalidCursor
+	}
+	for _, v := range payload.Values {
+		switch v.(type) {
+		case nil, string, float64, bool:
+		default:
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	return payload.Values, nil
+}
+
+func (k Keyset) orderString() string {
+	parts := make([]string, len(k.order))
+	for i, s := range k.order {
+		parts[i] = s.Col + " " + s.Order
+	}
+	return strings.Join(parts, ",")
+}
+
+// after returns the condition selecting the items after the values. When the
+// columns are sorted in the same direction and are not nullable, it is a row
+// comparison an index on the columns serves.
+func (k Keyset) after(values []any) sq.Sqlizer {
+	if k.uniform() {
+		cols := make([]string, len(k.order))
+		placeholders := make([]string, len(k.order))
+		for i, s := range k.order {
+			cols[i] = s.Col
+			placeholders[i] = "?"
+		}
+		op := ">"
+		if k.order[0].Order == sort.OrderDESC {
+			op = "<"
+		}
+		return sq.Expr(
+			fmt.Sprintf("(%s) %s (%s)", strings.Join(cols, ", "), op, strings.Join(placeholders, ", ")),
+			values...,
+		)
+	}
+
+	// An item is after the values when it has the same values for the
+	// first columns and is after the value of the next one.
+	or := sq.Or{}
+	for i, s := range k.order {
+		and := sq.And{}
+		for j := 0; j < i; j++ {
+			and = append(and, sq.Eq{k.order[j].Col: values[j]})
+		}
+		and = append(and, k.columnAfter(s, values[i]))
+		or = append(or, and)
+	}
+	return or
+}
+
+func (k Keyset) uniform() bool {
+	for _, s := range k.order {
+		if s.Order != k.order[0].Order || slices.Contains(k.nullable, s.Col) {
+			return false
+		}
+	}
+	return true
+}
+
+// columnAfter returns the condition selecting the values of the column after
+// v, NULL being sorted last in ascending order and first in descending order.
+func (k Keyset) columnAfter(s sort.Sort, v any) sq.Sqlizer {
+	nullable := slices.Contains(k.nullable, s.Col)
+	if s.Order == sort.OrderDESC {
+		if v == nil {
+			return sq.NotEq{s.Col: nil}
+		}
+		return sq.Lt{s.Col: v}
+	}
+
+	if v == nil {
+		return sq.Expr("FALSE")
+	}
+	if nullable {
+		return sq.Or{sq.Gt{s.Col: v}, sq.Eq{s.Col: nil}}
+	}
+	return sq.Gt{s.Col: v}
+}
@@ -0,0 +1,125 @@
+package paging_test
+
+import (
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/paging"
+	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
+)
+
+func TestKeyset(t *testing.T) {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	tests := []struct {
+		name     string
+		sorts    []sort.Sort
+		nullable []string
+		key      map[string]any
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "uniform order uses a row comparison",
+			sorts:    []sort.Sort{{Col: "created_at", Order: sort.OrderDESC}},
+			key:      map[string]any{"created_at": createdAt, "id": "b"},
+			wantSQL:  "SELECT * FROM t WHERE (created_at, id) < (?, ?) ORDER BY created_at DESC, id DESC",
+			wantArgs: []any{"2026-01-02T03:04:05.000006Z", "b"},
+		},
+		{
+			name:     "mixed order",
+			sorts:    []sort.Sort{{Col: "status", Order: sort.OrderASC}, {Col: "created_at", Order: sort.OrderDESC}},
+			key:      map[string]any{"status": "DONE", "created_at": createdAt, "id": "b"},
+			wantSQL:  "SELECT * FROM t WHERE ((status > ?) OR (status = ? AND created_at < ?) OR (status = ? AND created_at = ? AND id < ?)) ORDER BY status ASC, created_at DESC, id DESC",
+			wantArgs: []any{"DONE", "DONE", "2026-01-02T03:04:05.000006Z", "DONE", "2026-01-02T03:04:05.000006Z", "b"},
+		},
+		{
+			name:     "nullable ascending column after a value",
+			sorts:    []sort.Sort{{Col: "completed_at", Order: sort.OrderASC}},
+			nullable: []string{"completed_at"},
+			key:      map[string]any{"completed_at": &createdAt, "id": "b"},
+			wantSQL:  "SELECT * FROM t WHERE (((completed_at > ? OR completed_at IS NULL)) OR (completed_at = ? AND id > ?)) ORDER BY completed_at ASC, id ASC",
+			wantArgs: []any{"2026-01-02T03:04:05.000006Z", "2026-01-02T03:04:05.000006Z", "b"},
+		},
+		{
+			name:     "nullable ascending column after null",
+			sorts:    []sort.Sort{{Col: "completed_at", Order: sort.OrderASC}},
+			nullable: []string{"completed_at"},
+			key:      map[string]any{"completed_at": (*time.Time)(nil), "id": "b"},
+			wantSQL:  "SELECT * FROM t WHERE ((FALSE) OR (completed_at IS NULL AND id > ?)) ORDER BY completed_at ASC, id ASC",
+			wantArgs: []any{"b"},
+		},
+		{
+			name:     "nullable descending column after null",
+			sorts:    []sort.Sort{{Col: "completed_at", Order: sort.OrderDESC}},
+			nullable: []string{"completed_at"},
+			key:      map[string]any{"completed_at": nil, "id": "b"},
+			wantSQL:  "SELECT * FROM t WHERE ((completed_at IS NOT NULL) OR (completed_at IS NULL AND id < ?)) ORDER BY completed_at DESC, id DESC",
+			wantArgs: []any{"b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyset := paging.NewKeyset(tt.sorts, "id", tt.nullable...)
+
+			cursor, err := keyset.Cursor(tt.key)
+			require.NoError(t, err)
+
+			b, err := keyset.Attach(sq.Select("*").From("t"), cursor)
+			require.NoError(t, err)
+
+			sql, args, err := b.ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestKeysetWithoutCursor(t *testing.T) {
+	keyset := paging.NewKeyset(nil, "id")
+
+	b, err := keyset.Attach(sq.Select("*").From("t"), "")
+	require.NoError(t, err)
+
+	sql, _, err := b.ToSql()
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM t ORDER BY id ASC", sql)
+}
+
+func TestKeysetInvalidCursor(t *testing.T) {
+	byCreatedAt := paging.NewKeyset([]sort.Sort{{Col: "created_at", Order: sort.OrderDESC}}, "id")
+	byStatus := paging.NewKeyset([]sort.Sort{{Col: "status", Order: sort.OrderDESC}}, "id")
+
+	otherSort, err := byStatus.Cursor(map[string]any{"status": "DONE", "id": "b"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "!!"},
+		{name: "not json", cursor: "bm90IGpzb24"},
+		{name: "other sort", cursor: otherSort},
+		{name: "object value", cursor: "eyJvIjoiY3JlYXRlZF9hdCBERVNDLGlkIERFU0MiLCJ2Ijpbe30sImIiXX0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := byCreatedAt.Attach(sq.Select("*").From("t"), tt.cursor)
+			assert.ErrorIs(t, err, paging.ErrInvalidCursor)
+		})
+	}
+}
+
+func TestKeysetCursorMissingColumn(t *testing.T) {
+	keyset := paging.NewKeyset([]sort.Sort{{Col: "created_at", Order: sort.OrderDESC}}, "id")
+
+	_, err := keyset.Cursor(map[string]any{"id": "b"})
+	assert.Error(t, err)
+}
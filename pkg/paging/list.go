@@ -4,6 +4,12 @@ package paging
 type List[T any] struct {
 	Items      []T
 	TotalItems int64
+	// Counted is false when the total items were not counted, see
+	// Params.SkipCount.
+	Counted bool
+	// NextCursor selects the page after Items, see Params.Cursor. It is empty
+	// on the last page, or when the list does not support cursors.
+	NextCursor string
 }
 
 // NewList creates a new List instance with total item.
@@ -11,5 +17,13 @@ func NewList[T any](items []T, totalItems int64) List[T] {
 	return List[T]{
 		Items:      items,
 		TotalItems: totalItems,
+		Counted:    true,
+	}
+}
+
+// NewUncountedList creates a new List instance without total item.
+func NewUncountedList[T any](items []T) List[T] {
+	return List[T]{
+		Items: items,
 	}
 }
@@ -17,3 +17,13 @@ func TestListCreation(t *testing.T) {
 	assert.Equal(t, items, list.Items)
 	assert.Equal(t, totalItems, list.TotalItems)
 }
+
+func TestUncountedListCreation(t *testing.T) {
+	items := []string{"item1", "item2"}
+
+	list := paging.NewUncountedList(items)
+
+	assert.Equal(t, items, list.Items)
+	assert.False(t, list.Counted)
+	assert.True(t, paging.NewList(items, 2).Counted)
+}
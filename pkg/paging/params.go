@@ -9,6 +9,12 @@ const (
 type Params struct {
 	PageSize uint `validate:"required,min=1"`
 	Page     uint `validate:"required,min=1"`
+	// Cursor, when set, selects the page after the item the cursor was made
+	// from instead of Page. Only the lists returning a NextCursor support it.
+	Cursor string
+	// SkipCount skips counting the total items, which scans every item. Only
+	// the lists returning a NextCursor support it.
+	SkipCount bool
 }
 
 // NewParams creates a new Params instance with page size and page.
@@ -33,7 +39,12 @@ func NewParams(pageSize, page *uint, options ...ParamsOption) Params {
 	return p
 }
 
+// Offset returns the number of items before the page, zero when the page is
+// selected by Cursor.
 func (p Params) Offset() uint {
+	if p.Cursor != "" {
+		return 0
+	}
 	return (p.Page - 1) * p.PageSize
 }
 
@@ -62,3 +73,19 @@ func WithMaxPageSize(size uint) ParamsOption {
 		}
 	}
 }
+
+// WithCursor selects the page after the cursor, when it is not nil or empty.
+func WithCursor(cursor *string) ParamsOption {
+	return func(p *Params) {
+		if cursor != nil {
+			p.Cursor = *cursor
+		}
+	}
+}
+
+// WithSkipCount skips counting the total items, when count is false.
+func WithSkipCount(count *bool) ParamsOption {
+	return func(p *Params) {
+		p.SkipCount = count != nil && !*count
+	}
+}
@@ -54,3 +54,17 @@ func TestNewParams_WithZeroPage(t *testing.T) {
 	assert.Equal(t, uint(0), params.Offset())
 	assert.Equal(t, uint(15), params.Limit())
 }
+
+func TestNewParams_WithCursor(t *testing.T) {
+	pageSize := uint(20)
+	page := uint(3)
+	cursor := "abc"
+	count := false
+
+	params := paging.NewParams(&pageSize, &page, paging.WithCursor(&cursor), paging.WithSkipCount(&count))
+
+	assert.Equal(t, "abc", params.Cursor)
+	assert.True(t, params.SkipCount)
+	assert.Equal(t, uint(0), params.Offset()) // The cursor selects the page
+	assert.False(t, paging.NewParams(nil, nil, paging.WithSkipCount(nil)).SkipCount)
+}
@@ -0,0 +1,73 @@
+package qrlabel
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	// pageMargin is the margin of the A4 sheets, in millimetres.
+	pageMargin = 10.0
+	// labelGap is the space between the labels of a sheet, in millimetres,
+	// to cut them apart.
+	labelGap = 5.0
+	// ptPerMM converts millimetres to font points.
+	ptPerMM = 72 / 25.4
+)
+
+// PDF renders the labels on A4 sheets, in rows, each label size millimetres
+// wide. The codes are drawn as vector shapes, so they print sharp at any
+// size.
+func PDF(labels []Label, size float64, level Level) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(false, pageMargin)
+	pdf.SetFillColor(0, 0, 0)
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	captionHeight := size * captionRatio
+	labelHeight := size + captionHeight
+	columns := int((pageWidth - 2*pageMargin + labelGap) / (size + labelGap))
+	rows := int((pageHeight - 2*pageMargin + labelGap) / (labelHeight + labelGap))
+	if columns == 0 || rows == 0 {
+		return nil, fmt.Errorf("%w: %gmm labels do not fit on a page", ErrInvalidSize, size)
+	}
+
+	for i, label := range labels {
+		m, err := modules(label.Content, level)
+		if err != nil {
+			return nil, fmt.Errorf("label %d: %w", i, err)
+		}
+
+		slot := i % (columns * rows)
+		if slot == 0 {
+			pdf.AddPage()
+		}
+		x := pageMargin + float64(slot%columns)*(size+labelGap)
+		y := pageMargin + float64(slot/columns)*(labelHeight+labelGap)
+
+		moduleSize := size / float64(len(m))
+		for _, r := range darkRuns(m) {
+			pdf.Rect(x+float64(r.x)*moduleSize, y+float64(r.y)*moduleSize, float64(r.width)*moduleSize, moduleSize, "F")
+		}
+
+		if label.Caption != "" {
+			fontSize := captionHeight * 0.6 * ptPerMM
+			pdf.SetFont("Helvetica", "", fontSize)
+			if width := pdf.GetStringWidth(label.Caption); width > size*0.9 {
+				fontSize *= size * 0.9 / width
+				pdf.SetFont("Helvetica", "", fontSize)
+			}
+			pdf.SetXY(x, y+size)
+			pdf.CellFormat(size, captionHeight, label.Caption, "", 0, "CM", false, 0, "")
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("output pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
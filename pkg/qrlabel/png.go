@@ -0,0 +1,102 @@
+package qrlabel
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+var captionFont = sync.OnceValues(func() (*opentype.Font, error) {
+	return opentype.Parse(goregular.TTF)
+})
+
+// PNG renders the label as a PNG image size pixels wide. The modules are
+// scaled by a whole number of pixels to stay sharp, the code is centered
+// when they do not fill the width.
+func PNG(label Label, size int, level Level) ([]byte, error) {
+	m, err := modules(label.Content, level)
+	if err != nil {
+		return nil, err
+	}
+
+	scale := size / len(m)
+	if scale == 0 {
+		return nil, fmt.Errorf("%w: %d pixels for %d modules", ErrInvalidSize, size, len(m))
+	}
+	offset := (size - scale*len(m)) / 2
+	captionHeight := int(float64(size) * captionRatio)
+
+	img := image.NewGray(image.Rect(0, 0, size, size+captionHeight))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	for _, r := range darkRuns(m) {
+		rect := image.Rect(
+			offset+r.x*scale,
+			offset+r.y*scale,
+			offset+(r.x+r.width)*scale,
+			offset+(r.y+1)*scale,
+		)
+		draw.Draw(img, rect, image.Black, image.Point{}, draw.Src)
+	}
+
+	if err := drawCaption(img, label.Caption, size, captionHeight); err != nil {
+		return nil, fmt.Errorf("draw caption: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawCaption draws the caption centered below the code, shrinking it to fit
+// the width of the label.
+func drawCaption(img draw.Image, caption string, size, captionHeight int) error {
+	if caption == "" || captionHeight == 0 {
+		return nil
+	}
+
+	f, err := captionFont()
+	if err != nil {
+		return fmt.Errorf("parse font: %w", err)
+	}
+
+	fontSize := float64(captionHeight) * 0.6
+	maxWidth := float64(size) * 0.9
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: fontSize, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return fmt.Errorf("new face: %w", err)
+	}
+	if width := float64(font.MeasureString(face, caption)) / 64; width > maxWidth {
+		face.Close()
+		face, err = opentype.NewFace(f, &opentype.FaceOptions{Size: fontSize * maxWidth / width, DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			return fmt.Errorf("new face: %w", err)
+		}
+	}
+	defer face.Close()
+
+	d := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(color.Black),
+		Face: face,
+	}
+	width := d.MeasureString(caption)
+	metrics := face.Metrics()
+	d.Dot = fixed.Point26_6{
+		X: (fixed.I(size) - width) / 2,
+		Y: fixed.I(size+captionHeight/2) + (metrics.Ascent-metrics.Descent)/2,
+	}
+	d.DrawString(caption)
+
+	return nil
+}
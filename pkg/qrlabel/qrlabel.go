@@ -0,0 +1,155 @@
+// Package qrlabel renders QR codes as printable labels, with a caption below
+// the code.
+package qrlabel
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+
+	"github.com/boombuler/barcode/qr"
+)
+
+// Level is the error-correction level of a QR code, the share of a damaged
+// code which can still be read. The higher the level, the denser the code.
+type Level string
+
+const (
+	// LevelLow recovers 7% of the code.
+	LevelLow Level = "L"
+	// LevelMedium recovers 15% of the code.
+	LevelMedium Level = "M"
+	// LevelQuartile recovers 25% of the code.
+	LevelQuartile Level = "Q"
+	// LevelHigh recovers 30% of the code.
+	LevelHigh Level = "H"
+)
+
+var levelMap = map[Level]qr.ErrorCorrectionLevel{
+	LevelLow:      qr.L,
+	LevelMedium:   qr.M,
+	LevelQuartile: qr.Q,
+	LevelHigh:     qr.H,
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (l *Level) UnmarshalText(text []byte) error {
+	level := Level(text)
+	if _, ok := levelMap[level]; !ok {
+		return fmt.Errorf("invalid Level: %s", text)
+	}
+	*l = level
+	return nil
+}
+
+// Format is the image format of a single label.
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+)
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (f *Format) UnmarshalText(text []byte) error {
+	format := Format(text)
+	if format != FormatPNG && format != FormatSVG {
+		return fmt.Errorf("invalid Format: %s", text)
+	}
+	*f = format
+	return nil
+}
+
+const (
+	// DefaultLevel is the error-correction level of the labels when none is
+	// given.
+	DefaultLevel = LevelMedium
+	// DefaultImageSize is the width of a PNG or SVG label, in pixels.
+	DefaultImageSize = 256
+	// DefaultPDFSize is the width of a label on a PDF sheet, in millimetres.
+	DefaultPDFSize = 50
+)
+
+// ErrInvalidSize is returned when a label is too small to give a pixel to
+// each module of its code, or too large to fit on a sheet.
+var ErrInvalidSize = errors.New("invalid label size")
+
+// quietZone is the blank margin around a QR code, in modules, scanners need
+// to find it.
+const quietZone = 4
+
+// captionRatio is the height of the caption relative to the width of the
+// label.
+const captionRatio = 1.0 / 6
+
+// Label is a QR code encoding Content, with Caption printed below it.
+type Label struct {
+	Content string
+	Caption string
+}
+
+// Render renders the label as a square QR code of size pixels, quiet zone
+// included, with the caption below it.
+func Render(label Label, format Format, size int, level Level) ([]byte, error) {
+	switch format {
+	case FormatPNG:
+		return PNG(label, size, level)
+	case FormatSVG:
+		return SVG(label, size, level)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// modules encodes content and returns its modules, indexed by row then
+// column, the dark ones being true. The quiet zone is included.
+func modules(content string, level Level) ([][]bool, error) {
+	ecl, ok := levelMap[level]
+	if !ok {
+		return nil, fmt.Errorf("invalid level: %s", level)
+	}
+
+	code, err := qr.Encode(content, ecl, qr.Auto)
+	if err != nil {
+		return nil, fmt.Errorf("encode qr code: %w", err)
+	}
+
+	dim := code.Bounds().Dx()
+	m := make([][]bool, dim+2*quietZone)
+	for y := range m {
+		m[y] = make([]bool, dim+2*quietZone)
+	}
+	for y := 0; y < dim; y++ {
+		for x := 0; x < dim; x++ {
+			gray := color.GrayModel.Convert(code.At(x, y)).(color.Gray)
+			m[y+quietZone][x+quietZone] = gray.Y < 128
+		}
+	}
+
+	return m, nil
+}
+
+// run is a horizontal run of dark modules.
+type run struct {
+	x, y, width int
+}
+
+// darkRuns returns the horizontal runs of dark modules, which draw the code
+// with far fewer shapes than one per module.
+func darkRuns(m [][]bool) []run {
+	runs := []run{}
+	for y, row := range m {
+		for x := 0; x < len(row); {
+			if !row[x] {
+				x++
+				continue
+			}
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			runs = append(runs, run{x: start, y: y, width: x - start})
+		}
+	}
+	return runs
+}
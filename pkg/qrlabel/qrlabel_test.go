@@ -0,0 +1,115 @@
+package qrlabel_test
+
+import (
+	"bytes"
+	"image/png"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/qrlabel"
+)
+
+var label = qrlabel.Label{Content: "location-12", Caption: "Loading Dock 12"}
+
+func TestLevelUnmarshalText(t *testing.T) {
+	var l qrlabel.Level
+	require.NoError(t, l.UnmarshalText([]byte("Q")))
+	assert.Equal(t, qrlabel.LevelQuartile, l)
+
+	assert.Error(t, l.UnmarshalText([]byte("X")))
+}
+
+func TestPNG(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int
+		wantWidth  int
+		wantHeight int
+		expectErr  bool
+	}{
+		{name: "default size", size: 256, wantWidth: 256, wantHeight: 298},
+		{name: "size not a multiple of the modules", size: 100, wantWidth: 100, wantHeight: 116},
+		{name: "too small for the modules", size: 20, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := qrlabel.PNG(label, tt.size, qrlabel.LevelMedium)
+			if tt.expectErr {
+				assert.ErrorIs(t, err, qrlabel.ErrInvalidSize)
+				return
+			}
+			require.NoError(t, err)
+
+			img, err := png.Decode(bytes.NewReader(b))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantWidth, img.Bounds().Dx())
+			assert.Equal(t, tt.wantHeight, img.Bounds().Dy())
+		})
+	}
+}
+
+func TestPNGLevelChangesTheCode(t *testing.T) {
+	low, err := qrlabel.PNG(label, 256, qrlabel.LevelLow)
+	require.NoError(t, err)
+	high, err := qrlabel.PNG(label, 256, qrlabel.LevelHigh)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, low, high)
+}
+
+func TestSVG(t *testing.T) {
+	b, err := qrlabel.SVG(qrlabel.Label{Content: "location-12", Caption: "Dock <A> & B"}, 256, qrlabel.LevelMedium)
+	require.NoError(t, err)
+
+	svg := string(b)
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="256" height="298.67"`), svg)
+	assert.Contains(t, svg, "Dock &lt;A&gt; &amp; B</text>")
+	// The top left finder pattern starts after the quiet zone.
+	assert.Contains(t, svg, `d="M4 4h7v1h-7z`)
+}
+
+func TestSVGInvalidLevel(t *testing.T) {
+	_, err := qrlabel.SVG(label, 256, qrlabel.Level("X"))
+	assert.Error(t, err)
+}
+
+func TestPDF(t *testing.T) {
+	tests := []struct {
+		name      string
+		labels    int
+		size      float64
+		wantPages int
+		expectErr bool
+	}{
+		// 3 columns and 4 rows of 50mm labels fit on A4.
+		{name: "one page", labels: 12, size: 50, wantPages: 1},
+		{name: "two pages", labels: 13, size: 50, wantPages: 2},
+		{name: "one label per page", labels: 2, size: 180, wantPages: 2},
+		{name: "larger than a page", labels: 1, size: 250, expectErr: true},
+	}
+
+	pageRegex := regexp.MustCompile(`/Type /Page\b`)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels := make([]qrlabel.Label, tt.labels)
+			for i := range labels {
+				labels[i] = label
+			}
+
+			b, err := qrlabel.PDF(labels, tt.size, qrlabel.LevelMedium)
+			if tt.expectErr {
+				assert.ErrorIs(t, err, qrlabel.ErrInvalidSize)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.True(t, bytes.HasPrefix(b, []byte("%PDF-")))
+			assert.Len(t, pageRegex.FindAll(b, -1), tt.wantPages)
+		})
+	}
+}
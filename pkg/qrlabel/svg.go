@@ -0,0 +1,52 @@
+package qrlabel
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// SVG renders the label as an SVG image size pixels wide. The image is
+// drawn in modules, so it scales without blurring.
+func SVG(label Label, size int, level Level) ([]byte, error) {
+	m, err := modules(label.Content, level)
+	if err != nil {
+		return nil, err
+	}
+
+	n := float64(len(m))
+	captionHeight := n * captionRatio
+	height := float64(size) * (1 + captionRatio)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%s" viewBox="0 0 %d %s">`,
+		size, formatFloat(height), len(m), formatFloat(n+captionHeight))
+	fmt.Fprintf(&buf, `<rect width="100%%" height="100%%" fill="#fff"/>`)
+
+	buf.WriteString(`<path fill="#000" shape-rendering="crispEdges" d="`)
+	for _, r := range darkRuns(m) {
+		fmt.Fprintf(&buf, "M%d %dh%dv1h-%dz", r.x, r.y, r.width, r.width)
+	}
+	buf.WriteString(`"/>`)
+
+	if label.Caption != "" {
+		// Average glyphs are about 0.6em wide, shrink long captions to fit
+		// 90% of the width.
+		fontSize := math.Min(captionHeight*0.6, n*0.9/(0.6*float64(len([]rune(label.Caption)))))
+		fmt.Fprintf(&buf, `<text x="%s" y="%s" font-family="sans-serif" font-size="%s" text-anchor="middle" dominant-baseline="central">`,
+			formatFloat(n/2), formatFloat(n+captionHeight/2), formatFloat(fontSize))
+		if err := xml.EscapeText(&buf, []byte(label.Caption)); err != nil {
+			return nil, fmt.Errorf("escape caption: %w", err)
+		}
+		buf.WriteString(`</text>`)
+	}
+
+	buf.WriteString(`</svg>`)
+	return buf.Bytes(), nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-playground/validator/v10"
 
+	"github.com/tuanvumaihuynh/roboflow/pkg/filter"
 	"github.com/tuanvumaihuynh/roboflow/pkg/sort"
 )
 
@@ -25,6 +26,7 @@ func newValidator() *validator.Validate {
 	})
 
 	_ = v10.RegisterValidation("sort", validateSortFields)
+	_ = v10.RegisterValidation("filter", validateFilterFields)
 	_ = v10.RegisterValidation("enum", validateEnum)
 	return v10
 }
@@ -49,6 +51,36 @@ func validateSortFields(fl validator.FieldLevel) bool {
 	return true
 }
 
+// validateFilterFields validates the filters against the fields of the
+// param, separated by spaces. A field may name the kind of its values, such
+// as created_at:time or status:oneof=PENDING/RUNNING.
+func validateFilterFields(fl validator.FieldLevel) bool {
+	allowedFields := make(map[string]filter.Kind)
+	for _, field := range strings.Split(fl.Param(), " ") {
+		name, kind, _ := strings.Cut(field, ":")
+		allowedFields[name] = filter.Kind(kind)
+	}
+
+	value := fl.Field()
+	if value.Kind() != reflect.Slice {
+		return false
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		f := value.Index(i).Interface().(filter.Filter)
+		kind, ok := allowedFields[f.Col]
+		if !ok {
+			return false
+		}
+		for _, v := range f.Values {
+			if !kind.Valid(v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func validateEnum(fl validator.FieldLevel) bool {
 	field := fl.Field()
 	fieldType := field.Type()
@@ -95,10 +127,14 @@ func ValidationErrorMessage(fe validator.FieldError) string {
 		return "must contain only alphanumeric characters and spaces"
 	case "ip":
 		return "must be a valid IP address"
+	case "http_url":
+		return "must be a valid HTTP or HTTPS URL"
 	case "enum":
 		return fmt.Sprintf("invalid enum value: %s", fe.Value())
 	case "sort":
 		return fmt.Sprintf("must contain only allowed sort fields: [%s]", fe.Param())
+	case "filter":
+		return fmt.Sprintf("must contain only allowed filter fields and values of their kind: [%s]", fe.Param())
 	default:
 		return "is invalid"
 	}
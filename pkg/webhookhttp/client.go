@@ -0,0 +1,85 @@
+// Package webhookhttp sends signed webhook requests over HTTP.
+package webhookhttp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	HeaderSignature = "X-Roboflow-Signature"
+	HeaderEvent     = "X-Roboflow-Event"
+	HeaderDelivery  = "X-Roboflow-Delivery"
+	HeaderTimestamp = "X-Roboflow-Timestamp"
+
+	userAgent = "Roboflow-Webhook/1"
+
+	// maxErrorBodySize is the maximum size of the response body kept in the
+	// error of a failed request.
+	maxErrorBodySize = 512
+)
+
+// Request is a webhook request.
+type Request struct {
+	URL        string
+	Secret     string
+	EventType  string
+	DeliveryID string
+	Body       []byte
+}
+
+// Sender sends webhook requests.
+type Sender interface {
+	// Send posts the request and returns the status code of the response.
+	// A response with a non 2xx status code is an error.
+	Send(ctx context.Context, req Request) (int, error)
+}
+
+var _ Sender = (*Client)(nil)
+
+// Client is a Sender using an HTTP client.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a new Client, each request times out after timeout.
+func NewClient(timeout time.Duration) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *Client) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+
+	now := time.Now()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(HeaderEvent, req.EventType)
+	httpReq.Header.Set(HeaderDelivery, req.DeliveryID)
+	httpReq.Header.Set(HeaderTimestamp, fmt.Sprintf("%d", now.Unix()))
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, now, req.Body))
+
+	res, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+	// Drain the rest of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("unexpected status code %d: %s", res.StatusCode, bytes.TrimSpace(body))
+	}
+
+	return res.StatusCode, nil
+}
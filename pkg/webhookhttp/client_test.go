@@ -0,0 +1,90 @@
+package webhookhttp_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/webhookhttp"
+)
+
+func TestClientSend(t *testing.T) {
+	body := []byte(`{"type":"raybot:created"}`)
+
+	t.Run("Signed request", func(t *testing.T) {
+		var received *http.Request
+		var receivedBody []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			receivedBody, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := webhookhttp.NewClient(time.Second)
+		status, err := client.Send(context.Background(), webhookhttp.Request{
+			URL:        server.URL,
+			Secret:     "secret",
+			EventType:  "raybot:created",
+			DeliveryID: "delivery-id",
+			Body:       body,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		require.NotNil(t, received)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, "raybot:created", received.Header.Get(webhookhttp.HeaderEvent))
+		assert.Equal(t, "delivery-id", received.Header.Get(webhookhttp.HeaderDelivery))
+		assert.NotEmpty(t, received.Header.Get(webhookhttp.HeaderTimestamp))
+		assert.Equal(t, body, receivedBody)
+		assert.NoError(t, webhookhttp.Verify("secret", received.Header.Get(webhookhttp.HeaderSignature), receivedBody, time.Minute))
+	})
+
+	t.Run("Non 2xx status code", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := webhookhttp.NewClient(time.Second)
+		status, err := client.Send(context.Background(), webhookhttp.Request{URL: server.URL, Body: body})
+		assert.ErrorContains(t, err, "unavailable")
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-done:
+			case <-r.Context().Done():
+			}
+		}))
+		defer server.Close()
+		defer close(done)
+
+		client := webhookhttp.NewClient(50 * time.Millisecond)
+		status, err := client.Send(context.Background(), webhookhttp.Request{URL: server.URL, Body: body})
+		assert.Error(t, err)
+		assert.Zero(t, status)
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		url := server.URL
+		server.Close()
+
+		client := webhookhttp.NewClient(time.Second)
+		status, err := client.Send(context.Background(), webhookhttp.Request{URL: url, Body: body})
+		assert.Error(t, err)
+		assert.Zero(t, status)
+	})
+}
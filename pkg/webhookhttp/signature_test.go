@@ -0,0 +1,88 @@
+package webhookhttp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tuanvumaihuynh/roboflow/pkg/webhookhttp"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		secret    string
+		header    string
+		body      []byte
+		tolerance time.Duration
+		wantErr   error
+	}{
+		{
+			name:      "valid",
+			secret:    "secret",
+			header:    webhookhttp.Sign("secret", now, body),
+			body:      body,
+			tolerance: time.Minute,
+		},
+		{
+			name:    "wrong secret",
+			secret:  "other",
+			header:  webhookhttp.Sign("secret", now, body),
+			body:    body,
+			wantErr: webhookhttp.ErrSignatureMismatch,
+		},
+		{
+			name:    "tampered body",
+			secret:  "secret",
+			header:  webhookhttp.Sign("secret", now, body),
+			body:    []byte(`{"id":"2"}`),
+			wantErr: webhookhttp.ErrSignatureMismatch,
+		},
+		{
+			name:      "expired",
+			secret:    "secret",
+			header:    webhookhttp.Sign("secret", now.Add(-time.Hour), body),
+			body:      body,
+			tolerance: time.Minute,
+			wantErr:   webhookhttp.ErrSignatureExpired,
+		},
+		{
+			name:   "no tolerance",
+			secret: "secret",
+			header: webhookhttp.Sign("secret", now.Add(-time.Hour), body),
+			body:   body,
+		},
+		{
+			name:    "missing signature",
+			secret:  "secret",
+			header:  "t=1700000000",
+			body:    body,
+			wantErr: webhookhttp.ErrInvalidSignatureHeader,
+		},
+		{
+			name:    "malformed",
+			secret:  "secret",
+			header:  "garbage",
+			body:    body,
+			wantErr: webhookhttp.ErrInvalidSignatureHeader,
+		},
+		{
+			name:    "invalid timestamp",
+			secret:  "secret",
+			header:  "t=abc,v1=00",
+			body:    body,
+			wantErr: webhookhttp.ErrInvalidSignatureHeader,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := webhookhttp.Verify(tc.secret, tc.header, tc.body, tc.tolerance)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
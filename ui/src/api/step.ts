@@ -3,14 +3,18 @@ import type { Paging } from '@/types/paging'
 import type { Step } from '@/types/step'
 import http from '@/lib/http'
 
-export type ListStepSort = 'status' | 'started_at' | 'completed_at'
+export type ListStepSort = 'status' | 'started_at' | 'completed_at' | 'created_at'
 export interface ListStepParams {
+  page?: number
+  pageSize?: number
   sort?: SortPrefix<ListStepSort>[]
 }
 
 const steps = {
   list(workflowExecutionId: string, p: ListStepParams): Promise<Paging<Step>> {
     return http.get(`/workflow-executions/${workflowExecutionId}/steps`, { params: {
+      page: p.page,
+      pageSize: p.pageSize,
       sort: p.sort?.join(','),
     },
     })